@@ -47,7 +47,9 @@ import (
 
 	"realfin/docs"
 	creditscoremodulekeeper "realfin/x/creditscore/keeper"
+	oracleabci "realfin/x/oracle/abci"
 	oraclemodulekeeper "realfin/x/oracle/keeper"
+	oracleprovider "realfin/x/oracle/provider"
 	realestatemodulekeeper "realfin/x/realestate/keeper"
 	realfinmodulekeeper "realfin/x/realfin/keeper"
 	tokenizationmodulekeeper "realfin/x/tokenization/keeper"
@@ -154,6 +156,8 @@ func New(
 			depinject.Supply(
 				appOpts, // supply app options
 				logger,  // supply logger
+				// supply the IBC keeper getter to the modules with an IBC application
+				app.GetIBCKeeper,
 				// here alternative options can be supplied to the DI container.
 				// those options can be used f.e to override the default behavior of some modules.
 				// for instance supplying a custom address codec for not using bech32 addresses.
@@ -205,6 +209,11 @@ func New(
 		panic(err)
 	}
 
+	// register the oracle vote extension and proposal handlers
+	if err := app.registerOracleHandlers(logger, appOpts); err != nil {
+		panic(err)
+	}
+
 	/****  Module Options ****/
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
@@ -233,6 +242,34 @@ func New(
 	return app
 }
 
+// registerOracleHandlers wires the ABCI++ handlers through which validators
+// report oracle prices in their vote extensions.
+func (app *App) registerOracleHandlers(logger log.Logger, appOpts servertypes.AppOptions) error {
+	providerCfg := oracleprovider.ConfigFromAppOptions(appOpts)
+	priceProvider, err := oracleprovider.New(providerCfg)
+	if err != nil {
+		return err
+	}
+
+	voteExtHandler := oracleabci.NewVoteExtensionHandler(logger, priceProvider, providerCfg.Timeout)
+	app.SetExtendVoteHandler(voteExtHandler.ExtendVote())
+	app.SetVerifyVoteExtensionHandler(voteExtHandler.VerifyVoteExtension())
+
+	defaultProposalHandler := baseapp.NewDefaultProposalHandler(app.Mempool(), app)
+	proposalHandler := oracleabci.NewProposalHandler(
+		logger,
+		app.OracleKeeper,
+		app.StakingKeeper,
+		defaultProposalHandler.PrepareProposalHandler(),
+		defaultProposalHandler.ProcessProposalHandler(),
+	)
+	app.SetPrepareProposal(proposalHandler.PrepareProposal())
+	app.SetProcessProposal(proposalHandler.ProcessProposal())
+	app.SetPreBlocker(proposalHandler.PreBlocker(app.App.PreBlocker))
+
+	return nil
+}
+
 // GetSubspace returns a param subspace for a given module name.
 func (app *App) GetSubspace(moduleName string) paramstypes.Subspace {
 	subspace, _ := app.ParamsKeeper.GetSubspace(moduleName)
@@ -30,6 +30,11 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	creditscoremodule "realfin/x/creditscore/module"
+	creditscoremoduletypes "realfin/x/creditscore/types"
+	oraclemodule "realfin/x/oracle/module"
+	oraclemoduletypes "realfin/x/oracle/types"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -105,13 +110,17 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		transferStackV2    ibcapi.IBCModule    = ibctransferv2.NewIBCModule(app.TransferKeeper)
 		icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
 		icaHostStack       porttypes.IBCModule = icahost.NewIBCModule(app.ICAHostKeeper)
+		oracleStack        porttypes.IBCModule = oraclemodule.NewIBCModule(app.appCodec, app.OracleKeeper)
+		creditscoreStack   porttypes.IBCModule = creditscoremodule.NewIBCModule(app.appCodec, app.CreditscoreKeeper)
 	)
 
 	// create IBC v1 router, add transfer route, then set it on the keeper
 	ibcRouter := porttypes.NewRouter().
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
-		AddRoute(icahosttypes.SubModuleName, icaHostStack)
+		AddRoute(icahosttypes.SubModuleName, icaHostStack).
+		AddRoute(oraclemoduletypes.PortID, oracleStack).
+		AddRoute(creditscoremoduletypes.PortID, creditscoreStack)
 
 	// create IBC v2 router, add transfer route, then set it on the keeper
 	ibcv2Router := ibcapi.NewRouter().
@@ -145,6 +154,12 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	return nil
 }
 
+// GetIBCKeeper returns the IBC keeper. It is supplied to the modules through
+// depinject as a getter since the IBC keeper is created after them.
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
 // RegisterIBC Since the IBC modules don't support dependency injection,
 // we need to manually register the modules on the client side.
 // This needs to be removed after IBC supports App Wiring.
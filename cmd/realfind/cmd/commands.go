@@ -52,6 +52,7 @@ func initRootCmd(
 		genutilcli.Commands(txConfig, basicManager, app.DefaultNodeHome),
 		queryCommand(),
 		txCommand(),
+		oracleCommand(),
 		keys.Commands(),
 	)
 }
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+
+	"realfin/x/oracle/feeder"
+)
+
+const (
+	flagMetricsAddr    = "metrics-addr"
+	defaultMetricsAddr = "127.0.0.1:26670"
+)
+
+// oracleCommand returns the oracle sidecar subcommands.
+func oracleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "oracle",
+		Short:                      "Oracle sidecar subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(oracleFeederCmd())
+
+	return cmd
+}
+
+// oracleFeederCmd returns the command running the oracle price feeder.
+func oracleFeederCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeder [config-file]",
+		Short: "Periodically broadcast oracle prices read from files or HTTP endpoints",
+		Long: `Run the oracle price feeder. The feeder reads the JSON configuration file
+listing the symbols to feed and their sources, and every interval computes the
+median value of the sources of each symbol and broadcasts the prices in
+MsgUpdatePrice transactions signed with the --from key. The gas of every
+transaction is estimated by simulation, and the account sequence is reloaded
+from the node after a sequence mismatch. Prometheus metrics are served on
+--metrics-addr.`,
+		Example: "realfind oracle feeder feeder.json --from reporter --chain-id realfin",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := feeder.LoadConfig(args[0])
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			reg := prometheus.NewRegistry()
+			metrics := feeder.NewMetrics(reg)
+			broadcaster, err := feeder.NewBroadcaster(feeder.NewClientNode(clientCtx), clientCtx.TxConfig, txf, cfg.GasAdjustment, metrics)
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+
+			metricsAddr, err := cmd.Flags().GetString(flagMetricsAddr)
+			if err != nil {
+				return err
+			}
+			if metricsAddr != "" {
+				srv := &http.Server{
+					Addr:              metricsAddr,
+					Handler:           promhttp.HandlerFor(reg, promhttp.HandlerOpts{}),
+					ReadHeaderTimeout: 5 * time.Second,
+				}
+				go func() {
+					if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+						logger.Error("metrics server stopped", "err", err)
+					}
+				}()
+				defer srv.Close()
+			}
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			logger.Info("starting oracle feeder", "address", broadcaster.Address().String(), "symbols", len(cfg.Symbols))
+			return feeder.New(cfg, broadcaster, metrics, logger).Run(ctx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagMetricsAddr, defaultMetricsAddr, "Address of the Prometheus metrics endpoint, empty to disable it")
+
+	return cmd
+}
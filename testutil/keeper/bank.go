@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BankKeeper is a bank keeper keeping account and module balances in memory,
+// keyed by bech32 address.
+type BankKeeper struct {
+	Balances map[string]sdk.Coins
+}
+
+// NewBankKeeper returns a bank keeper without balances.
+func NewBankKeeper() *BankKeeper {
+	return &BankKeeper{Balances: make(map[string]sdk.Coins)}
+}
+
+// SpendableCoins returns the balance of the account.
+func (b *BankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.Balances[addr.String()]
+}
+
+// SendCoinsFromAccountToModule moves coins from the account to the module.
+func (b *BankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+// SendCoinsFromModuleToAccount moves coins from the module to the account.
+func (b *BankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+// BurnCoins removes coins from the balance of the module.
+func (b *BankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	balance, hasNeg := b.Balances[addr].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.Balances[addr] = balance
+	return nil
+}
+
+func (b *BankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b.Balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.Balances[from.String()] = balance
+	b.Balances[to.String()] = b.Balances[to.String()].Add(amt...)
+	return nil
+}
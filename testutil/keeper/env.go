@@ -0,0 +1,44 @@
+// Package keeper provides the environment and the in-memory dependencies the
+// keeper tests of the modules build their fixtures on.
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// Env is the environment of a keeper under test: a store of its own, the
+// context to access it, and the codecs of the module.
+type Env struct {
+	Ctx          context.Context
+	Codec        codec.Codec
+	AddressCodec address.Codec
+	StoreService corestore.KVStoreService
+}
+
+// NewEnv returns the environment of the keeper of the module registering its
+// types with appModule, storing under storeKey.
+func NewEnv(t *testing.T, storeKey string, appModule module.AppModuleBasic) Env {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(appModule)
+	key := storetypes.NewKVStoreKey(storeKey)
+
+	return Env{
+		Ctx:          testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx,
+		Codec:        encCfg.Codec,
+		AddressCodec: addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		StoreService: runtime.NewKVStoreService(key),
+	}
+}
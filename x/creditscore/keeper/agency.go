@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"realfin/x/creditscore/types"
+)
+
+// IsAccredited reports whether the address is an agency accredited by
+// governance and not revoked.
+func (k Keeper) IsAccredited(ctx context.Context, address string) (bool, error) {
+	agency, err := k.Agency.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return !agency.Revoked, nil
+}
+
+// withdrawRates marks all the rates issued by the agency as withdrawn and
+// returns the number of rates withdrawn.
+func (k Keeper) withdrawRates(ctx context.Context, address string) (uint64, error) {
+	iter, err := k.Rate.Indexes.Creator.MatchExact(ctx, address)
+	if err != nil {
+		return 0, err
+	}
+	keys, err := iter.PrimaryKeys()
+	if err != nil {
+		return 0, err
+	}
+
+	var withdrawn uint64
+	for _, key := range keys {
+		rate, err := k.Rate.Get(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+		if rate.Withdrawn {
+			continue
+		}
+
+		prev := rate
+		rate.Withdrawn = true
+		if err := k.Rate.Set(ctx, key, rate); err != nil {
+			return 0, err
+		}
+		if err := k.appendRateChange(ctx, types.RateAction_RATE_ACTION_WITHDRAWN, prev, rate, "agency revoked", ""); err != nil {
+			return 0, err
+		}
+		withdrawn++
+	}
+
+	return withdrawn, nil
+}
+
+// symbolRated reports whether the symbol has at least one rate.
+func (k Keeper) symbolRated(ctx context.Context, symbol string) (bool, error) {
+	iter, err := k.Rate.Iterate(ctx, collections.NewPrefixedPairRange[string, string](symbol))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
+
+// symbolRates returns the rates of the symbol by creator.
+func (k Keeper) symbolRates(ctx context.Context, symbol string) ([]types.Rate, error) {
+	var rates []types.Rate
+	err := k.Rate.Walk(ctx, collections.NewPrefixedPairRange[string, string](symbol), func(_ collections.Pair[string, string], rate types.Rate) (bool, error) {
+		rates = append(rates, rate)
+		return false, nil
+	})
+
+	return rates, err
+}
+
+// subjectRates returns the rates of the subject address, whatever their
+// symbol.
+func (k Keeper) subjectRates(ctx context.Context, subject string) ([]types.Rate, error) {
+	iter, err := k.Rate.Indexes.Subject.MatchExact(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.PrimaryKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]types.Rate, 0, len(keys))
+	for _, key := range keys {
+		rate, err := k.Rate.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"realfin/x/creditscore/types"
+	oracletypes "realfin/x/oracle/types"
+)
+
+// CreditLimit returns the credit line of the subject: the oracle value of the
+// spendable balances it holds, in the credit denom, times the loan-to-value
+// ratio of the grade of its consolidated rate, which consolidates the rates of
+// the subject whatever their symbol. Subjects without rating, with all their
+// ratings withdrawn or with a grade out of the curve have no credit, and
+// denoms without fresh price convertible to the credit denom are valued at
+// zero.
+func (k Keeper) CreditLimit(ctx context.Context, subject string) (types.CreditLimit, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.CreditLimit{}, err
+	}
+	moduleAddr, err := k.ModuleAddress()
+	if err != nil {
+		return types.CreditLimit{}, err
+	}
+
+	limit := types.CreditLimit{
+		Subject:         subject,
+		Ltv:             math.LegacyZeroDec(),
+		Denom:           params.CreditDenom,
+		CollateralValue: math.ZeroInt(),
+		Limit:           math.ZeroInt(),
+	}
+
+	subjectAddr, err := k.addressCodec.StringToBytes(subject)
+	if err != nil {
+		return types.CreditLimit{}, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	ratings, err := k.subjectRates(ctx, subject)
+	if err != nil {
+		return types.CreditLimit{}, err
+	}
+	rate, ratings, _, err := k.consolidateRatings(ctx, params, moduleAddr, subject, ratings)
+	if err != nil {
+		return types.CreditLimit{}, err
+	}
+	if len(ratings) > 0 && !rate.Withdrawn {
+		limit.Rate = rate.Rate
+		limit.Grade = rate.Grade
+		limit.Ltv = params.Ltv(rate.Grade)
+	}
+
+	// an empty credit denom disables the credit limits
+	if params.CreditDenom == "" {
+		return limit, nil
+	}
+
+	for _, coin := range k.bankKeeper.SpendableCoins(ctx, subjectAddr) {
+		value, err := k.oracleKeeper.ConvertAmount(ctx, coin.Amount, coin.Denom, params.CreditDenom)
+		switch {
+		case err == nil:
+			limit.Collateral = append(limit.Collateral, types.CollateralValue{Symbol: coin.Denom, Amount: coin.Amount, Value: value, Priced: true})
+			limit.CollateralValue = limit.CollateralValue.Add(value)
+		case errors.Is(err, oracletypes.ErrNoConversionPath), errors.Is(err, oracletypes.ErrStalePrice):
+			limit.Collateral = append(limit.Collateral, types.CollateralValue{Symbol: coin.Denom, Amount: coin.Amount, Value: math.ZeroInt()})
+		default:
+			return types.CreditLimit{}, err
+		}
+	}
+	limit.Limit = limit.Ltv.MulInt(limit.CollateralValue).TruncateInt()
+
+	return limit, nil
+}
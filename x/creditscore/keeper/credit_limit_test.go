@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/creditscore/keeper"
+	"realfin/x/creditscore/types"
+)
+
+func TestCreditLimit(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	agency, err := f.addressCodec.BytesToString([]byte("agencyAddr__________________"))
+	require.NoError(t, err)
+	subject, err := f.addressCodec.BytesToString([]byte("subjectAddr_________________"))
+	require.NoError(t, err)
+	f.registerAgency(t, agency)
+
+	// the collateral is what the subject holds, not what others hold
+	f.bankKeeper.Balances[subject] = sdk.NewCoins(sdk.NewInt64Coin("HOUSE-1", 2), sdk.NewInt64Coin("HOUSE-2", 5))
+	f.bankKeeper.Balances[agency] = sdk.NewCoins(sdk.NewInt64Coin("HOUSE-3", 1))
+	f.oracleKeeper.values["HOUSE-1"] = math.NewInt(500_000)
+	f.oracleKeeper.values["HOUSE-3"] = math.NewInt(5_000_000)
+
+	// no rating, no credit
+	limit, err := f.keeper.CreditLimit(f.ctx, subject)
+	require.NoError(t, err)
+	require.Empty(t, limit.Grade)
+	require.True(t, limit.Ltv.IsZero())
+	require.Equal(t, math.NewInt(1_000_000), limit.CollateralValue)
+	require.True(t, limit.Limit.IsZero())
+
+	// A grade, 70% of the priced collateral, from a rating identifying the
+	// subject by its subject field
+	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: agency, Symbol: "LOAN-1", Subject: subject, Rate: 720})
+	require.NoError(t, err)
+	res, err := qs.CreditLimit(f.ctx, &types.QueryCreditLimitRequest{Subject: subject})
+	require.NoError(t, err)
+	require.Equal(t, uint64(720), res.CreditLimit.Rate)
+	require.Equal(t, "A", res.CreditLimit.Grade)
+	require.Equal(t, math.LegacyNewDecWithPrec(70, 2), res.CreditLimit.Ltv)
+	require.Equal(t, types.DefaultCreditDenom, res.CreditLimit.Denom)
+	require.Equal(t, []types.CollateralValue{
+		{Symbol: "HOUSE-1", Amount: math.NewInt(2), Value: math.NewInt(1_000_000), Priced: true},
+		{Symbol: "HOUSE-2", Amount: math.NewInt(5), Value: math.ZeroInt()},
+	}, res.CreditLimit.Collateral)
+	require.Equal(t, math.NewInt(700_000), res.CreditLimit.Limit)
+
+	// grades out of the curve get no credit
+	_, err = srv.UpdateRate(f.ctx, &types.MsgUpdateRate{Creator: agency, Symbol: "LOAN-1", Rate: 420})
+	require.NoError(t, err)
+	limit, err = f.keeper.CreditLimit(f.ctx, subject)
+	require.NoError(t, err)
+	require.Equal(t, "C", limit.Grade)
+	require.True(t, limit.Limit.IsZero())
+
+	// ratings keyed by the subject address count as well
+	other, err := f.addressCodec.BytesToString([]byte("otherAgencyAddr_____________"))
+	require.NoError(t, err)
+	f.registerAgency(t, other)
+	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: other, Symbol: subject, Rate: 820})
+	require.NoError(t, err)
+	limit, err = f.keeper.CreditLimit(f.ctx, subject)
+	require.NoError(t, err)
+	require.Equal(t, uint64(620), limit.Rate)
+
+	// an empty credit denom disables the credit limits
+	params := types.DefaultParams()
+	params.CreditDenom = ""
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	limit, err = f.keeper.CreditLimit(f.ctx, subject)
+	require.NoError(t, err)
+	require.Empty(t, limit.Collateral)
+	require.True(t, limit.Limit.IsZero())
+
+	_, err = qs.CreditLimit(f.ctx, &types.QueryCreditLimitRequest{})
+	require.Error(t, err)
+}
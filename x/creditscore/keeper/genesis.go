@@ -3,13 +3,57 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	"realfin/x/creditscore/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
 	for _, elem := range genState.RateMap {
-		if err := k.Rate.Set(ctx, elem.Symbol, elem); err != nil {
+		if err := k.Rate.Set(ctx, collections.Join(elem.Symbol, elem.Creator), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.Repayments {
+		if err := k.Repayment.Set(ctx, collections.Join(elem.Borrower, elem.Id), elem); err != nil {
+			return err
+		}
+	}
+	if err := k.RepaymentSeq.Set(ctx, genState.RepaymentSeq); err != nil {
+		return err
+	}
+	for _, elem := range genState.Agencies {
+		if err := k.Agency.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.RateHistory {
+		if err := k.RateHistory.Set(ctx, collections.Join(elem.Symbol, elem.Id), elem); err != nil {
+			return err
+		}
+	}
+	if err := k.RateHistorySeq.Set(ctx, genState.RateHistorySeq); err != nil {
+		return err
+	}
+	for _, elem := range genState.Attestations {
+		if err := k.Attestation.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.AttestationSeq.Set(ctx, genState.AttestationSeq); err != nil {
+		return err
+	}
+	for _, elem := range genState.Disputes {
+		if err := k.Dispute.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.DisputeSeq.Set(ctx, genState.DisputeSeq); err != nil {
+		return err
+	}
+	for _, elem := range genState.ChannelCredits {
+		if err := k.ChannelCredit.Set(ctx, elem.ChannelId, elem); err != nil {
 			return err
 		}
 	}
@@ -26,12 +70,64 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
-	if err := k.Rate.Walk(ctx, nil, func(_ string, val types.Rate) (stop bool, err error) {
+	if err := k.Rate.Walk(ctx, nil, func(_ collections.Pair[string, string], val types.Rate) (stop bool, err error) {
 		genesis.RateMap = append(genesis.RateMap, val)
 		return false, nil
 	}); err != nil {
 		return nil, err
 	}
+	if err := k.Repayment.Walk(ctx, nil, func(_ collections.Pair[string, uint64], val types.RepaymentEvent) (stop bool, err error) {
+		genesis.Repayments = append(genesis.Repayments, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.RepaymentSeq, err = k.RepaymentSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Agency.Walk(ctx, nil, func(_ string, val types.Agency) (stop bool, err error) {
+		genesis.Agencies = append(genesis.Agencies, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.RateHistory.Walk(ctx, nil, func(_ collections.Pair[string, uint64], val types.RateChange) (stop bool, err error) {
+		genesis.RateHistory = append(genesis.RateHistory, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.RateHistorySeq, err = k.RateHistorySeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Attestation.Walk(ctx, nil, func(_ uint64, val types.Attestation) (stop bool, err error) {
+		genesis.Attestations = append(genesis.Attestations, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.AttestationSeq, err = k.AttestationSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Dispute.Walk(ctx, nil, func(_ uint64, val types.Dispute) (stop bool, err error) {
+		genesis.Disputes = append(genesis.Disputes, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.DisputeSeq, err = k.DisputeSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.ChannelCredit.Walk(ctx, nil, func(_ string, val types.ChannelCredit) (stop bool, err error) {
+		genesis.ChannelCredits = append(genesis.ChannelCredits, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
 
 	return genesis, nil
 }
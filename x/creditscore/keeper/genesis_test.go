@@ -1,17 +1,43 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
 	"realfin/x/creditscore/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params:  types.DefaultParams(),
-		RateMap: []types.Rate{{Symbol: "0"}, {Symbol: "1"}}}
+		RateMap: []types.Rate{{Symbol: "0"}, {Symbol: "1"}},
+		Repayments: []types.RepaymentEvent{
+			{Borrower: "0", Id: 0, Kind: types.RepaymentKind_REPAYMENT_KIND_ON_TIME},
+			{Borrower: "1", Id: 1, Kind: types.RepaymentKind_REPAYMENT_KIND_LATE, DaysLate: 2},
+		},
+		RepaymentSeq: 2,
+		Agencies:     []types.Agency{{Address: "0", Name: "Acme Ratings"}, {Address: "1", Revoked: true}},
+		RateHistory: []types.RateChange{
+			{Symbol: "0", Id: 0, Action: types.RateAction_RATE_ACTION_CREATED, Rate: 700},
+			{Symbol: "0", Id: 1, Action: types.RateAction_RATE_ACTION_UPDATED, PreviousRate: 700, Rate: 650, Reason: "downgrade"},
+		},
+		RateHistorySeq: 2,
+		Attestations: []types.Attestation{
+			{Id: 0, Agency: "0", Commitment: strings.Repeat("ab", 32)},
+			{Id: 1, Agency: "1", Commitment: strings.Repeat("cd", 32), Revoked: true},
+		},
+		AttestationSeq: 2,
+		Disputes: []types.Dispute{
+			{Id: 0, Symbol: "0", Agency: "0", Subject: "1", Reason: "outdated", Status: types.DisputeStatus_DISPUTE_STATUS_OPEN},
+		},
+		DisputeSeq: 1,
+		ChannelCredits: []types.ChannelCredit{
+			{ChannelId: "channel-0", Balance: sdk.NewCoins(sdk.NewInt64Coin("urlf", 10))},
+			{ChannelId: "channel-1"},
+		}}
 
 	f := initFixture(t)
 	err := f.keeper.InitGenesis(f.ctx, genesisState)
@@ -22,5 +48,15 @@ func TestGenesis(t *testing.T) {
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
 	require.EqualExportedValues(t, genesisState.RateMap, got.RateMap)
+	require.EqualExportedValues(t, genesisState.Repayments, got.Repayments)
+	require.Equal(t, genesisState.RepaymentSeq, got.RepaymentSeq)
+	require.EqualExportedValues(t, genesisState.Agencies, got.Agencies)
+	require.EqualExportedValues(t, genesisState.RateHistory, got.RateHistory)
+	require.Equal(t, genesisState.RateHistorySeq, got.RateHistorySeq)
+	require.EqualExportedValues(t, genesisState.Attestations, got.Attestations)
+	require.Equal(t, genesisState.AttestationSeq, got.AttestationSeq)
+	require.EqualExportedValues(t, genesisState.Disputes, got.Disputes)
+	require.Equal(t, genesisState.DisputeSeq, got.DisputeSeq)
+	require.EqualExportedValues(t, genesisState.ChannelCredits, got.ChannelCredits)
 
 }
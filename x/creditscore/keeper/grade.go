@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/creditscore/types"
+)
+
+// gradeRate sets the grade of the rate on its scale, the default scale when
+// the rate has none. Rates are not graded when no scale is configured.
+func gradeRate(params types.Params, rate *types.Rate) error {
+	scale, ok := params.Scale(rate.Scale)
+	switch {
+	case !ok && rate.Scale != "":
+		return errorsmod.Wrap(types.ErrUnknownScale, rate.Scale)
+	case !ok:
+		rate.Grade = ""
+		return nil
+	}
+
+	rate.Scale = scale.Name
+	rate.Grade, _ = scale.Grade(rate.Rate)
+	return nil
+}
+
+// emitGradeChange emits an upgrade or downgrade event when the grade of the
+// rate changed on its scale. No event is emitted when the scale changed.
+func emitGradeChange(ctx context.Context, params types.Params, prev, rate types.Rate) error {
+	if prev.Scale != rate.Scale || prev.Grade == rate.Grade {
+		return nil
+	}
+
+	scale, ok := params.Scale(rate.Scale)
+	if !ok {
+		return nil
+	}
+	prevRank, ok := scale.Rank(prev.Grade)
+	if !ok {
+		return nil
+	}
+	rank, _ := scale.Rank(rate.Grade)
+
+	em := sdk.UnwrapSDKContext(ctx).EventManager()
+	if rank < prevRank {
+		return em.EmitTypedEvent(&types.EventRateUpgraded{
+			Symbol:        rate.Symbol,
+			Creator:       rate.Creator,
+			Scale:         rate.Scale,
+			PreviousGrade: prev.Grade,
+			Grade:         rate.Grade,
+			PreviousRate:  prev.Rate,
+			Rate:          rate.Rate,
+		})
+	}
+
+	return em.EmitTypedEvent(&types.EventRateDowngraded{
+		Symbol:        rate.Symbol,
+		Creator:       rate.Creator,
+		Scale:         rate.Scale,
+		PreviousGrade: prev.Grade,
+		Grade:         rate.Grade,
+		PreviousRate:  prev.Rate,
+		Rate:          rate.Rate,
+	})
+}
+
+// currentRate returns the rate as of the block time: the scores computed by
+// the module decay with time, so they are recomputed and graded again with
+// the default scale. The breakdown of computed scores is returned as well.
+func (k Keeper) currentRate(ctx context.Context, params types.Params, moduleAddr string, rate types.Rate) (types.Rate, *types.ScoreBreakdown, error) {
+	if rate.Creator != moduleAddr {
+		return rate, nil, nil
+	}
+
+	breakdown, err := k.Score(ctx, rate.Symbol)
+	if err != nil {
+		return types.Rate{}, nil, err
+	}
+	rate.Rate = breakdown.Score
+	rate.Scale = ""
+	if err := gradeRate(params, &rate); err != nil {
+		return types.Rate{}, nil, err
+	}
+
+	return rate, &breakdown, nil
+}
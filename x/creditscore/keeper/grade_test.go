@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/creditscore/keeper"
+	"realfin/x/creditscore/types"
+)
+
+// gradeEvents returns the upgrade and downgrade events emitted on the
+// context.
+func gradeEvents(t *testing.T, ctx sdk.Context) []proto.Message {
+	t.Helper()
+
+	var events []proto.Message
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(&types.EventRateUpgraded{}) && event.Type != proto.MessageName(&types.EventRateDowngraded{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		events = append(events, msg)
+	}
+	return events
+}
+
+func TestRateMsgServerGrade(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	agency, err := f.addressCodec.BytesToString([]byte("agencyAddr__________________"))
+	require.NoError(t, err)
+	f.registerAgency(t, agency)
+
+	params := types.DefaultParams()
+	params.Scales = append(params.Scales, types.RatingScale{
+		Name:  "pd",
+		Bands: []types.GradeBand{{Grade: "investment", Min: 50}, {Grade: "speculative", Min: 0}},
+	})
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	review := time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)
+	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{
+		Creator:    agency,
+		Symbol:     "SME-001",
+		Rate:       760,
+		Outlook:    types.RatingOutlook_RATING_OUTLOOK_STABLE,
+		ReviewDate: &review,
+	})
+	require.NoError(t, err)
+
+	rate, err := f.keeper.Rate.Get(f.ctx, collections.Join("SME-001", agency))
+	require.NoError(t, err)
+	require.Equal(t, "default", rate.Scale)
+	require.Equal(t, "AA", rate.Grade)
+	require.Equal(t, types.RatingOutlook_RATING_OUTLOOK_STABLE, rate.Outlook)
+	require.Equal(t, review, *rate.ReviewDate)
+
+	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: agency, Symbol: "SME-002", Scale: "unknown"})
+	require.ErrorIs(t, err, types.ErrUnknownScale)
+	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: agency, Symbol: "SME-002", Outlook: 42})
+	require.ErrorIs(t, err, types.ErrInvalidOutlook)
+
+	tests := []struct {
+		desc   string
+		msg    *types.MsgUpdateRate
+		grade  string
+		events []proto.Message
+	}{
+		{
+			desc:  "same grade",
+			msg:   &types.MsgUpdateRate{Creator: agency, Symbol: "SME-001", Rate: 770},
+			grade: "AA",
+		},
+		{
+			desc:  "downgrade",
+			msg:   &types.MsgUpdateRate{Creator: agency, Symbol: "SME-001", Rate: 610, Outlook: types.RatingOutlook_RATING_OUTLOOK_NEGATIVE},
+			grade: "BB",
+			events: []proto.Message{&types.EventRateDowngraded{
+				Symbol: "SME-001", Creator: agency, Scale: "default", PreviousGrade: "AA", Grade: "BB", PreviousRate: 770, Rate: 610,
+			}},
+		},
+		{
+			desc:  "upgrade",
+			msg:   &types.MsgUpdateRate{Creator: agency, Symbol: "SME-001", Rate: 805},
+			grade: "AAA",
+			events: []proto.Message{&types.EventRateUpgraded{
+				Symbol: "SME-001", Creator: agency, Scale: "default", PreviousGrade: "BB", Grade: "AAA", PreviousRate: 610, Rate: 805,
+			}},
+		},
+		{
+			desc:  "other scale",
+			msg:   &types.MsgUpdateRate{Creator: agency, Symbol: "SME-001", Rate: 20, Scale: "pd"},
+			grade: "speculative",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+			_, err := srv.UpdateRate(ctx, tc.msg)
+			require.NoError(t, err)
+
+			rate, err := f.keeper.Rate.Get(ctx, collections.Join(tc.msg.Symbol, tc.msg.Creator))
+			require.NoError(t, err)
+			require.Equal(t, tc.grade, rate.Grade)
+			require.Equal(t, tc.events, gradeEvents(t, ctx))
+		})
+	}
+}
+
+func TestRateQueryFilter(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	agency, err := f.addressCodec.BytesToString([]byte("agencyAddr__________________"))
+	require.NoError(t, err)
+	f.registerAgency(t, agency)
+
+	for _, msg := range []*types.MsgCreateRate{
+		{Symbol: "SME-001", Rate: 820, Outlook: types.RatingOutlook_RATING_OUTLOOK_STABLE},
+		{Symbol: "SME-002", Rate: 810, Outlook: types.RatingOutlook_RATING_OUTLOOK_WATCH},
+		{Symbol: "SME-003", Rate: 420, Outlook: types.RatingOutlook_RATING_OUTLOOK_WATCH},
+	} {
+		msg.Creator = agency
+		_, err := srv.CreateRate(f.ctx, msg)
+		require.NoError(t, err)
+	}
+
+	symbols := func(req *types.QueryAllRateRequest) []string {
+		res, err := qs.ListRate(f.ctx, req)
+		require.NoError(t, err)
+		var symbols []string
+		for _, rate := range res.Rate {
+			symbols = append(symbols, rate.Symbol)
+		}
+		return symbols
+	}
+	require.Equal(t, []string{"SME-001", "SME-002", "SME-003"}, symbols(&types.QueryAllRateRequest{}))
+	require.Equal(t, []string{"SME-001", "SME-002"}, symbols(&types.QueryAllRateRequest{Grade: "AAA"}))
+	require.Equal(t, []string{"SME-002", "SME-003"}, symbols(&types.QueryAllRateRequest{Outlook: types.RatingOutlook_RATING_OUTLOOK_WATCH}))
+	require.Equal(t, []string{"SME-002"}, symbols(&types.QueryAllRateRequest{Grade: "AAA", Outlook: types.RatingOutlook_RATING_OUTLOOK_WATCH}))
+	require.Empty(t, symbols(&types.QueryAllRateRequest{Grade: "B"}))
+}
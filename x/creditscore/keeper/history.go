@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/creditscore/types"
+)
+
+// appendRateChange records the change of a rate from prev to rate in the
+// history of its symbol. The symbol and the creator are those of rate, and
+// deletions record a rate holding only those.
+func (k Keeper) appendRateChange(ctx context.Context, action types.RateAction, prev, rate types.Rate, reason, evidenceHash string) error {
+	id, err := k.RateHistorySeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	change := types.RateChange{
+		Symbol:        rate.Symbol,
+		Id:            id,
+		Creator:       rate.Creator,
+		Action:        action,
+		Height:        sdkCtx.BlockHeight(),
+		Time:          sdkCtx.BlockTime(),
+		PreviousRate:  prev.Rate,
+		Rate:          rate.Rate,
+		PreviousGrade: prev.Grade,
+		Grade:         rate.Grade,
+		Reason:        reason,
+		EvidenceHash:  evidenceHash,
+	}
+
+	return k.RateHistory.Set(ctx, collections.Join(change.Symbol, change.Id), change)
+}
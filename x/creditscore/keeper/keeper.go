@@ -4,13 +4,48 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"realfin/x/creditscore/types"
 )
 
+// RateIndexes are the indexes of the rates, keyed by symbol and creator.
+type RateIndexes struct {
+	// Creator indexes the rates by creator.
+	Creator *indexes.Multi[string, collections.Pair[string, string], types.Rate]
+	// Subject indexes the rates by the address they rate: their subject, or
+	// their symbol when they have none.
+	Subject *indexes.Multi[string, collections.Pair[string, string], types.Rate]
+}
+
+// IndexesList implements collections.Indexes.
+func (i RateIndexes) IndexesList() []collections.Index[collections.Pair[string, string], types.Rate] {
+	return []collections.Index[collections.Pair[string, string], types.Rate]{i.Creator, i.Subject}
+}
+
+func newRateIndexes(sb *collections.SchemaBuilder) RateIndexes {
+	return RateIndexes{
+		Creator: indexes.NewMulti(
+			sb, types.RateCreatorIndexKey, "rate_by_creator",
+			collections.StringKey, collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			func(pk collections.Pair[string, string], _ types.Rate) (string, error) {
+				return pk.K2(), nil
+			},
+		),
+		Subject: indexes.NewMulti(
+			sb, types.RateSubjectIndexKey, "rate_by_subject",
+			collections.StringKey, collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			func(_ collections.Pair[string, string], rate types.Rate) (string, error) {
+				return rate.SubjectAddress(), nil
+			},
+		),
+	}
+}
+
 type Keeper struct {
 	storeService corestore.KVStoreService
 	cdc          codec.Codec
@@ -19,9 +54,27 @@ type Keeper struct {
 	// Typically, this should be the x/gov module account.
 	authority []byte
 
+	bankKeeper   types.BankKeeper
+	oracleKeeper types.OracleKeeper
+
 	Schema collections.Schema
 	Params collections.Item[types.Params]
-	Rate   collections.Map[string, types.Rate]
+	Rate   *collections.IndexedMap[collections.Pair[string, string], types.Rate, RateIndexes]
+	Agency collections.Map[string, types.Agency]
+
+	Repayment    collections.Map[collections.Pair[string, uint64], types.RepaymentEvent]
+	RepaymentSeq collections.Sequence
+
+	RateHistory    collections.Map[collections.Pair[string, uint64], types.RateChange]
+	RateHistorySeq collections.Sequence
+
+	Attestation    collections.Map[uint64, types.Attestation]
+	AttestationSeq collections.Sequence
+
+	Dispute    collections.Map[uint64, types.Dispute]
+	DisputeSeq collections.Sequence
+
+	ChannelCredit collections.Map[string, types.ChannelCredit]
 }
 
 func NewKeeper(
@@ -29,7 +82,8 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
-
+	bankKeeper types.BankKeeper,
+	oracleKeeper types.OracleKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -42,9 +96,33 @@ func NewKeeper(
 		cdc:          cdc,
 		addressCodec: addressCodec,
 		authority:    authority,
+		bankKeeper:   bankKeeper,
+
+		oracleKeeper: oracleKeeper,
 
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		Rate:   collections.NewMap(sb, types.RateKey, "rate", collections.StringKey, codec.CollValue[types.Rate](cdc))}
+		Rate: collections.NewIndexedMap(
+			sb, types.RateKey, "rate",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.Rate](cdc),
+			newRateIndexes(sb),
+		),
+		Agency: collections.NewMap(sb, types.AgencyKey, "agency", collections.StringKey, codec.CollValue[types.Agency](cdc)),
+
+		Repayment:    collections.NewMap(sb, types.RepaymentKey, "repayment", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.RepaymentEvent](cdc)),
+		RepaymentSeq: collections.NewSequence(sb, types.RepaymentSeqKey, "repayment_seq"),
+
+		RateHistory:    collections.NewMap(sb, types.RateHistoryKey, "rate_history", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.RateChange](cdc)),
+		RateHistorySeq: collections.NewSequence(sb, types.RateHistorySeqKey, "rate_history_seq"),
+
+		Attestation:    collections.NewMap(sb, types.AttestationKey, "attestation", collections.Uint64Key, codec.CollValue[types.Attestation](cdc)),
+		AttestationSeq: collections.NewSequence(sb, types.AttestationSeqKey, "attestation_seq"),
+
+		Dispute:    collections.NewMap(sb, types.DisputeKey, "dispute", collections.Uint64Key, codec.CollValue[types.Dispute](cdc)),
+		DisputeSeq: collections.NewSequence(sb, types.DisputeSeqKey, "dispute_seq"),
+
+		ChannelCredit: collections.NewMap(sb, types.ChannelCreditKey, "channel_credit", collections.StringKey, codec.CollValue[types.ChannelCredit](cdc)),
+	}
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -59,3 +137,9 @@ func NewKeeper(
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
+
+// ModuleAddress returns the address of the module, the creator of the rates
+// computed from repayment events.
+func (k Keeper) ModuleAddress() (string, error) {
+	return k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+}
@@ -5,52 +5,78 @@ import (
 	"testing"
 
 	"cosmossdk.io/core/address"
-	storetypes "cosmossdk.io/store/types"
-	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
-	"github.com/cosmos/cosmos-sdk/runtime"
-	"github.com/cosmos/cosmos-sdk/testutil"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
 
+	keepertest "realfin/testutil/keeper"
 	"realfin/x/creditscore/keeper"
 	module "realfin/x/creditscore/module"
 	"realfin/x/creditscore/types"
+	oracletypes "realfin/x/oracle/types"
 )
 
 type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	storeService corestore.KVStoreService
+	bankKeeper   *keepertest.BankKeeper
+
+	oracleKeeper *mockOracleKeeper
 }
 
-func initFixture(t *testing.T) *fixture {
-	t.Helper()
+// mockOracleKeeper values one unit of a symbol in the credit denom.
+type mockOracleKeeper struct {
+	values map[string]math.Int
+}
 
-	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
-	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
-	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+func (o *mockOracleKeeper) ConvertAmount(_ context.Context, amount math.Int, from, to string) (math.Int, error) {
+	value, ok := o.values[from]
+	if !ok {
+		return math.Int{}, errorsmod.Wrapf(oracletypes.ErrNoConversionPath, "%s to %s", from, to)
+	}
+	return amount.Mul(value), nil
+}
 
-	storeService := runtime.NewKVStoreService(storeKey)
-	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
 
+	env := keepertest.NewEnv(t, types.StoreKey, module.AppModule{})
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := keepertest.NewBankKeeper()
+	oracleKeeper := &mockOracleKeeper{values: make(map[string]math.Int)}
 
 	k := keeper.NewKeeper(
-		storeService,
-		encCfg.Codec,
-		addressCodec,
+		env.StoreService,
+		env.Codec,
+		env.AddressCodec,
 		authority,
+		bankKeeper,
+		oracleKeeper,
 	)
 
 	// Initialize params
-	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
+	if err := k.Params.Set(env.Ctx, types.DefaultParams()); err != nil {
 		t.Fatalf("failed to set params: %v", err)
 	}
 
 	return &fixture{
-		ctx:          ctx,
+		ctx:          env.Ctx,
 		keeper:       k,
-		addressCodec: addressCodec,
+		addressCodec: env.AddressCodec,
+		storeService: env.StoreService,
+		bankKeeper:   bankKeeper,
+
+		oracleKeeper: oracleKeeper,
 	}
 }
+
+// registerAgency accredits the address as a rating agency.
+func (f *fixture) registerAgency(t *testing.T, address string) {
+	t.Helper()
+
+	require.NoError(t, f.keeper.Agency.Set(f.ctx, address, types.Agency{Address: address, Name: address}))
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "realfin/x/creditscore/migrations/v2"
+	"realfin/x/creditscore/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, keying the rates by symbol and
+// creator and setting the params introduced since version 1, which had none,
+// to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.keeper.Params.Set(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+
+	return v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc, func(ctx context.Context, rate types.Rate) error {
+		return m.keeper.Rate.Set(ctx, collections.Join(rate.Symbol, rate.Creator), rate)
+	})
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/creditscore/keeper"
+	v2 "realfin/x/creditscore/migrations/v2"
+	"realfin/x/creditscore/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	// the params of version 1 have no fields
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{}))
+
+	// rates of version 1 are keyed by symbol only
+	store := runtime.KVStoreAdapter(f.storeService.OpenKVStore(f.ctx))
+	legacy := []types.Rate{
+		{Symbol: "SME-001", Rate: 850, Name: "Acme Corp", Creator: creator},
+		{Symbol: "SME-002", Rate: 640, Creator: creator},
+	}
+	for _, rate := range legacy {
+		key, err := collections.EncodeKeyWithPrefix(v2.RateKey, collections.StringKey, rate.Symbol)
+		require.NoError(t, err)
+		bz, err := rate.Marshal()
+		require.NoError(t, err)
+		store.Set(key, bz)
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(sdk.UnwrapSDKContext(f.ctx)))
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+	require.NoError(t, params.Validate())
+
+	for _, rate := range legacy {
+		got, err := f.keeper.Rate.Get(f.ctx, collections.Join(rate.Symbol, creator))
+		require.NoError(t, err)
+		require.Equal(t, rate, got)
+
+		key, err := collections.EncodeKeyWithPrefix(v2.RateKey, collections.StringKey, rate.Symbol)
+		require.NoError(t, err)
+		require.False(t, store.Has(key))
+	}
+
+	// the migrated rates are indexed by creator
+	iter, err := f.keeper.Rate.Indexes.Creator.MatchExact(f.ctx, creator)
+	require.NoError(t, err)
+	keys, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"realfin/x/creditscore/types"
+)
+
+func (k msgServer) RegisterAgency(ctx context.Context, req *types.MsgRegisterAgency) (*types.MsgRegisterAgencyResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(req.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	}
+	if _, err := k.addressCodec.StringToBytes(req.Agency.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid agency address: %s", err))
+	}
+
+	// a revoked agency can be accredited again, its withdrawn rates are
+	// published again when it updates them
+	accredited, err := k.IsAccredited(ctx, req.Agency.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if accredited {
+		return nil, errorsmod.Wrap(types.ErrAgencyRegistered, req.Agency.Address)
+	}
+
+	agency := types.Agency{Address: req.Agency.Address, Name: req.Agency.Name}
+	if err := k.Agency.Set(ctx, agency.Address, agency); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAgencyRegistered{
+		Address: agency.Address,
+		Name:    agency.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterAgencyResponse{}, nil
+}
+
+func (k msgServer) RevokeAgency(ctx context.Context, req *types.MsgRevokeAgency) (*types.MsgRevokeAgencyResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(req.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	}
+
+	agency, err := k.Agency.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrNotAccredited, req.Address)
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if agency.Revoked {
+		return nil, errorsmod.Wrapf(types.ErrNotAccredited, "agency %s already revoked", req.Address)
+	}
+
+	agency.Revoked = true
+	if err := k.Agency.Set(ctx, agency.Address, agency); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// the rates of the agency are kept for the record
+	withdrawn, err := k.withdrawRates(ctx, agency.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAgencyRevoked{
+		Address:   agency.Address,
+		Withdrawn: withdrawn,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeAgencyResponse{Withdrawn: withdrawn}, nil
+}
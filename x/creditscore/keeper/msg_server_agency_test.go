@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/creditscore/keeper"
+	"realfin/x/creditscore/types"
+)
+
+func TestMsgRegisterAgency(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	agency, err := f.addressCodec.BytesToString([]byte("agencyAddr__________________"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgRegisterAgency
+		err  error
+	}{
+		{
+			desc: "invalid authority",
+			msg:  &types.MsgRegisterAgency{Authority: agency, Agency: types.Agency{Address: agency}},
+			err:  types.ErrInvalidSigner,
+		},
+		{
+			desc: "invalid address",
+			msg:  &types.MsgRegisterAgency{Authority: authority, Agency: types.Agency{Address: "invalid"}},
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc: "registered",
+			msg:  &types.MsgRegisterAgency{Authority: authority, Agency: types.Agency{Address: agency, Name: "Acme Ratings", Revoked: true}},
+		},
+		{
+			desc: "already registered",
+			msg:  &types.MsgRegisterAgency{Authority: authority, Agency: types.Agency{Address: agency}},
+			err:  types.ErrAgencyRegistered,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.RegisterAgency(f.ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := f.keeper.Agency.Get(f.ctx, tc.msg.Agency.Address)
+			require.NoError(t, err)
+			require.Equal(t, types.Agency{Address: agency, Name: "Acme Ratings"}, got)
+		})
+	}
+}
+
+func TestMsgRevokeAgency(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	agency, err := f.addressCodec.BytesToString([]byte("agencyAddr__________________"))
+	require.NoError(t, err)
+
+	_, err = srv.RevokeAgency(f.ctx, &types.MsgRevokeAgency{Authority: authority, Address: agency})
+	require.ErrorIs(t, err, types.ErrNotAccredited)
+
+	_, err = srv.RegisterAgency(f.ctx, &types.MsgRegisterAgency{Authority: authority, Agency: types.Agency{Address: agency}})
+	require.NoError(t, err)
+	for _, symbol := range []string{"SME-001", "SME-002"} {
+		_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: agency, Symbol: symbol, Rate: 700})
+		require.NoError(t, err)
+	}
+
+	_, err = srv.RevokeAgency(f.ctx, &types.MsgRevokeAgency{Authority: agency, Address: agency})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	res, err := srv.RevokeAgency(f.ctx, &types.MsgRevokeAgency{Authority: authority, Address: agency})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Withdrawn)
+
+	_, err = srv.RevokeAgency(f.ctx, &types.MsgRevokeAgency{Authority: authority, Address: agency})
+	require.ErrorIs(t, err, types.ErrNotAccredited)
+
+	rate, err := f.keeper.Rate.Get(f.ctx, collections.Join("SME-001", agency))
+	require.NoError(t, err)
+	require.True(t, rate.Withdrawn)
+	require.Equal(t, uint64(700), rate.Rate)
+
+	// a revoked agency can neither publish nor delete its withdrawn rates
+	_, err = srv.UpdateRate(f.ctx, &types.MsgUpdateRate{Creator: agency, Symbol: "SME-001", Rate: 720})
+	require.ErrorIs(t, err, types.ErrNotAccredited)
+	_, err = srv.DeleteRate(f.ctx, &types.MsgDeleteRate{Creator: agency, Symbol: "SME-001"})
+	require.ErrorIs(t, err, types.ErrRateWithdrawn)
+
+	// once accredited again, updating a withdrawn rate publishes it again
+	_, err = srv.RegisterAgency(f.ctx, &types.MsgRegisterAgency{Authority: authority, Agency: types.Agency{Address: agency}})
+	require.NoError(t, err)
+	_, err = srv.UpdateRate(f.ctx, &types.MsgUpdateRate{Creator: agency, Symbol: "SME-001", Rate: 720})
+	require.NoError(t, err)
+
+	rate, err = f.keeper.Rate.Get(f.ctx, collections.Join("SME-001", agency))
+	require.NoError(t, err)
+	require.False(t, rate.Withdrawn)
+	rate, err = f.keeper.Rate.Get(f.ctx, collections.Join("SME-002", agency))
+	require.NoError(t, err)
+	require.True(t, rate.Withdrawn)
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"realfin/x/creditscore/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateAttestation(ctx context.Context, msg *types.MsgCreateAttestation) (*types.MsgCreateAttestationResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Agency); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid agency address: %s", err))
+	}
+
+	// Only accredited agencies attest ratings
+	accredited, err := k.IsAccredited(ctx, msg.Agency)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if !accredited {
+		return nil, errorsmod.Wrap(types.ErrNotAccredited, msg.Agency)
+	}
+
+	if err := types.ValidateCommitment(msg.Commitment); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAttestation, err.Error())
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.ExpiresAt != nil && !msg.ExpiresAt.After(sdkCtx.BlockTime()) {
+		return nil, errorsmod.Wrap(types.ErrInvalidAttestation, "expiry must be in the future")
+	}
+
+	id, err := k.AttestationSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	attestation := types.Attestation{
+		Id:         id,
+		Agency:     msg.Agency,
+		Commitment: msg.Commitment,
+		Height:     sdkCtx.BlockHeight(),
+		Time:       sdkCtx.BlockTime(),
+		ExpiresAt:  msg.ExpiresAt,
+	}
+	if err := k.Attestation.Set(ctx, id, attestation); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventAttestationCreated{
+		Id:         id,
+		Agency:     attestation.Agency,
+		Commitment: attestation.Commitment,
+		ExpiresAt:  attestation.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateAttestationResponse{Id: id}, nil
+}
+
+func (k msgServer) RevokeAttestation(ctx context.Context, msg *types.MsgRevokeAttestation) (*types.MsgRevokeAttestationResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Agency); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid agency address: %s", err))
+	}
+
+	attestation, err := k.Attestation.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("attestation %d", msg.Id))
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// Checks if the msg agency is the same as the current owner
+	if msg.Agency != attestation.Agency {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+	if attestation.Revoked {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAttestation, "attestation %d already revoked", msg.Id)
+	}
+
+	attestation.Revoked = true
+	if err := k.Attestation.Set(ctx, attestation.Id, attestation); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAttestationRevoked{
+		Id:     attestation.Id,
+		Agency: attestation.Agency,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeAttestationResponse{}, nil
+}
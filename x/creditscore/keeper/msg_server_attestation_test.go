@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/creditscore/keeper"
+	"realfin/x/creditscore/types"
+)
+
+func TestMsgAttestation(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	agency, err := f.addressCodec.BytesToString([]byte("agencyAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAgencyAddr_____________"))
+	require.NoError(t, err)
+	f.registerAgency(t, agency)
+
+	salt := []byte("0123456789abcdef")
+	commitment := hex.EncodeToString(types.AttestationCommitment("SME-001", 720, "A", salt))
+	expiresAt := now.Add(24 * time.Hour)
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgCreateAttestation
+		err  error
+	}{
+		{
+			desc: "not accredited",
+			msg:  &types.MsgCreateAttestation{Agency: other, Commitment: commitment},
+			err:  types.ErrNotAccredited,
+		},
+		{
+			desc: "invalid commitment",
+			msg:  &types.MsgCreateAttestation{Agency: agency, Commitment: "abcd"},
+			err:  types.ErrInvalidAttestation,
+		},
+		{
+			desc: "past expiry",
+			msg:  &types.MsgCreateAttestation{Agency: agency, Commitment: commitment, ExpiresAt: &now},
+			err:  types.ErrInvalidAttestation,
+		},
+		{
+			desc: "created",
+			msg:  &types.MsgCreateAttestation{Agency: agency, Commitment: commitment, ExpiresAt: &expiresAt},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := srv.CreateAttestation(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := f.keeper.Attestation.Get(ctx, res.Id)
+			require.NoError(t, err)
+			require.Equal(t, agency, got.Agency)
+			require.Equal(t, commitment, got.Commitment)
+			require.Equal(t, now, got.Time)
+			require.Equal(t, expiresAt, *got.ExpiresAt)
+		})
+	}
+
+	verify := func(ctx sdk.Context, rate uint64, salt []byte) types.AttestationStatus {
+		t.Helper()
+
+		res, err := qs.VerifyAttestation(ctx, &types.QueryVerifyAttestationRequest{
+			Id: 0, Subject: "SME-001", Rate: rate, Grade: "A", Salt: hex.EncodeToString(salt),
+		})
+		require.NoError(t, err)
+		require.Equal(t, res.Status == types.AttestationStatus_ATTESTATION_STATUS_VALID, res.Valid)
+		return res.Status
+	}
+	require.Equal(t, types.AttestationStatus_ATTESTATION_STATUS_VALID, verify(ctx, 720, salt))
+	require.Equal(t, types.AttestationStatus_ATTESTATION_STATUS_MISMATCH, verify(ctx, 800, salt))
+	require.Equal(t, types.AttestationStatus_ATTESTATION_STATUS_MISMATCH, verify(ctx, 720, []byte(strings.Repeat("x", types.MinSaltLength))))
+	require.Equal(t, types.AttestationStatus_ATTESTATION_STATUS_EXPIRED, verify(ctx.WithBlockTime(expiresAt), 720, salt))
+
+	_, err = qs.VerifyAttestation(ctx, &types.QueryVerifyAttestationRequest{Id: 0, Subject: "SME-001", Rate: 720, Grade: "A", Salt: "abcd"})
+	require.Error(t, err)
+
+	// only the agency revokes its attestations
+	f.registerAgency(t, other)
+	_, err = srv.RevokeAttestation(ctx, &types.MsgRevokeAttestation{Agency: other, Id: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RevokeAttestation(ctx, &types.MsgRevokeAttestation{Agency: agency, Id: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// the attestations of revoked agencies do not verify
+	require.NoError(t, f.keeper.Agency.Set(ctx, agency, types.Agency{Address: agency, Revoked: true}))
+	require.Equal(t, types.AttestationStatus_ATTESTATION_STATUS_AGENCY_REVOKED, verify(ctx, 720, salt))
+
+	_, err = srv.RevokeAttestation(ctx, &types.MsgRevokeAttestation{Agency: agency, Id: 0})
+	require.NoError(t, err)
+	require.Equal(t, types.AttestationStatus_ATTESTATION_STATUS_REVOKED, verify(ctx, 720, salt))
+	_, err = srv.RevokeAttestation(ctx, &types.MsgRevokeAttestation{Agency: agency, Id: 0})
+	require.ErrorIs(t, err, types.ErrInvalidAttestation)
+
+	list, err := qs.ListAttestation(ctx, &types.QueryAllAttestationRequest{Agency: other})
+	require.NoError(t, err)
+	require.Empty(t, list.Attestations)
+	list, err = qs.ListAttestation(ctx, &types.QueryAllAttestationRequest{Agency: agency})
+	require.NoError(t, err)
+	require.Len(t, list.Attestations, 1)
+}
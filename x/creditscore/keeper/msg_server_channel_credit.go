@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"realfin/x/creditscore/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) FundChannelCredit(ctx context.Context, msg *types.MsgFundChannelCredit) (*types.MsgFundChannelCreditResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid sender address: %s", err))
+	}
+	if err := msg.Amount.Validate(); err != nil || msg.Amount.IsZero() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !params.QueryChannelAllowed(msg.ChannelId) {
+		return nil, errorsmod.Wrap(types.ErrChannelNotAllowed, msg.ChannelId)
+	}
+
+	credit, err := k.ChannelCredit.Get(ctx, msg.ChannelId)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	credit.ChannelId = msg.ChannelId
+	credit.Balance = credit.Balance.Add(msg.Amount...)
+	if err := k.ChannelCredit.Set(ctx, msg.ChannelId, credit); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventChannelCreditFunded{
+		ChannelId: msg.ChannelId,
+		Sender:    msg.Sender,
+		Amount:    msg.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundChannelCreditResponse{}, nil
+}
@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"realfin/x/creditscore/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) FileDispute(ctx context.Context, msg *types.MsgFileDispute) (*types.MsgFileDisputeResponse, error) {
+	subject, err := k.addressCodec.StringToBytes(msg.Subject)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid subject address: %s", err))
+	}
+	if msg.Reason == "" || len(msg.Reason) > types.MaxReasonLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDispute, "reason must be 1 to %d characters", types.MaxReasonLength)
+	}
+
+	// Computed scores are disputed through their repayment events
+	moduleAddr, err := k.ModuleAddress()
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if msg.Agency == moduleAddr {
+		return nil, errorsmod.Wrap(types.ErrInvalidDispute, "computed scores cannot be disputed")
+	}
+
+	rate, err := k.Rate.Get(ctx, collections.Join(msg.Symbol, msg.Agency))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "rate not found")
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if msg.Subject != rate.SubjectAddress() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the subject of the rate can dispute it")
+	}
+	if rate.Withdrawn {
+		return nil, errorsmod.Wrap(types.ErrRateWithdrawn, "cannot dispute a withdrawn rate")
+	}
+	if rate.Disputed {
+		return nil, errorsmod.Wrap(types.ErrRateDisputed, "rate already disputed")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	deposit := params.DisputeDepositCoins()
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, subject, types.ModuleName, deposit); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := k.DisputeSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	dispute := types.Dispute{
+		Id:               id,
+		Symbol:           rate.Symbol,
+		Agency:           rate.Creator,
+		Subject:          msg.Subject,
+		Reason:           msg.Reason,
+		Deposit:          deposit,
+		Status:           types.DisputeStatus_DISPUTE_STATUS_OPEN,
+		FiledAt:          sdkCtx.BlockTime(),
+		ResponseDeadline: sdkCtx.BlockTime().Add(time.Duration(params.DisputeResponseWindow) * time.Second),
+	}
+	if err := k.Dispute.Set(ctx, id, dispute); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	rate.Disputed = true
+	if err := k.Rate.Set(ctx, collections.Join(rate.Symbol, rate.Creator), rate); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventDisputeFiled{
+		Id:      id,
+		Symbol:  dispute.Symbol,
+		Agency:  dispute.Agency,
+		Subject: dispute.Subject,
+		Deposit: dispute.Deposit,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFileDisputeResponse{Id: id}, nil
+}
+
+func (k msgServer) RespondDispute(ctx context.Context, msg *types.MsgRespondDispute) (*types.MsgRespondDisputeResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Agency); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid agency address: %s", err))
+	}
+	if len(msg.Response) > types.MaxReasonLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDispute, "response longer than %d characters", types.MaxReasonLength)
+	}
+
+	dispute, err := k.getDispute(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Agency != dispute.Agency {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the agency of the rate can respond")
+	}
+	if dispute.Status != types.DisputeStatus_DISPUTE_STATUS_OPEN {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDispute, "dispute %d is not open", dispute.Id)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !sdkCtx.BlockTime().Before(dispute.ResponseDeadline) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDispute, "response window of dispute %d closed", dispute.Id)
+	}
+
+	dispute.Status = types.DisputeStatus_DISPUTE_STATUS_RESPONDED
+	dispute.Response = msg.Response
+	if err := k.Dispute.Set(ctx, dispute.Id, dispute); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventDisputeResponded{
+		Id:     dispute.Id,
+		Agency: dispute.Agency,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRespondDisputeResponse{}, nil
+}
+
+func (k msgServer) ResolveDispute(ctx context.Context, msg *types.MsgResolveDispute) (*types.MsgResolveDisputeResponse, error) {
+	resolver, err := k.addressCodec.StringToBytes(msg.Resolver)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid resolver address: %s", err))
+	}
+	if len(msg.Resolution) > types.MaxReasonLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDispute, "resolution longer than %d characters", types.MaxReasonLength)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	// Disputes are arbitrated by governance or the arbiter
+	if !bytes.Equal(k.GetAuthority(), resolver) && (params.Arbiter == "" || msg.Resolver != params.Arbiter) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "resolver is neither governance nor the arbiter")
+	}
+
+	dispute, err := k.getDispute(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !dispute.Pending() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDispute, "dispute %d already resolved", dispute.Id)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if dispute.Status == types.DisputeStatus_DISPUTE_STATUS_OPEN && sdkCtx.BlockTime().Before(dispute.ResponseDeadline) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDispute, "dispute %d awaits the response of the agency", dispute.Id)
+	}
+
+	key := collections.Join(dispute.Symbol, dispute.Agency)
+	rate, err := k.Rate.Get(ctx, key)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	prev := rate
+	rate.Disputed = false
+
+	refunded := true
+	switch msg.Outcome {
+	case types.DisputeOutcome_DISPUTE_OUTCOME_UPHOLD:
+		dispute.Status = types.DisputeStatus_DISPUTE_STATUS_UPHELD
+		refunded = false
+		if err := k.Rate.Set(ctx, key, rate); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	case types.DisputeOutcome_DISPUTE_OUTCOME_AMEND:
+		if err := types.ValidateRate(msg.Rate); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidRate, err.Error())
+		}
+		dispute.Status = types.DisputeStatus_DISPUTE_STATUS_AMENDED
+		rate.Rate = msg.Rate
+		if err := gradeRate(params, &rate); err != nil {
+			return nil, err
+		}
+		if err := k.Rate.Set(ctx, key, rate); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		if err := k.appendRateChange(ctx, types.RateAction_RATE_ACTION_UPDATED, prev, rate, fmt.Sprintf("dispute %d amended", dispute.Id), ""); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		if err := emitGradeChange(ctx, params, prev, rate); err != nil {
+			return nil, err
+		}
+	case types.DisputeOutcome_DISPUTE_OUTCOME_VOID:
+		dispute.Status = types.DisputeStatus_DISPUTE_STATUS_VOIDED
+		if err := k.Rate.Remove(ctx, key); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		deleted := types.Rate{Symbol: rate.Symbol, Creator: rate.Creator}
+		if err := k.appendRateChange(ctx, types.RateAction_RATE_ACTION_DELETED, prev, deleted, fmt.Sprintf("dispute %d voided", dispute.Id), ""); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidDispute, "invalid outcome %d", msg.Outcome)
+	}
+
+	// The deposit is refunded unless the rate is upheld
+	if !dispute.Deposit.IsZero() {
+		if refunded {
+			subject, err := k.addressCodec.StringToBytes(dispute.Subject)
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, subject, dispute.Deposit); err != nil {
+				return nil, err
+			}
+		} else if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, dispute.Deposit); err != nil {
+			return nil, err
+		}
+	}
+
+	dispute.Resolver = msg.Resolver
+	dispute.Resolution = msg.Resolution
+	if err := k.Dispute.Set(ctx, dispute.Id, dispute); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventDisputeResolved{
+		Id:       dispute.Id,
+		Resolver: dispute.Resolver,
+		Outcome:  msg.Outcome,
+		Refunded: refunded,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResolveDisputeResponse{}, nil
+}
+
+// getDispute returns the dispute of the id.
+func (k msgServer) getDispute(ctx context.Context, id uint64) (types.Dispute, error) {
+	dispute, err := k.Dispute.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Dispute{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("dispute %d", id))
+		}
+
+		return types.Dispute{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return dispute, nil
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/creditscore/keeper"
+	"realfin/x/creditscore/types"
+)
+
+func TestMsgDispute(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	agency, err := f.addressCodec.BytesToString([]byte("agencyAddr__________________"))
+	require.NoError(t, err)
+	subject, err := f.addressCodec.BytesToString([]byte("subjectAddr_________________"))
+	require.NoError(t, err)
+	arbiter, err := f.addressCodec.BytesToString([]byte("arbiterAddr_________________"))
+	require.NoError(t, err)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	f.registerAgency(t, agency)
+
+	params := types.DefaultParams()
+	params.Arbiter = arbiter
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	deposit := sdk.NewCoins(sdk.NewCoin(types.DefaultDisputeDenom, types.DefaultDisputeDeposit))
+	f.bankKeeper.Balances[sdk.MustAccAddressFromBech32(subject).String()] = deposit.MulInt(math.NewInt(3))
+	balance := func(address string) sdk.Coins {
+		return f.bankKeeper.Balances[sdk.MustAccAddressFromBech32(address).String()]
+	}
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	_, err = srv.CreateRate(ctx, &types.MsgCreateRate{Creator: agency, Symbol: "SME-001", Rate: 720, Subject: subject})
+	require.NoError(t, err)
+
+	// filing
+	_, err = srv.FileDispute(ctx, &types.MsgFileDispute{Subject: agency, Symbol: "SME-001", Agency: agency, Reason: "wrong"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.FileDispute(ctx, &types.MsgFileDispute{Subject: subject, Symbol: "SME-001", Agency: agency})
+	require.ErrorIs(t, err, types.ErrInvalidDispute)
+	_, err = srv.FileDispute(ctx, &types.MsgFileDispute{Subject: subject, Symbol: "SME-002", Agency: agency, Reason: "wrong"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	res, err := srv.FileDispute(ctx, &types.MsgFileDispute{Subject: subject, Symbol: "SME-001", Agency: agency, Reason: "outdated financials"})
+	require.NoError(t, err)
+	require.Equal(t, deposit.MulInt(math.NewInt(2)), balance(subject))
+	require.Equal(t, deposit, f.bankKeeper.Balances[moduleAddr])
+
+	rate, err := f.keeper.Rate.Get(ctx, collections.Join("SME-001", agency))
+	require.NoError(t, err)
+	require.True(t, rate.Disputed)
+	consolidated, err := keeper.NewQueryServerImpl(f.keeper).GetRate(ctx, &types.QueryGetRateRequest{Symbol: "SME-001"})
+	require.NoError(t, err)
+	require.True(t, consolidated.Rate.Disputed)
+
+	dispute, err := f.keeper.Dispute.Get(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.DisputeStatus_DISPUTE_STATUS_OPEN, dispute.Status)
+	require.Equal(t, now.Add(time.Duration(types.DefaultDisputeResponseWindow)*time.Second), dispute.ResponseDeadline)
+
+	_, err = srv.FileDispute(ctx, &types.MsgFileDispute{Subject: subject, Symbol: "SME-001", Agency: agency, Reason: "again"})
+	require.ErrorIs(t, err, types.ErrRateDisputed)
+	_, err = srv.DeleteRate(ctx, &types.MsgDeleteRate{Creator: agency, Symbol: "SME-001"})
+	require.ErrorIs(t, err, types.ErrRateDisputed)
+
+	// updates keep the dispute pending
+	_, err = srv.UpdateRate(ctx, &types.MsgUpdateRate{Creator: agency, Symbol: "SME-001", Rate: 730})
+	require.NoError(t, err)
+	rate, err = f.keeper.Rate.Get(ctx, collections.Join("SME-001", agency))
+	require.NoError(t, err)
+	require.True(t, rate.Disputed)
+	require.Equal(t, subject, rate.Subject)
+
+	// the agency responds within the window, before arbitration
+	amend := &types.MsgResolveDispute{Resolver: arbiter, Id: res.Id, Outcome: types.DisputeOutcome_DISPUTE_OUTCOME_AMEND, Rate: 650, Resolution: "restated"}
+	_, err = srv.ResolveDispute(ctx, amend)
+	require.ErrorIs(t, err, types.ErrInvalidDispute)
+	_, err = srv.RespondDispute(ctx, &types.MsgRespondDispute{Agency: subject, Id: res.Id, Response: "no"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RespondDispute(ctx, &types.MsgRespondDispute{Agency: agency, Id: res.Id, Response: "rating stands"})
+	require.NoError(t, err)
+	_, err = srv.RespondDispute(ctx, &types.MsgRespondDispute{Agency: agency, Id: res.Id, Response: "again"})
+	require.ErrorIs(t, err, types.ErrInvalidDispute)
+
+	_, err = srv.ResolveDispute(ctx, &types.MsgResolveDispute{Resolver: subject, Id: res.Id, Outcome: types.DisputeOutcome_DISPUTE_OUTCOME_VOID})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ResolveDispute(ctx, &types.MsgResolveDispute{Resolver: arbiter, Id: res.Id})
+	require.ErrorIs(t, err, types.ErrInvalidDispute)
+
+	// amendments are bound to the range of the rates
+	_, err = srv.ResolveDispute(ctx, &types.MsgResolveDispute{Resolver: arbiter, Id: res.Id, Outcome: types.DisputeOutcome_DISPUTE_OUTCOME_AMEND, Rate: types.MaxRate + 1})
+	require.ErrorIs(t, err, types.ErrInvalidRate)
+
+	// amending refunds the deposit
+	_, err = srv.ResolveDispute(ctx, amend)
+	require.NoError(t, err)
+	rate, err = f.keeper.Rate.Get(ctx, collections.Join("SME-001", agency))
+	require.NoError(t, err)
+	require.False(t, rate.Disputed)
+	require.Equal(t, uint64(650), rate.Rate)
+	require.Equal(t, "BBB", rate.Grade)
+	require.Equal(t, deposit.MulInt(math.NewInt(3)), balance(subject))
+
+	dispute, err = f.keeper.Dispute.Get(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.DisputeStatus_DISPUTE_STATUS_AMENDED, dispute.Status)
+	require.Equal(t, arbiter, dispute.Resolver)
+	require.Equal(t, "rating stands", dispute.Response)
+	_, err = srv.ResolveDispute(ctx, amend)
+	require.ErrorIs(t, err, types.ErrInvalidDispute)
+
+	history, err := keeper.NewQueryServerImpl(f.keeper).RateHistory(ctx, &types.QueryRateHistoryRequest{Symbol: "SME-001"})
+	require.NoError(t, err)
+	last := history.Changes[len(history.Changes)-1]
+	require.Equal(t, "dispute 0 amended", last.Reason)
+	require.Equal(t, uint64(650), last.Rate)
+
+	// upholding after the response window burns the deposit
+	res, err = srv.FileDispute(ctx, &types.MsgFileDispute{Subject: subject, Symbol: "SME-001", Agency: agency, Reason: "still wrong"})
+	require.NoError(t, err)
+	late := ctx.WithBlockTime(now.Add(time.Duration(types.DefaultDisputeResponseWindow) * time.Second))
+	_, err = srv.RespondDispute(late, &types.MsgRespondDispute{Agency: agency, Id: res.Id, Response: "late"})
+	require.ErrorIs(t, err, types.ErrInvalidDispute)
+	_, err = srv.ResolveDispute(late, &types.MsgResolveDispute{Resolver: authority, Id: res.Id, Outcome: types.DisputeOutcome_DISPUTE_OUTCOME_UPHOLD})
+	require.NoError(t, err)
+	require.Equal(t, deposit.MulInt(math.NewInt(2)), balance(subject))
+	require.True(t, f.bankKeeper.Balances[moduleAddr].IsZero())
+
+	// voiding deletes the rate
+	res, err = srv.FileDispute(ctx, &types.MsgFileDispute{Subject: subject, Symbol: "SME-001", Agency: agency, Reason: "not a client"})
+	require.NoError(t, err)
+	_, err = srv.RespondDispute(ctx, &types.MsgRespondDispute{Agency: agency, Id: res.Id})
+	require.NoError(t, err)
+	_, err = srv.ResolveDispute(ctx, &types.MsgResolveDispute{Resolver: authority, Id: res.Id, Outcome: types.DisputeOutcome_DISPUTE_OUTCOME_VOID})
+	require.NoError(t, err)
+	_, err = f.keeper.Rate.Get(ctx, collections.Join("SME-001", agency))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	require.Equal(t, deposit.MulInt(math.NewInt(2)), balance(subject))
+
+	list, err := keeper.NewQueryServerImpl(f.keeper).ListDispute(ctx, &types.QueryAllDisputeRequest{Symbol: "SME-001", Status: types.DisputeStatus_DISPUTE_STATUS_UPHELD})
+	require.NoError(t, err)
+	require.Len(t, list.Disputes, 1)
+}
+
+func TestMsgDisputeSubject(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	agency, err := f.addressCodec.BytesToString([]byte("agencyAddr__________________"))
+	require.NoError(t, err)
+	subject, err := f.addressCodec.BytesToString([]byte("subjectAddr_________________"))
+	require.NoError(t, err)
+	f.registerAgency(t, agency)
+
+	// the symbol is the subject of rates without subject
+	params := types.DefaultParams()
+	params.DisputeDenom = ""
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: agency, Symbol: subject, Rate: 720})
+	require.NoError(t, err)
+	_, err = srv.FileDispute(f.ctx, &types.MsgFileDispute{Subject: subject, Symbol: subject, Agency: agency, Reason: "wrong"})
+	require.NoError(t, err)
+
+	// computed scores cannot be disputed
+	moduleAddr, err := f.keeper.ModuleAddress()
+	require.NoError(t, err)
+	_, err = srv.FileDispute(f.ctx, &types.MsgFileDispute{Subject: subject, Symbol: subject, Agency: moduleAddr, Reason: "wrong"})
+	require.ErrorIs(t, err, types.ErrInvalidDispute)
+
+	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: agency, Symbol: "SME-002", Subject: "invalid"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
@@ -17,8 +17,22 @@ func (k msgServer) CreateRate(ctx context.Context, msg *types.MsgCreateRate) (*t
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
 	}
 
+	// Only accredited agencies publish rates
+	accredited, err := k.IsAccredited(ctx, msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if !accredited {
+		return nil, errorsmod.Wrap(types.ErrNotAccredited, msg.Creator)
+	}
+
+	if msg.Subject != "" {
+		if _, err := k.addressCodec.StringToBytes(msg.Subject); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid subject address: %s", err))
+		}
+	}
+
 	// Check if the value already exists
-	ok, err := k.Rate.Has(ctx, msg.Symbol)
+	ok, err := k.Rate.Has(ctx, collections.Join(msg.Symbol, msg.Creator))
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	} else if ok {
@@ -31,9 +45,34 @@ func (k msgServer) CreateRate(ctx context.Context, msg *types.MsgCreateRate) (*t
 		Rate:        msg.Rate,
 		Name:        msg.Name,
 		Description: msg.Description,
+		Scale:       msg.Scale,
+		Outlook:     msg.Outlook,
+		ReviewDate:  msg.ReviewDate,
+		Subject:     msg.Subject,
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := types.ValidateRate(rate.Rate); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRate, err.Error())
+	}
+	if err := validateOutlook(rate.Outlook); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateRateChange(msg.Reason, msg.EvidenceHash); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidChange, err.Error())
+	}
+	if err := gradeRate(params, &rate); err != nil {
+		return nil, err
+	}
+
+	if err := k.Rate.Set(ctx, collections.Join(rate.Symbol, rate.Creator), rate); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
-	if err := k.Rate.Set(ctx, rate.Symbol, rate); err != nil {
+	if err := k.appendRateChange(ctx, types.RateAction_RATE_ACTION_CREATED, types.Rate{}, rate, msg.Reason, msg.EvidenceHash); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
@@ -45,33 +84,63 @@ func (k msgServer) UpdateRate(ctx context.Context, msg *types.MsgUpdateRate) (*t
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
 	}
 
-	// Check if the value exists
-	val, err := k.Rate.Get(ctx, msg.Symbol)
+	accredited, err := k.IsAccredited(ctx, msg.Creator)
 	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
-		}
-
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if !accredited {
+		return nil, errorsmod.Wrap(types.ErrNotAccredited, msg.Creator)
 	}
 
-	// Checks if the msg creator is the same as the current owner
-	if msg.Creator != val.Creator {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	// Check if the value exists
+	val, err := k.ownRate(ctx, msg.Symbol, msg.Creator)
+	if err != nil {
+		return nil, err
 	}
 
+	// Updating a withdrawn rate publishes it again, and the subject and a
+	// pending dispute are kept
 	var rate = types.Rate{
 		Creator:     msg.Creator,
 		Symbol:      msg.Symbol,
 		Rate:        msg.Rate,
 		Name:        msg.Name,
 		Description: msg.Description,
+		Scale:       msg.Scale,
+		Outlook:     msg.Outlook,
+		ReviewDate:  msg.ReviewDate,
+		Subject:     val.Subject,
+		Disputed:    val.Disputed,
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := types.ValidateRate(rate.Rate); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRate, err.Error())
+	}
+	if err := validateOutlook(rate.Outlook); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateRateChange(msg.Reason, msg.EvidenceHash); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidChange, err.Error())
+	}
+	if err := gradeRate(params, &rate); err != nil {
+		return nil, err
 	}
 
-	if err := k.Rate.Set(ctx, rate.Symbol, rate); err != nil {
+	if err := k.Rate.Set(ctx, collections.Join(rate.Symbol, rate.Creator), rate); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update rate")
 	}
 
+	if err := k.appendRateChange(ctx, types.RateAction_RATE_ACTION_UPDATED, val, rate, msg.Reason, msg.EvidenceHash); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := emitGradeChange(ctx, params, val, rate); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateRateResponse{}, nil
 }
 
@@ -80,24 +149,61 @@ func (k msgServer) DeleteRate(ctx context.Context, msg *types.MsgDeleteRate) (*t
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
 	}
 
+	if err := types.ValidateRateChange(msg.Reason, ""); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidChange, err.Error())
+	}
+
 	// Check if the value exists
-	val, err := k.Rate.Get(ctx, msg.Symbol)
+	val, err := k.ownRate(ctx, msg.Symbol, msg.Creator)
 	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
-		}
-
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		return nil, err
 	}
 
-	// Checks if the msg creator is the same as the current owner
-	if msg.Creator != val.Creator {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	// Withdrawn rates are kept for the record
+	if val.Withdrawn {
+		return nil, errorsmod.Wrap(types.ErrRateWithdrawn, "cannot delete a withdrawn rate")
+	}
+	if val.Disputed {
+		return nil, errorsmod.Wrap(types.ErrRateDisputed, "cannot delete a disputed rate")
 	}
 
-	if err := k.Rate.Remove(ctx, msg.Symbol); err != nil {
+	if err := k.Rate.Remove(ctx, collections.Join(msg.Symbol, msg.Creator)); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove rate")
 	}
 
+	deleted := types.Rate{Symbol: val.Symbol, Creator: val.Creator}
+	if err := k.appendRateChange(ctx, types.RateAction_RATE_ACTION_DELETED, val, deleted, msg.Reason, ""); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
 	return &types.MsgDeleteRateResponse{}, nil
 }
+
+// ownRate returns the rate of the symbol issued by the creator. Rates of the
+// symbol issued by others are reported as owned by someone else.
+func (k msgServer) ownRate(ctx context.Context, symbol, creator string) (types.Rate, error) {
+	val, err := k.Rate.Get(ctx, collections.Join(symbol, creator))
+	if err == nil {
+		return val, nil
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return types.Rate{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	rated, err := k.symbolRated(ctx, symbol)
+	if err != nil {
+		return types.Rate{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if rated {
+		return types.Rate{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	return types.Rate{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+}
+
+// validateOutlook returns an error if the outlook is not a known outlook.
+func validateOutlook(outlook types.RatingOutlook) error {
+	if _, ok := types.RatingOutlook_name[int32(outlook)]; !ok {
+		return errorsmod.Wrapf(types.ErrInvalidOutlook, "unknown outlook %d", outlook)
+	}
+
+	return nil
+}
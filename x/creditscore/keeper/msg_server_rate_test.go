@@ -4,6 +4,7 @@ import (
 	"strconv"
 	"testing"
 
+	"cosmossdk.io/collections"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
@@ -17,13 +18,19 @@ func TestRateMsgServerCreate(t *testing.T) {
 	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
 	require.NoError(t, err)
 
+	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: creator, Symbol: "0"})
+	require.ErrorIs(t, err, types.ErrNotAccredited)
+
+	f.registerAgency(t, creator)
+	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: creator, Symbol: "0", Rate: types.MaxRate + 1})
+	require.ErrorIs(t, err, types.ErrInvalidRate)
 	for i := 0; i < 5; i++ {
 		expected := &types.MsgCreateRate{Creator: creator,
 			Symbol: strconv.Itoa(i),
 		}
 		_, err := srv.CreateRate(f.ctx, expected)
 		require.NoError(t, err)
-		rst, err := f.keeper.Rate.Get(f.ctx, expected.Symbol)
+		rst, err := f.keeper.Rate.Get(f.ctx, collections.Join(expected.Symbol, expected.Creator))
 		require.NoError(t, err)
 		require.Equal(t, expected.Creator, rst.Creator)
 	}
@@ -39,6 +46,11 @@ func TestRateMsgServerUpdate(t *testing.T) {
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
+	notAccreditedAddr, err := f.addressCodec.BytesToString([]byte("notAccreditedAddr__________"))
+	require.NoError(t, err)
+
+	f.registerAgency(t, creator)
+	f.registerAgency(t, unauthorizedAddr)
 	expected := &types.MsgCreateRate{Creator: creator,
 		Symbol: strconv.Itoa(0),
 	}
@@ -57,6 +69,13 @@ func TestRateMsgServerUpdate(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
+		{
+			desc: "not accredited",
+			request: &types.MsgUpdateRate{Creator: notAccreditedAddr,
+				Symbol: strconv.Itoa(0),
+			},
+			err: types.ErrNotAccredited,
+		},
 		{
 			desc: "unauthorized",
 			request: &types.MsgUpdateRate{Creator: unauthorizedAddr,
@@ -64,6 +83,14 @@ func TestRateMsgServerUpdate(t *testing.T) {
 			},
 			err: sdkerrors.ErrUnauthorized,
 		},
+		{
+			desc: "rate out of range",
+			request: &types.MsgUpdateRate{Creator: creator,
+				Symbol: strconv.Itoa(0),
+				Rate:   types.MaxRate + 1,
+			},
+			err: types.ErrInvalidRate,
+		},
 		{
 			desc: "key not found",
 			request: &types.MsgUpdateRate{Creator: creator,
@@ -85,7 +112,7 @@ func TestRateMsgServerUpdate(t *testing.T) {
 				require.ErrorIs(t, err, tc.err)
 			} else {
 				require.NoError(t, err)
-				rst, err := f.keeper.Rate.Get(f.ctx, expected.Symbol)
+				rst, err := f.keeper.Rate.Get(f.ctx, collections.Join(expected.Symbol, expected.Creator))
 				require.NoError(t, err)
 				require.Equal(t, expected.Creator, rst.Creator)
 			}
@@ -103,6 +130,7 @@ func TestRateMsgServerDelete(t *testing.T) {
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
+	f.registerAgency(t, creator)
 	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: creator,
 		Symbol: strconv.Itoa(0),
 	})
@@ -148,7 +176,7 @@ func TestRateMsgServerDelete(t *testing.T) {
 				require.ErrorIs(t, err, tc.err)
 			} else {
 				require.NoError(t, err)
-				found, err := f.keeper.Rate.Has(f.ctx, tc.request.Symbol)
+				found, err := f.keeper.Rate.Has(f.ctx, collections.Join(tc.request.Symbol, tc.request.Creator))
 				require.NoError(t, err)
 				require.False(t, found)
 			}
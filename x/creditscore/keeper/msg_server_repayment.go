@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"realfin/x/creditscore/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) SubmitRepayment(ctx context.Context, msg *types.MsgSubmitRepayment) (*types.MsgSubmitRepaymentResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Lender); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid lender address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Borrower); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid borrower address: %s", err))
+	}
+
+	// Only accredited agencies report repayments, so that no account can
+	// lower the score of a borrower it never lent to
+	accredited, err := k.IsAccredited(ctx, msg.Lender)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if !accredited {
+		return nil, errorsmod.Wrap(types.ErrNotAccredited, msg.Lender)
+	}
+
+	if msg.Borrower == msg.Lender {
+		return nil, errorsmod.Wrap(types.ErrInvalidRepayment, "lender cannot report its own repayments")
+	}
+	if err := types.ValidateRepayment(msg.Kind, msg.DaysLate); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRepayment, err.Error())
+	}
+
+	id, err := k.RepaymentSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	event := types.RepaymentEvent{
+		Borrower:  msg.Borrower,
+		Id:        id,
+		Lender:    msg.Lender,
+		Kind:      msg.Kind,
+		DaysLate:  msg.DaysLate,
+		Reference: msg.Reference,
+		Height:    sdkCtx.BlockHeight(),
+		Time:      sdkCtx.BlockTime(),
+	}
+	if err := k.Repayment.Set(ctx, collections.Join(event.Borrower, event.Id), event); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	breakdown, err := k.refreshScore(ctx, msg.Borrower, fmt.Sprintf("repayment %d", id))
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventRepaymentSubmitted{
+		Borrower: event.Borrower,
+		Id:       event.Id,
+		Lender:   event.Lender,
+		Kind:     event.Kind,
+		DaysLate: event.DaysLate,
+		Score:    breakdown.Score,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitRepaymentResponse{Id: id, Score: breakdown.Score}, nil
+}
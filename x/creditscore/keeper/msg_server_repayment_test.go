@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/creditscore/keeper"
+	"realfin/x/creditscore/types"
+)
+
+func TestMsgSubmitRepayment(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	lender, err := f.addressCodec.BytesToString([]byte("lenderAddr__________________"))
+	require.NoError(t, err)
+	borrower, err := f.addressCodec.BytesToString([]byte("borrowerAddr________________"))
+	require.NoError(t, err)
+
+	outsider, err := f.addressCodec.BytesToString([]byte("outsiderAddr________________"))
+	require.NoError(t, err)
+	f.registerAgency(t, lender)
+
+	moduleAddr, err := f.keeper.ModuleAddress()
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc  string
+		msg   *types.MsgSubmitRepayment
+		err   error
+		score uint64
+	}{
+		{
+			desc: "invalid borrower",
+			msg:  &types.MsgSubmitRepayment{Lender: lender, Borrower: "invalid", Kind: types.RepaymentKind_REPAYMENT_KIND_ON_TIME},
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc: "not accredited",
+			msg:  &types.MsgSubmitRepayment{Lender: outsider, Borrower: borrower, Kind: types.RepaymentKind_REPAYMENT_KIND_DEFAULT},
+			err:  types.ErrNotAccredited,
+		},
+		{
+			desc: "own repayment",
+			msg:  &types.MsgSubmitRepayment{Lender: lender, Borrower: lender, Kind: types.RepaymentKind_REPAYMENT_KIND_ON_TIME},
+			err:  types.ErrInvalidRepayment,
+		},
+		{
+			desc: "unspecified kind",
+			msg:  &types.MsgSubmitRepayment{Lender: lender, Borrower: borrower},
+			err:  types.ErrInvalidRepayment,
+		},
+		{
+			desc: "late without days",
+			msg:  &types.MsgSubmitRepayment{Lender: lender, Borrower: borrower, Kind: types.RepaymentKind_REPAYMENT_KIND_LATE},
+			err:  types.ErrInvalidRepayment,
+		},
+		{
+			desc: "on time with days",
+			msg:  &types.MsgSubmitRepayment{Lender: lender, Borrower: borrower, Kind: types.RepaymentKind_REPAYMENT_KIND_ON_TIME, DaysLate: 3},
+			err:  types.ErrInvalidRepayment,
+		},
+		{
+			desc:  "on time",
+			msg:   &types.MsgSubmitRepayment{Lender: lender, Borrower: borrower, Kind: types.RepaymentKind_REPAYMENT_KIND_ON_TIME, Reference: "loan-1/1"},
+			score: 605,
+		},
+		{
+			desc:  "late",
+			msg:   &types.MsgSubmitRepayment{Lender: lender, Borrower: borrower, Kind: types.RepaymentKind_REPAYMENT_KIND_LATE, DaysLate: 10},
+			score: 585,
+		},
+		{
+			desc:  "default",
+			msg:   &types.MsgSubmitRepayment{Lender: lender, Borrower: borrower, Kind: types.RepaymentKind_REPAYMENT_KIND_DEFAULT},
+			score: 385,
+		},
+		{
+			desc:  "floor",
+			msg:   &types.MsgSubmitRepayment{Lender: lender, Borrower: borrower, Kind: types.RepaymentKind_REPAYMENT_KIND_DEFAULT},
+			score: 300,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := srv.SubmitRepayment(f.ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.score, res.Score)
+
+			rate, err := f.keeper.Rate.Get(f.ctx, collections.Join(borrower, moduleAddr))
+			require.NoError(t, err)
+			require.Equal(t, tc.score, rate.Rate)
+			require.Equal(t, moduleAddr, rate.Creator)
+		})
+	}
+
+	// the computed rate is graded with the default scale
+	rate, err := f.keeper.Rate.Get(f.ctx, collections.Join(borrower, moduleAddr))
+	require.NoError(t, err)
+	require.Equal(t, "D", rate.Grade)
+
+	// each change of the score is recorded with its repayment event
+	history, err := keeper.NewQueryServerImpl(f.keeper).RateHistory(f.ctx, &types.QueryRateHistoryRequest{Symbol: borrower})
+	require.NoError(t, err)
+	require.Len(t, history.Changes, 4)
+	require.Equal(t, types.RateAction_RATE_ACTION_CREATED, history.Changes[0].Action)
+	require.Equal(t, types.RateAction_RATE_ACTION_UPDATED, history.Changes[3].Action)
+	require.Equal(t, uint64(385), history.Changes[3].PreviousRate)
+	require.Equal(t, uint64(300), history.Changes[3].Rate)
+
+	// the computed rate is issued by the module
+	_, err = srv.UpdateRate(f.ctx, &types.MsgUpdateRate{Creator: lender, Symbol: borrower, Rate: 850})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestRateQueryScoreBreakdown(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	lender, err := f.addressCodec.BytesToString([]byte("lenderAddr__________________"))
+	require.NoError(t, err)
+	borrower, err := f.addressCodec.BytesToString([]byte("borrowerAddr________________"))
+	require.NoError(t, err)
+
+	f.registerAgency(t, lender)
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+	for _, msg := range []*types.MsgSubmitRepayment{
+		{Kind: types.RepaymentKind_REPAYMENT_KIND_DEFAULT},
+		{Kind: types.RepaymentKind_REPAYMENT_KIND_ON_TIME},
+		{Kind: types.RepaymentKind_REPAYMENT_KIND_LATE, DaysLate: 10},
+		{Kind: types.RepaymentKind_REPAYMENT_KIND_ON_TIME},
+	} {
+		msg.Lender, msg.Borrower = lender, borrower
+		_, err := srv.SubmitRepayment(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	res, err := qs.GetRate(ctx, &types.QueryGetRateRequest{Symbol: borrower})
+	require.NoError(t, err)
+	require.Equal(t, uint64(390), res.Rate.Rate)
+	require.Equal(t, &types.ScoreBreakdown{
+		BaseScore: 600,
+		Factors: []types.ScoreFactor{
+			{Kind: types.RepaymentKind_REPAYMENT_KIND_ON_TIME, Count: 2, Impact: math.LegacyNewDec(10)},
+			{Kind: types.RepaymentKind_REPAYMENT_KIND_LATE, Count: 1, DaysLate: 10, Impact: math.LegacyNewDec(-20)},
+			{Kind: types.RepaymentKind_REPAYMENT_KIND_DEFAULT, Count: 1, Impact: math.LegacyNewDec(-200)},
+		},
+		RawScore:   math.LegacyNewDec(390),
+		Score:      390,
+		ComputedAt: start,
+	}, res.Breakdown)
+
+	// the events are halved after a half-life
+	halfLife := time.Duration(types.DefaultScoreModel().DecayHalfLife) * time.Second
+	res, err = qs.GetRate(ctx.WithBlockTime(start.Add(halfLife)), &types.QueryGetRateRequest{Symbol: borrower})
+	require.NoError(t, err)
+	require.Equal(t, uint64(495), res.Rate.Rate)
+	require.Equal(t, math.LegacyNewDec(495), res.Breakdown.RawScore)
+
+	// and decay linearly between two half-lives
+	res, err = qs.GetRate(ctx.WithBlockTime(start.Add(halfLife*3/2)), &types.QueryGetRateRequest{Symbol: borrower})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("521.25"), res.Breakdown.RawScore)
+	require.Equal(t, uint64(521), res.Rate.Rate)
+
+	list, err := qs.ListRate(ctx.WithBlockTime(start.Add(halfLife)), &types.QueryAllRateRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Rate, 1)
+	require.Equal(t, uint64(495), list.Rate[0].Rate)
+
+	repayments, err := qs.ListRepayment(ctx, &types.QueryAllRepaymentRequest{Borrower: borrower})
+	require.NoError(t, err)
+	require.Len(t, repayments.Repayments, 4)
+	require.Equal(t, lender, repayments.Repayments[2].Lender)
+	require.Equal(t, uint32(10), repayments.Repayments[2].DaysLate)
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"realfin/x/creditscore/types"
+)
+
+// OnRecvCreditscorePacket processes a creditscore packet received on the
+// channel and returns the result of its acknowledgement. The channel must be
+// allowed to query ratings and is charged the query fee.
+func (k Keeper) OnRecvCreditscorePacket(ctx context.Context, packet channeltypes.Packet, data types.CreditscorePacketData) ([]byte, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.QueryChannelAllowed(packet.DestinationChannel) {
+		return nil, errorsmod.Wrap(types.ErrChannelNotAllowed, packet.DestinationChannel)
+	}
+
+	switch data := data.Packet.(type) {
+	case *types.CreditscorePacketData_RatingRequest:
+		return k.onRecvRatingRequest(ctx, params, packet, data.RatingRequest)
+	default:
+		return nil, fmt.Errorf("unrecognized creditscore packet type: %T", data)
+	}
+}
+
+// onRecvRatingRequest charges the query fee to the channel and answers a
+// rating request with the consolidated ratings of the requested symbols.
+func (k Keeper) onRecvRatingRequest(ctx context.Context, params types.Params, packet channeltypes.Packet, request *types.RatingRequestPacketData) ([]byte, error) {
+	fee, err := k.chargeQueryFee(ctx, params, packet.DestinationChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleAddr, err := k.ModuleAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var response types.RatingResponsePacketData
+	for _, symbol := range request.Symbols {
+		rate, ratings, _, err := k.consolidatedRate(ctx, params, moduleAddr, symbol)
+		if err != nil {
+			return nil, err
+		}
+		if len(ratings) == 0 {
+			continue
+		}
+
+		response.Ratings = append(response.Ratings, types.PacketRating{
+			Symbol:    rate.Symbol,
+			Rate:      rate.Rate,
+			Scale:     rate.Scale,
+			Grade:     rate.Grade,
+			Withdrawn: rate.Withdrawn,
+			Disputed:  rate.Disputed,
+			Height:    sdkCtx.BlockHeight(),
+		})
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventRatingRequestAnswered{
+		ChannelId: packet.DestinationChannel,
+		Sequence:  packet.Sequence,
+		Symbols:   request.Symbols,
+		Fee:       fee,
+	}); err != nil {
+		return nil, err
+	}
+
+	return types.ModuleCdc.MarshalJSON(&response)
+}
+
+// chargeQueryFee burns the query fee from the credit of the channel and
+// returns it.
+func (k Keeper) chargeQueryFee(ctx context.Context, params types.Params, channelID string) (sdk.Coins, error) {
+	fee := params.QueryFeeCoins()
+	if fee.IsZero() {
+		return fee, nil
+	}
+
+	credit, err := k.ChannelCredit.Get(ctx, channelID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	balance, hasNeg := credit.Balance.SafeSub(fee...)
+	if hasNeg {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "channel credit %s is below the query fee %s", credit.Balance, fee)
+	}
+
+	credit.ChannelId = channelID
+	credit.Balance = balance
+	if err := k.ChannelCredit.Set(ctx, channelID, credit); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee); err != nil {
+		return nil, err
+	}
+
+	return fee, nil
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/creditscore/keeper"
+	"realfin/x/creditscore/types"
+)
+
+func TestOnRecvRatingRequest(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(12)
+	agency, err := f.addressCodec.BytesToString([]byte("agencyAddr__________________"))
+	require.NoError(t, err)
+	f.registerAgency(t, agency)
+	_, err = srv.CreateRate(ctx, &types.MsgCreateRate{Creator: agency, Symbol: "SME-001", Rate: 720})
+	require.NoError(t, err)
+
+	packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: "channel-0", Sequence: 3}
+	request := types.CreditscorePacketData{Packet: &types.CreditscorePacketData_RatingRequest{
+		RatingRequest: &types.RatingRequestPacketData{Symbols: []string{"SME-001", "SME-002"}},
+	}}
+
+	// channels are not allowed by default
+	_, err = f.keeper.OnRecvCreditscorePacket(ctx, packet, request)
+	require.ErrorIs(t, err, types.ErrChannelNotAllowed)
+
+	params := types.DefaultParams()
+	params.QueryChannels = []string{"channel-0"}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	result, err := f.keeper.OnRecvCreditscorePacket(ctx, packet, request)
+	require.NoError(t, err)
+	answered := false
+	for _, event := range ctx.EventManager().Events() {
+		answered = answered || event.Type == proto.MessageName(&types.EventRatingRequestAnswered{})
+	}
+	require.True(t, answered)
+
+	consolidated, err := qs.GetRate(ctx, &types.QueryGetRateRequest{Symbol: "SME-001"})
+	require.NoError(t, err)
+	var response types.RatingResponsePacketData
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(result, &response))
+	require.Equal(t, []types.PacketRating{{
+		Symbol: "SME-001",
+		Rate:   720,
+		Scale:  consolidated.Rate.Scale,
+		Grade:  consolidated.Rate.Grade,
+		Height: 12,
+	}}, response.Ratings)
+
+	// invalid requests are rejected
+	_, err = f.keeper.OnRecvCreditscorePacket(ctx, packet, types.CreditscorePacketData{Packet: &types.CreditscorePacketData_RatingRequest{
+		RatingRequest: &types.RatingRequestPacketData{Symbols: []string{"SME-001", "SME-001"}},
+	}})
+	require.Error(t, err)
+	_, err = f.keeper.OnRecvCreditscorePacket(ctx, packet, types.CreditscorePacketData{Packet: &types.CreditscorePacketData_NoData{}})
+	require.Error(t, err)
+}
+
+func TestChannelCreditQueryFee(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	sender, err := f.addressCodec.BytesToString([]byte("senderAddr__________________"))
+	require.NoError(t, err)
+	fee := sdk.NewInt64Coin(types.DefaultDisputeDenom, 100)
+	params := types.DefaultParams()
+	params.QueryChannels = []string{"channel-0"}
+	params.QueryFee = fee
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.bankKeeper.Balances[sdk.MustAccAddressFromBech32(sender).String()] = sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDisputeDenom, 1_000))
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	// funding
+	_, err = srv.FundChannelCredit(f.ctx, &types.MsgFundChannelCredit{Sender: sender, ChannelId: "channel-1", Amount: sdk.NewCoins(fee)})
+	require.ErrorIs(t, err, types.ErrChannelNotAllowed)
+	_, err = srv.FundChannelCredit(f.ctx, &types.MsgFundChannelCredit{Sender: sender, ChannelId: "channel-0"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+	_, err = srv.FundChannelCredit(f.ctx, &types.MsgFundChannelCredit{Sender: sender, ChannelId: "channel-0", Amount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDisputeDenom, 2_000))})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	_, err = srv.FundChannelCredit(f.ctx, &types.MsgFundChannelCredit{Sender: sender, ChannelId: "channel-0", Amount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDisputeDenom, 150))})
+	require.NoError(t, err)
+	credit, err := qs.GetChannelCredit(f.ctx, &types.QueryGetChannelCreditRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDisputeDenom, 150)), credit.ChannelCredit.Balance)
+	require.Equal(t, credit.ChannelCredit.Balance, f.bankKeeper.Balances[moduleAddr])
+
+	// every request burns the fee from the credit
+	packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: "channel-0"}
+	request := types.CreditscorePacketData{Packet: &types.CreditscorePacketData_RatingRequest{
+		RatingRequest: &types.RatingRequestPacketData{Symbols: []string{"SME-001"}},
+	}}
+	_, err = f.keeper.OnRecvCreditscorePacket(f.ctx, packet, request)
+	require.NoError(t, err)
+	credit, err = qs.GetChannelCredit(f.ctx, &types.QueryGetChannelCreditRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDisputeDenom, 50)), credit.ChannelCredit.Balance)
+	require.Equal(t, credit.ChannelCredit.Balance, f.bankKeeper.Balances[moduleAddr])
+
+	_, err = f.keeper.OnRecvCreditscorePacket(f.ctx, packet, request)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// free queries need no credit
+	params.QueryFee = sdk.NewCoin(types.DefaultDisputeDenom, math.ZeroInt())
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	_, err = f.keeper.OnRecvCreditscorePacket(f.ctx, packet, request)
+	require.NoError(t, err)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"realfin/x/creditscore/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListAgency(ctx context.Context, req *types.QueryAllAgencyRequest) (*types.QueryAllAgencyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	agencies, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Agency,
+		req.Pagination,
+		func(_ string, value types.Agency) (types.Agency, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAgencyResponse{Agencies: agencies, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetAgency(ctx context.Context, req *types.QueryGetAgencyRequest) (*types.QueryGetAgencyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Agency.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetAgencyResponse{Agency: val}, nil
+}
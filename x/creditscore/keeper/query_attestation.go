@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"realfin/x/creditscore/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListAttestation(ctx context.Context, req *types.QueryAllAttestationRequest) (*types.QueryAllAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	attestations, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.Attestation,
+		req.Pagination,
+		func(_ uint64, value types.Attestation) (bool, error) {
+			return req.Agency == "" || value.Agency == req.Agency, nil
+		},
+		func(_ uint64, value types.Attestation) (types.Attestation, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAttestationResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetAttestation(ctx context.Context, req *types.QueryGetAttestationRequest) (*types.QueryGetAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Attestation.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetAttestationResponse{Attestation: val}, nil
+}
+
+func (q queryServer) VerifyAttestation(ctx context.Context, req *types.QueryVerifyAttestationRequest) (*types.QueryVerifyAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	salt, err := hex.DecodeString(req.Salt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "salt is not hex encoded")
+	}
+	if len(salt) < types.MinSaltLength {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("salt must be at least %d bytes", types.MinSaltLength))
+	}
+
+	val, err := q.k.Attestation.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	accredited, err := q.k.IsAccredited(ctx, val.Agency)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	commitment, err := hex.DecodeString(val.Commitment)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	var verified types.AttestationStatus
+	switch {
+	case !bytes.Equal(types.AttestationCommitment(req.Subject, req.Rate, req.Grade, salt), commitment):
+		verified = types.AttestationStatus_ATTESTATION_STATUS_MISMATCH
+	case val.Revoked:
+		verified = types.AttestationStatus_ATTESTATION_STATUS_REVOKED
+	case !accredited:
+		verified = types.AttestationStatus_ATTESTATION_STATUS_AGENCY_REVOKED
+	case val.Expired(sdk.UnwrapSDKContext(ctx).BlockTime()):
+		verified = types.AttestationStatus_ATTESTATION_STATUS_EXPIRED
+	default:
+		verified = types.AttestationStatus_ATTESTATION_STATUS_VALID
+	}
+
+	return &types.QueryVerifyAttestationResponse{
+		Valid:       verified == types.AttestationStatus_ATTESTATION_STATUS_VALID,
+		Status:      verified,
+		Attestation: val,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"realfin/x/creditscore/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) CreditLimit(ctx context.Context, req *types.QueryCreditLimitRequest) (*types.QueryCreditLimitResponse, error) {
+	if req == nil || req.Subject == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	limit, err := q.k.CreditLimit(ctx, req.Subject)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCreditLimitResponse{CreditLimit: limit}, nil
+}
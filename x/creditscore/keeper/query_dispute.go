@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"realfin/x/creditscore/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListDispute(ctx context.Context, req *types.QueryAllDisputeRequest) (*types.QueryAllDisputeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	disputes, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.Dispute,
+		req.Pagination,
+		func(_ uint64, value types.Dispute) (bool, error) {
+			if req.Symbol != "" && value.Symbol != req.Symbol {
+				return false, nil
+			}
+			return req.Status == types.DisputeStatus_DISPUTE_STATUS_UNSPECIFIED || value.Status == req.Status, nil
+		},
+		func(_ uint64, value types.Dispute) (types.Dispute, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDisputeResponse{Disputes: disputes, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetDispute(ctx context.Context, req *types.QueryGetDisputeRequest) (*types.QueryGetDisputeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Dispute.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetDisputeResponse{Dispute: val}, nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"realfin/x/creditscore/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) RateHistory(ctx context.Context, req *types.QueryRateHistoryRequest) (*types.QueryRateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Symbol == "" {
+		return nil, status.Error(codes.InvalidArgument, "symbol is required")
+	}
+
+	changes, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.RateHistory,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], value types.RateChange) (bool, error) {
+			return req.Creator == "" || value.Creator == req.Creator, nil
+		},
+		func(_ collections.Pair[string, uint64], value types.RateChange) (types.RateChange, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Symbol),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRateHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/creditscore/keeper"
+	"realfin/x/creditscore/types"
+)
+
+func TestRateHistory(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	agency, err := f.addressCodec.BytesToString([]byte("agencyAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAgencyAddr_____________"))
+	require.NoError(t, err)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	f.registerAgency(t, agency)
+	f.registerAgency(t, other)
+
+	evidence := strings.Repeat("ab", types.EvidenceHashLength)
+	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: agency, Symbol: "SME-001", Rate: 760, Reason: "initial rating", EvidenceHash: evidence})
+	require.NoError(t, err)
+	_, err = srv.UpdateRate(f.ctx, &types.MsgUpdateRate{Creator: agency, Symbol: "SME-001", Rate: 610, Reason: "missed payments"})
+	require.NoError(t, err)
+	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: other, Symbol: "SME-001", Rate: 700})
+	require.NoError(t, err)
+	_, err = srv.DeleteRate(f.ctx, &types.MsgDeleteRate{Creator: other, Symbol: "SME-001", Reason: "mandate ended"})
+	require.NoError(t, err)
+	_, err = srv.RevokeAgency(f.ctx, &types.MsgRevokeAgency{Authority: authority, Address: agency})
+	require.NoError(t, err)
+
+	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: other, Symbol: "SME-002", EvidenceHash: "abcd"})
+	require.ErrorIs(t, err, types.ErrInvalidChange)
+	_, err = srv.DeleteRate(f.ctx, &types.MsgDeleteRate{Creator: other, Symbol: "SME-002", Reason: strings.Repeat("x", types.MaxReasonLength+1)})
+	require.ErrorIs(t, err, types.ErrInvalidChange)
+
+	resp, err := qs.RateHistory(f.ctx, &types.QueryRateHistoryRequest{Symbol: "SME-001"})
+	require.NoError(t, err)
+	require.Len(t, resp.Changes, 5)
+
+	type change struct {
+		action               types.RateAction
+		creator              string
+		prevRate, rate       uint64
+		prevGrade, grade     string
+		reason, evidenceHash string
+	}
+	expected := []change{
+		{types.RateAction_RATE_ACTION_CREATED, agency, 0, 760, "", "AA", "initial rating", evidence},
+		{types.RateAction_RATE_ACTION_UPDATED, agency, 760, 610, "AA", "BB", "missed payments", ""},
+		{types.RateAction_RATE_ACTION_CREATED, other, 0, 700, "", "A", "", ""},
+		{types.RateAction_RATE_ACTION_DELETED, other, 700, 0, "A", "", "mandate ended", ""},
+		{types.RateAction_RATE_ACTION_WITHDRAWN, agency, 610, 610, "BB", "BB", "agency revoked", ""},
+	}
+	for i, got := range resp.Changes {
+		require.Equal(t, uint64(i), got.Id)
+		require.Equal(t, "SME-001", got.Symbol)
+		require.Equal(t, expected[i], change{got.Action, got.Creator, got.PreviousRate, got.Rate, got.PreviousGrade, got.Grade, got.Reason, got.EvidenceHash})
+	}
+
+	resp, err = qs.RateHistory(f.ctx, &types.QueryRateHistoryRequest{Symbol: "SME-001", Creator: other})
+	require.NoError(t, err)
+	require.Len(t, resp.Changes, 2)
+
+	resp, err = qs.RateHistory(f.ctx, &types.QueryRateHistoryRequest{Symbol: "SME-001", Pagination: &query.PageRequest{Offset: 3, Limit: 10, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, resp.Changes, 2)
+	require.Equal(t, uint64(5), resp.Pagination.Total)
+
+	_, err = qs.RateHistory(f.ctx, &types.QueryRateHistoryRequest{})
+	require.Error(t, err)
+}
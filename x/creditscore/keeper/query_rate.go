@@ -2,7 +2,7 @@ package keeper
 
 import (
 	"context"
-	"errors"
+	"slices"
 
 	"realfin/x/creditscore/types"
 
@@ -17,12 +17,35 @@ func (q queryServer) ListRate(ctx context.Context, req *types.QueryAllRateReques
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	rates, pageRes, err := query.CollectionPaginate(
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	moduleAddr, err := q.k.ModuleAddress()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rates, pageRes, err := query.CollectionFilteredPaginate(
 		ctx,
 		q.k.Rate,
 		req.Pagination,
-		func(_ string, value types.Rate) (types.Rate, error) {
-			return value, nil
+		func(_ collections.Pair[string, string], value types.Rate) (bool, error) {
+			if req.Grade == "" && req.Outlook == types.RatingOutlook_RATING_OUTLOOK_UNSPECIFIED {
+				return true, nil
+			}
+
+			// computed scores decay with time
+			value, _, err := q.k.currentRate(ctx, params, moduleAddr, value)
+			if err != nil {
+				return false, err
+			}
+			return (req.Grade == "" || value.Grade == req.Grade) &&
+				(req.Outlook == types.RatingOutlook_RATING_OUTLOOK_UNSPECIFIED || value.Outlook == req.Outlook), nil
+		},
+		func(_ collections.Pair[string, string], value types.Rate) (types.Rate, error) {
+			value, _, err := q.k.currentRate(ctx, params, moduleAddr, value)
+			return value, err
 		},
 	)
 	if err != nil {
@@ -37,14 +60,92 @@ func (q queryServer) GetRate(ctx context.Context, req *types.QueryGetRateRequest
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, err := q.k.Rate.Get(ctx, req.Symbol)
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	moduleAddr, err := q.k.ModuleAddress()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rate, ratings, breakdown, err := q.k.consolidatedRate(ctx, params, moduleAddr, req.Symbol)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(ratings) == 0 {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRateResponse{Rate: rate, Ratings: ratings, Breakdown: breakdown}, nil
+}
+
+// consolidatedRate returns the consolidated rate of the symbol as of the block
+// time, with the ratings of its agencies and the breakdown of its computed
+// score. No ratings are returned for an unknown symbol.
+func (k Keeper) consolidatedRate(ctx context.Context, params types.Params, moduleAddr, symbol string) (types.Rate, []types.Rate, *types.ScoreBreakdown, error) {
+	ratings, err := k.symbolRates(ctx, symbol)
 	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "not found")
+		return types.Rate{}, nil, nil, err
+	}
+
+	return k.consolidateRatings(ctx, params, moduleAddr, symbol, ratings)
+}
+
+// consolidateRatings consolidates the ratings under the symbol, recomputing
+// those of the module at the block time.
+func (k Keeper) consolidateRatings(ctx context.Context, params types.Params, moduleAddr, symbol string, ratings []types.Rate) (types.Rate, []types.Rate, *types.ScoreBreakdown, error) {
+	if len(ratings) == 0 {
+		return types.Rate{}, nil, nil, nil
+	}
+
+	var breakdown *types.ScoreBreakdown
+	for i, rating := range ratings {
+		// the score is recomputed at the block time, as the events decay
+		rating, ratingBreakdown, err := k.currentRate(ctx, params, moduleAddr, rating)
+		if err != nil {
+			return types.Rate{}, nil, nil, err
+		}
+		ratings[i] = rating
+		if ratingBreakdown != nil {
+			breakdown = ratingBreakdown
 		}
+	}
+
+	rate := consolidateRates(symbol, ratings)
+	if !rate.Withdrawn {
+		if err := gradeRate(params, &rate); err != nil {
+			return types.Rate{}, nil, nil, err
+		}
+	}
+
+	return rate, ratings, breakdown, nil
+}
+
+// consolidateRates returns the rate of the symbol consolidating the rates of
+// its agencies: the median of the rates not withdrawn, rounded down, disputed
+// when one of them is. It is graded with the default scale by the caller
+// unless withdrawn.
+func consolidateRates(symbol string, ratings []types.Rate) types.Rate {
+	values := make([]uint64, 0, len(ratings))
+	disputed := false
+	for _, rating := range ratings {
+		if !rating.Withdrawn {
+			values = append(values, rating.Rate)
+			disputed = disputed || rating.Disputed
+		}
+	}
+	if len(values) == 0 {
+		return types.Rate{Symbol: symbol, Withdrawn: true}
+	}
 
-		return nil, status.Error(codes.Internal, "internal error")
+	slices.Sort(values)
+	mid := len(values) / 2
+	if len(values)%2 == 1 {
+		return types.Rate{Symbol: symbol, Rate: values[mid], Disputed: disputed}
 	}
 
-	return &types.QueryGetRateResponse{Rate: val}, nil
+	// the mean of the two middle values, without overflow
+	lo, hi := values[mid-1], values[mid]
+	return types.Rate{Symbol: symbol, Rate: lo + (hi-lo)/2, Disputed: disputed}
 }
@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"testing"
 
+	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -21,7 +23,7 @@ func createNRate(keeper keeper.Keeper, ctx context.Context, n int) []types.Rate
 		items[i].Rate = uint64(i)
 		items[i].Name = strconv.Itoa(i)
 		items[i].Description = strconv.Itoa(i)
-		_ = keeper.Rate.Set(ctx, items[i].Symbol, items[i])
+		_ = keeper.Rate.Set(ctx, collections.Join(items[i].Symbol, items[i].Creator), items[i])
 	}
 	return items
 }
@@ -41,14 +43,20 @@ func TestRateQuerySingle(t *testing.T) {
 			request: &types.QueryGetRateRequest{
 				Symbol: msgs[0].Symbol,
 			},
-			response: &types.QueryGetRateResponse{Rate: msgs[0]},
+			response: &types.QueryGetRateResponse{
+				Rate:    types.Rate{Symbol: msgs[0].Symbol, Rate: msgs[0].Rate, Scale: "default", Grade: "D"},
+				Ratings: []types.Rate{msgs[0]},
+			},
 		},
 		{
 			desc: "Second",
 			request: &types.QueryGetRateRequest{
 				Symbol: msgs[1].Symbol,
 			},
-			response: &types.QueryGetRateResponse{Rate: msgs[1]},
+			response: &types.QueryGetRateResponse{
+				Rate:    types.Rate{Symbol: msgs[1].Symbol, Rate: msgs[1].Rate, Scale: "default", Grade: "D"},
+				Ratings: []types.Rate{msgs[1]},
+			},
 		},
 		{
 			desc: "KeyNotFound",
@@ -75,6 +83,53 @@ func TestRateQuerySingle(t *testing.T) {
 	}
 }
 
+func TestRateQueryConsolidated(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	var agencies []string
+	for i, rate := range []uint64{700, 640, 820, 660} {
+		agency, err := f.addressCodec.BytesToString([]byte(fmt.Sprintf("agencyAddr%018d", i)))
+		require.NoError(t, err)
+		f.registerAgency(t, agency)
+		agencies = append(agencies, agency)
+
+		_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: agency, Symbol: "SME-001", Rate: rate})
+		require.NoError(t, err)
+	}
+
+	// the mean of the two middle rates
+	res, err := qs.GetRate(f.ctx, &types.QueryGetRateRequest{Symbol: "SME-001"})
+	require.NoError(t, err)
+	require.Equal(t, types.Rate{Symbol: "SME-001", Rate: 680, Scale: "default", Grade: "BBB"}, res.Rate)
+	require.Len(t, res.Ratings, 4)
+
+	// the rates of a revoked agency are withdrawn, not deleted
+	revoked, err := srv.RevokeAgency(f.ctx, &types.MsgRevokeAgency{Authority: authority, Address: agencies[2]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), revoked.Withdrawn)
+
+	res, err = qs.GetRate(f.ctx, &types.QueryGetRateRequest{Symbol: "SME-001"})
+	require.NoError(t, err)
+	require.Equal(t, types.Rate{Symbol: "SME-001", Rate: 660, Scale: "default", Grade: "BBB"}, res.Rate)
+	require.Len(t, res.Ratings, 4)
+	for _, rating := range res.Ratings {
+		require.Equal(t, rating.Creator == agencies[2], rating.Withdrawn)
+	}
+
+	for _, agency := range []string{agencies[0], agencies[1], agencies[3]} {
+		_, err = srv.RevokeAgency(f.ctx, &types.MsgRevokeAgency{Authority: authority, Address: agency})
+		require.NoError(t, err)
+	}
+	res, err = qs.GetRate(f.ctx, &types.QueryGetRateRequest{Symbol: "SME-001"})
+	require.NoError(t, err)
+	require.Equal(t, types.Rate{Symbol: "SME-001", Withdrawn: true}, res.Rate)
+}
+
 func TestRateQueryPaginated(t *testing.T) {
 	f := initFixture(t)
 	qs := keeper.NewQueryServerImpl(f.keeper)
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"realfin/x/creditscore/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListRepayment(ctx context.Context, req *types.QueryAllRepaymentRequest) (*types.QueryAllRepaymentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	repayments, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Repayment,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], value types.RepaymentEvent) (types.RepaymentEvent, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Borrower),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRepaymentResponse{Repayments: repayments, Pagination: pageRes}, nil
+}
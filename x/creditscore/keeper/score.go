@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/creditscore/types"
+)
+
+// Score computes the score of the borrower from its repayment events at the
+// block time, and returns the factors that produced it.
+func (k Keeper) Score(ctx context.Context, borrower string) (types.ScoreBreakdown, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.ScoreBreakdown{}, err
+	}
+	model := params.ScoreModel
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	factors := make(map[types.RepaymentKind]*types.ScoreFactor)
+	raw := math.LegacyNewDecFromInt(math.NewIntFromUint64(model.BaseScore))
+	err = k.Repayment.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](borrower), func(_ collections.Pair[string, uint64], event types.RepaymentEvent) (bool, error) {
+		factor, ok := factors[event.Kind]
+		if !ok {
+			factor = &types.ScoreFactor{Kind: event.Kind, Impact: math.LegacyZeroDec()}
+			factors[event.Kind] = factor
+		}
+
+		impact := math.LegacyNewDec(eventWeight(model, event)).Mul(decayFactor(now.Sub(event.Time), model.DecayHalfLife))
+		factor.Count++
+		factor.DaysLate += uint64(event.DaysLate)
+		factor.Impact = factor.Impact.Add(impact)
+		raw = raw.Add(impact)
+		return false, nil
+	})
+	if err != nil {
+		return types.ScoreBreakdown{}, err
+	}
+
+	breakdown := types.ScoreBreakdown{
+		BaseScore:  model.BaseScore,
+		RawScore:   raw,
+		Score:      boundScore(model, raw),
+		ComputedAt: now,
+	}
+	// the factors are listed in the order of their kinds
+	for kind := int32(0); kind < int32(len(types.RepaymentKind_name)); kind++ {
+		if factor, ok := factors[types.RepaymentKind(kind)]; ok {
+			breakdown.Factors = append(breakdown.Factors, *factor)
+		}
+	}
+
+	return breakdown, nil
+}
+
+// eventWeight returns the number of points of the event before decay.
+func eventWeight(model types.ScoreModel, event types.RepaymentEvent) int64 {
+	switch event.Kind {
+	case types.RepaymentKind_REPAYMENT_KIND_ON_TIME:
+		return model.OnTimeWeight
+	case types.RepaymentKind_REPAYMENT_KIND_LATE:
+		return model.LateWeight + model.LateDayWeight*int64(event.DaysLate)
+	case types.RepaymentKind_REPAYMENT_KIND_DEFAULT:
+		return model.DefaultWeight
+	case types.RepaymentKind_REPAYMENT_KIND_RESTRUCTURED:
+		return model.RestructuredWeight
+	default:
+		return 0
+	}
+}
+
+// decayFactor returns the share of its weight an event of the given age
+// still contributes: it is halved every half-life, and decreases linearly
+// between two half-lives. A zero half-life disables the decay.
+func decayFactor(age time.Duration, halfLife uint64) math.LegacyDec {
+	if halfLife == 0 || age <= 0 {
+		return math.LegacyOneDec()
+	}
+
+	seconds := uint64(age / time.Second)
+	halvings := seconds / halfLife
+	if halvings >= 63 {
+		return math.LegacyZeroDec()
+	}
+
+	// 0.5^n * (1 - f/2) with f the elapsed fraction of the current half-life
+	fraction := math.LegacyNewDecFromInt(math.NewIntFromUint64(seconds % halfLife)).
+		Quo(math.LegacyNewDecFromInt(math.NewIntFromUint64(halfLife)))
+	return math.LegacyOneDec().Sub(fraction.QuoInt64(2)).QuoInt64(int64(1) << halvings)
+}
+
+// boundScore truncates the raw score and bounds it by the floor and the
+// ceiling of the model.
+func boundScore(model types.ScoreModel, raw math.LegacyDec) uint64 {
+	switch {
+	case raw.LTE(math.LegacyNewDecFromInt(math.NewIntFromUint64(model.Floor))):
+		return model.Floor
+	case raw.GTE(math.LegacyNewDecFromInt(math.NewIntFromUint64(model.Ceiling))):
+		return model.Ceiling
+	default:
+		return raw.TruncateInt().Uint64()
+	}
+}
+
+// refreshScore recomputes the score of the borrower and stores it as the rate
+// of the borrower issued by the module, graded with the default scale. The
+// name and description of the rate are kept, and the changes of the score are
+// recorded in the history of the rate with the reason.
+func (k Keeper) refreshScore(ctx context.Context, borrower, reason string) (types.ScoreBreakdown, error) {
+	breakdown, err := k.Score(ctx, borrower)
+	if err != nil {
+		return types.ScoreBreakdown{}, err
+	}
+	moduleAddr, err := k.ModuleAddress()
+	if err != nil {
+		return types.ScoreBreakdown{}, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.ScoreBreakdown{}, err
+	}
+
+	action := types.RateAction_RATE_ACTION_UPDATED
+	prev, err := k.Rate.Get(ctx, collections.Join(borrower, moduleAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		action = types.RateAction_RATE_ACTION_CREATED
+	} else if err != nil {
+		return types.ScoreBreakdown{}, err
+	}
+	rate := prev
+	rate.Symbol = borrower
+	rate.Rate = breakdown.Score
+	rate.Creator = moduleAddr
+	rate.Scale = ""
+	if err := gradeRate(params, &rate); err != nil {
+		return types.ScoreBreakdown{}, err
+	}
+
+	if err := k.Rate.Set(ctx, collections.Join(borrower, moduleAddr), rate); err != nil {
+		return types.ScoreBreakdown{}, err
+	}
+
+	// Only the changes of the score are recorded
+	if action == types.RateAction_RATE_ACTION_CREATED || prev.Rate != rate.Rate || prev.Grade != rate.Grade {
+		if err := k.appendRateChange(ctx, action, prev, rate, reason, ""); err != nil {
+			return types.ScoreBreakdown{}, err
+		}
+	}
+
+	return breakdown, emitGradeChange(ctx, params, prev, rate)
+}
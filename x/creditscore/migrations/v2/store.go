@@ -0,0 +1,50 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"realfin/x/creditscore/types"
+)
+
+// RateKey is the prefix of the rates of version 1, keyed by symbol only.
+var RateKey = collections.NewPrefix("rate/value/")
+
+// MigrateStore migrates the rates of version 1, keyed by symbol, to the rates
+// keyed by symbol and creator, so that several agencies can rate the same
+// symbol: every rate is removed from the store of version 1 and passed to
+// setRate. The creators of the rates are kept whether or not they are
+// accredited agencies.
+func MigrateStore(
+	ctx context.Context,
+	storeService corestore.KVStoreService,
+	cdc codec.BinaryCodec,
+	setRate func(context.Context, types.Rate) error,
+) error {
+	legacy := collections.NewMap(
+		collections.NewSchemaBuilder(storeService), RateKey, "rate",
+		collections.StringKey, codec.CollValue[types.Rate](cdc),
+	)
+
+	var rates []types.Rate
+	if err := legacy.Walk(ctx, nil, func(_ string, rate types.Rate) (bool, error) {
+		rates = append(rates, rate)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, rate := range rates {
+		if err := legacy.Remove(ctx, rate.Symbol); err != nil {
+			return err
+		}
+		if err := setRate(ctx, rate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
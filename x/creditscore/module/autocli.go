@@ -25,10 +25,79 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod:      "GetRate",
 					Use:            "get-rate [id]",
-					Short:          "Gets a rate",
+					Short:          "Gets the consolidated rate of a symbol and the rates of its agencies",
 					Alias:          []string{"show-rate"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
 				},
+				{
+					RpcMethod:      "ListRepayment",
+					Use:            "list-repayment [borrower]",
+					Short:          "List the repayment events of a borrower",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "borrower"}},
+				},
+				{
+					RpcMethod:      "RateHistory",
+					Use:            "rate-history [symbol]",
+					Short:          "List the changes of the rates of a symbol, oldest first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
+				},
+				{
+					RpcMethod: "ListAgency",
+					Use:       "list-agency",
+					Short:     "List the accredited rating agencies",
+				},
+				{
+					RpcMethod:      "GetAgency",
+					Use:            "get-agency [address]",
+					Short:          "Gets an accredited rating agency",
+					Alias:          []string{"show-agency"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "ListAttestation",
+					Use:       "list-attestation",
+					Short:     "List the attestations, optionally of an agency",
+				},
+				{
+					RpcMethod:      "GetAttestation",
+					Use:            "get-attestation [id]",
+					Short:          "Gets an attestation",
+					Alias:          []string{"show-attestation"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "VerifyAttestation",
+					Use:            "verify-attestation [id] [subject] [rate] [salt]",
+					Short:          "Verify a rating revealed by the subject of an attestation",
+					Long:           "Verify a rating revealed by the subject of an attestation against its commitment. The salt is hex encoded, and the grade of the rating is set with --grade.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "subject"}, {ProtoField: "rate"}, {ProtoField: "salt"}},
+				},
+				{
+					RpcMethod: "ListDispute",
+					Use:       "list-dispute",
+					Short:     "List the disputes, optionally of a symbol and by status",
+				},
+				{
+					RpcMethod:      "GetDispute",
+					Use:            "get-dispute [id]",
+					Short:          "Gets a dispute",
+					Alias:          []string{"show-dispute"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "GetChannelCredit",
+					Use:            "get-channel-credit [channel-id]",
+					Short:          "Gets the prepaid query fees of an IBC channel",
+					Alias:          []string{"show-channel-credit"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}},
+				},
+				{
+					RpcMethod:      "CreditLimit",
+					Use:            "credit-limit [subject]",
+					Short:          "Shows the credit line of a subject and its breakdown",
+					Long:           "Shows the credit line of a subject: the oracle value of the spendable balances it holds times the loan-to-value ratio of the grade of its consolidated rate, with the value of every balance.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subject"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -40,6 +109,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "RegisterAgency",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RevokeAgency",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod:      "CreateRate",
 					Use:            "create-rate [symbol] [rate] [name] [description]",
@@ -58,6 +135,53 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete rate",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
 				},
+				{
+					RpcMethod:      "SubmitRepayment",
+					Use:            "submit-repayment [borrower] [kind]",
+					Short:          "Submit a repayment event of a borrower",
+					Long:           "Submit a repayment event of a borrower, of kind on-time, late, default or restructured. Late repayments require --days-late. Only accredited agencies can submit repayments. The score of the borrower is recomputed from its repayment events.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "borrower"}, {ProtoField: "kind"}},
+				},
+				{
+					RpcMethod:      "CreateAttestation",
+					Use:            "create-attestation [commitment]",
+					Short:          "Post the commitment to a rating kept off chain",
+					Long:           "Post the hex-encoded SHA-256 commitment to a rating kept off chain, optionally expiring at --expires-at.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "commitment"}},
+				},
+				{
+					RpcMethod:      "RevokeAttestation",
+					Use:            "revoke-attestation [id]",
+					Short:          "Revoke an attestation",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "FileDispute",
+					Use:            "file-dispute [symbol] [agency] [reason]",
+					Short:          "Dispute a rate as its subject",
+					Long:           "Dispute the rate of a symbol issued by an agency as the subject of the rate, escrowing the dispute deposit.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}, {ProtoField: "agency"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "RespondDispute",
+					Use:            "respond-dispute [id] [response]",
+					Short:          "Respond to a dispute of a rate of the agency",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "response"}},
+				},
+				{
+					RpcMethod:      "ResolveDispute",
+					Use:            "resolve-dispute [id] [outcome]",
+					Short:          "Resolve a dispute as the arbiter",
+					Long:           "Resolve a dispute as the arbiter, once the agency responded or its response window closed. The outcome is uphold, amend or void, and amendments set the new rate with --rate.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "outcome"}},
+				},
+				{
+					RpcMethod:      "FundChannelCredit",
+					Use:            "fund-channel-credit [channel-id] [amount]",
+					Short:          "Prepay the rating query fees of an IBC channel",
+					Long:           "Prepay the rating query fees of an IBC channel allowed to query ratings. Every rating request received on the channel burns the query fee from its credit, which is not refundable.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "amount", Varargs: true}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
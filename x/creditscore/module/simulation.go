@@ -2,7 +2,10 @@ package creditscore
 
 import (
 	"math/rand"
+	"strconv"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -18,13 +21,32 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+	// the first accounts are accredited agencies
+	agencies := make([]types.Agency, 0, 3)
+	for i := 0; i < len(accs) && i < cap(agencies); i++ {
+		agencies = append(agencies, types.Agency{Address: accs[i], Name: "agency-" + strconv.Itoa(i)})
+	}
+	// the first account arbitrates disputes, whose deposits are in the bond
+	// denom of the simulation
+	params := types.DefaultParams()
+	params.DisputeDenom = sdk.DefaultBondDenom
+	params.DisputeDeposit = math.NewInt(1000)
+	params.DisputeResponseWindow = 3600
+	if len(accs) > 0 {
+		params.Arbiter = accs[0]
+	}
+	// rating queries are charged in the bond denom as well
+	params.QueryChannels = []string{"channel-0"}
+	params.QueryFee = sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10))
 	creditscoreGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params: params,
 		RateMap: []types.Rate{{Creator: sample.AccAddress(),
 			Symbol: "0",
 		}, {Creator: sample.AccAddress(),
 			Symbol: "1",
-		}}}
+		}},
+		Agencies: agencies,
+	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&creditscoreGenesis)
 }
 
@@ -79,11 +101,122 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgDeleteRate,
 		creditscoresimulation.SimulateMsgDeleteRate(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
+	const (
+		opWeightMsgSubmitRepayment          = "op_weight_msg_creditscore"
+		defaultWeightMsgSubmitRepayment int = 100
+	)
+
+	var weightMsgSubmitRepayment int
+	simState.AppParams.GetOrGenerate(opWeightMsgSubmitRepayment, &weightMsgSubmitRepayment, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitRepayment = defaultWeightMsgSubmitRepayment
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitRepayment,
+		creditscoresimulation.SimulateMsgSubmitRepayment(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgCreateAttestation          = "op_weight_msg_creditscore"
+		defaultWeightMsgCreateAttestation int = 100
+	)
+
+	var weightMsgCreateAttestation int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateAttestation, &weightMsgCreateAttestation, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateAttestation = defaultWeightMsgCreateAttestation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateAttestation,
+		creditscoresimulation.SimulateMsgCreateAttestation(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgRevokeAttestation          = "op_weight_msg_creditscore"
+		defaultWeightMsgRevokeAttestation int = 50
+	)
+
+	var weightMsgRevokeAttestation int
+	simState.AppParams.GetOrGenerate(opWeightMsgRevokeAttestation, &weightMsgRevokeAttestation, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeAttestation = defaultWeightMsgRevokeAttestation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRevokeAttestation,
+		creditscoresimulation.SimulateMsgRevokeAttestation(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgFileDispute          = "op_weight_msg_creditscore"
+		defaultWeightMsgFileDispute int = 50
+	)
+
+	var weightMsgFileDispute int
+	simState.AppParams.GetOrGenerate(opWeightMsgFileDispute, &weightMsgFileDispute, nil,
+		func(_ *rand.Rand) {
+			weightMsgFileDispute = defaultWeightMsgFileDispute
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgFileDispute,
+		creditscoresimulation.SimulateMsgFileDispute(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgRespondDispute          = "op_weight_msg_creditscore"
+		defaultWeightMsgRespondDispute int = 50
+	)
+
+	var weightMsgRespondDispute int
+	simState.AppParams.GetOrGenerate(opWeightMsgRespondDispute, &weightMsgRespondDispute, nil,
+		func(_ *rand.Rand) {
+			weightMsgRespondDispute = defaultWeightMsgRespondDispute
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRespondDispute,
+		creditscoresimulation.SimulateMsgRespondDispute(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgResolveDispute          = "op_weight_msg_creditscore"
+		defaultWeightMsgResolveDispute int = 50
+	)
+
+	var weightMsgResolveDispute int
+	simState.AppParams.GetOrGenerate(opWeightMsgResolveDispute, &weightMsgResolveDispute, nil,
+		func(_ *rand.Rand) {
+			weightMsgResolveDispute = defaultWeightMsgResolveDispute
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgResolveDispute,
+		creditscoresimulation.SimulateMsgResolveDispute(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgFundChannelCredit          = "op_weight_msg_creditscore"
+		defaultWeightMsgFundChannelCredit int = 20
+	)
+
+	var weightMsgFundChannelCredit int
+	simState.AppParams.GetOrGenerate(opWeightMsgFundChannelCredit, &weightMsgFundChannelCredit, nil,
+		func(_ *rand.Rand) {
+			weightMsgFundChannelCredit = defaultWeightMsgFundChannelCredit
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgFundChannelCredit,
+		creditscoresimulation.SimulateMsgFundChannelCredit(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
 
 	return operations
 }
 
 // ProposalMsgs returns msgs used for governance proposals for simulations.
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
-	return []simtypes.WeightedProposalMsg{}
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			"op_weight_msg_register_agency",
+			100,
+			creditscoresimulation.SimulateMsgRegisterAgency,
+		),
+	}
 }
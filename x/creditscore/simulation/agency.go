@@ -0,0 +1,25 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"realfin/x/creditscore/types"
+)
+
+// SimulateMsgRegisterAgency returns a governance proposal message accrediting
+// a random account as a rating agency.
+func SimulateMsgRegisterAgency(r *rand.Rand, _ sdk.Context, accs []simtypes.Account) sdk.Msg {
+	simAccount, _ := simtypes.RandomAcc(r, accs)
+
+	return &types.MsgRegisterAgency{
+		Authority: authtypes.NewModuleAddress(types.GovModuleName).String(),
+		Agency: types.Agency{
+			Address: simAccount.Address.String(),
+			Name:    simtypes.RandStringOfLength(r, 8),
+		},
+	}
+}
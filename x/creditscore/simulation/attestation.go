@@ -0,0 +1,118 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"realfin/x/creditscore/keeper"
+	"realfin/x/creditscore/types"
+)
+
+func SimulateMsgCreateAttestation(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgCreateAttestation{}
+		simAccount, found := randomAgency(r, ctx, ak, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no accredited agency"), nil, nil
+		}
+		subject, _ := simtypes.RandomAcc(r, accs)
+
+		salt := []byte(simtypes.RandStringOfLength(r, types.MinSaltLength))
+		msg.Agency = simAccount.Address.String()
+		msg.Commitment = hex.EncodeToString(types.AttestationCommitment(subject.Address.String(), uint64(r.Intn(1000)), "", salt))
+		if r.Intn(2) == 0 {
+			expiresAt := ctx.BlockTime().Add(time.Duration(1+r.Intn(365*24)) * time.Hour)
+			msg.ExpiresAt = &expiresAt
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgRevokeAttestation(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			simAccount  = simtypes.Account{}
+			attestation = types.Attestation{}
+			msg         = &types.MsgRevokeAttestation{}
+			found       = false
+		)
+
+		var allAttestation []types.Attestation
+		err := k.Attestation.Walk(ctx, nil, func(_ uint64, value types.Attestation) (stop bool, err error) {
+			if !value.Revoked {
+				allAttestation = append(allAttestation, value)
+			}
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		for _, i := range r.Perm(len(allAttestation)) {
+			obj := allAttestation[i]
+			acc, err := ak.AddressCodec().StringToBytes(obj.Agency)
+			if err != nil {
+				return simtypes.OperationMsg{}, nil, err
+			}
+
+			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
+			if found {
+				attestation = obj
+				break
+			}
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "attestation agency not found"), nil, nil
+		}
+		msg.Agency = simAccount.Address.String()
+		msg.Id = attestation.Id
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,65 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"realfin/x/creditscore/keeper"
+	"realfin/x/creditscore/types"
+)
+
+func SimulateMsgFundChannelCredit(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgFundChannelCredit{}
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		if len(params.QueryChannels) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no query channel"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		balance := spendable.AmountOf(sdk.DefaultBondDenom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "insufficient funds"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, math.MinInt(balance, math.NewInt(10_000)))
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+
+		msg.Sender = simAccount.Address.String()
+		msg.ChannelId = params.QueryChannels[r.Intn(len(params.QueryChannels))]
+		msg.Amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: msg.Amount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
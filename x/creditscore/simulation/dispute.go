@@ -0,0 +1,207 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"realfin/x/creditscore/keeper"
+	"realfin/x/creditscore/types"
+)
+
+func SimulateMsgFileDispute(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			simAccount = simtypes.Account{}
+			rate       = types.Rate{}
+			msg        = &types.MsgFileDispute{}
+			found      = false
+		)
+
+		moduleAddr, err := k.ModuleAddress()
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		err = k.Rate.Walk(ctx, nil, func(_ collections.Pair[string, string], value types.Rate) (stop bool, err error) {
+			if value.Withdrawn || value.Disputed || value.Creator == moduleAddr || value.Subject == "" {
+				return false, nil
+			}
+			acc, err := ak.AddressCodec().StringToBytes(value.Subject)
+			if err != nil {
+				return true, err
+			}
+			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
+			rate = value
+			return found, nil
+		})
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no rate of a subject"), nil, nil
+		}
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		deposit := params.DisputeDepositCoins()
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if !spendable.IsAllGTE(deposit) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "insufficient funds for the deposit"), nil, nil
+		}
+
+		msg.Subject = simAccount.Address.String()
+		msg.Symbol = rate.Symbol
+		msg.Agency = rate.Creator
+		msg.Reason = simtypes.RandStringOfLength(r, 16)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: deposit,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgRespondDispute(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgRespondDispute{}
+		dispute, simAccount, found, err := randomDispute(ctx, ak, k, accs, func(dispute types.Dispute) (string, bool) {
+			open := dispute.Status == types.DisputeStatus_DISPUTE_STATUS_OPEN && ctx.BlockTime().Before(dispute.ResponseDeadline)
+			return dispute.Agency, open
+		})
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no open dispute"), nil, nil
+		}
+		msg.Agency = simAccount.Address.String()
+		msg.Id = dispute.Id
+		msg.Response = simtypes.RandStringOfLength(r, 16)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgResolveDispute(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgResolveDispute{}
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		if params.Arbiter == "" {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no arbiter"), nil, nil
+		}
+		dispute, simAccount, found, err := randomDispute(ctx, ak, k, accs, func(dispute types.Dispute) (string, bool) {
+			resolvable := dispute.Status == types.DisputeStatus_DISPUTE_STATUS_RESPONDED ||
+				(dispute.Status == types.DisputeStatus_DISPUTE_STATUS_OPEN && !ctx.BlockTime().Before(dispute.ResponseDeadline))
+			return params.Arbiter, resolvable
+		})
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no resolvable dispute"), nil, nil
+		}
+		msg.Resolver = simAccount.Address.String()
+		msg.Id = dispute.Id
+		msg.Outcome = types.DisputeOutcome(1 + r.Intn(len(types.DisputeOutcome_name)-1))
+		if msg.Outcome == types.DisputeOutcome_DISPUTE_OUTCOME_AMEND {
+			msg.Rate = uint64(r.Intn(1000))
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomDispute returns the first dispute selected by the filter, along with
+// the simulation account of the address the filter returns for it.
+func randomDispute(
+	ctx sdk.Context,
+	ak types.AuthKeeper,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+	filter func(types.Dispute) (string, bool),
+) (types.Dispute, simtypes.Account, bool, error) {
+	var (
+		selected   types.Dispute
+		simAccount simtypes.Account
+		found      bool
+	)
+	err := k.Dispute.Walk(ctx, nil, func(_ uint64, dispute types.Dispute) (bool, error) {
+		address, ok := filter(dispute)
+		if !ok {
+			return false, nil
+		}
+		acc, err := ak.AddressCodec().StringToBytes(address)
+		if err != nil {
+			return true, err
+		}
+		simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
+		selected = dispute
+		return found, nil
+	})
+
+	return selected, simAccount, found, err
+}
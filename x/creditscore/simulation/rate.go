@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"strconv"
 
+	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,15 +23,21 @@ func SimulateMsgCreateRate(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgCreateRate{}
+		simAccount, found := randomAgency(r, ctx, ak, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no accredited agency"), nil, nil
+		}
 
 		i := r.Int()
-		msg := &types.MsgCreateRate{
-			Creator: simAccount.Address.String(),
-			Symbol:  strconv.Itoa(i),
+		msg.Creator = simAccount.Address.String()
+		msg.Symbol = strconv.Itoa(i)
+		if r.Intn(2) == 0 {
+			subject, _ := simtypes.RandomAcc(r, accs)
+			msg.Subject = subject.Address.String()
 		}
 
-		found, err := k.Rate.Has(ctx, msg.Symbol)
+		found, err := k.Rate.Has(ctx, collections.Join(msg.Symbol, msg.Creator))
 		if err == nil && found {
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Rate already exist"), nil, nil
 		}
@@ -68,9 +75,13 @@ func SimulateMsgUpdateRate(
 		)
 
 		var allRate []types.Rate
-		err := k.Rate.Walk(ctx, nil, func(key string, value types.Rate) (stop bool, err error) {
-			allRate = append(allRate, value)
-			return false, nil
+		err := k.Rate.Walk(ctx, nil, func(key collections.Pair[string, string], value types.Rate) (stop bool, err error) {
+			// only accredited agencies update their rates
+			accredited, err := k.IsAccredited(ctx, value.Creator)
+			if accredited {
+				allRate = append(allRate, value)
+			}
+			return false, err
 		})
 		if err != nil {
 			panic(err)
@@ -127,9 +138,13 @@ func SimulateMsgDeleteRate(
 		)
 
 		var allRate []types.Rate
-		err := k.Rate.Walk(ctx, nil, func(key string, value types.Rate) (stop bool, err error) {
-			allRate = append(allRate, value)
-			return false, nil
+		err := k.Rate.Walk(ctx, nil, func(key collections.Pair[string, string], value types.Rate) (stop bool, err error) {
+			// only accredited agencies update their rates
+			accredited, err := k.IsAccredited(ctx, value.Creator)
+			if accredited {
+				allRate = append(allRate, value)
+			}
+			return false, err
 		})
 		if err != nil {
 			panic(err)
@@ -169,3 +184,26 @@ func SimulateMsgDeleteRate(
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
+
+// randomAgency returns a random simulation account accredited as an agency.
+func randomAgency(r *rand.Rand, ctx sdk.Context, ak types.AuthKeeper, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	var agencies []simtypes.Account
+	err := k.Agency.Walk(ctx, nil, func(address string, agency types.Agency) (bool, error) {
+		if agency.Revoked {
+			return false, nil
+		}
+		acc, err := ak.AddressCodec().StringToBytes(address)
+		if err != nil {
+			return true, err
+		}
+		if simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(acc)); found {
+			agencies = append(agencies, simAccount)
+		}
+		return false, nil
+	})
+	if err != nil || len(agencies) == 0 {
+		return simtypes.Account{}, false
+	}
+
+	return agencies[r.Intn(len(agencies))], true
+}
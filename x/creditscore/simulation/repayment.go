@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"realfin/x/creditscore/keeper"
+	"realfin/x/creditscore/types"
+)
+
+func SimulateMsgSubmitRepayment(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		borrower, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgSubmitRepayment{
+			Borrower: borrower.Address.String(),
+			Kind:     types.RepaymentKind(1 + r.Intn(len(types.RepaymentKind_name)-1)),
+		}
+
+		// only accredited agencies report repayments
+		lender, found := randomAgency(r, ctx, ak, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no accredited agency"), nil, nil
+		}
+		msg.Lender = lender.Address.String()
+		if msg.Lender == msg.Borrower {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "lender is the borrower"), nil, nil
+		}
+		if msg.Kind == types.RepaymentKind_REPAYMENT_KIND_LATE {
+			msg.DaysLate = uint32(1 + r.Intn(90))
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      lender,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
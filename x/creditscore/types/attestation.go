@@ -0,0 +1,48 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// MinSaltLength is the minimum length in bytes of the salt of a commitment,
+// so that the rating cannot be recovered by trying every rate and grade.
+const MinSaltLength = 16
+
+// AttestationCommitment returns the commitment to the rating of the subject:
+// the SHA-256 hash of the length-prefixed subject, the rate, the
+// length-prefixed grade and the salt, with lengths and the rate encoded as
+// 8 bytes big endian integers.
+func AttestationCommitment(subject string, rate uint64, grade string, salt []byte) []byte {
+	h := sha256.New()
+	_ = binary.Write(h, binary.BigEndian, uint64(len(subject)))
+	h.Write([]byte(subject))
+	_ = binary.Write(h, binary.BigEndian, rate)
+	_ = binary.Write(h, binary.BigEndian, uint64(len(grade)))
+	h.Write([]byte(grade))
+	h.Write(salt)
+
+	return h.Sum(nil)
+}
+
+// ValidateCommitment returns an error if the commitment is not the hex
+// encoding of a SHA-256 hash.
+func ValidateCommitment(commitment string) error {
+	hash, err := hex.DecodeString(commitment)
+	if err != nil {
+		return fmt.Errorf("commitment is not hex encoded: %w", err)
+	}
+	if len(hash) != sha256.Size {
+		return fmt.Errorf("commitment must be %d bytes, got %d", sha256.Size, len(hash))
+	}
+
+	return nil
+}
+
+// Expired reports whether the attestation is expired at the time.
+func (a Attestation) Expired(now time.Time) bool {
+	return a.ExpiresAt != nil && !now.Before(*a.ExpiresAt)
+}
@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/creditscore/v1/attestation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AttestationStatus defines the outcome of the verification of a revealed
+// attestation.
+type AttestationStatus int32
+
+const (
+	// ATTESTATION_STATUS_UNSPECIFIED is an invalid status.
+	AttestationStatus_ATTESTATION_STATUS_UNSPECIFIED AttestationStatus = 0
+	// ATTESTATION_STATUS_VALID is a revealed rating matching a live commitment.
+	AttestationStatus_ATTESTATION_STATUS_VALID AttestationStatus = 1
+	// ATTESTATION_STATUS_MISMATCH is a revealed rating not matching the
+	// commitment.
+	AttestationStatus_ATTESTATION_STATUS_MISMATCH AttestationStatus = 2
+	// ATTESTATION_STATUS_EXPIRED is a commitment past its expiry.
+	AttestationStatus_ATTESTATION_STATUS_EXPIRED AttestationStatus = 3
+	// ATTESTATION_STATUS_REVOKED is a commitment revoked by its agency.
+	AttestationStatus_ATTESTATION_STATUS_REVOKED AttestationStatus = 4
+	// ATTESTATION_STATUS_AGENCY_REVOKED is a commitment of an agency whose
+	// accreditation is revoked.
+	AttestationStatus_ATTESTATION_STATUS_AGENCY_REVOKED AttestationStatus = 5
+)
+
+var AttestationStatus_name = map[int32]string{
+	0: "ATTESTATION_STATUS_UNSPECIFIED",
+	1: "ATTESTATION_STATUS_VALID",
+	2: "ATTESTATION_STATUS_MISMATCH",
+	3: "ATTESTATION_STATUS_EXPIRED",
+	4: "ATTESTATION_STATUS_REVOKED",
+	5: "ATTESTATION_STATUS_AGENCY_REVOKED",
+}
+
+var AttestationStatus_value = map[string]int32{
+	"ATTESTATION_STATUS_UNSPECIFIED":    0,
+	"ATTESTATION_STATUS_VALID":          1,
+	"ATTESTATION_STATUS_MISMATCH":       2,
+	"ATTESTATION_STATUS_EXPIRED":        3,
+	"ATTESTATION_STATUS_REVOKED":        4,
+	"ATTESTATION_STATUS_AGENCY_REVOKED": 5,
+}
+
+func (x AttestationStatus) String() string {
+	return proto.EnumName(AttestationStatus_name, int32(x))
+}
+
+func (AttestationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e8295269da7b016b, []int{0}
+}
+
+// Attestation is the commitment of an agency to a rating it keeps off chain.
+// The commitment is the SHA-256 hash of the subject, the rate, the grade and
+// a salt, so that the rating is only known to those the subject reveals it
+// to.
+type Attestation struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Agency string `protobuf:"bytes,2,opt,name=agency,proto3" json:"agency,omitempty"`
+	// commitment is the hex-encoded hash committed to.
+	Commitment string    `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Height     int64     `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Time       time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// expires_at is the time the attestation expires at, if any.
+	ExpiresAt *time.Time `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	Revoked   bool       `protobuf:"varint,7,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (m *Attestation) Reset()         { *m = Attestation{} }
+func (m *Attestation) String() string { return proto.CompactTextString(m) }
+func (*Attestation) ProtoMessage()    {}
+func (*Attestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8295269da7b016b, []int{0}
+}
+func (m *Attestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attestation.Merge(m, src)
+}
+func (m *Attestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attestation proto.InternalMessageInfo
+
+func (m *Attestation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Attestation) GetAgency() string {
+	if m != nil {
+		return m.Agency
+	}
+	return ""
+}
+
+func (m *Attestation) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *Attestation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Attestation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Attestation) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *Attestation) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("realfin.creditscore.v1.AttestationStatus", AttestationStatus_name, AttestationStatus_value)
+	proto.RegisterType((*Attestation)(nil), "realfin.creditscore.v1.Attestation")
+}
+
+func init() {
+	proto.RegisterFile("realfin/creditscore/v1/attestation.proto", fileDescriptor_e8295269da7b016b)
+}
+
+var fileDescriptor_e8295269da7b016b = []byte{
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x77, 0xb2, 0xe9, 0xd6, 0x4e, 0x51, 0xd2, 0xa1, 0x94, 0x71, 0x2b, 0xd9, 0x58, 0x10,
+	0x42, 0xc1, 0xc4, 0x2a, 0x1e, 0x45, 0xb2, 0xbb, 0x51, 0x83, 0x76, 0x5b, 0x26, 0x69, 0x51, 0x2f,
+	0x4b, 0x9a, 0x4c, 0xd3, 0xc1, 0x26, 0xb3, 0x64, 0xa6, 0x4b, 0xfb, 0x2d, 0xfa, 0x15, 0xbc, 0x79,
+	0xf4, 0xe0, 0x87, 0xe8, 0xb1, 0x78, 0xf2, 0xe2, 0x1f, 0x76, 0x0f, 0x7e, 0x0d, 0xc9, 0x9f, 0xd5,
+	0x15, 0x56, 0xbc, 0x24, 0xf3, 0xbc, 0xcf, 0xef, 0x9d, 0x79, 0x78, 0x79, 0xa1, 0x99, 0xd3, 0xf0,
+	0xf4, 0x98, 0x65, 0x76, 0x94, 0xd3, 0x98, 0x49, 0x11, 0xf1, 0x9c, 0xda, 0xe3, 0x1d, 0x3b, 0x94,
+	0x92, 0x0a, 0x19, 0x4a, 0xc6, 0x33, 0x6b, 0x94, 0x73, 0xc9, 0xd1, 0x46, 0x4d, 0x5a, 0x73, 0xa4,
+	0x35, 0xde, 0x69, 0xaf, 0x85, 0x29, 0xcb, 0xb8, 0x5d, 0x7e, 0x2b, 0xb4, 0x7d, 0x3b, 0xe2, 0x22,
+	0xe5, 0x62, 0x58, 0x2a, 0xbb, 0x12, 0xb5, 0xb5, 0x9e, 0xf0, 0x84, 0x57, 0xf5, 0xe2, 0x54, 0x57,
+	0x3b, 0x09, 0xe7, 0xc9, 0x29, 0xb5, 0x4b, 0x75, 0x74, 0x76, 0x6c, 0x4b, 0x96, 0x16, 0xcf, 0xa7,
+	0xa3, 0x0a, 0xd8, 0x7a, 0xaf, 0xc0, 0x55, 0xe7, 0x4f, 0x24, 0x74, 0x0b, 0x2a, 0x2c, 0xc6, 0xc0,
+	0x00, 0xa6, 0x4a, 0x14, 0x16, 0xa3, 0x07, 0xb0, 0x15, 0x26, 0x34, 0x8b, 0x2e, 0xb0, 0x62, 0x00,
+	0x73, 0xa5, 0x8b, 0x3f, 0x7f, 0xba, 0xbf, 0x5e, 0x3f, 0xec, 0xc4, 0x71, 0x4e, 0x85, 0xf0, 0x65,
+	0xce, 0xb2, 0x84, 0xd4, 0x1c, 0xd2, 0x21, 0x8c, 0x78, 0x9a, 0x32, 0x99, 0xd2, 0x4c, 0xe2, 0x66,
+	0xd1, 0x45, 0xe6, 0x2a, 0x68, 0x03, 0xb6, 0x4e, 0x28, 0x4b, 0x4e, 0x24, 0x56, 0x0d, 0x60, 0x36,
+	0x49, 0xad, 0xd0, 0x13, 0xa8, 0x16, 0xe1, 0xf0, 0x92, 0x01, 0xcc, 0xd5, 0x87, 0x6d, 0xab, 0x4a,
+	0x6e, 0xcd, 0x92, 0x5b, 0xc1, 0x2c, 0x79, 0xf7, 0xe6, 0xd5, 0xb7, 0x4e, 0xe3, 0xf2, 0x7b, 0x07,
+	0x7c, 0xf8, 0xf9, 0x71, 0x1b, 0x90, 0xb2, 0x0d, 0x3d, 0x85, 0x90, 0x9e, 0x8f, 0x58, 0x4e, 0xc5,
+	0x30, 0x94, 0xb8, 0xf5, 0xdf, 0x4b, 0xd4, 0xe2, 0x02, 0xb2, 0x52, 0xf7, 0x38, 0x12, 0x61, 0xb8,
+	0x9c, 0xd3, 0x31, 0x7f, 0x47, 0x63, 0xbc, 0x6c, 0x00, 0xf3, 0x06, 0x99, 0xc9, 0xed, 0xaf, 0x00,
+	0xae, 0xcd, 0xcd, 0xc8, 0x97, 0xa1, 0x3c, 0x13, 0x68, 0x0b, 0xea, 0x4e, 0x10, 0xb8, 0x7e, 0xe0,
+	0x04, 0xde, 0xde, 0x60, 0x58, 0xfc, 0x0f, 0xfc, 0xe1, 0xc1, 0xc0, 0xdf, 0x77, 0x7b, 0xde, 0x33,
+	0xcf, 0xed, 0x6b, 0x0d, 0x74, 0x07, 0xe2, 0x05, 0xcc, 0xa1, 0xf3, 0xca, 0xeb, 0x6b, 0x00, 0x75,
+	0xe0, 0xe6, 0x02, 0x77, 0xd7, 0xf3, 0x77, 0x9d, 0xa0, 0xf7, 0x42, 0x53, 0x90, 0x0e, 0xdb, 0x0b,
+	0x00, 0xf7, 0xf5, 0xbe, 0x47, 0xdc, 0xbe, 0xd6, 0xfc, 0x87, 0x4f, 0xdc, 0xc3, 0xbd, 0x97, 0x6e,
+	0x5f, 0x53, 0xd1, 0x3d, 0x78, 0x77, 0x81, 0xef, 0x3c, 0x77, 0x07, 0xbd, 0x37, 0xbf, 0xb1, 0xa5,
+	0xee, 0xe3, 0xab, 0x89, 0x0e, 0xae, 0x27, 0x3a, 0xf8, 0x31, 0xd1, 0xc1, 0xe5, 0x54, 0x6f, 0x5c,
+	0x4f, 0xf5, 0xc6, 0x97, 0xa9, 0xde, 0x78, 0xbb, 0x39, 0x5b, 0xe2, 0xf3, 0xbf, 0xd6, 0x58, 0x5e,
+	0x8c, 0xa8, 0x38, 0x6a, 0x95, 0x53, 0x7d, 0xf4, 0x6b, 0x00, 0x2d, 0x15, 0x3c, 0x0a, 0xea, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Attestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiresAt != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAttestation(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAttestation(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Agency) > 0 {
+		i -= len(m.Agency)
+		copy(dAtA[i:], m.Agency)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Agency)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttestation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttestation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Attestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAttestation(uint64(m.Id))
+	}
+	l = len(m.Agency)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAttestation(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAttestation(uint64(l))
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.Revoked {
+		n += 2
+	}
+	return n
+}
+
+func sovAttestation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestation(x uint64) (n int) {
+	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Attestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAttestation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAttestation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAttestation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAttestation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAttestation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAttestation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAttestation = fmt.Errorf("proto: unexpected end of group")
+)
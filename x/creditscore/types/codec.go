@@ -11,10 +11,19 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgCreateRate{},
 		&MsgUpdateRate{},
 		&MsgDeleteRate{},
+		&MsgSubmitRepayment{},
+		&MsgCreateAttestation{},
+		&MsgRevokeAttestation{},
+		&MsgFileDispute{},
+		&MsgRespondDispute{},
+		&MsgResolveDispute{},
+		&MsgFundChannelCredit{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRegisterAgency{},
+		&MsgRevokeAgency{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -0,0 +1,996 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/creditscore/v1/credit_limit.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CreditLimit defines the credit line of a subject: the value of the
+// balances it holds times the loan-to-value ratio of its grade.
+type CreditLimit struct {
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// rate is the consolidated rate of the subject.
+	Rate uint64 `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	// grade is the grade of the rate on the default rating scale.
+	Grade string `protobuf:"bytes,3,opt,name=grade,proto3" json:"grade,omitempty"`
+	// ltv is the loan-to-value ratio of the grade.
+	Ltv cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=ltv,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ltv"`
+	// denom is the denom of the values and of the limit.
+	Denom      string            `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	Collateral []CollateralValue `protobuf:"bytes,6,rep,name=collateral,proto3" json:"collateral"`
+	// collateral_value is the total value of the priced collateral.
+	CollateralValue cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=collateral_value,json=collateralValue,proto3,customtype=cosmossdk.io/math.Int" json:"collateral_value"`
+	// limit is the collateral value times the ltv, rounded down.
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+}
+
+func (m *CreditLimit) Reset()         { *m = CreditLimit{} }
+func (m *CreditLimit) String() string { return proto.CompactTextString(m) }
+func (*CreditLimit) ProtoMessage()    {}
+func (*CreditLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_940ce020155bce45, []int{0}
+}
+func (m *CreditLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditLimit.Merge(m, src)
+}
+func (m *CreditLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditLimit proto.InternalMessageInfo
+
+func (m *CreditLimit) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *CreditLimit) GetRate() uint64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *CreditLimit) GetGrade() string {
+	if m != nil {
+		return m.Grade
+	}
+	return ""
+}
+
+func (m *CreditLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *CreditLimit) GetCollateral() []CollateralValue {
+	if m != nil {
+		return m.Collateral
+	}
+	return nil
+}
+
+// CollateralValue defines the oracle value of a balance of the subject.
+type CollateralValue struct {
+	// symbol is the denom of the balance.
+	Symbol string                `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Value  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=value,proto3,customtype=cosmossdk.io/math.Int" json:"value"`
+	// priced is unset when the denom has no fresh oracle price convertible to
+	// the credit denom. Its value is then zero.
+	Priced bool `protobuf:"varint,3,opt,name=priced,proto3" json:"priced,omitempty"`
+	// amount is the balance of the subject in the denom.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *CollateralValue) Reset()         { *m = CollateralValue{} }
+func (m *CollateralValue) String() string { return proto.CompactTextString(m) }
+func (*CollateralValue) ProtoMessage()    {}
+func (*CollateralValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_940ce020155bce45, []int{1}
+}
+func (m *CollateralValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollateralValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollateralValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollateralValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralValue.Merge(m, src)
+}
+func (m *CollateralValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollateralValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralValue proto.InternalMessageInfo
+
+func (m *CollateralValue) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *CollateralValue) GetPriced() bool {
+	if m != nil {
+		return m.Priced
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*CreditLimit)(nil), "realfin.creditscore.v1.CreditLimit")
+	proto.RegisterType((*CollateralValue)(nil), "realfin.creditscore.v1.CollateralValue")
+}
+
+func init() {
+	proto.RegisterFile("realfin/creditscore/v1/credit_limit.proto", fileDescriptor_940ce020155bce45)
+}
+
+var fileDescriptor_940ce020155bce45 = []byte{
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xe3, 0x25, 0xcd, 0x86, 0x77, 0x18, 0x58, 0x63, 0x32, 0x9b, 0x94, 0x55, 0xbb, 0x50,
+	0x90, 0x48, 0x18, 0x08, 0x3e, 0x40, 0x37, 0xa1, 0x55, 0x2a, 0x97, 0x1c, 0x38, 0xc0, 0xa1, 0x72,
+	0x1d, 0x13, 0x02, 0x4e, 0x5c, 0x39, 0x6e, 0x44, 0xbf, 0x05, 0x5f, 0x02, 0x89, 0x23, 0x07, 0x3e,
+	0x44, 0x8f, 0x15, 0x5c, 0x10, 0x87, 0x0a, 0xb5, 0x07, 0xbe, 0x06, 0xf2, 0x9f, 0x42, 0x0b, 0x3b,
+	0xf5, 0x12, 0xf9, 0xf7, 0xe6, 0xf1, 0xf3, 0x3e, 0xce, 0x1b, 0xc3, 0x7b, 0x92, 0x11, 0xfe, 0xba,
+	0xa8, 0x12, 0x2a, 0x59, 0x56, 0xa8, 0x9a, 0x0a, 0xc9, 0x92, 0xe6, 0xdc, 0xe1, 0x80, 0x17, 0x65,
+	0xa1, 0xe2, 0x91, 0x14, 0x4a, 0xa0, 0x23, 0x27, 0x8d, 0xd7, 0xa4, 0x71, 0x73, 0x7e, 0x7c, 0x8b,
+	0x94, 0x45, 0x25, 0x12, 0xf3, 0xb4, 0xd2, 0xe3, 0x3b, 0x54, 0xd4, 0xa5, 0xa8, 0x07, 0x86, 0x12,
+	0x0b, 0xee, 0xd5, 0x61, 0x2e, 0x72, 0x61, 0xeb, 0x7a, 0x65, 0xab, 0x67, 0x1f, 0x7d, 0xb8, 0x7f,
+	0x61, 0x6c, 0xfb, 0xba, 0x23, 0xc2, 0x70, 0xb7, 0x1e, 0x0f, 0xdf, 0x32, 0xaa, 0x30, 0x68, 0x83,
+	0xce, 0x8d, 0x74, 0x85, 0x08, 0xc1, 0x40, 0x12, 0xc5, 0xf0, 0x4e, 0x1b, 0x74, 0x82, 0xd4, 0xac,
+	0xd1, 0x21, 0x6c, 0xe5, 0x92, 0x64, 0x0c, 0xfb, 0x46, 0x6b, 0x01, 0x5d, 0x41, 0x9f, 0xab, 0x06,
+	0x07, 0xba, 0xd6, 0x7d, 0x3a, 0x9d, 0x9f, 0x7a, 0x3f, 0xe6, 0xa7, 0x27, 0x36, 0x4c, 0x9d, 0xbd,
+	0x8b, 0x0b, 0x91, 0x94, 0x44, 0xbd, 0x89, 0xfb, 0x2c, 0x27, 0x74, 0x72, 0xc9, 0xe8, 0xd7, 0x2f,
+	0x0f, 0xa0, 0xcb, 0x7a, 0xc9, 0xe8, 0xa7, 0x5f, 0x9f, 0xef, 0x83, 0x54, 0x5b, 0x68, 0xff, 0x8c,
+	0x55, 0xa2, 0xc4, 0x2d, 0xeb, 0x6f, 0x00, 0x3d, 0x87, 0x90, 0x0a, 0xce, 0x89, 0x62, 0x92, 0x70,
+	0x1c, 0xb6, 0xfd, 0xce, 0xfe, 0xa3, 0xbb, 0xf1, 0xf5, 0x1f, 0x29, 0xbe, 0xf8, 0xa3, 0x7c, 0x41,
+	0xf8, 0x98, 0x75, 0x03, 0x9d, 0x27, 0x5d, 0x33, 0x40, 0xaf, 0xe0, 0xcd, 0xbf, 0x34, 0x68, 0xb4,
+	0x0a, 0xef, 0x9a, 0xec, 0x0f, 0x5d, 0xf6, 0xdb, 0xff, 0x67, 0xef, 0x55, 0x6a, 0x2d, 0x75, 0xaf,
+	0x52, 0x36, 0xf5, 0x01, 0xdd, 0x6c, 0x87, 0x9e, 0xc1, 0x96, 0x19, 0x25, 0xde, 0xdb, 0xd2, 0xd1,
+	0x6e, 0x3f, 0xfb, 0x06, 0xe0, 0xc1, 0x3f, 0x47, 0x41, 0x47, 0x30, 0xac, 0x27, 0xe5, 0x50, 0x70,
+	0x37, 0x2a, 0x47, 0xba, 0xa7, 0x3d, 0xc5, 0xce, 0xb6, 0x3d, 0x9b, 0x95, 0xff, 0x48, 0x16, 0x94,
+	0x65, 0x66, 0xbc, 0x7b, 0xa9, 0x23, 0x74, 0x05, 0x43, 0x52, 0x8a, 0x71, 0xa5, 0x70, 0xb0, 0x65,
+	0x03, 0xb7, 0xbf, 0xfb, 0x64, 0xba, 0x88, 0xc0, 0x6c, 0x11, 0x81, 0x9f, 0x8b, 0x08, 0x7c, 0x58,
+	0x46, 0xde, 0x6c, 0x19, 0x79, 0xdf, 0x97, 0x91, 0xf7, 0xf2, 0x64, 0x75, 0x3d, 0xde, 0x6f, 0x5c,
+	0x10, 0x35, 0x19, 0xb1, 0x7a, 0x18, 0x9a, 0x7f, 0xf7, 0xf1, 0xef, 0x01, 0x00, 0x7d, 0x25, 0xa8,
+	0x14, 0x44, 0x03, 0x00, 0x00,
+}
+
+func (m *CreditLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCreditLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CollateralValue.Size()
+		i -= size
+		if _, err := m.CollateralValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCreditLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCreditLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCreditLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Ltv.Size()
+		i -= size
+		if _, err := m.Ltv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCreditLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Grade) > 0 {
+		i -= len(m.Grade)
+		copy(dAtA[i:], m.Grade)
+		i = encodeVarintCreditLimit(dAtA, i, uint64(len(m.Grade)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Rate != 0 {
+		i = encodeVarintCreditLimit(dAtA, i, uint64(m.Rate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintCreditLimit(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollateralValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCreditLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Priced {
+		i--
+		if m.Priced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCreditLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintCreditLimit(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCreditLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCreditLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreditLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovCreditLimit(uint64(l))
+	}
+	if m.Rate != 0 {
+		n += 1 + sovCreditLimit(uint64(m.Rate))
+	}
+	l = len(m.Grade)
+	if l > 0 {
+		n += 1 + l + sovCreditLimit(uint64(l))
+	}
+	l = m.Ltv.Size()
+	n += 1 + l + sovCreditLimit(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCreditLimit(uint64(l))
+	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovCreditLimit(uint64(l))
+		}
+	}
+	l = m.CollateralValue.Size()
+	n += 1 + l + sovCreditLimit(uint64(l))
+	l = m.Limit.Size()
+	n += 1 + l + sovCreditLimit(uint64(l))
+	return n
+}
+
+func (m *CollateralValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovCreditLimit(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovCreditLimit(uint64(l))
+	if m.Priced {
+		n += 2
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCreditLimit(uint64(l))
+	return n
+}
+
+func sovCreditLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCreditLimit(x uint64) (n int) {
+	return sovCreditLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreditLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCreditLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			m.Rate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ltv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral, CollateralValue{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCreditLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCreditLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priced = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCreditLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCreditLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCreditLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCreditLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCreditLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCreditLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCreditLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCreditLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCreditLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCreditLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCreditLimit = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,16 @@
+package types
+
+// SubjectAddress returns the address entitled to dispute the rate: its subject, or
+// its symbol when the rate has no subject.
+func (r Rate) SubjectAddress() string {
+	if r.Subject != "" {
+		return r.Subject
+	}
+
+	return r.Symbol
+}
+
+// Pending reports whether the dispute awaits a response or a resolution.
+func (d Dispute) Pending() bool {
+	return d.Status == DisputeStatus_DISPUTE_STATUS_OPEN || d.Status == DisputeStatus_DISPUTE_STATUS_RESPONDED
+}
@@ -0,0 +1,985 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/creditscore/v1/dispute.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DisputeStatus defines the stage of a dispute.
+type DisputeStatus int32
+
+const (
+	// DISPUTE_STATUS_UNSPECIFIED is an invalid status.
+	DisputeStatus_DISPUTE_STATUS_UNSPECIFIED DisputeStatus = 0
+	// DISPUTE_STATUS_OPEN is a dispute awaiting the response of the agency.
+	DisputeStatus_DISPUTE_STATUS_OPEN DisputeStatus = 1
+	// DISPUTE_STATUS_RESPONDED is a dispute the agency responded to, awaiting
+	// arbitration.
+	DisputeStatus_DISPUTE_STATUS_RESPONDED DisputeStatus = 2
+	// DISPUTE_STATUS_UPHELD is a dispute resolved by upholding the rate.
+	DisputeStatus_DISPUTE_STATUS_UPHELD DisputeStatus = 3
+	// DISPUTE_STATUS_AMENDED is a dispute resolved by amending the rate.
+	DisputeStatus_DISPUTE_STATUS_AMENDED DisputeStatus = 4
+	// DISPUTE_STATUS_VOIDED is a dispute resolved by voiding the rate.
+	DisputeStatus_DISPUTE_STATUS_VOIDED DisputeStatus = 5
+)
+
+var DisputeStatus_name = map[int32]string{
+	0: "DISPUTE_STATUS_UNSPECIFIED",
+	1: "DISPUTE_STATUS_OPEN",
+	2: "DISPUTE_STATUS_RESPONDED",
+	3: "DISPUTE_STATUS_UPHELD",
+	4: "DISPUTE_STATUS_AMENDED",
+	5: "DISPUTE_STATUS_VOIDED",
+}
+
+var DisputeStatus_value = map[string]int32{
+	"DISPUTE_STATUS_UNSPECIFIED": 0,
+	"DISPUTE_STATUS_OPEN":        1,
+	"DISPUTE_STATUS_RESPONDED":   2,
+	"DISPUTE_STATUS_UPHELD":      3,
+	"DISPUTE_STATUS_AMENDED":     4,
+	"DISPUTE_STATUS_VOIDED":      5,
+}
+
+func (x DisputeStatus) String() string {
+	return proto.EnumName(DisputeStatus_name, int32(x))
+}
+
+func (DisputeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_789d3d71a9398447, []int{0}
+}
+
+// DisputeOutcome defines the resolution of a dispute.
+type DisputeOutcome int32
+
+const (
+	// DISPUTE_OUTCOME_UNSPECIFIED is an invalid outcome.
+	DisputeOutcome_DISPUTE_OUTCOME_UNSPECIFIED DisputeOutcome = 0
+	// DISPUTE_OUTCOME_UPHOLD keeps the rate and burns the deposit.
+	DisputeOutcome_DISPUTE_OUTCOME_UPHOLD DisputeOutcome = 1
+	// DISPUTE_OUTCOME_AMEND replaces the rate and refunds the deposit.
+	DisputeOutcome_DISPUTE_OUTCOME_AMEND DisputeOutcome = 2
+	// DISPUTE_OUTCOME_VOID deletes the rate and refunds the deposit.
+	DisputeOutcome_DISPUTE_OUTCOME_VOID DisputeOutcome = 3
+)
+
+var DisputeOutcome_name = map[int32]string{
+	0: "DISPUTE_OUTCOME_UNSPECIFIED",
+	1: "DISPUTE_OUTCOME_UPHOLD",
+	2: "DISPUTE_OUTCOME_AMEND",
+	3: "DISPUTE_OUTCOME_VOID",
+}
+
+var DisputeOutcome_value = map[string]int32{
+	"DISPUTE_OUTCOME_UNSPECIFIED": 0,
+	"DISPUTE_OUTCOME_UPHOLD":      1,
+	"DISPUTE_OUTCOME_AMEND":       2,
+	"DISPUTE_OUTCOME_VOID":        3,
+}
+
+func (x DisputeOutcome) String() string {
+	return proto.EnumName(DisputeOutcome_name, int32(x))
+}
+
+func (DisputeOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_789d3d71a9398447, []int{1}
+}
+
+// Dispute is the challenge of a rate by its subject.
+type Dispute struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// agency is the creator of the disputed rate.
+	Agency  string `protobuf:"bytes,3,opt,name=agency,proto3" json:"agency,omitempty"`
+	Subject string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Reason  string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// deposit is the deposit escrowed by the subject.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	Status  DisputeStatus                            `protobuf:"varint,7,opt,name=status,proto3,enum=realfin.creditscore.v1.DisputeStatus" json:"status,omitempty"`
+	FiledAt time.Time                                `protobuf:"bytes,8,opt,name=filed_at,json=filedAt,proto3,stdtime" json:"filed_at"`
+	// response_deadline is the end of the response window of the agency.
+	ResponseDeadline time.Time `protobuf:"bytes,9,opt,name=response_deadline,json=responseDeadline,proto3,stdtime" json:"response_deadline"`
+	Response         string    `protobuf:"bytes,10,opt,name=response,proto3" json:"response,omitempty"`
+	// resolver is governance or the arbiter that resolved the dispute.
+	Resolver   string `protobuf:"bytes,11,opt,name=resolver,proto3" json:"resolver,omitempty"`
+	Resolution string `protobuf:"bytes,12,opt,name=resolution,proto3" json:"resolution,omitempty"`
+}
+
+func (m *Dispute) Reset()         { *m = Dispute{} }
+func (m *Dispute) String() string { return proto.CompactTextString(m) }
+func (*Dispute) ProtoMessage()    {}
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_789d3d71a9398447, []int{0}
+}
+func (m *Dispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Dispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Dispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Dispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dispute.Merge(m, src)
+}
+func (m *Dispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Dispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dispute proto.InternalMessageInfo
+
+func (m *Dispute) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Dispute) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *Dispute) GetAgency() string {
+	if m != nil {
+		return m.Agency
+	}
+	return ""
+}
+
+func (m *Dispute) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *Dispute) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Dispute) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Dispute) GetStatus() DisputeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DisputeStatus_DISPUTE_STATUS_UNSPECIFIED
+}
+
+func (m *Dispute) GetFiledAt() time.Time {
+	if m != nil {
+		return m.FiledAt
+	}
+	return time.Time{}
+}
+
+func (m *Dispute) GetResponseDeadline() time.Time {
+	if m != nil {
+		return m.ResponseDeadline
+	}
+	return time.Time{}
+}
+
+func (m *Dispute) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+func (m *Dispute) GetResolver() string {
+	if m != nil {
+		return m.Resolver
+	}
+	return ""
+}
+
+func (m *Dispute) GetResolution() string {
+	if m != nil {
+		return m.Resolution
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("realfin.creditscore.v1.DisputeStatus", DisputeStatus_name, DisputeStatus_value)
+	proto.RegisterEnum("realfin.creditscore.v1.DisputeOutcome", DisputeOutcome_name, DisputeOutcome_value)
+	proto.RegisterType((*Dispute)(nil), "realfin.creditscore.v1.Dispute")
+}
+
+func init() {
+	proto.RegisterFile("realfin/creditscore/v1/dispute.proto", fileDescriptor_789d3d71a9398447)
+}
+
+var fileDescriptor_789d3d71a9398447 = []byte{
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0xcd, 0x24, 0x21, 0x81, 0xe1, 0x03, 0x99, 0xf9, 0x28, 0x1d, 0x42, 0xe5, 0x44, 0x55, 0x2b,
+	0x45, 0x48, 0xd8, 0x25, 0x2d, 0xcb, 0x2e, 0x12, 0xec, 0x8a, 0x48, 0x40, 0xa2, 0xfc, 0xb0, 0xe8,
+	0x26, 0x72, 0xec, 0x21, 0x1d, 0x1a, 0x7b, 0x22, 0xcf, 0x18, 0x95, 0x6d, 0x9f, 0x80, 0xc7, 0xa8,
+	0xba, 0xea, 0xa2, 0x8b, 0x3e, 0x02, 0x4b, 0xd4, 0x55, 0x57, 0xa5, 0x82, 0x45, 0xa5, 0x3e, 0x45,
+	0xe5, 0xf1, 0x18, 0x85, 0x08, 0x09, 0x75, 0x63, 0xcf, 0xbd, 0xe7, 0xdc, 0x73, 0x8f, 0xee, 0x1d,
+	0x0d, 0x7c, 0x16, 0x12, 0x67, 0x7c, 0x4c, 0x03, 0xd3, 0x0d, 0x89, 0x47, 0x05, 0x77, 0x59, 0x48,
+	0xcc, 0xd3, 0x6d, 0xd3, 0xa3, 0x7c, 0x12, 0x09, 0x62, 0x4c, 0x42, 0x26, 0x18, 0x5a, 0x53, 0x2c,
+	0x63, 0x8a, 0x65, 0x9c, 0x6e, 0x97, 0x56, 0x1c, 0x9f, 0x06, 0xcc, 0x94, 0xdf, 0x84, 0x5a, 0xd2,
+	0x5d, 0xc6, 0x7d, 0xc6, 0xcd, 0xa1, 0xc3, 0x63, 0xa1, 0x21, 0x11, 0xce, 0xb6, 0xe9, 0x32, 0x1a,
+	0x28, 0x7c, 0x3d, 0xc1, 0x07, 0x32, 0x32, 0x93, 0x40, 0x41, 0xab, 0x23, 0x36, 0x62, 0x49, 0x3e,
+	0x3e, 0xa9, 0x6c, 0x79, 0xc4, 0xd8, 0x68, 0x4c, 0x4c, 0x19, 0x0d, 0xa3, 0x63, 0x53, 0x50, 0x9f,
+	0x70, 0xe1, 0xf8, 0x93, 0x84, 0xf0, 0xf4, 0x4f, 0x1e, 0x16, 0xad, 0xc4, 0x2e, 0x5a, 0x86, 0x59,
+	0xea, 0x61, 0x50, 0x01, 0xd5, 0x7c, 0x27, 0x4b, 0x3d, 0xb4, 0x06, 0x0b, 0xfc, 0xcc, 0x1f, 0xb2,
+	0x31, 0xce, 0x56, 0x40, 0x75, 0xa1, 0xa3, 0x22, 0xf4, 0x02, 0x16, 0x9c, 0x11, 0x09, 0xdc, 0x33,
+	0x9c, 0x8b, 0xf3, 0x0d, 0xfc, 0xfd, 0xeb, 0xd6, 0xaa, 0x32, 0x53, 0xf7, 0xbc, 0x90, 0x70, 0xde,
+	0x15, 0x21, 0x0d, 0x46, 0x1d, 0xc5, 0x43, 0x35, 0x58, 0xe4, 0xd1, 0xf0, 0x84, 0xb8, 0x02, 0xe7,
+	0x1f, 0x28, 0x49, 0x89, 0x71, 0xf7, 0x90, 0x38, 0x9c, 0x05, 0x78, 0x2e, 0xe9, 0x9e, 0x44, 0xe8,
+	0x04, 0x16, 0x3d, 0x32, 0x61, 0x9c, 0x0a, 0x5c, 0xa8, 0xe4, 0xaa, 0x8b, 0xb5, 0x75, 0x43, 0x09,
+	0xc5, 0x53, 0x33, 0xd4, 0xd4, 0x8c, 0x5d, 0x46, 0x83, 0xc6, 0xce, 0xc5, 0xcf, 0x72, 0xe6, 0xf3,
+	0x55, 0xb9, 0x3a, 0xa2, 0xe2, 0x5d, 0x34, 0x34, 0x5c, 0xe6, 0xab, 0xa9, 0xa9, 0xdf, 0x16, 0xf7,
+	0xde, 0x9b, 0xe2, 0x6c, 0x42, 0xb8, 0x2c, 0xe0, 0x9f, 0x7e, 0x7f, 0xd9, 0x04, 0x9d, 0xb4, 0x01,
+	0x7a, 0x0d, 0x0b, 0x5c, 0x38, 0x22, 0xe2, 0xb8, 0x58, 0x01, 0xd5, 0xe5, 0xda, 0x73, 0xe3, 0xfe,
+	0x5d, 0x1a, 0x6a, 0x84, 0x5d, 0x49, 0xee, 0xa8, 0x22, 0x64, 0xc1, 0xf9, 0x63, 0x3a, 0x26, 0xde,
+	0xc0, 0x11, 0x78, 0xbe, 0x02, 0xaa, 0x8b, 0xb5, 0x92, 0x91, 0x2c, 0xc4, 0x48, 0x17, 0x62, 0xf4,
+	0xd2, 0x85, 0x34, 0x96, 0x62, 0xb3, 0xe7, 0x57, 0x65, 0xa0, 0x4c, 0xc8, 0xd2, 0xba, 0x40, 0x47,
+	0x70, 0x25, 0x24, 0x7c, 0xc2, 0x02, 0x4e, 0x06, 0x1e, 0x71, 0xbc, 0x31, 0x0d, 0x08, 0x5e, 0xf8,
+	0x57, 0x39, 0x2d, 0xd5, 0xb0, 0x94, 0x04, 0x2a, 0xc1, 0xf9, 0x34, 0x87, 0xa1, 0x1c, 0xf1, 0x6d,
+	0x8c, 0x5e, 0x49, 0x8c, 0x8d, 0x4f, 0x49, 0x88, 0x17, 0x1f, 0xd8, 0xd8, 0x2d, 0x13, 0xe9, 0x10,
+	0xca, 0x73, 0x24, 0x28, 0x0b, 0xf0, 0x7f, 0x52, 0x73, 0x2a, 0xb3, 0xf9, 0x0d, 0xc0, 0xa5, 0x3b,
+	0x93, 0x42, 0x3a, 0x2c, 0x59, 0xcd, 0x6e, 0xbb, 0xdf, 0xb3, 0x07, 0xdd, 0x5e, 0xbd, 0xd7, 0xef,
+	0x0e, 0xfa, 0x87, 0xdd, 0xb6, 0xbd, 0xdb, 0x7c, 0xd3, 0xb4, 0x2d, 0x2d, 0x83, 0x1e, 0xc3, 0xff,
+	0x67, 0xf0, 0x56, 0xdb, 0x3e, 0xd4, 0x00, 0x7a, 0x02, 0xf1, 0x0c, 0xd0, 0xb1, 0xbb, 0xed, 0xd6,
+	0xa1, 0x65, 0x5b, 0x5a, 0x16, 0xad, 0xc3, 0x47, 0xb3, 0xb2, 0xed, 0x3d, 0x7b, 0xdf, 0xd2, 0x72,
+	0xa8, 0x04, 0xd7, 0x66, 0xa0, 0xfa, 0x81, 0x2d, 0xcb, 0xf2, 0xf7, 0x94, 0x1d, 0xb5, 0x9a, 0x31,
+	0x34, 0xb7, 0xf9, 0x11, 0xc0, 0x65, 0x65, 0xbd, 0x15, 0x09, 0x97, 0xf9, 0x04, 0x95, 0xe1, 0x46,
+	0xca, 0x6e, 0xf5, 0x7b, 0xbb, 0xad, 0x03, 0x7b, 0xc6, 0xfc, 0x54, 0xab, 0x5b, 0x42, 0x7b, 0xaf,
+	0xb5, 0x6f, 0x69, 0x60, 0xba, 0x55, 0x8a, 0x49, 0x1f, 0x5a, 0x16, 0x61, 0xb8, 0x3a, 0x0b, 0xc5,
+	0x36, 0xb4, 0x5c, 0x63, 0xe7, 0xe2, 0x5a, 0x07, 0x97, 0xd7, 0x3a, 0xf8, 0x75, 0xad, 0x83, 0xf3,
+	0x1b, 0x3d, 0x73, 0x79, 0xa3, 0x67, 0x7e, 0xdc, 0xe8, 0x99, 0xb7, 0x1b, 0xe9, 0x4b, 0xf4, 0xe1,
+	0xce, 0x5b, 0x24, 0x6f, 0xf6, 0xb0, 0x20, 0x6f, 0xc7, 0xcb, 0xbf, 0x03, 0x00, 0xbb, 0xc5, 0x04,
+	0xb5, 0xaf, 0x04, 0x00, 0x00,
+}
+
+func (m *Dispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Dispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Dispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resolution) > 0 {
+		i -= len(m.Resolution)
+		copy(dAtA[i:], m.Resolution)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Resolution)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Resolver) > 0 {
+		i -= len(m.Resolver)
+		copy(dAtA[i:], m.Resolver)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Resolver)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x52
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ResponseDeadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResponseDeadline):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDispute(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FiledAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FiledAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDispute(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	if m.Status != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDispute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Agency) > 0 {
+		i -= len(m.Agency)
+		copy(dAtA[i:], m.Agency)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Agency)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDispute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDispute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Dispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDispute(uint64(m.Id))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Agency)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovDispute(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovDispute(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FiledAt)
+	n += 1 + l + sovDispute(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResponseDeadline)
+	n += 1 + l + sovDispute(uint64(l))
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Resolver)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	return n
+}
+
+func sovDispute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDispute(x uint64) (n int) {
+	return sovDispute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Dispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DisputeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FiledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FiledAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ResponseDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Response = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDispute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDispute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDispute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDispute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDispute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDispute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDispute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDispute = fmt.Errorf("proto: unexpected end of group")
+)
@@ -8,5 +8,17 @@ import (
 
 // x/creditscore module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner      = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidRepayment   = errors.Register(ModuleName, 1101, "invalid repayment")
+	ErrNotAccredited      = errors.Register(ModuleName, 1102, "agency not accredited")
+	ErrAgencyRegistered   = errors.Register(ModuleName, 1103, "agency already accredited")
+	ErrRateWithdrawn      = errors.Register(ModuleName, 1104, "rate withdrawn")
+	ErrUnknownScale       = errors.Register(ModuleName, 1105, "unknown rating scale")
+	ErrInvalidOutlook     = errors.Register(ModuleName, 1106, "invalid rating outlook")
+	ErrInvalidChange      = errors.Register(ModuleName, 1107, "invalid rate change")
+	ErrInvalidAttestation = errors.Register(ModuleName, 1108, "invalid attestation")
+	ErrInvalidDispute     = errors.Register(ModuleName, 1109, "invalid dispute")
+	ErrRateDisputed       = errors.Register(ModuleName, 1110, "rate disputed")
+	ErrChannelNotAllowed  = errors.Register(ModuleName, 1111, "channel not allowed to query ratings")
+	ErrInvalidRate        = errors.Register(ModuleName, 1112, "invalid rate")
 )
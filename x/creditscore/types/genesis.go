@@ -1,12 +1,22 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:  DefaultParams(),
-		RateMap: []Rate{}}
+		Params:         DefaultParams(),
+		RateMap:        []Rate{},
+		Repayments:     []RepaymentEvent{},
+		Agencies:       []Agency{},
+		RateHistory:    []RateChange{},
+		Attestations:   []Attestation{},
+		Disputes:       []Dispute{},
+		ChannelCredits: []ChannelCredit{}}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -15,12 +25,125 @@ func (gs GenesisState) Validate() error {
 	rateIndexMap := make(map[string]struct{})
 
 	for _, elem := range gs.RateMap {
-		index := fmt.Sprint(elem.Symbol)
+		index := fmt.Sprint(elem.Symbol, "/", elem.Creator)
 		if _, ok := rateIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for rate")
 		}
 		rateIndexMap[index] = struct{}{}
 	}
 
+	repaymentIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.Repayments {
+		index := fmt.Sprint(elem.Borrower, "/", elem.Id)
+		if _, ok := repaymentIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for repayment")
+		}
+		repaymentIndexMap[index] = struct{}{}
+
+		if elem.Id >= gs.RepaymentSeq {
+			return fmt.Errorf("repayment id %d is not below the sequence %d", elem.Id, gs.RepaymentSeq)
+		}
+		if elem.Borrower == "" || elem.Lender == "" {
+			return fmt.Errorf("repayment %d has no borrower or lender", elem.Id)
+		}
+		if err := ValidateRepayment(elem.Kind, elem.DaysLate); err != nil {
+			return fmt.Errorf("repayment %d: %w", elem.Id, err)
+		}
+	}
+
+	agencyIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.Agencies {
+		index := fmt.Sprint(elem.Address)
+		if _, ok := agencyIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for agency")
+		}
+		agencyIndexMap[index] = struct{}{}
+
+		if elem.Address == "" {
+			return fmt.Errorf("agency has no address")
+		}
+	}
+
+	rateChangeIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RateHistory {
+		index := fmt.Sprint(elem.Symbol, "/", elem.Id)
+		if _, ok := rateChangeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for rate change")
+		}
+		rateChangeIndexMap[index] = struct{}{}
+
+		if elem.Id >= gs.RateHistorySeq {
+			return fmt.Errorf("rate change id %d is not below the sequence %d", elem.Id, gs.RateHistorySeq)
+		}
+		if _, ok := RateAction_name[int32(elem.Action)]; !ok || elem.Action == RateAction_RATE_ACTION_UNSPECIFIED {
+			return fmt.Errorf("rate change %d has invalid action %d", elem.Id, elem.Action)
+		}
+		if err := ValidateRateChange(elem.Reason, elem.EvidenceHash); err != nil {
+			return fmt.Errorf("rate change %d: %w", elem.Id, err)
+		}
+	}
+
+	attestationIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.Attestations {
+		index := fmt.Sprint(elem.Id)
+		if _, ok := attestationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for attestation")
+		}
+		attestationIndexMap[index] = struct{}{}
+
+		if elem.Id >= gs.AttestationSeq {
+			return fmt.Errorf("attestation id %d is not below the sequence %d", elem.Id, gs.AttestationSeq)
+		}
+		if elem.Agency == "" {
+			return fmt.Errorf("attestation %d has no agency", elem.Id)
+		}
+		if err := ValidateCommitment(elem.Commitment); err != nil {
+			return fmt.Errorf("attestation %d: %w", elem.Id, err)
+		}
+	}
+
+	disputeIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.Disputes {
+		index := fmt.Sprint(elem.Id)
+		if _, ok := disputeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for dispute")
+		}
+		disputeIndexMap[index] = struct{}{}
+
+		if elem.Id >= gs.DisputeSeq {
+			return fmt.Errorf("dispute id %d is not below the sequence %d", elem.Id, gs.DisputeSeq)
+		}
+		if elem.Agency == "" || elem.Subject == "" {
+			return fmt.Errorf("dispute %d has no agency or subject", elem.Id)
+		}
+		if _, ok := DisputeStatus_name[int32(elem.Status)]; !ok || elem.Status == DisputeStatus_DISPUTE_STATUS_UNSPECIFIED {
+			return fmt.Errorf("dispute %d has invalid status %d", elem.Id, elem.Status)
+		}
+		if err := elem.Deposit.Validate(); err != nil {
+			return fmt.Errorf("dispute %d: %w", elem.Id, err)
+		}
+	}
+
+	channelCreditIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ChannelCredits {
+		if _, ok := channelCreditIndexMap[elem.ChannelId]; ok {
+			return fmt.Errorf("duplicated index for channel credit")
+		}
+		channelCreditIndexMap[elem.ChannelId] = struct{}{}
+
+		if err := host.ChannelIdentifierValidator(elem.ChannelId); err != nil {
+			return fmt.Errorf("channel credit: %w", err)
+		}
+		if err := elem.Balance.Validate(); err != nil {
+			return fmt.Errorf("channel credit of %s: %w", elem.ChannelId, err)
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -27,8 +27,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the creditscore module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params  Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	RateMap []Rate `protobuf:"bytes,2,rep,name=rate_map,json=rateMap,proto3" json:"rate_map"`
+	Params     Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	RateMap    []Rate           `protobuf:"bytes,2,rep,name=rate_map,json=rateMap,proto3" json:"rate_map"`
+	Repayments []RepaymentEvent `protobuf:"bytes,3,rep,name=repayments,proto3" json:"repayments"`
+	// repayment_seq is the id of the next repayment event.
+	RepaymentSeq uint64       `protobuf:"varint,4,opt,name=repayment_seq,json=repaymentSeq,proto3" json:"repayment_seq,omitempty"`
+	Agencies     []Agency     `protobuf:"bytes,5,rep,name=agencies,proto3" json:"agencies"`
+	RateHistory  []RateChange `protobuf:"bytes,6,rep,name=rate_history,json=rateHistory,proto3" json:"rate_history"`
+	// rate_history_seq is the id of the next rate change.
+	RateHistorySeq uint64        `protobuf:"varint,7,opt,name=rate_history_seq,json=rateHistorySeq,proto3" json:"rate_history_seq,omitempty"`
+	Attestations   []Attestation `protobuf:"bytes,8,rep,name=attestations,proto3" json:"attestations"`
+	// attestation_seq is the id of the next attestation.
+	AttestationSeq uint64    `protobuf:"varint,9,opt,name=attestation_seq,json=attestationSeq,proto3" json:"attestation_seq,omitempty"`
+	Disputes       []Dispute `protobuf:"bytes,10,rep,name=disputes,proto3" json:"disputes"`
+	// dispute_seq is the id of the next dispute.
+	DisputeSeq     uint64          `protobuf:"varint,11,opt,name=dispute_seq,json=disputeSeq,proto3" json:"dispute_seq,omitempty"`
+	ChannelCredits []ChannelCredit `protobuf:"bytes,12,rep,name=channel_credits,json=channelCredits,proto3" json:"channel_credits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +92,76 @@ func (m *GenesisState) GetRateMap() []Rate {
 	return nil
 }
 
+func (m *GenesisState) GetRepayments() []RepaymentEvent {
+	if m != nil {
+		return m.Repayments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRepaymentSeq() uint64 {
+	if m != nil {
+		return m.RepaymentSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAgencies() []Agency {
+	if m != nil {
+		return m.Agencies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateHistory() []RateChange {
+	if m != nil {
+		return m.RateHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateHistorySeq() uint64 {
+	if m != nil {
+		return m.RateHistorySeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestationSeq() uint64 {
+	if m != nil {
+		return m.AttestationSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDisputes() []Dispute {
+	if m != nil {
+		return m.Disputes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisputeSeq() uint64 {
+	if m != nil {
+		return m.DisputeSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetChannelCredits() []ChannelCredit {
+	if m != nil {
+		return m.ChannelCredits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "realfin.creditscore.v1.GenesisState")
 }
@@ -87,23 +171,39 @@ func init() {
 }
 
 var fileDescriptor_c8f54e22ae0a9a32 = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x4a, 0x4d, 0xcc,
-	0x49, 0xcb, 0xcc, 0xd3, 0x4f, 0x2e, 0x4a, 0x4d, 0xc9, 0x2c, 0x29, 0x4e, 0xce, 0x2f, 0x4a, 0xd5,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xaa, 0xd2, 0x43, 0x52, 0xa5, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b, 0x99,
-	0x97, 0xaf, 0x0f, 0x26, 0x21, 0x4a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10,
-	0x0b, 0x2a, 0xaa, 0x8c, 0xc3, 0x9a, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x2d, 0x52, 0x8a, 0x38,
-	0x14, 0x15, 0x25, 0x96, 0xa4, 0x42, 0x94, 0x28, 0x4d, 0x60, 0xe4, 0xe2, 0x71, 0x87, 0x38, 0x2d,
-	0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x91, 0x8b, 0x0d, 0x62, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0xb7, 0x91, 0x9c, 0x1e, 0x76, 0xa7, 0xea, 0x05, 0x80, 0x55, 0x39, 0x71, 0x9e, 0xb8, 0x27, 0xcf,
-	0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8, 0x46, 0x21, 0x5b, 0x2e, 0x0e, 0x90, 0x0d, 0xf1,
-	0xb9, 0x89, 0x05, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x32, 0xb8, 0x0c, 0x09, 0x4a, 0x2c,
-	0x49, 0x75, 0x62, 0x01, 0x19, 0x11, 0xc4, 0x0e, 0xd2, 0xe3, 0x9b, 0x58, 0xe0, 0x64, 0x7a, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xd2, 0x30, 0xff, 0x54, 0xa0, 0xf8, 0xa8,
-	0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x21, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xc4, 0x40, 0x1b, 0x4d, 0x81, 0x01, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0x9a, 0xa6, 0xe9, 0xda, 0xb4, 0xb0, 0x42, 0x68, 0x15, 0x90, 0x13, 0x1a, 0x28,
+	0x16, 0x07, 0x5b, 0x2d, 0xe2, 0x88, 0x44, 0x12, 0x10, 0x48, 0x50, 0x09, 0xa5, 0x9c, 0xb8, 0x44,
+	0x8b, 0x3b, 0x38, 0x16, 0xcd, 0xda, 0xf5, 0x2e, 0x11, 0x79, 0x0b, 0x1e, 0x83, 0x23, 0x8f, 0xd1,
+	0x63, 0x8f, 0x9c, 0x10, 0x4a, 0x0e, 0xdc, 0x78, 0x06, 0xe4, 0xf1, 0xda, 0xb8, 0x12, 0xdb, 0x5c,
+	0xac, 0xf5, 0xe8, 0x9b, 0xef, 0xf7, 0xed, 0x9f, 0x21, 0xf7, 0x33, 0xe0, 0xa7, 0x1f, 0x63, 0x11,
+	0x84, 0x19, 0x9c, 0xc4, 0x4a, 0x86, 0x49, 0x06, 0xc1, 0xfc, 0x20, 0x88, 0x40, 0x80, 0x8c, 0xa5,
+	0x9f, 0x66, 0x89, 0x4a, 0xe8, 0x6d, 0xad, 0xf2, 0x6b, 0x2a, 0x7f, 0x7e, 0xd0, 0xb9, 0xc9, 0x67,
+	0xb1, 0x48, 0x02, 0xfc, 0x16, 0xd2, 0xce, 0xad, 0x28, 0x89, 0x12, 0x5c, 0x06, 0xf9, 0x4a, 0x57,
+	0xfb, 0x06, 0x0c, 0x8f, 0x40, 0x84, 0x0b, 0x2d, 0xf2, 0x4c, 0x22, 0xa5, 0x40, 0x2a, 0xae, 0xe2,
+	0x44, 0x68, 0xa5, 0x29, 0xf5, 0x49, 0x2c, 0xd3, 0xcf, 0x0a, 0xd6, 0xa8, 0xa6, 0xb1, 0x54, 0x49,
+	0xb6, 0x58, 0x13, 0x2d, 0xe5, 0xe1, 0x27, 0x50, 0x6b, 0x45, 0x19, 0x9f, 0xe9, 0x53, 0xea, 0xdc,
+	0x33, 0x88, 0x32, 0x5e, 0x45, 0xda, 0x37, 0x49, 0x20, 0xe5, 0x8b, 0x19, 0x08, 0xcd, 0xdb, 0xfb,
+	0xb3, 0x49, 0x9c, 0x97, 0xc5, 0x15, 0x1c, 0x2b, 0xae, 0x80, 0x0e, 0x48, 0xab, 0x60, 0x31, 0xab,
+	0x67, 0x79, 0xf6, 0xa1, 0xeb, 0xff, 0xff, 0x4a, 0xfc, 0xb7, 0xa8, 0x1a, 0x6e, 0x9f, 0xff, 0xec,
+	0x36, 0xbe, 0xfd, 0xfe, 0xfe, 0xc8, 0x1a, 0xeb, 0x46, 0xfa, 0x94, 0xb4, 0xf3, 0x24, 0x93, 0x19,
+	0x4f, 0xd9, 0xb5, 0xde, 0x86, 0x67, 0x1f, 0xde, 0x35, 0x99, 0x8c, 0xb9, 0x82, 0x61, 0x33, 0xb7,
+	0x18, 0x6f, 0xe5, 0x3d, 0x47, 0x3c, 0xa5, 0x6f, 0x08, 0xa9, 0x52, 0x4a, 0xb6, 0x81, 0x06, 0xfb,
+	0x46, 0x83, 0x52, 0xf9, 0x62, 0x0e, 0x42, 0x69, 0xab, 0x5a, 0x3f, 0xed, 0x93, 0xeb, 0xd5, 0xdf,
+	0x44, 0xc2, 0x19, 0x6b, 0xf6, 0x2c, 0xaf, 0x39, 0x76, 0xaa, 0xe2, 0x31, 0x9c, 0xd1, 0x67, 0xa4,
+	0x8d, 0x0f, 0x24, 0x06, 0xc9, 0x36, 0x7b, 0x1b, 0x57, 0x6d, 0x7b, 0x80, 0x0f, 0x49, 0x83, 0xaa,
+	0x2e, 0xfa, 0x9a, 0x38, 0xb8, 0x67, 0x7d, 0xe5, 0xac, 0x85, 0x2e, 0x7b, 0x57, 0xed, 0x7b, 0x34,
+	0xe5, 0x22, 0x2a, 0x77, 0x6f, 0xe7, 0xdd, 0xaf, 0x8a, 0x66, 0xea, 0x91, 0x1b, 0x75, 0x33, 0x8c,
+	0xbd, 0x85, 0xb1, 0x77, 0x6a, 0xb2, 0x3c, 0xf8, 0x11, 0x71, 0x6a, 0x8f, 0x56, 0xb2, 0x36, 0x62,
+	0xfb, 0xc6, 0xf0, 0xff, 0xb4, 0x9a, 0x7b, 0xa9, 0x9d, 0x3e, 0x24, 0xbb, 0xb5, 0x7f, 0xe4, 0x6e,
+	0x17, 0xdc, 0x5a, 0x39, 0xe7, 0x0e, 0x48, 0x5b, 0x8f, 0x80, 0x64, 0x04, 0x99, 0x5d, 0x13, 0xf3,
+	0x79, 0xa1, 0x2b, 0x4f, 0xac, 0x6c, 0xa3, 0x5d, 0x62, 0xeb, 0x35, 0x72, 0x6c, 0xe4, 0x10, 0x5d,
+	0xca, 0x19, 0xef, 0xc8, 0x6e, 0x38, 0xe5, 0x42, 0xc0, 0xe9, 0x44, 0x5b, 0x32, 0x07, 0x51, 0x0f,
+	0x4c, 0xa8, 0x51, 0x21, 0x1f, 0x61, 0x55, 0x03, 0x77, 0xc2, 0x7a, 0x51, 0x0e, 0x9f, 0x9c, 0x2f,
+	0x5d, 0xeb, 0x62, 0xe9, 0x5a, 0xbf, 0x96, 0xae, 0xf5, 0x75, 0xe5, 0x36, 0x2e, 0x56, 0x6e, 0xe3,
+	0xc7, 0xca, 0x6d, 0xbc, 0xbf, 0x53, 0x8e, 0xcc, 0x97, 0x4b, 0x43, 0xa3, 0x16, 0x29, 0xc8, 0x0f,
+	0x2d, 0x1c, 0x97, 0xc7, 0x7f, 0x07, 0x00, 0x41, 0xf3, 0x48, 0x10, 0xc7, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +226,110 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelCredits) > 0 {
+		for iNdEx := len(m.ChannelCredits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelCredits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.DisputeSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DisputeSeq))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Disputes) > 0 {
+		for iNdEx := len(m.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.AttestationSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationSeq))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.RateHistorySeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RateHistorySeq))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RateHistory) > 0 {
+		for iNdEx := len(m.RateHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Agencies) > 0 {
+		for iNdEx := len(m.Agencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Agencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.RepaymentSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RepaymentSeq))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Repayments) > 0 {
+		for iNdEx := len(m.Repayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Repayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.RateMap) > 0 {
 		for iNdEx := len(m.RateMap) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -178,6 +382,54 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Repayments) > 0 {
+		for _, e := range m.Repayments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RepaymentSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.RepaymentSeq))
+	}
+	if len(m.Agencies) > 0 {
+		for _, e := range m.Agencies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateHistory) > 0 {
+		for _, e := range m.RateHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RateHistorySeq != 0 {
+		n += 1 + sovGenesis(uint64(m.RateHistorySeq))
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AttestationSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.AttestationSeq))
+	}
+	if len(m.Disputes) > 0 {
+		for _, e := range m.Disputes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DisputeSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.DisputeSeq))
+	}
+	if len(m.ChannelCredits) > 0 {
+		for _, e := range m.ChannelCredits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -283,6 +535,286 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repayments = append(m.Repayments, RepaymentEvent{})
+			if err := m.Repayments[len(m.Repayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepaymentSeq", wireType)
+			}
+			m.RepaymentSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepaymentSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agencies = append(m.Agencies, Agency{})
+			if err := m.Agencies[len(m.Agencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateHistory = append(m.RateHistory, RateChange{})
+			if err := m.RateHistory[len(m.RateHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateHistorySeq", wireType)
+			}
+			m.RateHistorySeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateHistorySeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationSeq", wireType)
+			}
+			m.AttestationSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disputes = append(m.Disputes, Dispute{})
+			if err := m.Disputes[len(m.Disputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeSeq", wireType)
+			}
+			m.DisputeSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelCredits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelCredits = append(m.ChannelCredits, ChannelCredit{})
+			if err := m.ChannelCredits[len(m.ChannelCredits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
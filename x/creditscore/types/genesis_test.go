@@ -1,8 +1,12 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"realfin/x/creditscore/types"
 
 	"github.com/stretchr/testify/require"
@@ -23,6 +27,10 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc:     "valid genesis state",
 			genState: &types.GenesisState{RateMap: []types.Rate{{Symbol: "0"}, {Symbol: "1"}}},
 			valid:    true,
+		}, {
+			desc:     "rates of a symbol by several agencies",
+			genState: &types.GenesisState{RateMap: []types.Rate{{Symbol: "0", Creator: "0"}, {Symbol: "0", Creator: "1"}}},
+			valid:    true,
 		}, {
 			desc: "duplicated rate",
 			genState: &types.GenesisState{
@@ -37,6 +45,209 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated repayment",
+			genState: &types.GenesisState{
+				Repayments: []types.RepaymentEvent{
+					{Borrower: "0", Lender: "1", Id: 0, Kind: types.RepaymentKind_REPAYMENT_KIND_ON_TIME},
+					{Borrower: "0", Lender: "1", Id: 0, Kind: types.RepaymentKind_REPAYMENT_KIND_ON_TIME},
+				},
+				RepaymentSeq: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "repayment id above sequence",
+			genState: &types.GenesisState{
+				Repayments: []types.RepaymentEvent{
+					{Borrower: "0", Lender: "1", Id: 1, Kind: types.RepaymentKind_REPAYMENT_KIND_ON_TIME},
+				},
+				RepaymentSeq: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "late repayment without days late",
+			genState: &types.GenesisState{
+				Repayments: []types.RepaymentEvent{
+					{Borrower: "0", Lender: "1", Id: 0, Kind: types.RepaymentKind_REPAYMENT_KIND_LATE},
+				},
+				RepaymentSeq: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated agency",
+			genState: &types.GenesisState{
+				Agencies: []types.Agency{{Address: "0"}, {Address: "0", Revoked: true}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated rate change",
+			genState: &types.GenesisState{
+				RateHistory: []types.RateChange{
+					{Symbol: "0", Id: 0, Action: types.RateAction_RATE_ACTION_CREATED},
+					{Symbol: "0", Id: 0, Action: types.RateAction_RATE_ACTION_UPDATED},
+				},
+				RateHistorySeq: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "rate change id above sequence",
+			genState: &types.GenesisState{
+				RateHistory:    []types.RateChange{{Symbol: "0", Id: 1, Action: types.RateAction_RATE_ACTION_CREATED}},
+				RateHistorySeq: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "rate change without action",
+			genState: &types.GenesisState{
+				RateHistory:    []types.RateChange{{Symbol: "0", Id: 0}},
+				RateHistorySeq: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated attestation",
+			genState: &types.GenesisState{
+				Attestations: []types.Attestation{
+					{Id: 0, Agency: "0", Commitment: strings.Repeat("ab", 32)},
+					{Id: 0, Agency: "1", Commitment: strings.Repeat("cd", 32)},
+				},
+				AttestationSeq: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "attestation with invalid commitment",
+			genState: &types.GenesisState{
+				Attestations:   []types.Attestation{{Id: 0, Agency: "0", Commitment: "abcd"}},
+				AttestationSeq: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "rate change with short evidence hash",
+			genState: &types.GenesisState{
+				RateHistory:    []types.RateChange{{Symbol: "0", Id: 0, Action: types.RateAction_RATE_ACTION_CREATED, EvidenceHash: "abcd"}},
+				RateHistorySeq: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "unsorted rating scale",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultScoreModel(), []types.RatingScale{{
+					Name:  "pd",
+					Bands: []types.GradeBand{{Grade: "A", Min: 10}, {Grade: "B", Min: 20}, {Grade: "C", Min: 0}},
+				}}, "", math.ZeroInt(), 0, "", nil, types.DefaultQueryFee, "", nil),
+			},
+			valid: false,
+		},
+		{
+			desc: "rating scale without grade for zero",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultScoreModel(), []types.RatingScale{{
+					Name:  "pd",
+					Bands: []types.GradeBand{{Grade: "A", Min: 10}},
+				}}, "", math.ZeroInt(), 0, "", nil, types.DefaultQueryFee, "", nil),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative dispute deposit",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultScoreModel(), nil, types.DefaultDisputeDenom, math.NewInt(-1), 0, "", nil, types.DefaultQueryFee, "", nil),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid arbiter",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultScoreModel(), nil, "", math.ZeroInt(), 0, "arbiter", nil, types.DefaultQueryFee, "", nil),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated dispute",
+			genState: &types.GenesisState{
+				Disputes: []types.Dispute{
+					{Id: 0, Symbol: "0", Agency: "0", Subject: "1", Status: types.DisputeStatus_DISPUTE_STATUS_OPEN},
+					{Id: 0, Symbol: "1", Agency: "0", Subject: "1", Status: types.DisputeStatus_DISPUTE_STATUS_OPEN},
+				},
+				DisputeSeq: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "dispute without status",
+			genState: &types.GenesisState{
+				Disputes:   []types.Dispute{{Id: 0, Symbol: "0", Agency: "0", Subject: "1"}},
+				DisputeSeq: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "channel credits",
+			genState: &types.GenesisState{
+				ChannelCredits: []types.ChannelCredit{{ChannelId: "channel-0"}, {ChannelId: "channel-1"}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated channel credit",
+			genState: &types.GenesisState{
+				ChannelCredits: []types.ChannelCredit{{ChannelId: "channel-0"}, {ChannelId: "channel-0"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid query channel",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultScoreModel(), nil, "", math.ZeroInt(), 0, "", []string{"channel 0"}, types.DefaultQueryFee, "", nil),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid query fee",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultScoreModel(), nil, "", math.ZeroInt(), 0, "", nil, sdk.Coin{Denom: "u", Amount: math.OneInt()}, "", nil),
+			},
+			valid: false,
+		},
+		{
+			desc: "ltv of an unknown grade",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultScoreModel(), []types.RatingScale{types.DefaultRatingScale()}, "", math.ZeroInt(), 0, "", nil, types.DefaultQueryFee,
+					types.DefaultCreditDenom, []types.GradeLtv{{Grade: "AAA+", Ltv: math.LegacyNewDecWithPrec(9, 1)}}),
+			},
+			valid: false,
+		},
+		{
+			desc: "ltv above one",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultScoreModel(), []types.RatingScale{types.DefaultRatingScale()}, "", math.ZeroInt(), 0, "", nil, types.DefaultQueryFee,
+					types.DefaultCreditDenom, []types.GradeLtv{{Grade: "AAA", Ltv: math.LegacyNewDecWithPrec(11, 1)}}),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated rating scale",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultScoreModel(), []types.RatingScale{types.DefaultRatingScale(), types.DefaultRatingScale()}, "", math.ZeroInt(), 0, "", nil, types.DefaultQueryFee, "", nil),
+			},
+			valid: false,
+		},
+		{
+			desc: "base score below floor",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.ScoreModel{BaseScore: 100, Floor: 300, Ceiling: 850}, nil, "", math.ZeroInt(), 0, "", nil, types.DefaultQueryFee, "", nil),
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	// MaxReasonLength is the maximum length of the reason of a rate change.
+	MaxReasonLength = 256
+
+	// EvidenceHashLength is the length in bytes of an evidence hash.
+	EvidenceHashLength = 32
+)
+
+// ValidateRateChange validates the reason and the evidence hash of a rate
+// change. The evidence hash is optional, and otherwise the hex encoding of a
+// 32 bytes hash such as a SHA-256 digest.
+func ValidateRateChange(reason, evidenceHash string) error {
+	if len(reason) > MaxReasonLength {
+		return fmt.Errorf("reason longer than %d characters", MaxReasonLength)
+	}
+	if evidenceHash == "" {
+		return nil
+	}
+
+	hash, err := hex.DecodeString(evidenceHash)
+	if err != nil {
+		return fmt.Errorf("evidence hash is not hex encoded: %w", err)
+	}
+	if len(hash) != EvidenceHashLength {
+		return fmt.Errorf("evidence hash must be %d bytes, got %d", EvidenceHashLength, len(hash))
+	}
+
+	return nil
+}
@@ -0,0 +1,872 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/creditscore/v1/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RateAction defines the change of a rate recorded in its history.
+type RateAction int32
+
+const (
+	// RATE_ACTION_UNSPECIFIED is an invalid action.
+	RateAction_RATE_ACTION_UNSPECIFIED RateAction = 0
+	// RATE_ACTION_CREATED is the publication of a rate.
+	RateAction_RATE_ACTION_CREATED RateAction = 1
+	// RATE_ACTION_UPDATED is the update of a rate, including the scores
+	// recomputed from repayment events.
+	RateAction_RATE_ACTION_UPDATED RateAction = 2
+	// RATE_ACTION_DELETED is the deletion of a rate.
+	RateAction_RATE_ACTION_DELETED RateAction = 3
+	// RATE_ACTION_WITHDRAWN is the withdrawal of a rate when its agency is
+	// revoked.
+	RateAction_RATE_ACTION_WITHDRAWN RateAction = 4
+)
+
+var RateAction_name = map[int32]string{
+	0: "RATE_ACTION_UNSPECIFIED",
+	1: "RATE_ACTION_CREATED",
+	2: "RATE_ACTION_UPDATED",
+	3: "RATE_ACTION_DELETED",
+	4: "RATE_ACTION_WITHDRAWN",
+}
+
+var RateAction_value = map[string]int32{
+	"RATE_ACTION_UNSPECIFIED": 0,
+	"RATE_ACTION_CREATED":     1,
+	"RATE_ACTION_UPDATED":     2,
+	"RATE_ACTION_DELETED":     3,
+	"RATE_ACTION_WITHDRAWN":   4,
+}
+
+func (x RateAction) String() string {
+	return proto.EnumName(RateAction_name, int32(x))
+}
+
+func (RateAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f5bf046575bc8c62, []int{0}
+}
+
+// RateChange records a change of a rate. The history of the rates is append
+// only.
+type RateChange struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// creator is the agency that issued the rate.
+	Creator       string     `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Action        RateAction `protobuf:"varint,4,opt,name=action,proto3,enum=realfin.creditscore.v1.RateAction" json:"action,omitempty"`
+	Height        int64      `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Time          time.Time  `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
+	PreviousRate  uint64     `protobuf:"varint,7,opt,name=previous_rate,json=previousRate,proto3" json:"previous_rate,omitempty"`
+	Rate          uint64     `protobuf:"varint,8,opt,name=rate,proto3" json:"rate,omitempty"`
+	PreviousGrade string     `protobuf:"bytes,9,opt,name=previous_grade,json=previousGrade,proto3" json:"previous_grade,omitempty"`
+	Grade         string     `protobuf:"bytes,10,opt,name=grade,proto3" json:"grade,omitempty"`
+	Reason        string     `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	// evidence_hash is the hex-encoded hash of the evidence supporting the
+	// change.
+	EvidenceHash string `protobuf:"bytes,12,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`
+}
+
+func (m *RateChange) Reset()         { *m = RateChange{} }
+func (m *RateChange) String() string { return proto.CompactTextString(m) }
+func (*RateChange) ProtoMessage()    {}
+func (*RateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bf046575bc8c62, []int{0}
+}
+func (m *RateChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateChange.Merge(m, src)
+}
+func (m *RateChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateChange proto.InternalMessageInfo
+
+func (m *RateChange) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *RateChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RateChange) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *RateChange) GetAction() RateAction {
+	if m != nil {
+		return m.Action
+	}
+	return RateAction_RATE_ACTION_UNSPECIFIED
+}
+
+func (m *RateChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RateChange) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *RateChange) GetPreviousRate() uint64 {
+	if m != nil {
+		return m.PreviousRate
+	}
+	return 0
+}
+
+func (m *RateChange) GetRate() uint64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *RateChange) GetPreviousGrade() string {
+	if m != nil {
+		return m.PreviousGrade
+	}
+	return ""
+}
+
+func (m *RateChange) GetGrade() string {
+	if m != nil {
+		return m.Grade
+	}
+	return ""
+}
+
+func (m *RateChange) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RateChange) GetEvidenceHash() string {
+	if m != nil {
+		return m.EvidenceHash
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("realfin.creditscore.v1.RateAction", RateAction_name, RateAction_value)
+	proto.RegisterType((*RateChange)(nil), "realfin.creditscore.v1.RateChange")
+}
+
+func init() {
+	proto.RegisterFile("realfin/creditscore/v1/history.proto", fileDescriptor_f5bf046575bc8c62)
+}
+
+var fileDescriptor_f5bf046575bc8c62 = []byte{
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0x36, 0xeb, 0x98, 0xb7, 0x55, 0xc5, 0x8c, 0xcd, 0x74, 0x52, 0x1a, 0x0d, 0x90,
+	0xa2, 0x1d, 0x12, 0x6d, 0x88, 0x0b, 0x12, 0x87, 0xac, 0x09, 0xac, 0x12, 0x2a, 0x53, 0xc8, 0x34,
+	0x89, 0x4b, 0xe5, 0x26, 0x5e, 0x62, 0xa9, 0x8d, 0x2b, 0xc7, 0xab, 0xe8, 0x53, 0xb0, 0x03, 0x0f,
+	0xc1, 0x91, 0xc7, 0xd8, 0x71, 0x47, 0x4e, 0x80, 0xda, 0x03, 0xaf, 0x81, 0xe2, 0x24, 0xa3, 0x4c,
+	0x5c, 0xaa, 0xef, 0xff, 0xfd, 0xfe, 0x5f, 0xfd, 0xfd, 0x1d, 0xc3, 0x67, 0x82, 0x92, 0xf1, 0x25,
+	0x4b, 0xed, 0x50, 0xd0, 0x88, 0xc9, 0x2c, 0xe4, 0x82, 0xda, 0xb3, 0x23, 0x3b, 0x61, 0x99, 0xe4,
+	0x62, 0x6e, 0x4d, 0x05, 0x97, 0x1c, 0xed, 0x96, 0x2e, 0x6b, 0xc5, 0x65, 0xcd, 0x8e, 0x3a, 0x0f,
+	0xc9, 0x84, 0xa5, 0xdc, 0x56, 0xbf, 0x85, 0xb5, 0xb3, 0x13, 0xf3, 0x98, 0xab, 0xd2, 0xce, 0xab,
+	0xb2, 0xdb, 0x8d, 0x39, 0x8f, 0xc7, 0xd4, 0x56, 0x6a, 0x74, 0x75, 0x69, 0x4b, 0x36, 0xa1, 0x99,
+	0x24, 0x93, 0x69, 0x61, 0x38, 0xf8, 0xd2, 0x80, 0xd0, 0x27, 0x92, 0xf6, 0x12, 0x92, 0xc6, 0x14,
+	0xed, 0xc2, 0x66, 0x36, 0x9f, 0x8c, 0xf8, 0x18, 0x03, 0x03, 0x98, 0x1b, 0x7e, 0xa9, 0x50, 0x0b,
+	0xd6, 0x59, 0x84, 0xeb, 0x06, 0x30, 0x35, 0xbf, 0xce, 0x22, 0x84, 0xe1, 0x7a, 0x28, 0x28, 0x91,
+	0x5c, 0xe0, 0x86, 0x32, 0x56, 0x12, 0xbd, 0x82, 0x4d, 0x12, 0x4a, 0xc6, 0x53, 0xac, 0x19, 0xc0,
+	0x6c, 0x1d, 0x1f, 0x58, 0xff, 0xcf, 0x60, 0xe5, 0xa7, 0x3a, 0xca, 0xe9, 0x97, 0x13, 0xf9, 0xe9,
+	0x09, 0x65, 0x71, 0x22, 0xf1, 0x9a, 0x01, 0xcc, 0x86, 0x5f, 0x2a, 0xf4, 0x1a, 0x6a, 0xf9, 0xde,
+	0xb8, 0x69, 0x00, 0x73, 0xf3, 0xb8, 0x63, 0x15, 0xa1, 0xac, 0x2a, 0x94, 0x15, 0x54, 0xa1, 0x4e,
+	0xb6, 0x6f, 0x7e, 0x74, 0x6b, 0xd7, 0x3f, 0xbb, 0xe0, 0xeb, 0xef, 0x6f, 0x87, 0xc0, 0x57, 0x63,
+	0xe8, 0x29, 0xdc, 0x9e, 0x0a, 0x3a, 0x63, 0xfc, 0x2a, 0x1b, 0x0a, 0x22, 0x29, 0x5e, 0x57, 0x39,
+	0xb6, 0xaa, 0x66, 0xbe, 0x09, 0x42, 0x50, 0x53, 0xec, 0x81, 0x62, 0xaa, 0x46, 0xcf, 0x61, 0xeb,
+	0x6e, 0x30, 0x16, 0x24, 0xa2, 0x78, 0x43, 0x85, 0xbd, 0xfb, 0xbb, 0xb7, 0x79, 0x13, 0xed, 0xc0,
+	0xb5, 0x82, 0x42, 0x45, 0x0b, 0x91, 0x87, 0x11, 0x94, 0x64, 0x3c, 0xc5, 0x9b, 0xc5, 0x55, 0x16,
+	0x2a, 0xdf, 0x86, 0xce, 0x58, 0x44, 0xd3, 0x90, 0x0e, 0x13, 0x92, 0x25, 0x78, 0x4b, 0xe1, 0xad,
+	0xaa, 0x79, 0x4a, 0xb2, 0xe4, 0xf0, 0x33, 0x80, 0xf0, 0xef, 0x05, 0xa1, 0x7d, 0xb8, 0xe7, 0x3b,
+	0x81, 0x37, 0x74, 0x7a, 0x41, 0xff, 0xfd, 0x60, 0x78, 0x3e, 0xf8, 0x70, 0xe6, 0xf5, 0xfa, 0x6f,
+	0xfa, 0x9e, 0xdb, 0xae, 0xa1, 0x3d, 0xf8, 0x68, 0x15, 0xf6, 0x7c, 0xcf, 0x09, 0x3c, 0xb7, 0x0d,
+	0xee, 0x83, 0xf3, 0x33, 0x57, 0x81, 0xfa, 0x7d, 0xe0, 0x7a, 0xef, 0xbc, 0x1c, 0x34, 0xd0, 0x13,
+	0xf8, 0x78, 0x15, 0x5c, 0xf4, 0x83, 0x53, 0xd7, 0x77, 0x2e, 0x06, 0x6d, 0xed, 0xe4, 0xe5, 0xcd,
+	0x42, 0x07, 0xb7, 0x0b, 0x1d, 0xfc, 0x5a, 0xe8, 0xe0, 0x7a, 0xa9, 0xd7, 0x6e, 0x97, 0x7a, 0xed,
+	0xfb, 0x52, 0xaf, 0x7d, 0xdc, 0xaf, 0x9e, 0xf2, 0xa7, 0x7f, 0x1e, 0xb3, 0x9c, 0x4f, 0x69, 0x36,
+	0x6a, 0xaa, 0x8f, 0xf4, 0xe2, 0xcf, 0x00, 0xd3, 0xf1, 0xdf, 0x61, 0xf0, 0x02, 0x00, 0x00,
+}
+
+func (m *RateChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceHash) > 0 {
+		i -= len(m.EvidenceHash)
+		copy(dAtA[i:], m.EvidenceHash)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.EvidenceHash)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Grade) > 0 {
+		i -= len(m.Grade)
+		copy(dAtA[i:], m.Grade)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Grade)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PreviousGrade) > 0 {
+		i -= len(m.PreviousGrade)
+		copy(dAtA[i:], m.PreviousGrade)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.PreviousGrade)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Rate != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Rate))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PreviousRate != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.PreviousRate))
+		i--
+		dAtA[i] = 0x38
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintHistory(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Action != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RateChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovHistory(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovHistory(uint64(m.Action))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovHistory(uint64(l))
+	if m.PreviousRate != 0 {
+		n += 1 + sovHistory(uint64(m.PreviousRate))
+	}
+	if m.Rate != 0 {
+		n += 1 + sovHistory(uint64(m.Rate))
+	}
+	l = len(m.PreviousGrade)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Grade)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.EvidenceHash)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RateChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= RateAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRate", wireType)
+			}
+			m.PreviousRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			m.Rate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousGrade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousGrade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// AgencyKey is the prefix to retrieve all Agency
+var AgencyKey = collections.NewPrefix("agency/value/")
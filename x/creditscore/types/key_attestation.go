@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// AttestationKey is the prefix to retrieve all Attestation
+	AttestationKey = collections.NewPrefix("attestation/value/")
+
+	// AttestationSeqKey is the prefix of the attestation id sequence
+	AttestationSeqKey = collections.NewPrefix("attestation/seq/")
+)
@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// ChannelCreditKey is the prefix to retrieve all ChannelCredit
+var ChannelCreditKey = collections.NewPrefix("channel_credit/value/")
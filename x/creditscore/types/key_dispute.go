@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// DisputeKey is the prefix to retrieve all Dispute
+	DisputeKey = collections.NewPrefix("dispute/value/")
+
+	// DisputeSeqKey is the prefix of the dispute id sequence
+	DisputeSeqKey = collections.NewPrefix("dispute/seq/")
+)
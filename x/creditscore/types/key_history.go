@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// RateHistoryKey is the prefix to retrieve all RateChange
+	RateHistoryKey = collections.NewPrefix("rate_history/value/")
+
+	// RateHistorySeqKey is the prefix of the rate change id sequence
+	RateHistorySeqKey = collections.NewPrefix("rate_history/seq/")
+)
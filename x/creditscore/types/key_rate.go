@@ -2,5 +2,13 @@ package types
 
 import "cosmossdk.io/collections"
 
-// RateKey is the prefix to retrieve all Rate
-var RateKey = collections.NewPrefix("rate/value/")
+var (
+	// RateKey is the prefix to retrieve all Rate
+	RateKey = collections.NewPrefix("rate/rating/")
+
+	// RateCreatorIndexKey is the prefix of the index of the rates by creator
+	RateCreatorIndexKey = collections.NewPrefix("rate/creator/")
+
+	// RateSubjectIndexKey is the prefix of the index of the rates by subject
+	RateSubjectIndexKey = collections.NewPrefix("rate/subject/")
+)
@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// RepaymentKey is the prefix to retrieve all RepaymentEvent
+	RepaymentKey = collections.NewPrefix("repayment/value/")
+
+	// RepaymentSeqKey is the prefix of the repayment event id sequence
+	RepaymentSeqKey = collections.NewPrefix("repayment/seq/")
+)
@@ -13,6 +13,12 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// PortID is the default port id the creditscore IBC application binds to
+	PortID = ModuleName
+
+	// Version defines the current version of the creditscore IBC application
+	Version = "realfin-creditscore-1"
 )
 
 // ParamsKey is the prefix to retrieve all Params
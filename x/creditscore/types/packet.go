@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// MaxPacketSymbols is the maximum number of symbols requested in a packet.
+const MaxPacketSymbols = 100
+
+// ModuleCdc encodes the creditscore packets exchanged over IBC.
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// GetBytes returns the JSON encoding of the packet.
+func (p CreditscorePacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&p)
+}
+
+// ValidateBasic performs the stateless checks of the packet.
+func (p CreditscorePacketData) ValidateBasic() error {
+	switch packet := p.Packet.(type) {
+	case *CreditscorePacketData_RatingRequest:
+		return validatePacketSymbols(packet.RatingRequest.Symbols)
+	default:
+		return fmt.Errorf("unrecognized creditscore packet type: %T", packet)
+	}
+}
+
+func validatePacketSymbols(symbols []string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("symbols cannot be empty")
+	}
+	if len(symbols) > MaxPacketSymbols {
+		return fmt.Errorf("too many symbols: %d > %d", len(symbols), MaxPacketSymbols)
+	}
+
+	seen := make(map[string]struct{}, len(symbols))
+	for _, symbol := range symbols {
+		if symbol == "" {
+			return fmt.Errorf("symbol cannot be empty")
+		}
+		if _, ok := seen[symbol]; ok {
+			return fmt.Errorf("duplicated symbol %s", symbol)
+		}
+		seen[symbol] = struct{}{}
+	}
+
+	return nil
+}
@@ -1,17 +1,228 @@
 package types
 
+import (
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+const (
+	// DefaultDisputeDenom is the default denom of dispute deposits.
+	DefaultDisputeDenom = "urlf"
+
+	// DefaultDisputeResponseWindow is the default number of seconds an agency
+	// has to respond to a dispute.
+	DefaultDisputeResponseWindow uint64 = 14 * 24 * 60 * 60
+
+	// DefaultCreditDenom is the default denom of the credit limits.
+	DefaultCreditDenom = "urlf"
+)
+
+var (
+	// DefaultDisputeDeposit is the default deposit of a dispute.
+	DefaultDisputeDeposit = math.NewInt(1_000_000)
+
+	// DefaultQueryFee is the default fee of a rating request received over
+	// IBC: queries are free.
+	DefaultQueryFee = sdk.NewCoin(DefaultDisputeDenom, math.ZeroInt())
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	scoreModel ScoreModel,
+	scales []RatingScale,
+	disputeDenom string,
+	disputeDeposit math.Int,
+	disputeResponseWindow uint64,
+	arbiter string,
+	queryChannels []string,
+	queryFee sdk.Coin,
+	creditDenom string,
+	ltvCurve []GradeLtv,
+) Params {
+	return Params{
+		ScoreModel:            scoreModel,
+		Scales:                scales,
+		DisputeDenom:          disputeDenom,
+		DisputeDeposit:        disputeDeposit,
+		DisputeResponseWindow: disputeResponseWindow,
+		Arbiter:               arbiter,
+		QueryChannels:         queryChannels,
+		QueryFee:              queryFee,
+		CreditDenom:           creditDenom,
+		LtvCurve:              ltvCurve,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultScoreModel(), []RatingScale{DefaultRatingScale()},
+		DefaultDisputeDenom, DefaultDisputeDeposit, DefaultDisputeResponseWindow, "",
+		nil, DefaultQueryFee,
+		DefaultCreditDenom, DefaultLtvCurve(),
+	)
+}
+
+// DefaultLtvCurve returns the default loan-to-value curve of the grades of
+// the default rating scale: from 80% for AAA down to 25% for CCC, no credit
+// below.
+func DefaultLtvCurve() []GradeLtv {
+	return []GradeLtv{
+		{Grade: "AAA", Ltv: math.LegacyNewDecWithPrec(80, 2)},
+		{Grade: "AA", Ltv: math.LegacyNewDecWithPrec(75, 2)},
+		{Grade: "A", Ltv: math.LegacyNewDecWithPrec(70, 2)},
+		{Grade: "BBB", Ltv: math.LegacyNewDecWithPrec(60, 2)},
+		{Grade: "BB", Ltv: math.LegacyNewDecWithPrec(50, 2)},
+		{Grade: "B", Ltv: math.LegacyNewDecWithPrec(40, 2)},
+		{Grade: "CCC", Ltv: math.LegacyNewDecWithPrec(25, 2)},
+	}
+}
+
+// DefaultScoreModel returns the default score model: scores range from 300
+// to 850 and the impact of an event is halved every year.
+func DefaultScoreModel() ScoreModel {
+	return ScoreModel{
+		BaseScore:          600,
+		Floor:              300,
+		Ceiling:            850,
+		OnTimeWeight:       5,
+		LateWeight:         -10,
+		LateDayWeight:      -1,
+		DefaultWeight:      -200,
+		RestructuredWeight: -60,
+		DecayHalfLife:      365 * 24 * 60 * 60,
+	}
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if err := p.ScoreModel.Validate(); err != nil {
+		return err
+	}
+
+	names := make(map[string]struct{})
+	for _, scale := range p.Scales {
+		if _, ok := names[scale.Name]; ok {
+			return fmt.Errorf("duplicated rating scale %s", scale.Name)
+		}
+		names[scale.Name] = struct{}{}
+
+		if err := scale.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// an empty dispute denom disables the deposit
+	if p.DisputeDenom != "" {
+		if err := sdk.ValidateDenom(p.DisputeDenom); err != nil {
+			return fmt.Errorf("invalid dispute denom: %w", err)
+		}
+	}
+	if !p.DisputeDeposit.IsNil() && p.DisputeDeposit.IsNegative() {
+		return fmt.Errorf("dispute deposit cannot be negative")
+	}
+	if p.Arbiter != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Arbiter); err != nil {
+			return fmt.Errorf("invalid arbiter address: %w", err)
+		}
+	}
+
+	channels := make(map[string]struct{})
+	for _, channelID := range p.QueryChannels {
+		if _, ok := channels[channelID]; ok {
+			return fmt.Errorf("duplicated query channel %s", channelID)
+		}
+		channels[channelID] = struct{}{}
+
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid query channel: %w", err)
+		}
+	}
+	// an unset query fee makes the queries free
+	if p.QueryFee.Denom != "" || !p.QueryFee.Amount.IsNil() {
+		if err := p.QueryFee.Validate(); err != nil {
+			return fmt.Errorf("invalid query fee: %w", err)
+		}
+	}
+
+	// an empty credit denom disables the credit limits
+	if p.CreditDenom != "" {
+		if err := sdk.ValidateDenom(p.CreditDenom); err != nil {
+			return fmt.Errorf("invalid credit denom: %w", err)
+		}
+	}
+	ltvGrades := make(map[string]struct{})
+	for _, ltv := range p.LtvCurve {
+		if _, ok := ltvGrades[ltv.Grade]; ok {
+			return fmt.Errorf("duplicated ltv of grade %s", ltv.Grade)
+		}
+		ltvGrades[ltv.Grade] = struct{}{}
+
+		// the consolidated rates are graded with the default scale
+		if len(p.Scales) == 0 {
+			return fmt.Errorf("ltv of grade %s without rating scale", ltv.Grade)
+		}
+		if _, ok := p.Scales[0].Rank(ltv.Grade); !ok {
+			return fmt.Errorf("ltv grade %s is not a grade of the default scale %s", ltv.Grade, p.Scales[0].Name)
+		}
+		if ltv.Ltv.IsNil() || ltv.Ltv.IsNegative() || ltv.Ltv.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("ltv of grade %s must be between 0 and 1", ltv.Grade)
+		}
+	}
+
+	return nil
+}
+
+// DisputeDepositCoins returns the deposit of a dispute, none when the
+// deposit is disabled.
+func (p Params) DisputeDepositCoins() sdk.Coins {
+	if p.DisputeDenom == "" || p.DisputeDeposit.IsNil() || !p.DisputeDeposit.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(p.DisputeDenom, p.DisputeDeposit))
+}
+
+// QueryChannelAllowed reports whether the channel is allowed to query
+// ratings.
+func (p Params) QueryChannelAllowed(channelID string) bool {
+	return slices.Contains(p.QueryChannels, channelID)
+}
+
+// QueryFeeCoins returns the fee of a rating request, none when queries are
+// free.
+func (p Params) QueryFeeCoins() sdk.Coins {
+	if p.QueryFee.Denom == "" || p.QueryFee.Amount.IsNil() || !p.QueryFee.Amount.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	return sdk.NewCoins(p.QueryFee)
+}
+
+// Ltv returns the loan-to-value ratio of the grade, zero for the grades left
+// out of the curve.
+func (p Params) Ltv(grade string) math.LegacyDec {
+	for _, ltv := range p.LtvCurve {
+		if ltv.Grade == grade {
+			return ltv.Ltv
+		}
+	}
+
+	return math.LegacyZeroDec()
+}
+
+// Validate validates the score model.
+func (m ScoreModel) Validate() error {
+	if m.Floor > m.Ceiling {
+		return fmt.Errorf("score floor %d is above the ceiling %d", m.Floor, m.Ceiling)
+	}
+	if m.BaseScore < m.Floor || m.BaseScore > m.Ceiling {
+		return fmt.Errorf("base score %d is outside [%d, %d]", m.BaseScore, m.Floor, m.Ceiling)
+	}
 
 	return nil
 }
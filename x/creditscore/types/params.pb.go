@@ -4,7 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +29,35 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// score_model is the model computing the scores of borrowers from their
+	// repayment events.
+	ScoreModel ScoreModel `protobuf:"bytes,1,opt,name=score_model,json=scoreModel,proto3" json:"score_model"`
+	// scales are the rating scales mapping rates to grades. The first scale is
+	// the default scale.
+	Scales []RatingScale `protobuf:"bytes,2,rep,name=scales,proto3" json:"scales"`
+	// dispute_denom is the denom of the deposit of a dispute. An empty denom
+	// disables the deposit.
+	DisputeDenom string `protobuf:"bytes,3,opt,name=dispute_denom,json=disputeDenom,proto3" json:"dispute_denom,omitempty"`
+	// dispute_deposit is the amount a subject deposits to dispute a rate.
+	DisputeDeposit cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=dispute_deposit,json=disputeDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"dispute_deposit"`
+	// dispute_response_window is the number of seconds an agency has to
+	// respond to a dispute before it can be arbitrated.
+	DisputeResponseWindow uint64 `protobuf:"varint,5,opt,name=dispute_response_window,json=disputeResponseWindow,proto3" json:"dispute_response_window,omitempty"`
+	// arbiter is the address resolving disputes besides governance. An empty
+	// arbiter leaves disputes to governance.
+	Arbiter string `protobuf:"bytes,6,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	// query_channels are the IBC channels allowed to query ratings. No channel
+	// can be opened or queried when empty.
+	QueryChannels []string `protobuf:"bytes,7,rep,name=query_channels,json=queryChannels,proto3" json:"query_channels,omitempty"`
+	// query_fee is charged to the credit of the channel for every rating
+	// request. A zero fee makes the queries free.
+	QueryFee types.Coin `protobuf:"bytes,8,opt,name=query_fee,json=queryFee,proto3" json:"query_fee"`
+	// credit_denom is the denom the collateral and the credit limits are
+	// valued in. An empty denom disables the credit limits.
+	CreditDenom string `protobuf:"bytes,9,opt,name=credit_denom,json=creditDenom,proto3" json:"credit_denom,omitempty"`
+	// ltv_curve is the loan-to-value ratio granted to the grades of the default
+	// rating scale. Grades left out are granted no credit.
+	LtvCurve []GradeLtv `protobuf:"bytes,10,rep,name=ltv_curve,json=ltvCurve,proto3" json:"ltv_curve"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,8 +93,241 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetScoreModel() ScoreModel {
+	if m != nil {
+		return m.ScoreModel
+	}
+	return ScoreModel{}
+}
+
+func (m *Params) GetScales() []RatingScale {
+	if m != nil {
+		return m.Scales
+	}
+	return nil
+}
+
+func (m *Params) GetDisputeDenom() string {
+	if m != nil {
+		return m.DisputeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetDisputeResponseWindow() uint64 {
+	if m != nil {
+		return m.DisputeResponseWindow
+	}
+	return 0
+}
+
+func (m *Params) GetArbiter() string {
+	if m != nil {
+		return m.Arbiter
+	}
+	return ""
+}
+
+func (m *Params) GetQueryChannels() []string {
+	if m != nil {
+		return m.QueryChannels
+	}
+	return nil
+}
+
+func (m *Params) GetQueryFee() types.Coin {
+	if m != nil {
+		return m.QueryFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetCreditDenom() string {
+	if m != nil {
+		return m.CreditDenom
+	}
+	return ""
+}
+
+func (m *Params) GetLtvCurve() []GradeLtv {
+	if m != nil {
+		return m.LtvCurve
+	}
+	return nil
+}
+
+// GradeLtv defines the loan-to-value ratio granted to a grade.
+type GradeLtv struct {
+	Grade string `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	// ltv is the fraction of the collateral value lent, between 0 and 1.
+	Ltv cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ltv,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ltv"`
+}
+
+func (m *GradeLtv) Reset()         { *m = GradeLtv{} }
+func (m *GradeLtv) String() string { return proto.CompactTextString(m) }
+func (*GradeLtv) ProtoMessage()    {}
+func (*GradeLtv) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75e50fd51fde365d, []int{1}
+}
+func (m *GradeLtv) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GradeLtv) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GradeLtv.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GradeLtv) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GradeLtv.Merge(m, src)
+}
+func (m *GradeLtv) XXX_Size() int {
+	return m.Size()
+}
+func (m *GradeLtv) XXX_DiscardUnknown() {
+	xxx_messageInfo_GradeLtv.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GradeLtv proto.InternalMessageInfo
+
+func (m *GradeLtv) GetGrade() string {
+	if m != nil {
+		return m.Grade
+	}
+	return ""
+}
+
+// ScoreModel defines how a score is computed from repayment events: the
+// weighted events, decayed by their age, are added to the base score and the
+// result is bounded by the floor and the ceiling.
+type ScoreModel struct {
+	// base_score is the score of a borrower without events.
+	BaseScore uint64 `protobuf:"varint,1,opt,name=base_score,json=baseScore,proto3" json:"base_score,omitempty"`
+	// floor is the lowest score.
+	Floor uint64 `protobuf:"varint,2,opt,name=floor,proto3" json:"floor,omitempty"`
+	// ceiling is the highest score.
+	Ceiling uint64 `protobuf:"varint,3,opt,name=ceiling,proto3" json:"ceiling,omitempty"`
+	// on_time_weight is the number of points of an on-time repayment.
+	OnTimeWeight int64 `protobuf:"varint,4,opt,name=on_time_weight,json=onTimeWeight,proto3" json:"on_time_weight,omitempty"`
+	// late_weight is the number of points of a late repayment.
+	LateWeight int64 `protobuf:"varint,5,opt,name=late_weight,json=lateWeight,proto3" json:"late_weight,omitempty"`
+	// late_day_weight is the number of points of every day a repayment is late.
+	LateDayWeight int64 `protobuf:"varint,6,opt,name=late_day_weight,json=lateDayWeight,proto3" json:"late_day_weight,omitempty"`
+	// default_weight is the number of points of a default.
+	DefaultWeight int64 `protobuf:"varint,7,opt,name=default_weight,json=defaultWeight,proto3" json:"default_weight,omitempty"`
+	// restructured_weight is the number of points of a restructuring.
+	RestructuredWeight int64 `protobuf:"varint,8,opt,name=restructured_weight,json=restructuredWeight,proto3" json:"restructured_weight,omitempty"`
+	// decay_half_life is the number of seconds after which the impact of an
+	// event is halved. Zero disables the decay.
+	DecayHalfLife uint64 `protobuf:"varint,9,opt,name=decay_half_life,json=decayHalfLife,proto3" json:"decay_half_life,omitempty"`
+}
+
+func (m *ScoreModel) Reset()         { *m = ScoreModel{} }
+func (m *ScoreModel) String() string { return proto.CompactTextString(m) }
+func (*ScoreModel) ProtoMessage()    {}
+func (*ScoreModel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75e50fd51fde365d, []int{2}
+}
+func (m *ScoreModel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScoreModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScoreModel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScoreModel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreModel.Merge(m, src)
+}
+func (m *ScoreModel) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScoreModel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreModel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoreModel proto.InternalMessageInfo
+
+func (m *ScoreModel) GetBaseScore() uint64 {
+	if m != nil {
+		return m.BaseScore
+	}
+	return 0
+}
+
+func (m *ScoreModel) GetFloor() uint64 {
+	if m != nil {
+		return m.Floor
+	}
+	return 0
+}
+
+func (m *ScoreModel) GetCeiling() uint64 {
+	if m != nil {
+		return m.Ceiling
+	}
+	return 0
+}
+
+func (m *ScoreModel) GetOnTimeWeight() int64 {
+	if m != nil {
+		return m.OnTimeWeight
+	}
+	return 0
+}
+
+func (m *ScoreModel) GetLateWeight() int64 {
+	if m != nil {
+		return m.LateWeight
+	}
+	return 0
+}
+
+func (m *ScoreModel) GetLateDayWeight() int64 {
+	if m != nil {
+		return m.LateDayWeight
+	}
+	return 0
+}
+
+func (m *ScoreModel) GetDefaultWeight() int64 {
+	if m != nil {
+		return m.DefaultWeight
+	}
+	return 0
+}
+
+func (m *ScoreModel) GetRestructuredWeight() int64 {
+	if m != nil {
+		return m.RestructuredWeight
+	}
+	return 0
+}
+
+func (m *ScoreModel) GetDecayHalfLife() uint64 {
+	if m != nil {
+		return m.DecayHalfLife
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "realfin.creditscore.v1.Params")
+	proto.RegisterType((*GradeLtv)(nil), "realfin.creditscore.v1.GradeLtv")
+	proto.RegisterType((*ScoreModel)(nil), "realfin.creditscore.v1.ScoreModel")
 }
 
 func init() {
@@ -70,18 +335,55 @@ func init() {
 }
 
 var fileDescriptor_75e50fd51fde365d = []byte{
-	// 163 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x4a, 0x4d, 0xcc,
-	0x49, 0xcb, 0xcc, 0xd3, 0x4f, 0x2e, 0x4a, 0x4d, 0xc9, 0x2c, 0x29, 0x4e, 0xce, 0x2f, 0x4a, 0xd5,
-	0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x83, 0x2a, 0xd2, 0x43, 0x52, 0xa4, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97,
-	0xaf, 0x0f, 0x26, 0x21, 0x4a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b,
-	0x22, 0xaa, 0xa4, 0xcf, 0xc5, 0x16, 0x00, 0x36, 0xd0, 0x4a, 0xf5, 0xc5, 0x02, 0x79, 0xc6, 0xae,
-	0xe7, 0x1b, 0xb4, 0x64, 0x60, 0x16, 0x57, 0xa0, 0x58, 0x0d, 0x51, 0xe6, 0x64, 0x7a, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xd2, 0xd8, 0xf5, 0x95, 0x54, 0x16, 0xa4, 0x16,
-	0x27, 0xb1, 0x81, 0xad, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xf6, 0xc8, 0x0f, 0xd6,
-	0x00, 0x00, 0x00,
+	// 766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0xf1, 0xc6, 0xf1, 0x8e, 0x93, 0x54, 0x0c, 0x29, 0x6c, 0x5b, 0x70, 0x8c, 0x43,
+	0x90, 0x55, 0xa9, 0xbb, 0x24, 0x88, 0x1e, 0x7a, 0x4b, 0x62, 0x41, 0x2b, 0x05, 0x84, 0x36, 0x48,
+	0x15, 0x5c, 0x56, 0xe3, 0xdd, 0xb7, 0xeb, 0x11, 0xbb, 0x33, 0x66, 0x66, 0xec, 0xe0, 0x1b, 0x67,
+	0x4e, 0xfc, 0x09, 0x1c, 0x39, 0xf6, 0xd0, 0x3f, 0xa2, 0xc7, 0xa8, 0x27, 0xc4, 0xa1, 0x42, 0xc9,
+	0xa1, 0xfc, 0x19, 0x68, 0x7e, 0x6c, 0x62, 0xd4, 0xe4, 0x62, 0xcd, 0x7c, 0xdf, 0x67, 0xbe, 0xef,
+	0xed, 0x7b, 0x4f, 0x46, 0xbb, 0x02, 0x48, 0x55, 0x50, 0x16, 0x67, 0x02, 0x72, 0xaa, 0x64, 0xc6,
+	0x05, 0xc4, 0xf3, 0xfd, 0x78, 0x4a, 0x04, 0xa9, 0x65, 0x34, 0x15, 0x5c, 0x71, 0xfc, 0x81, 0x83,
+	0xa2, 0x25, 0x28, 0x9a, 0xef, 0xdf, 0x7f, 0x8f, 0xd4, 0x94, 0xf1, 0xd8, 0xfc, 0x5a, 0xf4, 0x7e,
+	0x2f, 0xe3, 0xb2, 0xe6, 0x32, 0x1e, 0x13, 0xa9, 0x7d, 0xc6, 0xa0, 0xc8, 0x7e, 0x9c, 0x71, 0xca,
+	0x5c, 0xfc, 0x9e, 0x8d, 0xa7, 0xe6, 0x16, 0xdb, 0x8b, 0x0b, 0x6d, 0x97, 0xbc, 0xe4, 0x56, 0xd7,
+	0x27, 0xa7, 0x0e, 0x6e, 0x29, 0x50, 0x66, 0xa4, 0x02, 0xcb, 0x0c, 0x7e, 0x5d, 0x43, 0xed, 0xef,
+	0x4c, 0xc1, 0xf8, 0x5b, 0xd4, 0x35, 0x48, 0x5a, 0xf3, 0x1c, 0xaa, 0xd0, 0xeb, 0x7b, 0xc3, 0xee,
+	0xc1, 0x20, 0xba, 0xf9, 0x03, 0xa2, 0x53, 0x7d, 0xf8, 0x46, 0x93, 0x47, 0xc1, 0xab, 0x37, 0x3b,
+	0x2b, 0x7f, 0xbe, 0x7d, 0xf1, 0xd0, 0x4b, 0x90, 0xbc, 0x92, 0xf1, 0x21, 0x6a, 0x9b, 0x4c, 0x32,
+	0x5c, 0xed, 0xb7, 0x86, 0xdd, 0x83, 0xdd, 0xdb, 0xac, 0x12, 0xa2, 0x28, 0x2b, 0x4f, 0x35, 0x7b,
+	0xe4, 0x6b, 0xaf, 0xc4, 0x3d, 0xc4, 0xbb, 0x68, 0x33, 0xa7, 0x72, 0x3a, 0x53, 0x90, 0xe6, 0xc0,
+	0x78, 0x1d, 0xb6, 0xfa, 0xde, 0x30, 0x48, 0x36, 0x9c, 0x38, 0xd2, 0x1a, 0xfe, 0x01, 0xdd, 0xb9,
+	0x86, 0xa6, 0x5c, 0x52, 0x15, 0xfa, 0x1a, 0x3b, 0xfa, 0x5c, 0x7b, 0xfd, 0xfd, 0x66, 0xe7, 0xae,
+	0xed, 0x95, 0xcc, 0x7f, 0x8a, 0x28, 0x8f, 0x6b, 0xa2, 0x26, 0xd1, 0x33, 0xa6, 0x5e, 0xbf, 0x7c,
+	0x84, 0x5c, 0x13, 0x9f, 0x31, 0x65, 0xcb, 0xdf, 0xba, 0x32, 0x36, 0x3e, 0xf8, 0x31, 0xfa, 0xb0,
+	0xb1, 0x16, 0x20, 0xa7, 0x9c, 0x49, 0x48, 0xcf, 0x28, 0xcb, 0xf9, 0x59, 0xb8, 0xd6, 0xf7, 0x86,
+	0x7e, 0x72, 0xd7, 0x85, 0x13, 0x17, 0x7d, 0x6e, 0x82, 0xf8, 0x00, 0xad, 0x13, 0x31, 0xa6, 0x0a,
+	0x44, 0xd8, 0x36, 0xa5, 0x84, 0xaf, 0x5f, 0x3e, 0xda, 0x76, 0xd9, 0x0e, 0xf3, 0x5c, 0x80, 0x94,
+	0xa7, 0x4a, 0x50, 0x56, 0x26, 0x0d, 0x88, 0xf7, 0xd0, 0xd6, 0xcf, 0x33, 0x10, 0x8b, 0x34, 0x9b,
+	0x10, 0xc6, 0xa0, 0x92, 0xe1, 0x7a, 0xbf, 0x35, 0x0c, 0x92, 0x4d, 0xa3, 0x1e, 0x3b, 0x11, 0x1f,
+	0xa2, 0xc0, 0x62, 0x05, 0x40, 0xd8, 0x31, 0x33, 0xba, 0x17, 0x39, 0x67, 0xbd, 0x39, 0x91, 0xdb,
+	0x9c, 0xe8, 0x98, 0x53, 0xb6, 0x3c, 0x9a, 0x8e, 0x79, 0xf6, 0x15, 0x00, 0xfe, 0x04, 0x6d, 0xd8,
+	0x09, 0xb8, 0xa6, 0x06, 0xa6, 0xa9, 0x5d, 0xab, 0xd9, 0x9e, 0x1e, 0xa3, 0xa0, 0x52, 0xf3, 0x34,
+	0x9b, 0x89, 0x39, 0x84, 0xc8, 0x8c, 0xaf, 0x7f, 0xdb, 0xf8, 0xbe, 0x16, 0x24, 0x87, 0x13, 0x35,
+	0x77, 0xb3, 0xeb, 0x54, 0x6a, 0x7e, 0xac, 0xdf, 0x3d, 0xd9, 0xfb, 0xf7, 0x8f, 0x1d, 0xef, 0xb7,
+	0xb7, 0x2f, 0x1e, 0x7e, 0xd4, 0x2c, 0xe2, 0x2f, 0xff, 0x5b, 0x45, 0xbb, 0x77, 0x83, 0x29, 0xea,
+	0x34, 0x16, 0x78, 0x1b, 0xad, 0x95, 0xfa, 0x6c, 0xb6, 0x2f, 0x48, 0xec, 0x05, 0x3f, 0x45, 0xad,
+	0x4a, 0xcd, 0xc3, 0x55, 0xd3, 0xca, 0xc7, 0x6e, 0xaa, 0x0f, 0xde, 0x9d, 0xea, 0x09, 0x94, 0x24,
+	0x5b, 0x8c, 0x20, 0x5b, 0x9a, 0xed, 0x08, 0x32, 0xfb, 0xfd, 0xda, 0xe2, 0x89, 0xaf, 0x4b, 0x1a,
+	0x9c, 0xaf, 0x22, 0x74, 0xbd, 0xbf, 0xf8, 0x63, 0x84, 0x74, 0xe7, 0x52, 0x53, 0x95, 0xc9, 0xec,
+	0x27, 0x81, 0x56, 0x0c, 0xa3, 0x6b, 0x2a, 0x2a, 0xce, 0x85, 0xc9, 0xef, 0x27, 0xf6, 0x82, 0x43,
+	0xb4, 0x9e, 0x01, 0xad, 0x28, 0x2b, 0xcd, 0x52, 0xfa, 0x49, 0x73, 0xc5, 0x9f, 0xa2, 0x2d, 0xce,
+	0x52, 0x45, 0x6b, 0x48, 0xcf, 0x80, 0x96, 0x13, 0xbb, 0x8e, 0xad, 0x64, 0x83, 0xb3, 0xef, 0x69,
+	0x0d, 0xcf, 0x8d, 0x86, 0x77, 0x50, 0xb7, 0x22, 0xea, 0x0a, 0x59, 0x33, 0x08, 0xd2, 0x92, 0x03,
+	0x3e, 0x43, 0x77, 0x0c, 0x90, 0x93, 0x45, 0x03, 0xb5, 0x0d, 0xb4, 0xa9, 0xe5, 0x11, 0x59, 0x38,
+	0x6e, 0x0f, 0x6d, 0xe5, 0x50, 0x90, 0x59, 0xa5, 0x1a, 0x6c, 0xdd, 0x62, 0x4e, 0x75, 0x58, 0x8c,
+	0xde, 0x17, 0x20, 0x95, 0x98, 0x65, 0x6a, 0x26, 0x20, 0x6f, 0xd8, 0x8e, 0x61, 0xf1, 0x72, 0xe8,
+	0x3a, 0x7f, 0x0e, 0x19, 0x59, 0xa4, 0x13, 0x52, 0x15, 0x69, 0x45, 0x0b, 0x30, 0x8b, 0xe2, 0x6b,
+	0xe3, 0x8c, 0x2c, 0x9e, 0x92, 0xaa, 0x38, 0xa1, 0x05, 0xd8, 0x96, 0x1e, 0x7d, 0xf9, 0xea, 0xa2,
+	0xe7, 0x9d, 0x5f, 0xf4, 0xbc, 0x7f, 0x2e, 0x7a, 0xde, 0xef, 0x97, 0xbd, 0x95, 0xf3, 0xcb, 0xde,
+	0xca, 0x5f, 0x97, 0xbd, 0x95, 0x1f, 0x1f, 0xdc, 0x3c, 0x7c, 0xb5, 0x98, 0x82, 0x1c, 0xb7, 0xcd,
+	0xbf, 0xd0, 0x17, 0xff, 0x0d, 0x00, 0xd7, 0xe6, 0x53, 0x81, 0x4c, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,6 +405,126 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.ScoreModel.Equal(&that1.ScoreModel) {
+		return false
+	}
+	if len(this.Scales) != len(that1.Scales) {
+		return false
+	}
+	for i := range this.Scales {
+		if !this.Scales[i].Equal(&that1.Scales[i]) {
+			return false
+		}
+	}
+	if this.DisputeDenom != that1.DisputeDenom {
+		return false
+	}
+	if !this.DisputeDeposit.Equal(that1.DisputeDeposit) {
+		return false
+	}
+	if this.DisputeResponseWindow != that1.DisputeResponseWindow {
+		return false
+	}
+	if this.Arbiter != that1.Arbiter {
+		return false
+	}
+	if len(this.QueryChannels) != len(that1.QueryChannels) {
+		return false
+	}
+	for i := range this.QueryChannels {
+		if this.QueryChannels[i] != that1.QueryChannels[i] {
+			return false
+		}
+	}
+	if !this.QueryFee.Equal(&that1.QueryFee) {
+		return false
+	}
+	if this.CreditDenom != that1.CreditDenom {
+		return false
+	}
+	if len(this.LtvCurve) != len(that1.LtvCurve) {
+		return false
+	}
+	for i := range this.LtvCurve {
+		if !this.LtvCurve[i].Equal(&that1.LtvCurve[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GradeLtv) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GradeLtv)
+	if !ok {
+		that2, ok := that.(GradeLtv)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Grade != that1.Grade {
+		return false
+	}
+	if !this.Ltv.Equal(that1.Ltv) {
+		return false
+	}
+	return true
+}
+func (this *ScoreModel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScoreModel)
+	if !ok {
+		that2, ok := that.(ScoreModel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BaseScore != that1.BaseScore {
+		return false
+	}
+	if this.Floor != that1.Floor {
+		return false
+	}
+	if this.Ceiling != that1.Ceiling {
+		return false
+	}
+	if this.OnTimeWeight != that1.OnTimeWeight {
+		return false
+	}
+	if this.LateWeight != that1.LateWeight {
+		return false
+	}
+	if this.LateDayWeight != that1.LateDayWeight {
+		return false
+	}
+	if this.DefaultWeight != that1.DefaultWeight {
+		return false
+	}
+	if this.RestructuredWeight != that1.RestructuredWeight {
+		return false
+	}
+	if this.DecayHalfLife != that1.DecayHalfLife {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,34 +547,325 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LtvCurve) > 0 {
+		for iNdEx := len(m.LtvCurve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LtvCurve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.CreditDenom) > 0 {
+		i -= len(m.CreditDenom)
+		copy(dAtA[i:], m.CreditDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.CreditDenom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.QueryFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.QueryChannels) > 0 {
+		for iNdEx := len(m.QueryChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueryChannels[iNdEx])
+			copy(dAtA[i:], m.QueryChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.QueryChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DisputeResponseWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeResponseWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.DisputeDeposit.Size()
+		i -= size
+		if _, err := m.DisputeDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DisputeDenom) > 0 {
+		i -= len(m.DisputeDenom)
+		copy(dAtA[i:], m.DisputeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DisputeDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Scales) > 0 {
+		for iNdEx := len(m.Scales) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scales[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ScoreModel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GradeLtv) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GradeLtv) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GradeLtv) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size := m.Ltv.Size()
+		i -= size
+		if _, err := m.Ltv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Grade) > 0 {
+		i -= len(m.Grade)
+		copy(dAtA[i:], m.Grade)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Grade)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ScoreModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ScoreModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoreModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecayHalfLife != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DecayHalfLife))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RestructuredWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RestructuredWeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DefaultWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultWeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LateDayWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LateDayWeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LateWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LateWeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OnTimeWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OnTimeWeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Ceiling != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Ceiling))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Floor != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Floor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BaseScore != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseScore))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScoreModel.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Scales) > 0 {
+		for _, e := range m.Scales {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.DisputeDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.DisputeDeposit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DisputeResponseWindow != 0 {
+		n += 1 + sovParams(uint64(m.DisputeResponseWindow))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.QueryChannels) > 0 {
+		for _, s := range m.QueryChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.QueryFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.CreditDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.LtvCurve) > 0 {
+		for _, e := range m.LtvCurve {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GradeLtv) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grade)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Ltv.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ScoreModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseScore != 0 {
+		n += 1 + sovParams(uint64(m.BaseScore))
+	}
+	if m.Floor != 0 {
+		n += 1 + sovParams(uint64(m.Floor))
+	}
+	if m.Ceiling != 0 {
+		n += 1 + sovParams(uint64(m.Ceiling))
+	}
+	if m.OnTimeWeight != 0 {
+		n += 1 + sovParams(uint64(m.OnTimeWeight))
+	}
+	if m.LateWeight != 0 {
+		n += 1 + sovParams(uint64(m.LateWeight))
+	}
+	if m.LateDayWeight != 0 {
+		n += 1 + sovParams(uint64(m.LateDayWeight))
+	}
+	if m.DefaultWeight != 0 {
+		n += 1 + sovParams(uint64(m.DefaultWeight))
+	}
+	if m.RestructuredWeight != 0 {
+		n += 1 + sovParams(uint64(m.RestructuredWeight))
+	}
+	if m.DecayHalfLife != 0 {
+		n += 1 + sovParams(uint64(m.DecayHalfLife))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -183,6 +896,658 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreModel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScoreModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scales", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scales = append(m.Scales, RatingScale{})
+			if err := m.Scales[len(m.Scales)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisputeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisputeDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeResponseWindow", wireType)
+			}
+			m.DisputeResponseWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeResponseWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryChannels = append(m.QueryChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueryFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LtvCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LtvCurve = append(m.LtvCurve, GradeLtv{})
+			if err := m.LtvCurve[len(m.LtvCurve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GradeLtv) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GradeLtv: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GradeLtv: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ltv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScoreModel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScoreModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScoreModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseScore", wireType)
+			}
+			m.BaseScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseScore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			m.Floor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Floor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ceiling", wireType)
+			}
+			m.Ceiling = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ceiling |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnTimeWeight", wireType)
+			}
+			m.OnTimeWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnTimeWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateWeight", wireType)
+			}
+			m.LateWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LateWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateDayWeight", wireType)
+			}
+			m.LateDayWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LateDayWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultWeight", wireType)
+			}
+			m.DefaultWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestructuredWeight", wireType)
+			}
+			m.RestructuredWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestructuredWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayHalfLife", wireType)
+			}
+			m.DecayHalfLife = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayHalfLife |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -161,7 +161,13 @@ func (m *QueryGetRateRequest) GetSymbol() string {
 
 // QueryGetRateResponse defines the QueryGetRateResponse message.
 type QueryGetRateResponse struct {
+	// rate is the consolidated rate of the symbol: the median of the rates not
+	// withdrawn. It is withdrawn when all the rates of the symbol are.
 	Rate Rate `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate"`
+	// breakdown holds the factors of a score computed from repayment events.
+	Breakdown *ScoreBreakdown `protobuf:"bytes,2,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	// ratings are the rates of the symbol by agency, withdrawn rates included.
+	Ratings []Rate `protobuf:"bytes,3,rep,name=ratings,proto3" json:"ratings"`
 }
 
 func (m *QueryGetRateResponse) Reset()         { *m = QueryGetRateResponse{} }
@@ -204,9 +210,27 @@ func (m *QueryGetRateResponse) GetRate() Rate {
 	return Rate{}
 }
 
+func (m *QueryGetRateResponse) GetBreakdown() *ScoreBreakdown {
+	if m != nil {
+		return m.Breakdown
+	}
+	return nil
+}
+
+func (m *QueryGetRateResponse) GetRatings() []Rate {
+	if m != nil {
+		return m.Ratings
+	}
+	return nil
+}
+
 // QueryAllRateRequest defines the QueryAllRateRequest message.
 type QueryAllRateRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// grade filters the rates by grade when set.
+	Grade string `protobuf:"bytes,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	// outlook filters the rates by outlook when set.
+	Outlook RatingOutlook `protobuf:"varint,3,opt,name=outlook,proto3,enum=realfin.creditscore.v1.RatingOutlook" json:"outlook,omitempty"`
 }
 
 func (m *QueryAllRateRequest) Reset()         { *m = QueryAllRateRequest{} }
@@ -249,6 +273,20 @@ func (m *QueryAllRateRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllRateRequest) GetGrade() string {
+	if m != nil {
+		return m.Grade
+	}
+	return ""
+}
+
+func (m *QueryAllRateRequest) GetOutlook() RatingOutlook {
+	if m != nil {
+		return m.Outlook
+	}
+	return RatingOutlook_RATING_OUTLOOK_UNSPECIFIED
+}
+
 // QueryAllRateResponse defines the QueryAllRateResponse message.
 type QueryAllRateResponse struct {
 	Rate       []Rate              `protobuf:"bytes,1,rep,name=rate,proto3" json:"rate"`
@@ -302,511 +340,5699 @@ func (m *QueryAllRateResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "realfin.creditscore.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "realfin.creditscore.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetRateRequest)(nil), "realfin.creditscore.v1.QueryGetRateRequest")
-	proto.RegisterType((*QueryGetRateResponse)(nil), "realfin.creditscore.v1.QueryGetRateResponse")
-	proto.RegisterType((*QueryAllRateRequest)(nil), "realfin.creditscore.v1.QueryAllRateRequest")
-	proto.RegisterType((*QueryAllRateResponse)(nil), "realfin.creditscore.v1.QueryAllRateResponse")
+// QueryAllRepaymentRequest defines the QueryAllRepaymentRequest message.
+type QueryAllRepaymentRequest struct {
+	Borrower   string             `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("realfin/creditscore/v1/query.proto", fileDescriptor_e5a4db7d8a6f1b81)
+func (m *QueryAllRepaymentRequest) Reset()         { *m = QueryAllRepaymentRequest{} }
+func (m *QueryAllRepaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRepaymentRequest) ProtoMessage()    {}
+func (*QueryAllRepaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{6}
 }
-
-var fileDescriptor_e5a4db7d8a6f1b81 = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0xe3, 0xb6, 0x04, 0x6a, 0x26, 0x4c, 0x54, 0xa1, 0x10, 0x99, 0x62, 0xa0, 0x45, 0x81,
-	0xda, 0x4a, 0x11, 0xec, 0xcd, 0x40, 0x17, 0x84, 0xca, 0x4d, 0x08, 0x89, 0xc1, 0x09, 0xe6, 0x74,
-	0xd2, 0xe5, 0x7c, 0x3d, 0xbb, 0x11, 0x11, 0x62, 0x61, 0x43, 0x2c, 0x48, 0x1d, 0xf8, 0x0a, 0x8c,
-	0x6c, 0x7c, 0x85, 0x8e, 0x95, 0x58, 0x98, 0x10, 0x4a, 0x90, 0xf8, 0x1a, 0xe8, 0xec, 0x17, 0xb8,
-	0xa3, 0xdc, 0x35, 0x5d, 0x4e, 0x3e, 0xdf, 0xff, 0xff, 0xde, 0xef, 0xfc, 0xfe, 0xc6, 0x2c, 0x53,
-	0x32, 0x7e, 0x19, 0x25, 0x62, 0x98, 0xa9, 0x17, 0x91, 0x35, 0x43, 0x9d, 0x29, 0x31, 0xee, 0x89,
-	0xfd, 0x03, 0x95, 0x4d, 0x78, 0x9a, 0x69, 0xab, 0xc9, 0x1a, 0x68, 0x78, 0x41, 0xc3, 0xc7, 0xbd,
-	0xf6, 0x25, 0x39, 0x8a, 0x12, 0x2d, 0xdc, 0xd3, 0x4b, 0xdb, 0xdd, 0xa1, 0x36, 0x23, 0x6d, 0xc4,
-	0x40, 0x1a, 0xe5, 0x6b, 0x88, 0x71, 0x6f, 0xa0, 0xac, 0xec, 0x89, 0x54, 0x86, 0x51, 0x22, 0x6d,
-	0xa4, 0x13, 0xd0, 0xb6, 0x42, 0x1d, 0x6a, 0xb7, 0x14, 0xf9, 0x0a, 0x76, 0x3b, 0xa1, 0xd6, 0x61,
-	0xac, 0x84, 0x4c, 0x23, 0x21, 0x93, 0x44, 0x5b, 0x67, 0x31, 0xf0, 0xf5, 0x46, 0x05, 0x6e, 0x2a,
-	0x33, 0x39, 0x9a, 0x8b, 0xae, 0x57, 0x88, 0x32, 0x69, 0x95, 0x97, 0xb0, 0x16, 0x26, 0x4f, 0x72,
-	0xba, 0x3d, 0xe7, 0x0b, 0xd4, 0xfe, 0x81, 0x32, 0x96, 0x3d, 0xc5, 0x97, 0x4b, 0xbb, 0x26, 0xd5,
-	0x89, 0x51, 0x64, 0x07, 0x37, 0x7d, 0xfd, 0x2b, 0x68, 0x1d, 0xdd, 0xbe, 0xb8, 0x4d, 0xf9, 0xff,
-	0x0f, 0x84, 0x7b, 0x5f, 0x7f, 0xf5, 0xe8, 0xfb, 0xb5, 0xc6, 0xa7, 0x5f, 0x9f, 0xbb, 0x28, 0x00,
-	0x23, 0xdb, 0x82, 0xca, 0xbb, 0xca, 0x06, 0xd2, 0x2a, 0x68, 0x48, 0xd6, 0x70, 0xd3, 0x4c, 0x46,
-	0x03, 0x1d, 0xbb, 0xca, 0xab, 0x01, 0xbc, 0xb1, 0xc7, 0xb8, 0x55, 0x96, 0x03, 0xc9, 0x03, 0xbc,
-	0x92, 0xff, 0x04, 0x70, 0x74, 0xaa, 0x38, 0x72, 0x4f, 0x7f, 0x25, 0xa7, 0x08, 0x9c, 0x9e, 0x3d,
-	0x87, 0xf6, 0x3b, 0x71, 0x5c, 0x6c, 0xff, 0x10, 0xe3, 0xbf, 0x53, 0x81, 0xa2, 0x1b, 0xdc, 0x8f,
-	0x90, 0xe7, 0x23, 0xe4, 0x3e, 0x06, 0x30, 0x42, 0xbe, 0x27, 0xc3, 0xb9, 0x37, 0x28, 0x38, 0xd9,
-	0x47, 0x04, 0xbc, 0x7f, 0xea, 0x9f, 0xe0, 0x5d, 0x3e, 0x0b, 0x2f, 0xd9, 0x2d, 0x81, 0x2d, 0x39,
-	0xb0, 0xcd, 0x53, 0xc1, 0x7c, 0xd3, 0x22, 0xd9, 0xf6, 0x97, 0x65, 0x7c, 0xce, 0x91, 0x91, 0x77,
-	0x08, 0x37, 0xfd, 0x7c, 0x48, 0xb7, 0x8a, 0xe3, 0x64, 0x24, 0xda, 0x77, 0x16, 0xd2, 0xfa, 0xce,
-	0x6c, 0xe3, 0xed, 0xd7, 0x9f, 0x87, 0x4b, 0xeb, 0x84, 0x8a, 0xda, 0x98, 0x92, 0x43, 0x84, 0xcf,
-	0xc3, 0x68, 0x49, 0x7d, 0x83, 0x72, 0x5e, 0xda, 0x77, 0x17, 0x13, 0x03, 0xce, 0x96, 0xc3, 0xd9,
-	0x24, 0xb7, 0x44, 0xcd, 0x85, 0x10, 0xaf, 0x7d, 0xe6, 0xde, 0x90, 0xf7, 0x08, 0x5f, 0x78, 0x14,
-	0x99, 0x45, 0xb0, 0xca, 0x39, 0x3a, 0x05, 0xeb, 0x9f, 0x50, 0xb0, 0x9b, 0x0e, 0x8b, 0x92, 0x4e,
-	0x1d, 0x56, 0xff, 0xfe, 0xd1, 0x94, 0xa2, 0xe3, 0x29, 0x45, 0x3f, 0xa6, 0x14, 0x7d, 0x98, 0xd1,
-	0xc6, 0xf1, 0x8c, 0x36, 0xbe, 0xcd, 0x68, 0xe3, 0xd9, 0xd5, 0xb9, 0xed, 0x55, 0xc9, 0x68, 0x27,
-	0xa9, 0x32, 0x83, 0xa6, 0xbb, 0xdf, 0xf7, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x45, 0x47, 0x86,
-	0x1c, 0xd8, 0x04, 0x00, 0x00,
+func (m *QueryAllRepaymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRepaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRepaymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRepaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRepaymentRequest.Merge(m, src)
+}
+func (m *QueryAllRepaymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRepaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRepaymentRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryAllRepaymentRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListRate Queries a list of Rate items.
-	GetRate(ctx context.Context, in *QueryGetRateRequest, opts ...grpc.CallOption) (*QueryGetRateResponse, error)
-	// ListRate defines the ListRate RPC.
-	ListRate(ctx context.Context, in *QueryAllRateRequest, opts ...grpc.CallOption) (*QueryAllRateResponse, error)
+func (m *QueryAllRepaymentRequest) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAllRepaymentRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryAllRepaymentResponse defines the QueryAllRepaymentResponse message.
+type QueryAllRepaymentResponse struct {
+	Repayments []RepaymentEvent    `protobuf:"bytes,1,rep,name=repayments,proto3" json:"repayments"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllRepaymentResponse) Reset()         { *m = QueryAllRepaymentResponse{} }
+func (m *QueryAllRepaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRepaymentResponse) ProtoMessage()    {}
+func (*QueryAllRepaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{7}
+}
+func (m *QueryAllRepaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRepaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRepaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllRepaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRepaymentResponse.Merge(m, src)
+}
+func (m *QueryAllRepaymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRepaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRepaymentResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetRate(ctx context.Context, in *QueryGetRateRequest, opts ...grpc.CallOption) (*QueryGetRateResponse, error) {
-	out := new(QueryGetRateResponse)
-	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/GetRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllRepaymentResponse proto.InternalMessageInfo
+
+func (m *QueryAllRepaymentResponse) GetRepayments() []RepaymentEvent {
+	if m != nil {
+		return m.Repayments
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListRate(ctx context.Context, in *QueryAllRateRequest, opts ...grpc.CallOption) (*QueryAllRateResponse, error) {
-	out := new(QueryAllRateResponse)
-	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/ListRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllRepaymentResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListRate Queries a list of Rate items.
-	GetRate(context.Context, *QueryGetRateRequest) (*QueryGetRateResponse, error)
-	// ListRate defines the ListRate RPC.
-	ListRate(context.Context, *QueryAllRateRequest) (*QueryAllRateResponse, error)
+// QueryGetAgencyRequest defines the QueryGetAgencyRequest message.
+type QueryGetAgencyRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryGetAgencyRequest) Reset()         { *m = QueryGetAgencyRequest{} }
+func (m *QueryGetAgencyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAgencyRequest) ProtoMessage()    {}
+func (*QueryGetAgencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetAgencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetRate(ctx context.Context, req *QueryGetRateRequest) (*QueryGetRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRate not implemented")
+func (m *QueryGetAgencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAgencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListRate(ctx context.Context, req *QueryAllRateRequest) (*QueryAllRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRate not implemented")
+func (m *QueryGetAgencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAgencyRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGetAgencyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAgencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAgencyRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/realfin.creditscore.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryGetAgencyRequest proto.InternalMessageInfo
+
+func (m *QueryGetAgencyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/realfin.creditscore.v1.Query/GetRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRate(ctx, req.(*QueryGetRateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetAgencyResponse defines the QueryGetAgencyResponse message.
+type QueryGetAgencyResponse struct {
+	Agency Agency `protobuf:"bytes,1,opt,name=agency,proto3" json:"agency"`
 }
 
-func _Query_ListRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/realfin.creditscore.v1.Query/ListRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListRate(ctx, req.(*QueryAllRateRequest))
+func (m *QueryGetAgencyResponse) Reset()         { *m = QueryGetAgencyResponse{} }
+func (m *QueryGetAgencyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAgencyResponse) ProtoMessage()    {}
+func (*QueryGetAgencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{9}
+}
+func (m *QueryGetAgencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAgencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAgencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "realfin.creditscore.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetRate",
-			Handler:    _Query_GetRate_Handler,
-		},
-		{
-			MethodName: "ListRate",
-			Handler:    _Query_ListRate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "realfin/creditscore/v1/query.proto",
+func (m *QueryGetAgencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAgencyResponse.Merge(m, src)
+}
+func (m *QueryGetAgencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAgencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAgencyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAgencyResponse proto.InternalMessageInfo
+
+func (m *QueryGetAgencyResponse) GetAgency() Agency {
+	if m != nil {
+		return m.Agency
 	}
-	return dAtA[:n], nil
+	return Agency{}
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllAgencyRequest defines the QueryAllAgencyRequest message.
+type QueryAllAgencyRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllAgencyRequest) Reset()         { *m = QueryAllAgencyRequest{} }
+func (m *QueryAllAgencyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAgencyRequest) ProtoMessage()    {}
+func (*QueryAllAgencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{10}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllAgencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllAgencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAgencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllAgencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAgencyRequest.Merge(m, src)
 }
-
-func (m *QueryGetRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllAgencyRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAllAgencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAgencyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllAgencyRequest proto.InternalMessageInfo
+
+func (m *QueryAllAgencyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllAgencyResponse defines the QueryAllAgencyResponse message.
+type QueryAllAgencyResponse struct {
+	Agencies   []Agency            `protobuf:"bytes,1,rep,name=agencies,proto3" json:"agencies"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllAgencyResponse) Reset()         { *m = QueryAllAgencyResponse{} }
+func (m *QueryAllAgencyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAgencyResponse) ProtoMessage()    {}
+func (*QueryAllAgencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{11}
+}
+func (m *QueryAllAgencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAgencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAgencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllAgencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAgencyResponse.Merge(m, src)
 }
-
-func (m *QueryAllRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllAgencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAgencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAgencyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllAgencyResponse proto.InternalMessageInfo
+
+func (m *QueryAllAgencyResponse) GetAgencies() []Agency {
+	if m != nil {
+		return m.Agencies
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllAgencyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRateHistoryRequest defines the QueryRateHistoryRequest message.
+type QueryRateHistoryRequest struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// creator filters the changes by agency when set.
+	Creator    string             `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Rate) > 0 {
-		for iNdEx := len(m.Rate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRateHistoryRequest) Reset()         { *m = QueryRateHistoryRequest{} }
+func (m *QueryRateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateHistoryRequest) ProtoMessage()    {}
+func (*QueryRateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{12}
+}
+func (m *QueryRateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateHistoryRequest.Merge(m, src)
+}
+func (m *QueryRateHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateHistoryRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryRateHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRateHistoryRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryRateHistoryRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRateHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryGetRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryRateHistoryResponse defines the QueryRateHistoryResponse message.
+type QueryRateHistoryResponse struct {
+	Changes    []RateChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRateHistoryResponse) Reset()         { *m = QueryRateHistoryResponse{} }
+func (m *QueryRateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateHistoryResponse) ProtoMessage()    {}
+func (*QueryRateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{13}
+}
+func (m *QueryRateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryRateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateHistoryResponse.Merge(m, src)
+}
+func (m *QueryRateHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRateHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRateHistoryResponse) GetChanges() []RateChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *QueryRateHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetAttestationRequest defines the QueryGetAttestationRequest message.
+type QueryGetAttestationRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetAttestationRequest) Reset()         { *m = QueryGetAttestationRequest{} }
+func (m *QueryGetAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAttestationRequest) ProtoMessage()    {}
+func (*QueryGetAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{14}
+}
+func (m *QueryGetAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAttestationRequest.Merge(m, src)
+}
+func (m *QueryGetAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAttestationRequest proto.InternalMessageInfo
+
+func (m *QueryGetAttestationRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetAttestationResponse defines the QueryGetAttestationResponse
+// message.
+type QueryGetAttestationResponse struct {
+	Attestation Attestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation"`
+}
+
+func (m *QueryGetAttestationResponse) Reset()         { *m = QueryGetAttestationResponse{} }
+func (m *QueryGetAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAttestationResponse) ProtoMessage()    {}
+func (*QueryGetAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{15}
+}
+func (m *QueryGetAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAttestationResponse.Merge(m, src)
+}
+func (m *QueryGetAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryGetAttestationResponse) GetAttestation() Attestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return Attestation{}
+}
+
+// QueryAllAttestationRequest defines the QueryAllAttestationRequest message.
+type QueryAllAttestationRequest struct {
+	// agency filters the attestations by agency when set.
+	Agency     string             `protobuf:"bytes,1,opt,name=agency,proto3" json:"agency,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAttestationRequest) Reset()         { *m = QueryAllAttestationRequest{} }
+func (m *QueryAllAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAttestationRequest) ProtoMessage()    {}
+func (*QueryAllAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{16}
+}
+func (m *QueryAllAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAttestationRequest.Merge(m, src)
+}
+func (m *QueryAllAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAttestationRequest proto.InternalMessageInfo
+
+func (m *QueryAllAttestationRequest) GetAgency() string {
+	if m != nil {
+		return m.Agency
+	}
+	return ""
+}
+
+func (m *QueryAllAttestationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllAttestationResponse defines the QueryAllAttestationResponse
+// message.
+type QueryAllAttestationResponse struct {
+	Attestations []Attestation       `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAttestationResponse) Reset()         { *m = QueryAllAttestationResponse{} }
+func (m *QueryAllAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAttestationResponse) ProtoMessage()    {}
+func (*QueryAllAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{17}
+}
+func (m *QueryAllAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAttestationResponse.Merge(m, src)
+}
+func (m *QueryAllAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryAllAttestationResponse) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *QueryAllAttestationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVerifyAttestationRequest holds the rating revealed by the subject of
+// an attestation.
+type QueryVerifyAttestationRequest struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Rate    uint64 `protobuf:"varint,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Grade   string `protobuf:"bytes,4,opt,name=grade,proto3" json:"grade,omitempty"`
+	// salt is the hex-encoded salt of the commitment.
+	Salt string `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (m *QueryVerifyAttestationRequest) Reset()         { *m = QueryVerifyAttestationRequest{} }
+func (m *QueryVerifyAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyAttestationRequest) ProtoMessage()    {}
+func (*QueryVerifyAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{18}
+}
+func (m *QueryVerifyAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyAttestationRequest.Merge(m, src)
+}
+func (m *QueryVerifyAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyAttestationRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyAttestationRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryVerifyAttestationRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *QueryVerifyAttestationRequest) GetRate() uint64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *QueryVerifyAttestationRequest) GetGrade() string {
+	if m != nil {
+		return m.Grade
+	}
+	return ""
+}
+
+func (m *QueryVerifyAttestationRequest) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+// QueryVerifyAttestationResponse defines the QueryVerifyAttestationResponse
+// message.
+type QueryVerifyAttestationResponse struct {
+	// valid is set when the revealed rating matches a live commitment.
+	Valid       bool              `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Status      AttestationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=realfin.creditscore.v1.AttestationStatus" json:"status,omitempty"`
+	Attestation Attestation       `protobuf:"bytes,3,opt,name=attestation,proto3" json:"attestation"`
+}
+
+func (m *QueryVerifyAttestationResponse) Reset()         { *m = QueryVerifyAttestationResponse{} }
+func (m *QueryVerifyAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyAttestationResponse) ProtoMessage()    {}
+func (*QueryVerifyAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{19}
+}
+func (m *QueryVerifyAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyAttestationResponse.Merge(m, src)
+}
+func (m *QueryVerifyAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyAttestationResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *QueryVerifyAttestationResponse) GetStatus() AttestationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AttestationStatus_ATTESTATION_STATUS_UNSPECIFIED
+}
+
+func (m *QueryVerifyAttestationResponse) GetAttestation() Attestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return Attestation{}
+}
+
+// QueryGetDisputeRequest defines the QueryGetDisputeRequest message.
+type QueryGetDisputeRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetDisputeRequest) Reset()         { *m = QueryGetDisputeRequest{} }
+func (m *QueryGetDisputeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDisputeRequest) ProtoMessage()    {}
+func (*QueryGetDisputeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{20}
+}
+func (m *QueryGetDisputeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDisputeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDisputeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDisputeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDisputeRequest.Merge(m, src)
+}
+func (m *QueryGetDisputeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDisputeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDisputeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDisputeRequest proto.InternalMessageInfo
+
+func (m *QueryGetDisputeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetDisputeResponse defines the QueryGetDisputeResponse message.
+type QueryGetDisputeResponse struct {
+	Dispute Dispute `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute"`
+}
+
+func (m *QueryGetDisputeResponse) Reset()         { *m = QueryGetDisputeResponse{} }
+func (m *QueryGetDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDisputeResponse) ProtoMessage()    {}
+func (*QueryGetDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{21}
+}
+func (m *QueryGetDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDisputeResponse.Merge(m, src)
+}
+func (m *QueryGetDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDisputeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDisputeResponse proto.InternalMessageInfo
+
+func (m *QueryGetDisputeResponse) GetDispute() Dispute {
+	if m != nil {
+		return m.Dispute
+	}
+	return Dispute{}
+}
+
+// QueryAllDisputeRequest defines the QueryAllDisputeRequest message.
+type QueryAllDisputeRequest struct {
+	// symbol filters the disputes by symbol when set.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// status filters the disputes by status when set.
+	Status     DisputeStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=realfin.creditscore.v1.DisputeStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDisputeRequest) Reset()         { *m = QueryAllDisputeRequest{} }
+func (m *QueryAllDisputeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDisputeRequest) ProtoMessage()    {}
+func (*QueryAllDisputeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{22}
+}
+func (m *QueryAllDisputeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDisputeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDisputeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDisputeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDisputeRequest.Merge(m, src)
+}
+func (m *QueryAllDisputeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDisputeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDisputeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDisputeRequest proto.InternalMessageInfo
+
+func (m *QueryAllDisputeRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *QueryAllDisputeRequest) GetStatus() DisputeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DisputeStatus_DISPUTE_STATUS_UNSPECIFIED
+}
+
+func (m *QueryAllDisputeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllDisputeResponse defines the QueryAllDisputeResponse message.
+type QueryAllDisputeResponse struct {
+	Disputes   []Dispute           `protobuf:"bytes,1,rep,name=disputes,proto3" json:"disputes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDisputeResponse) Reset()         { *m = QueryAllDisputeResponse{} }
+func (m *QueryAllDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDisputeResponse) ProtoMessage()    {}
+func (*QueryAllDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{23}
+}
+func (m *QueryAllDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDisputeResponse.Merge(m, src)
+}
+func (m *QueryAllDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDisputeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDisputeResponse proto.InternalMessageInfo
+
+func (m *QueryAllDisputeResponse) GetDisputes() []Dispute {
+	if m != nil {
+		return m.Disputes
+	}
+	return nil
+}
+
+func (m *QueryAllDisputeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetChannelCreditRequest defines the QueryGetChannelCreditRequest
+// message.
+type QueryGetChannelCreditRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryGetChannelCreditRequest) Reset()         { *m = QueryGetChannelCreditRequest{} }
+func (m *QueryGetChannelCreditRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChannelCreditRequest) ProtoMessage()    {}
+func (*QueryGetChannelCreditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{24}
+}
+func (m *QueryGetChannelCreditRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChannelCreditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChannelCreditRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetChannelCreditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChannelCreditRequest.Merge(m, src)
+}
+func (m *QueryGetChannelCreditRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChannelCreditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChannelCreditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetChannelCreditRequest proto.InternalMessageInfo
+
+func (m *QueryGetChannelCreditRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryGetChannelCreditResponse defines the QueryGetChannelCreditResponse
+// message.
+type QueryGetChannelCreditResponse struct {
+	ChannelCredit ChannelCredit `protobuf:"bytes,1,opt,name=channel_credit,json=channelCredit,proto3" json:"channel_credit"`
+}
+
+func (m *QueryGetChannelCreditResponse) Reset()         { *m = QueryGetChannelCreditResponse{} }
+func (m *QueryGetChannelCreditResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChannelCreditResponse) ProtoMessage()    {}
+func (*QueryGetChannelCreditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{25}
+}
+func (m *QueryGetChannelCreditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChannelCreditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChannelCreditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetChannelCreditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChannelCreditResponse.Merge(m, src)
+}
+func (m *QueryGetChannelCreditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChannelCreditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChannelCreditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetChannelCreditResponse proto.InternalMessageInfo
+
+func (m *QueryGetChannelCreditResponse) GetChannelCredit() ChannelCredit {
+	if m != nil {
+		return m.ChannelCredit
+	}
+	return ChannelCredit{}
+}
+
+// QueryCreditLimitRequest defines the QueryCreditLimitRequest message.
+type QueryCreditLimitRequest struct {
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+}
+
+func (m *QueryCreditLimitRequest) Reset()         { *m = QueryCreditLimitRequest{} }
+func (m *QueryCreditLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCreditLimitRequest) ProtoMessage()    {}
+func (*QueryCreditLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{26}
+}
+func (m *QueryCreditLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreditLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreditLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCreditLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreditLimitRequest.Merge(m, src)
+}
+func (m *QueryCreditLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreditLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreditLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCreditLimitRequest proto.InternalMessageInfo
+
+func (m *QueryCreditLimitRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+// QueryCreditLimitResponse defines the QueryCreditLimitResponse message.
+type QueryCreditLimitResponse struct {
+	CreditLimit CreditLimit `protobuf:"bytes,1,opt,name=credit_limit,json=creditLimit,proto3" json:"credit_limit"`
+}
+
+func (m *QueryCreditLimitResponse) Reset()         { *m = QueryCreditLimitResponse{} }
+func (m *QueryCreditLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCreditLimitResponse) ProtoMessage()    {}
+func (*QueryCreditLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5a4db7d8a6f1b81, []int{27}
+}
+func (m *QueryCreditLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreditLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreditLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCreditLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreditLimitResponse.Merge(m, src)
+}
+func (m *QueryCreditLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreditLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreditLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCreditLimitResponse proto.InternalMessageInfo
+
+func (m *QueryCreditLimitResponse) GetCreditLimit() CreditLimit {
+	if m != nil {
+		return m.CreditLimit
+	}
+	return CreditLimit{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "realfin.creditscore.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "realfin.creditscore.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetRateRequest)(nil), "realfin.creditscore.v1.QueryGetRateRequest")
+	proto.RegisterType((*QueryGetRateResponse)(nil), "realfin.creditscore.v1.QueryGetRateResponse")
+	proto.RegisterType((*QueryAllRateRequest)(nil), "realfin.creditscore.v1.QueryAllRateRequest")
+	proto.RegisterType((*QueryAllRateResponse)(nil), "realfin.creditscore.v1.QueryAllRateResponse")
+	proto.RegisterType((*QueryAllRepaymentRequest)(nil), "realfin.creditscore.v1.QueryAllRepaymentRequest")
+	proto.RegisterType((*QueryAllRepaymentResponse)(nil), "realfin.creditscore.v1.QueryAllRepaymentResponse")
+	proto.RegisterType((*QueryGetAgencyRequest)(nil), "realfin.creditscore.v1.QueryGetAgencyRequest")
+	proto.RegisterType((*QueryGetAgencyResponse)(nil), "realfin.creditscore.v1.QueryGetAgencyResponse")
+	proto.RegisterType((*QueryAllAgencyRequest)(nil), "realfin.creditscore.v1.QueryAllAgencyRequest")
+	proto.RegisterType((*QueryAllAgencyResponse)(nil), "realfin.creditscore.v1.QueryAllAgencyResponse")
+	proto.RegisterType((*QueryRateHistoryRequest)(nil), "realfin.creditscore.v1.QueryRateHistoryRequest")
+	proto.RegisterType((*QueryRateHistoryResponse)(nil), "realfin.creditscore.v1.QueryRateHistoryResponse")
+	proto.RegisterType((*QueryGetAttestationRequest)(nil), "realfin.creditscore.v1.QueryGetAttestationRequest")
+	proto.RegisterType((*QueryGetAttestationResponse)(nil), "realfin.creditscore.v1.QueryGetAttestationResponse")
+	proto.RegisterType((*QueryAllAttestationRequest)(nil), "realfin.creditscore.v1.QueryAllAttestationRequest")
+	proto.RegisterType((*QueryAllAttestationResponse)(nil), "realfin.creditscore.v1.QueryAllAttestationResponse")
+	proto.RegisterType((*QueryVerifyAttestationRequest)(nil), "realfin.creditscore.v1.QueryVerifyAttestationRequest")
+	proto.RegisterType((*QueryVerifyAttestationResponse)(nil), "realfin.creditscore.v1.QueryVerifyAttestationResponse")
+	proto.RegisterType((*QueryGetDisputeRequest)(nil), "realfin.creditscore.v1.QueryGetDisputeRequest")
+	proto.RegisterType((*QueryGetDisputeResponse)(nil), "realfin.creditscore.v1.QueryGetDisputeResponse")
+	proto.RegisterType((*QueryAllDisputeRequest)(nil), "realfin.creditscore.v1.QueryAllDisputeRequest")
+	proto.RegisterType((*QueryAllDisputeResponse)(nil), "realfin.creditscore.v1.QueryAllDisputeResponse")
+	proto.RegisterType((*QueryGetChannelCreditRequest)(nil), "realfin.creditscore.v1.QueryGetChannelCreditRequest")
+	proto.RegisterType((*QueryGetChannelCreditResponse)(nil), "realfin.creditscore.v1.QueryGetChannelCreditResponse")
+	proto.RegisterType((*QueryCreditLimitRequest)(nil), "realfin.creditscore.v1.QueryCreditLimitRequest")
+	proto.RegisterType((*QueryCreditLimitResponse)(nil), "realfin.creditscore.v1.QueryCreditLimitResponse")
+}
+
+func init() {
+	proto.RegisterFile("realfin/creditscore/v1/query.proto", fileDescriptor_e5a4db7d8a6f1b81)
+}
+
+var fileDescriptor_e5a4db7d8a6f1b81 = []byte{
+	// 1532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x71, 0x7e, 0xf9, 0xa5, 0x0d, 0x74, 0x08, 0xa9, 0xd9, 0xa6, 0x6e, 0xba, 0x6d,
+	0xd2, 0x34, 0x4d, 0x3c, 0x71, 0xd2, 0x94, 0x03, 0xad, 0x4a, 0xd2, 0xd2, 0x82, 0x28, 0xa2, 0x6c,
+	0xa5, 0x0a, 0xf5, 0x52, 0xad, 0xed, 0xa9, 0xbb, 0xad, 0xbd, 0xeb, 0xee, 0x6e, 0x52, 0xa2, 0x10,
+	0x0e, 0x5c, 0x10, 0xe2, 0x82, 0xd4, 0x43, 0x0f, 0x70, 0x43, 0xa8, 0x15, 0x5c, 0x10, 0x02, 0x71,
+	0x80, 0x33, 0xea, 0xb1, 0x82, 0x0b, 0x27, 0x84, 0x1a, 0x24, 0xfe, 0x08, 0x2e, 0x68, 0x67, 0xdf,
+	0xac, 0x77, 0x6d, 0xaf, 0x77, 0x5d, 0x7c, 0x89, 0x76, 0x36, 0xef, 0x3b, 0xf3, 0x79, 0xef, 0xcd,
+	0x1b, 0xbf, 0x59, 0x50, 0x6d, 0xae, 0xd7, 0x6e, 0x19, 0x26, 0x2b, 0xdb, 0xbc, 0x62, 0xb8, 0x4e,
+	0xd9, 0xb2, 0x39, 0xdb, 0x2a, 0xb2, 0x7b, 0x9b, 0xdc, 0xde, 0x2e, 0x34, 0x6c, 0xcb, 0xb5, 0xe8,
+	0x14, 0xda, 0x14, 0x42, 0x36, 0x85, 0xad, 0xa2, 0x72, 0x40, 0xaf, 0x1b, 0xa6, 0xc5, 0xc4, 0x5f,
+	0xdf, 0x54, 0x59, 0x28, 0x5b, 0x4e, 0xdd, 0x72, 0x58, 0x49, 0x77, 0xb8, 0x3f, 0x07, 0xdb, 0x2a,
+	0x96, 0xb8, 0xab, 0x17, 0x59, 0x43, 0xaf, 0x1a, 0xa6, 0xee, 0x1a, 0x96, 0x89, 0xb6, 0x93, 0x55,
+	0xab, 0x6a, 0x89, 0x47, 0xe6, 0x3d, 0xe1, 0xdb, 0xe9, 0xaa, 0x65, 0x55, 0x6b, 0x9c, 0xe9, 0x0d,
+	0x83, 0xe9, 0xa6, 0x69, 0xb9, 0x42, 0xe2, 0xe0, 0x7f, 0x8f, 0xc5, 0xe0, 0xea, 0x55, 0x6e, 0x96,
+	0x91, 0x57, 0x99, 0x8f, 0x33, 0x72, 0x5d, 0xee, 0xb8, 0x61, 0x84, 0x93, 0x31, 0x96, 0xfe, 0xf0,
+	0x66, 0xcd, 0xa8, 0x1b, 0x2e, 0x9a, 0x1e, 0x8f, 0x31, 0xad, 0x18, 0x4e, 0x63, 0xd3, 0xe5, 0x09,
+	0x56, 0xb7, 0x0d, 0xc7, 0xb5, 0xec, 0xed, 0x04, 0x2f, 0x1a, 0x7a, 0xf9, 0x2e, 0x77, 0x13, 0x8d,
+	0x6c, 0xbd, 0x2e, 0xe3, 0x71, 0x34, 0xc6, 0xc8, 0xd6, 0x03, 0xa4, 0xb9, 0x38, 0x13, 0xde, 0xd0,
+	0xb7, 0xeb, 0xdc, 0x94, 0xeb, 0xc5, 0xed, 0x04, 0xa7, 0xac, 0xd7, 0x70, 0x2e, 0x75, 0x12, 0xe8,
+	0x7b, 0x5e, 0x52, 0xaf, 0x0a, 0x06, 0x8d, 0xdf, 0xdb, 0xe4, 0x8e, 0xab, 0xbe, 0x0f, 0x2f, 0x45,
+	0xde, 0x3a, 0x0d, 0xcb, 0x74, 0x38, 0x5d, 0x87, 0x11, 0x9f, 0x35, 0x47, 0x66, 0xc8, 0xfc, 0xf8,
+	0x4a, 0xbe, 0xd0, 0x79, 0x1f, 0x15, 0x7c, 0xdd, 0x46, 0xf6, 0xc9, 0x9f, 0x47, 0x06, 0x1e, 0xff,
+	0xf3, 0xdd, 0x02, 0xd1, 0x50, 0xa8, 0x2e, 0xe1, 0xcc, 0x97, 0xb9, 0xab, 0xe9, 0x2e, 0xc7, 0x05,
+	0xe9, 0x14, 0x8c, 0x38, 0xdb, 0xf5, 0x92, 0x55, 0x13, 0x33, 0x67, 0x35, 0x1c, 0xa9, 0xbf, 0x11,
+	0x98, 0x8c, 0xda, 0x23, 0xca, 0x19, 0x18, 0xf2, 0x22, 0x82, 0x20, 0xd3, 0x71, 0x20, 0x9e, 0x66,
+	0x63, 0xc8, 0xc3, 0xd0, 0x84, 0x3d, 0xbd, 0x08, 0xd9, 0x92, 0xcd, 0xf5, 0xbb, 0x15, 0xeb, 0xbe,
+	0x99, 0x1b, 0x14, 0xe2, 0xb9, 0x38, 0xf1, 0x35, 0xef, 0x61, 0x43, 0x5a, 0x6b, 0x4d, 0x21, 0x3d,
+	0x0b, 0xa3, 0xb6, 0xee, 0x1a, 0x66, 0xd5, 0xc9, 0x65, 0x66, 0x32, 0x29, 0x01, 0xa4, 0x44, 0xfd,
+	0x81, 0x60, 0x10, 0xd6, 0x6b, 0xb5, 0x70, 0x10, 0x2e, 0x01, 0x34, 0x4b, 0x0a, 0x3d, 0x9b, 0x2b,
+	0xf8, 0xf5, 0x57, 0xf0, 0xea, 0xaf, 0xe0, 0xd7, 0x30, 0xd6, 0x5f, 0xe1, 0xaa, 0x5e, 0x95, 0x5a,
+	0x2d, 0xa4, 0xa4, 0x93, 0x30, 0x5c, 0xb5, 0xf5, 0x0a, 0x17, 0xfe, 0x65, 0x35, 0x7f, 0x40, 0xcf,
+	0xc3, 0xa8, 0xb5, 0xe9, 0xd6, 0x2c, 0xeb, 0x6e, 0x2e, 0x33, 0x43, 0xe6, 0x27, 0x56, 0x66, 0xbb,
+	0x30, 0x1b, 0x66, 0xf5, 0x5d, 0xdf, 0x58, 0x93, 0x2a, 0xf5, 0xa1, 0xcc, 0x45, 0x80, 0xdd, 0x96,
+	0x8b, 0x4c, 0x4f, 0xb9, 0xb8, 0x1c, 0xf1, 0xd7, 0x4f, 0xc6, 0x89, 0x44, 0x7f, 0xfd, 0x45, 0xc3,
+	0x0e, 0xab, 0x1f, 0x41, 0x2e, 0x00, 0x93, 0x35, 0x20, 0x83, 0xaa, 0xc0, 0x58, 0xc9, 0xb2, 0x6d,
+	0xeb, 0x3e, 0xb7, 0x71, 0x6f, 0x05, 0x63, 0x7a, 0xa9, 0x03, 0xc0, 0x73, 0x04, 0x5c, 0xfd, 0x9e,
+	0xc0, 0x2b, 0x1d, 0x00, 0x30, 0x3c, 0x57, 0x00, 0x82, 0xca, 0x74, 0x30, 0x48, 0xb1, 0x7b, 0x2e,
+	0x90, 0xbf, 0xb1, 0xc5, 0x4d, 0x17, 0xc3, 0x15, 0xd2, 0xf7, 0x2f, 0x68, 0x45, 0x78, 0x59, 0x56,
+	0xd6, 0xba, 0x38, 0x6b, 0x65, 0xc4, 0x72, 0x30, 0xaa, 0x57, 0x2a, 0x36, 0x77, 0x1c, 0x0c, 0x98,
+	0x1c, 0xaa, 0xd7, 0x61, 0xaa, 0x55, 0x82, 0x3e, 0x9e, 0x85, 0x11, 0xff, 0xc0, 0x4e, 0x3a, 0x19,
+	0x7c, 0x1d, 0xfa, 0x85, 0x1a, 0xf5, 0x26, 0xa2, 0xac, 0xd7, 0x6a, 0x51, 0x94, 0x3e, 0x55, 0x84,
+	0xfa, 0x15, 0x81, 0xa9, 0xd6, 0x15, 0x90, 0xfc, 0x75, 0x18, 0x13, 0x14, 0x06, 0x97, 0xb9, 0x49,
+	0xc7, 0x1e, 0xa8, 0xfa, 0x97, 0x91, 0x07, 0x04, 0x0e, 0x0a, 0x4a, 0xaf, 0x52, 0xde, 0xf4, 0x7f,
+	0x5f, 0x12, 0x0e, 0x48, 0x2f, 0x59, 0x65, 0x9b, 0xeb, 0xae, 0x65, 0x63, 0xb5, 0xcb, 0x61, 0x4b,
+	0xec, 0x32, 0xcf, 0x1d, 0xbb, 0x47, 0x04, 0x72, 0xed, 0x54, 0x18, 0xbd, 0x0d, 0x18, 0x2d, 0xdf,
+	0xd6, 0xcd, 0x6a, 0x10, 0x3c, 0xb5, 0x5b, 0xf5, 0x5f, 0x10, 0xa6, 0xf2, 0x38, 0x44, 0x61, 0xff,
+	0xe2, 0xb7, 0x08, 0x4a, 0xb0, 0x3d, 0x9b, 0x8d, 0x81, 0x8c, 0xe0, 0x04, 0x0c, 0x1a, 0x15, 0x11,
+	0xbd, 0x21, 0x6d, 0xd0, 0xa8, 0xa8, 0x77, 0xe0, 0x50, 0x47, 0x6b, 0xf4, 0xec, 0x6d, 0x18, 0x0f,
+	0x75, 0x17, 0xb8, 0xf7, 0x8e, 0xc5, 0x6e, 0x8d, 0xa6, 0x29, 0xba, 0x17, 0x56, 0xab, 0x1f, 0x82,
+	0x12, 0x6c, 0xbf, 0x76, 0xb2, 0xa9, 0x48, 0xf1, 0x64, 0x65, 0x59, 0xf4, 0xed, 0x78, 0xfa, 0x91,
+	0xc0, 0xa1, 0x8e, 0xcb, 0xa3, 0xab, 0xef, 0xc0, 0xbe, 0x10, 0xac, 0xcc, 0x64, 0x0f, 0xbe, 0x46,
+	0xe4, 0xfd, 0xcb, 0xe7, 0x27, 0x04, 0x0e, 0x0b, 0xee, 0xeb, 0xdc, 0x36, 0x6e, 0x6d, 0x27, 0xe7,
+	0xd4, 0xab, 0x06, 0x67, 0xb3, 0x74, 0x87, 0x97, 0x5d, 0x59, 0x0d, 0x38, 0xa4, 0x14, 0x7f, 0xa3,
+	0x32, 0xc2, 0x56, 0x3c, 0x37, 0x7f, 0x27, 0x87, 0xc2, 0xbf, 0x93, 0x14, 0x86, 0x1c, 0xbd, 0xe6,
+	0xe6, 0x86, 0xc5, 0x4b, 0xf1, 0xac, 0xfe, 0x4a, 0x20, 0x1f, 0x47, 0x82, 0x41, 0x9c, 0x84, 0xe1,
+	0x2d, 0xbd, 0x86, 0x34, 0x63, 0x9a, 0x3f, 0xf0, 0x3a, 0x26, 0xcf, 0x70, 0xd3, 0x11, 0x3c, 0x13,
+	0x2b, 0x27, 0x53, 0x04, 0xf5, 0x9a, 0x10, 0x68, 0x28, 0x6c, 0xdd, 0x88, 0x99, 0xff, 0xb5, 0x11,
+	0xe7, 0x9b, 0x27, 0xf8, 0x45, 0xbf, 0xcd, 0x8d, 0x2b, 0x8f, 0x1b, 0x70, 0xb0, 0xcd, 0x12, 0x5d,
+	0x3d, 0x0f, 0xa3, 0xd8, 0x23, 0x63, 0x59, 0x1c, 0x89, 0xa3, 0x41, 0xa5, 0xac, 0x78, 0x54, 0xa9,
+	0x3f, 0x85, 0x8e, 0xe3, 0x16, 0x8c, 0xb8, 0x73, 0xee, 0x5c, 0x4b, 0x20, 0x67, 0x13, 0x96, 0x6c,
+	0x09, 0x62, 0xbf, 0x0e, 0xc3, 0xaf, 0xe5, 0x11, 0x1d, 0x26, 0x0f, 0xba, 0xe3, 0x31, 0x74, 0x50,
+	0x96, 0x50, 0xca, 0xb8, 0x04, 0xb2, 0xfe, 0x95, 0xce, 0x39, 0x98, 0x96, 0xd9, 0xf3, 0x0e, 0x5d,
+	0x93, 0xd7, 0x2e, 0x08, 0x02, 0x19, 0xe6, 0xc3, 0x00, 0x65, 0xff, 0xfd, 0x4d, 0xcc, 0x7a, 0x56,
+	0xcb, 0xe2, 0x9b, 0xb7, 0x2a, 0xaa, 0x03, 0x87, 0x63, 0xe4, 0xe8, 0xab, 0x06, 0x13, 0x52, 0xef,
+	0xbb, 0x86, 0x3b, 0x21, 0x36, 0x2d, 0x91, 0x69, 0xd0, 0xef, 0xfd, 0xe5, 0xf0, 0x4b, 0x75, 0x15,
+	0x43, 0xeb, 0x0f, 0xaf, 0x18, 0xf5, 0x26, 0x6e, 0xa8, 0xae, 0x49, 0xa4, 0xae, 0xd5, 0xdb, 0x90,
+	0x6b, 0x17, 0x05, 0x8d, 0xd7, 0xbe, 0xf0, 0xb5, 0x2f, 0xe9, 0x0c, 0x0f, 0x4d, 0x21, 0x4b, 0xa7,
+	0xdc, 0x7c, 0xb5, 0xf2, 0xef, 0x01, 0x18, 0x16, 0x4b, 0xd1, 0x4f, 0x09, 0x8c, 0xf8, 0x37, 0x1c,
+	0xba, 0x10, 0x37, 0x59, 0xfb, 0xa5, 0x4a, 0x39, 0x95, 0xca, 0xd6, 0x67, 0x57, 0xe7, 0x3e, 0xfe,
+	0xfd, 0xef, 0x07, 0x83, 0x33, 0x34, 0xcf, 0xba, 0x5e, 0x1a, 0xe9, 0x03, 0x02, 0xa3, 0x78, 0x37,
+	0xa2, 0xdd, 0x17, 0x88, 0xde, 0xb8, 0x94, 0xc5, 0x74, 0xc6, 0x88, 0xb3, 0x24, 0x70, 0x4e, 0xd0,
+	0x59, 0xd6, 0xe5, 0x7a, 0xca, 0x76, 0xfc, 0x62, 0xdd, 0xa5, 0x9f, 0x11, 0x18, 0xbb, 0x62, 0x38,
+	0x69, 0xb0, 0xa2, 0x77, 0x20, 0x65, 0x31, 0x9d, 0x31, 0x62, 0x1d, 0x17, 0x58, 0x79, 0x3a, 0xdd,
+	0x0d, 0x8b, 0x7e, 0x43, 0x60, 0xbf, 0xa0, 0x91, 0x5d, 0x34, 0x5d, 0x4e, 0x5c, 0xa5, 0xe5, 0x1a,
+	0xa1, 0x14, 0x7b, 0x50, 0x20, 0xdc, 0x69, 0x01, 0x57, 0xa0, 0x8b, 0x2c, 0xe9, 0xbe, 0xce, 0x76,
+	0xe4, 0x95, 0x64, 0x97, 0x3e, 0x22, 0x30, 0x1e, 0xea, 0xb4, 0x28, 0xeb, 0xba, 0x70, 0x7b, 0xa7,
+	0xa8, 0x2c, 0xa7, 0x17, 0x20, 0xe8, 0x9a, 0x00, 0x65, 0x74, 0x29, 0x55, 0x72, 0xe5, 0x97, 0x0f,
+	0xfa, 0x25, 0x81, 0x6c, 0x70, 0x13, 0xa0, 0x4b, 0x49, 0xfb, 0x29, 0xd2, 0xd9, 0x2b, 0x85, 0xb4,
+	0xe6, 0xc8, 0xb8, 0x2c, 0x18, 0x17, 0xe8, 0x3c, 0xeb, 0xfa, 0xbd, 0x88, 0xed, 0xe0, 0x5d, 0x65,
+	0xd7, 0xab, 0x0c, 0xf0, 0xb2, 0x9e, 0x8a, 0xaf, 0xf5, 0xe6, 0xa1, 0x14, 0xd2, 0x9a, 0xa7, 0xad,
+	0x57, 0xec, 0xe9, 0xbe, 0x25, 0x30, 0x11, 0xed, 0x38, 0xe9, 0x4a, 0x62, 0x28, 0xda, 0x1a, 0x1f,
+	0x65, 0xb5, 0x27, 0x4d, 0xea, 0x18, 0x36, 0x45, 0x6c, 0xc7, 0xa8, 0x88, 0xcd, 0xf8, 0x82, 0x88,
+	0x61, 0x6a, 0xdc, 0x8e, 0x1d, 0xae, 0xb2, 0xda, 0x93, 0x06, 0x71, 0x4f, 0x09, 0xdc, 0x59, 0x7a,
+	0x2c, 0x05, 0x2e, 0xfd, 0x99, 0xc0, 0x81, 0xb6, 0xe6, 0x8c, 0xae, 0x75, 0x5d, 0x37, 0xae, 0xad,
+	0x54, 0xce, 0xf4, 0x2a, 0x43, 0xe2, 0x57, 0x05, 0x71, 0x91, 0xb2, 0xb4, 0x01, 0x66, 0x5b, 0x62,
+	0x2e, 0xfa, 0x05, 0x01, 0x68, 0x36, 0x5a, 0x34, 0xb1, 0x38, 0xa2, 0x4d, 0x93, 0xc2, 0x52, 0xdb,
+	0x23, 0xe8, 0xa2, 0x00, 0x9d, 0xa3, 0xc7, 0x59, 0xf7, 0x6f, 0xa0, 0xfe, 0x2e, 0x78, 0x48, 0x60,
+	0xdc, 0xdb, 0x05, 0xe9, 0xf0, 0xda, 0x7a, 0x3a, 0x85, 0xa5, 0xb6, 0x47, 0xbc, 0x13, 0x02, 0xef,
+	0x28, 0x3d, 0x92, 0x80, 0x47, 0x7f, 0x21, 0xf0, 0x62, 0x6b, 0x8f, 0x42, 0x4f, 0x27, 0x45, 0xa3,
+	0x53, 0x47, 0xa4, 0xac, 0xf5, 0xa8, 0x42, 0xd4, 0xd7, 0x04, 0xea, 0x1a, 0x5d, 0x8d, 0x43, 0x8d,
+	0xb6, 0x49, 0x6c, 0xa7, 0xd9, 0x76, 0xed, 0xd2, 0xc7, 0x04, 0xc6, 0x43, 0x5d, 0x47, 0xc2, 0x59,
+	0xdf, 0xde, 0x17, 0x29, 0xcb, 0xe9, 0x05, 0xc8, 0x7b, 0x46, 0xf0, 0x2e, 0xd3, 0x02, 0x4b, 0xf1,
+	0xa1, 0x9c, 0xed, 0x60, 0x9b, 0xb5, 0xbb, 0xb1, 0xf6, 0xe4, 0x59, 0x9e, 0x3c, 0x7d, 0x96, 0x27,
+	0x7f, 0x3d, 0xcb, 0x93, 0xcf, 0xf7, 0xf2, 0x03, 0x4f, 0xf7, 0xf2, 0x03, 0x7f, 0xec, 0xe5, 0x07,
+	0x6e, 0x1c, 0x92, 0x13, 0x7d, 0x10, 0x99, 0xca, 0xdd, 0x6e, 0x70, 0xa7, 0x34, 0x22, 0xbe, 0x32,
+	0xaf, 0xfe, 0x37, 0x00, 0x57, 0x2f, 0xc7, 0xcd, 0x95, 0x18, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetRate queries the consolidated rate of a symbol and the rates of the
+	// agencies rating it.
+	GetRate(ctx context.Context, in *QueryGetRateRequest, opts ...grpc.CallOption) (*QueryGetRateResponse, error)
+	// ListRate defines the ListRate RPC.
+	ListRate(ctx context.Context, in *QueryAllRateRequest, opts ...grpc.CallOption) (*QueryAllRateResponse, error)
+	// ListRepayment queries the repayment events of a borrower.
+	ListRepayment(ctx context.Context, in *QueryAllRepaymentRequest, opts ...grpc.CallOption) (*QueryAllRepaymentResponse, error)
+	// RateHistory queries the changes of the rates of a symbol, oldest first.
+	RateHistory(ctx context.Context, in *QueryRateHistoryRequest, opts ...grpc.CallOption) (*QueryRateHistoryResponse, error)
+	// GetAgency queries an accredited rating agency.
+	GetAgency(ctx context.Context, in *QueryGetAgencyRequest, opts ...grpc.CallOption) (*QueryGetAgencyResponse, error)
+	// ListAgency queries the accredited rating agencies.
+	ListAgency(ctx context.Context, in *QueryAllAgencyRequest, opts ...grpc.CallOption) (*QueryAllAgencyResponse, error)
+	// GetAttestation queries an attestation.
+	GetAttestation(ctx context.Context, in *QueryGetAttestationRequest, opts ...grpc.CallOption) (*QueryGetAttestationResponse, error)
+	// ListAttestation queries the attestations, optionally of an agency.
+	ListAttestation(ctx context.Context, in *QueryAllAttestationRequest, opts ...grpc.CallOption) (*QueryAllAttestationResponse, error)
+	// VerifyAttestation checks a rating revealed by its subject against the
+	// commitment of an attestation.
+	VerifyAttestation(ctx context.Context, in *QueryVerifyAttestationRequest, opts ...grpc.CallOption) (*QueryVerifyAttestationResponse, error)
+	// GetDispute queries a dispute.
+	GetDispute(ctx context.Context, in *QueryGetDisputeRequest, opts ...grpc.CallOption) (*QueryGetDisputeResponse, error)
+	// ListDispute queries the disputes, optionally of a symbol and by status.
+	ListDispute(ctx context.Context, in *QueryAllDisputeRequest, opts ...grpc.CallOption) (*QueryAllDisputeResponse, error)
+	// GetChannelCredit queries the prepaid query fees of a channel.
+	GetChannelCredit(ctx context.Context, in *QueryGetChannelCreditRequest, opts ...grpc.CallOption) (*QueryGetChannelCreditResponse, error)
+	// CreditLimit queries the credit line of a subject and its breakdown.
+	CreditLimit(ctx context.Context, in *QueryCreditLimitRequest, opts ...grpc.CallOption) (*QueryCreditLimitResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRate(ctx context.Context, in *QueryGetRateRequest, opts ...grpc.CallOption) (*QueryGetRateResponse, error) {
+	out := new(QueryGetRateResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/GetRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRate(ctx context.Context, in *QueryAllRateRequest, opts ...grpc.CallOption) (*QueryAllRateResponse, error) {
+	out := new(QueryAllRateResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/ListRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRepayment(ctx context.Context, in *QueryAllRepaymentRequest, opts ...grpc.CallOption) (*QueryAllRepaymentResponse, error) {
+	out := new(QueryAllRepaymentResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/ListRepayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateHistory(ctx context.Context, in *QueryRateHistoryRequest, opts ...grpc.CallOption) (*QueryRateHistoryResponse, error) {
+	out := new(QueryRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/RateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAgency(ctx context.Context, in *QueryGetAgencyRequest, opts ...grpc.CallOption) (*QueryGetAgencyResponse, error) {
+	out := new(QueryGetAgencyResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/GetAgency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAgency(ctx context.Context, in *QueryAllAgencyRequest, opts ...grpc.CallOption) (*QueryAllAgencyResponse, error) {
+	out := new(QueryAllAgencyResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/ListAgency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAttestation(ctx context.Context, in *QueryGetAttestationRequest, opts ...grpc.CallOption) (*QueryGetAttestationResponse, error) {
+	out := new(QueryGetAttestationResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/GetAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAttestation(ctx context.Context, in *QueryAllAttestationRequest, opts ...grpc.CallOption) (*QueryAllAttestationResponse, error) {
+	out := new(QueryAllAttestationResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/ListAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyAttestation(ctx context.Context, in *QueryVerifyAttestationRequest, opts ...grpc.CallOption) (*QueryVerifyAttestationResponse, error) {
+	out := new(QueryVerifyAttestationResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/VerifyAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDispute(ctx context.Context, in *QueryGetDisputeRequest, opts ...grpc.CallOption) (*QueryGetDisputeResponse, error) {
+	out := new(QueryGetDisputeResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/GetDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListDispute(ctx context.Context, in *QueryAllDisputeRequest, opts ...grpc.CallOption) (*QueryAllDisputeResponse, error) {
+	out := new(QueryAllDisputeResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/ListDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetChannelCredit(ctx context.Context, in *QueryGetChannelCreditRequest, opts ...grpc.CallOption) (*QueryGetChannelCreditResponse, error) {
+	out := new(QueryGetChannelCreditResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/GetChannelCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CreditLimit(ctx context.Context, in *QueryCreditLimitRequest, opts ...grpc.CallOption) (*QueryCreditLimitResponse, error) {
+	out := new(QueryCreditLimitResponse)
+	err := c.cc.Invoke(ctx, "/realfin.creditscore.v1.Query/CreditLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetRate queries the consolidated rate of a symbol and the rates of the
+	// agencies rating it.
+	GetRate(context.Context, *QueryGetRateRequest) (*QueryGetRateResponse, error)
+	// ListRate defines the ListRate RPC.
+	ListRate(context.Context, *QueryAllRateRequest) (*QueryAllRateResponse, error)
+	// ListRepayment queries the repayment events of a borrower.
+	ListRepayment(context.Context, *QueryAllRepaymentRequest) (*QueryAllRepaymentResponse, error)
+	// RateHistory queries the changes of the rates of a symbol, oldest first.
+	RateHistory(context.Context, *QueryRateHistoryRequest) (*QueryRateHistoryResponse, error)
+	// GetAgency queries an accredited rating agency.
+	GetAgency(context.Context, *QueryGetAgencyRequest) (*QueryGetAgencyResponse, error)
+	// ListAgency queries the accredited rating agencies.
+	ListAgency(context.Context, *QueryAllAgencyRequest) (*QueryAllAgencyResponse, error)
+	// GetAttestation queries an attestation.
+	GetAttestation(context.Context, *QueryGetAttestationRequest) (*QueryGetAttestationResponse, error)
+	// ListAttestation queries the attestations, optionally of an agency.
+	ListAttestation(context.Context, *QueryAllAttestationRequest) (*QueryAllAttestationResponse, error)
+	// VerifyAttestation checks a rating revealed by its subject against the
+	// commitment of an attestation.
+	VerifyAttestation(context.Context, *QueryVerifyAttestationRequest) (*QueryVerifyAttestationResponse, error)
+	// GetDispute queries a dispute.
+	GetDispute(context.Context, *QueryGetDisputeRequest) (*QueryGetDisputeResponse, error)
+	// ListDispute queries the disputes, optionally of a symbol and by status.
+	ListDispute(context.Context, *QueryAllDisputeRequest) (*QueryAllDisputeResponse, error)
+	// GetChannelCredit queries the prepaid query fees of a channel.
+	GetChannelCredit(context.Context, *QueryGetChannelCreditRequest) (*QueryGetChannelCreditResponse, error)
+	// CreditLimit queries the credit line of a subject and its breakdown.
+	CreditLimit(context.Context, *QueryCreditLimitRequest) (*QueryCreditLimitResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetRate(ctx context.Context, req *QueryGetRateRequest) (*QueryGetRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRate not implemented")
+}
+func (*UnimplementedQueryServer) ListRate(ctx context.Context, req *QueryAllRateRequest) (*QueryAllRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRate not implemented")
+}
+func (*UnimplementedQueryServer) ListRepayment(ctx context.Context, req *QueryAllRepaymentRequest) (*QueryAllRepaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepayment not implemented")
+}
+func (*UnimplementedQueryServer) RateHistory(ctx context.Context, req *QueryRateHistoryRequest) (*QueryRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetAgency(ctx context.Context, req *QueryGetAgencyRequest) (*QueryGetAgencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgency not implemented")
+}
+func (*UnimplementedQueryServer) ListAgency(ctx context.Context, req *QueryAllAgencyRequest) (*QueryAllAgencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgency not implemented")
+}
+func (*UnimplementedQueryServer) GetAttestation(ctx context.Context, req *QueryGetAttestationRequest) (*QueryGetAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttestation not implemented")
+}
+func (*UnimplementedQueryServer) ListAttestation(ctx context.Context, req *QueryAllAttestationRequest) (*QueryAllAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttestation not implemented")
+}
+func (*UnimplementedQueryServer) VerifyAttestation(ctx context.Context, req *QueryVerifyAttestationRequest) (*QueryVerifyAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAttestation not implemented")
+}
+func (*UnimplementedQueryServer) GetDispute(ctx context.Context, req *QueryGetDisputeRequest) (*QueryGetDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDispute not implemented")
+}
+func (*UnimplementedQueryServer) ListDispute(ctx context.Context, req *QueryAllDisputeRequest) (*QueryAllDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDispute not implemented")
+}
+func (*UnimplementedQueryServer) GetChannelCredit(ctx context.Context, req *QueryGetChannelCreditRequest) (*QueryGetChannelCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelCredit not implemented")
+}
+func (*UnimplementedQueryServer) CreditLimit(ctx context.Context, req *QueryCreditLimitRequest) (*QueryCreditLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditLimit not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/GetRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRate(ctx, req.(*QueryGetRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/ListRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRate(ctx, req.(*QueryAllRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRepaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRepayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/ListRepayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRepayment(ctx, req.(*QueryAllRepaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/RateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateHistory(ctx, req.(*QueryRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAgency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAgencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAgency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/GetAgency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAgency(ctx, req.(*QueryGetAgencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAgency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAgencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAgency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/ListAgency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAgency(ctx, req.(*QueryAllAgencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/GetAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAttestation(ctx, req.(*QueryGetAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/ListAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAttestation(ctx, req.(*QueryAllAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/VerifyAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyAttestation(ctx, req.(*QueryVerifyAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/GetDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDispute(ctx, req.(*QueryGetDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/ListDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDispute(ctx, req.(*QueryAllDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetChannelCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChannelCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetChannelCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/GetChannelCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetChannelCredit(ctx, req.(*QueryGetChannelCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreditLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreditLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.creditscore.v1.Query/CreditLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditLimit(ctx, req.(*QueryCreditLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "realfin.creditscore.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetRate",
+			Handler:    _Query_GetRate_Handler,
+		},
+		{
+			MethodName: "ListRate",
+			Handler:    _Query_ListRate_Handler,
+		},
+		{
+			MethodName: "ListRepayment",
+			Handler:    _Query_ListRepayment_Handler,
+		},
+		{
+			MethodName: "RateHistory",
+			Handler:    _Query_RateHistory_Handler,
+		},
+		{
+			MethodName: "GetAgency",
+			Handler:    _Query_GetAgency_Handler,
+		},
+		{
+			MethodName: "ListAgency",
+			Handler:    _Query_ListAgency_Handler,
+		},
+		{
+			MethodName: "GetAttestation",
+			Handler:    _Query_GetAttestation_Handler,
+		},
+		{
+			MethodName: "ListAttestation",
+			Handler:    _Query_ListAttestation_Handler,
+		},
+		{
+			MethodName: "VerifyAttestation",
+			Handler:    _Query_VerifyAttestation_Handler,
+		},
+		{
+			MethodName: "GetDispute",
+			Handler:    _Query_GetDispute_Handler,
+		},
+		{
+			MethodName: "ListDispute",
+			Handler:    _Query_ListDispute_Handler,
+		},
+		{
+			MethodName: "GetChannelCredit",
+			Handler:    _Query_GetChannelCredit_Handler,
+		},
+		{
+			MethodName: "CreditLimit",
+			Handler:    _Query_CreditLimit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "realfin/creditscore/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ratings) > 0 {
+		for iNdEx := len(m.Ratings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ratings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Breakdown != nil {
+		{
+			size, err := m.Breakdown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Outlook != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Outlook))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Grade) > 0 {
+		i -= len(m.Grade)
+		copy(dAtA[i:], m.Grade)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grade)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rate) > 0 {
+		for iNdEx := len(m.Rate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRepaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRepaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRepaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRepaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRepaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRepaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Repayments) > 0 {
+		for iNdEx := len(m.Repayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Repayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAgencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAgencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAgencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAgencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAgencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAgencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Agency.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAgencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAgencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAgencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAgencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAgencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAgencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agencies) > 0 {
+		for iNdEx := len(m.Agencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Agencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agency) > 0 {
+		i -= len(m.Agency)
+		copy(dAtA[i:], m.Agency)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agency)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Grade) > 0 {
+		i -= len(m.Grade)
+		copy(dAtA[i:], m.Grade)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grade)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Rate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Rate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDisputeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDisputeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDisputeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Dispute.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDisputeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDisputeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDisputeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Disputes) > 0 {
+		for iNdEx := len(m.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChannelCreditRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChannelCreditRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChannelCreditRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChannelCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChannelCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChannelCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ChannelCredit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CreditLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Breakdown != nil {
+		l = m.Breakdown.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Ratings) > 0 {
+		for _, e := range m.Ratings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grade)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Outlook != 0 {
+		n += 1 + sovQuery(uint64(m.Outlook))
+	}
+	return n
+}
+
+func (m *QueryAllRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rate) > 0 {
+		for _, e := range m.Rate {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRepaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRepaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Repayments) > 0 {
+		for _, e := range m.Repayments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAgencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAgencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Agency.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAgencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAgencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Agencies) > 0 {
+		for _, e := range m.Agencies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Attestation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agency)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Rate != 0 {
+		n += 1 + sovQuery(uint64(m.Rate))
+	}
+	l = len(m.Grade)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = m.Attestation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDisputeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Dispute.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDisputeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Disputes) > 0 {
+		for _, e := range m.Disputes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
+	return n
+}
+
+func (m *QueryGetChannelCreditRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChannelCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChannelCredit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCreditLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCreditLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CreditLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakdown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Breakdown == nil {
+				m.Breakdown = &ScoreBreakdown{}
+			}
+			if err := m.Breakdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ratings = append(m.Ratings, Rate{})
+			if err := m.Ratings[len(m.Ratings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outlook", wireType)
+			}
+			m.Outlook = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outlook |= RatingOutlook(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = append(m.Rate, Rate{})
+			if err := m.Rate[len(m.Rate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRepaymentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRepaymentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRepaymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRepaymentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRepaymentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRepaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repayments = append(m.Repayments, RepaymentEvent{})
+			if err := m.Repayments[len(m.Repayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAgencyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAgencyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAgencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAgencyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAgencyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAgencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Agency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAgencyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAgencyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAgencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAgencyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAgencyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAgencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agencies = append(m.Agencies, Agency{})
+			if err := m.Agencies[len(m.Agencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, RateChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			m.Rate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *QueryVerifyAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AttestationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAllRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Rate) > 0 {
-		for _, e := range m.Rate {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryGetDisputeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDisputeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDisputeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -829,12 +6055,45 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dispute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -856,7 +6115,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDisputeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -879,15 +6138,66 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDisputeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDisputeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DisputeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -914,7 +6224,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -939,7 +6252,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -962,17 +6275,17 @@ func (m *QueryGetRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -982,23 +6295,61 @@ func (m *QueryGetRateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Disputes = append(m.Disputes, Dispute{})
+			if err := m.Disputes[len(m.Disputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1021,7 +6372,7 @@ func (m *QueryGetRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChannelCreditRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1044,17 +6395,17 @@ func (m *QueryGetRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChannelCreditRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChannelCreditRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1064,24 +6415,23 @@ func (m *QueryGetRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1104,7 +6454,7 @@ func (m *QueryGetRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChannelCreditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1127,15 +6477,15 @@ func (m *QueryAllRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChannelCreditResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChannelCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelCredit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1162,10 +6512,7 @@ func (m *QueryAllRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChannelCredit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1190,7 +6537,7 @@ func (m *QueryAllRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCreditLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1213,17 +6560,17 @@ func (m *QueryAllRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCreditLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCreditLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1233,29 +6580,77 @@ func (m *QueryAllRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rate = append(m.Rate, Rate{})
-			if err := m.Rate[len(m.Rate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1282,10 +6677,7 @@ func (m *QueryAllRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreditLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,7 +33,23 @@ type Rate struct {
 	Rate        uint64 `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`
 	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Creator     string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// creator is the agency that issued the rate, or the module account for
+	// the scores computed from repayment events.
+	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// withdrawn is set when the accreditation of the agency is revoked.
+	Withdrawn bool `protobuf:"varint,6,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+	// scale is the rating scale of the grade.
+	Scale string `protobuf:"bytes,7,opt,name=scale,proto3" json:"scale,omitempty"`
+	// grade is the grade of the rate on its scale when it was published.
+	Grade   string        `protobuf:"bytes,8,opt,name=grade,proto3" json:"grade,omitempty"`
+	Outlook RatingOutlook `protobuf:"varint,9,opt,name=outlook,proto3,enum=realfin.creditscore.v1.RatingOutlook" json:"outlook,omitempty"`
+	// review_date is the date the rate is next reviewed.
+	ReviewDate *time.Time `protobuf:"bytes,10,opt,name=review_date,json=reviewDate,proto3,stdtime" json:"review_date,omitempty"`
+	// subject is the address of the rated entity, entitled to dispute the
+	// rate. The symbol is the subject when unset.
+	Subject string `protobuf:"bytes,11,opt,name=subject,proto3" json:"subject,omitempty"`
+	// disputed is set while a dispute of the rate is pending.
+	Disputed bool `protobuf:"varint,12,opt,name=disputed,proto3" json:"disputed,omitempty"`
 }
 
 func (m *Rate) Reset()         { *m = Rate{} }
@@ -99,6 +120,55 @@ func (m *Rate) GetCreator() string {
 	return ""
 }
 
+func (m *Rate) GetWithdrawn() bool {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return false
+}
+
+func (m *Rate) GetScale() string {
+	if m != nil {
+		return m.Scale
+	}
+	return ""
+}
+
+func (m *Rate) GetGrade() string {
+	if m != nil {
+		return m.Grade
+	}
+	return ""
+}
+
+func (m *Rate) GetOutlook() RatingOutlook {
+	if m != nil {
+		return m.Outlook
+	}
+	return RatingOutlook_RATING_OUTLOOK_UNSPECIFIED
+}
+
+func (m *Rate) GetReviewDate() *time.Time {
+	if m != nil {
+		return m.ReviewDate
+	}
+	return nil
+}
+
+func (m *Rate) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *Rate) GetDisputed() bool {
+	if m != nil {
+		return m.Disputed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Rate)(nil), "realfin.creditscore.v1.Rate")
 }
@@ -106,20 +176,32 @@ func init() {
 func init() { proto.RegisterFile("realfin/creditscore/v1/rate.proto", fileDescriptor_260eadf622bbbd37) }
 
 var fileDescriptor_260eadf622bbbd37 = []byte{
-	// 206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0x4a, 0x4d, 0xcc,
-	0x49, 0xcb, 0xcc, 0xd3, 0x4f, 0x2e, 0x4a, 0x4d, 0xc9, 0x2c, 0x29, 0x4e, 0xce, 0x2f, 0x4a, 0xd5,
-	0x2f, 0x33, 0xd4, 0x2f, 0x4a, 0x2c, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83,
-	0x2a, 0xd1, 0x43, 0x52, 0xa2, 0x57, 0x66, 0xa8, 0xd4, 0xc4, 0xc8, 0xc5, 0x12, 0x94, 0x58, 0x92,
-	0x2a, 0x24, 0xc6, 0xc5, 0x56, 0x5c, 0x99, 0x9b, 0x94, 0x9f, 0x23, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x19, 0x04, 0xe5, 0x09, 0x09, 0x71, 0xb1, 0x80, 0x8c, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x09,
-	0x02, 0xb3, 0x41, 0x62, 0x79, 0x89, 0xb9, 0xa9, 0x12, 0xcc, 0x60, 0x95, 0x60, 0xb6, 0x90, 0x02,
-	0x17, 0x77, 0x4a, 0x6a, 0x71, 0x72, 0x51, 0x66, 0x41, 0x49, 0x66, 0x7e, 0x9e, 0x04, 0x0b, 0x58,
-	0x0a, 0x59, 0x48, 0x48, 0x82, 0x8b, 0x3d, 0xb9, 0x28, 0x35, 0xb1, 0x24, 0xbf, 0x48, 0x82, 0x15,
-	0x2c, 0x0b, 0xe3, 0x3a, 0x99, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x34, 0xcc, 0x67, 0x15, 0x28, 0x7e, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xcd,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x35, 0x95, 0x6e, 0x0a, 0xff, 0x00, 0x00, 0x00,
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0xae, 0xd3, 0x30,
+	0x14, 0x86, 0x6b, 0x6e, 0x6e, 0xd3, 0x3a, 0x88, 0xc1, 0xba, 0xba, 0xb2, 0x02, 0x4a, 0x43, 0x25,
+	0xa4, 0x4c, 0x8e, 0x5a, 0xc4, 0x8c, 0xa8, 0xd8, 0x91, 0x22, 0x26, 0x16, 0xe4, 0x24, 0xa7, 0xc1,
+	0x90, 0xc4, 0x91, 0xed, 0xb4, 0xf4, 0x05, 0x98, 0xfb, 0x58, 0x8c, 0x1d, 0xd9, 0x40, 0xed, 0x8b,
+	0xa0, 0x38, 0x09, 0x14, 0x89, 0xbb, 0x9d, 0xff, 0xf7, 0x77, 0xe4, 0x73, 0xfe, 0x83, 0x9f, 0x2b,
+	0xe0, 0xe5, 0x56, 0xd4, 0x71, 0xa6, 0x20, 0x17, 0x46, 0x67, 0x52, 0x41, 0xbc, 0x5b, 0xc5, 0x8a,
+	0x1b, 0x60, 0x8d, 0x92, 0x46, 0x92, 0xfb, 0x01, 0x61, 0x57, 0x08, 0xdb, 0xad, 0xfc, 0xbb, 0x42,
+	0x16, 0xd2, 0x22, 0x71, 0x57, 0xf5, 0xb4, 0xbf, 0x28, 0xa4, 0x2c, 0x4a, 0x88, 0xad, 0x4a, 0xdb,
+	0x6d, 0x6c, 0x44, 0x05, 0xda, 0xf0, 0xaa, 0x19, 0x80, 0xe5, 0x03, 0x3f, 0xea, 0x8c, 0x97, 0xc3,
+	0x97, 0xcb, 0x6f, 0x37, 0xd8, 0x49, 0xb8, 0x01, 0x72, 0x8f, 0xa7, 0xfa, 0x50, 0xa5, 0xb2, 0xa4,
+	0x28, 0x44, 0xd1, 0x3c, 0x19, 0x14, 0x21, 0xd8, 0xe9, 0x26, 0xa4, 0x8f, 0x42, 0x14, 0x39, 0x89,
+	0xad, 0x3b, 0xaf, 0xe6, 0x15, 0xd0, 0x1b, 0x4b, 0xda, 0x9a, 0x84, 0xd8, 0xcb, 0x41, 0x67, 0x4a,
+	0x34, 0x46, 0xc8, 0x9a, 0x3a, 0xf6, 0xe9, 0xda, 0x22, 0x14, 0xbb, 0x99, 0x02, 0x6e, 0xa4, 0xa2,
+	0xb7, 0xf6, 0x75, 0x94, 0xe4, 0x19, 0x9e, 0xef, 0x85, 0xf9, 0x94, 0x2b, 0xbe, 0xaf, 0xe9, 0x34,
+	0x44, 0xd1, 0x2c, 0xf9, 0x6b, 0x90, 0x3b, 0x7c, 0x6b, 0x27, 0xa6, 0xae, 0xed, 0xea, 0x45, 0xe7,
+	0x16, 0x8a, 0xe7, 0x40, 0x67, 0xbd, 0x6b, 0x05, 0x79, 0x8d, 0x5d, 0xd9, 0x9a, 0x52, 0xca, 0x2f,
+	0x74, 0x1e, 0xa2, 0xe8, 0xc9, 0xfa, 0x05, 0xfb, 0x7f, 0xa6, 0x2c, 0xe1, 0x46, 0xd4, 0xc5, 0xbb,
+	0x1e, 0x4e, 0xc6, 0x2e, 0xf2, 0x06, 0x7b, 0x0a, 0x76, 0x02, 0xf6, 0x1f, 0xf3, 0x6e, 0x6b, 0x1c,
+	0xa2, 0xc8, 0x5b, 0xfb, 0xac, 0x8f, 0x9a, 0x8d, 0x51, 0xb3, 0xf7, 0x63, 0xd4, 0x1b, 0xe7, 0xf8,
+	0x73, 0x81, 0x12, 0xdc, 0x37, 0xbd, 0xed, 0xd2, 0xa1, 0xd8, 0xd5, 0x6d, 0xfa, 0x19, 0x32, 0x43,
+	0xbd, 0x7e, 0xcf, 0x41, 0x12, 0x1f, 0xcf, 0x72, 0xa1, 0x9b, 0xd6, 0x40, 0x4e, 0x1f, 0xdb, 0x35,
+	0xff, 0xe8, 0xcd, 0xab, 0xef, 0xe7, 0x00, 0x9d, 0xce, 0x01, 0xfa, 0x75, 0x0e, 0xd0, 0xf1, 0x12,
+	0x4c, 0x4e, 0x97, 0x60, 0xf2, 0xe3, 0x12, 0x4c, 0x3e, 0x3c, 0x1d, 0xcf, 0xf8, 0xf5, 0x9f, 0x43,
+	0x9a, 0x43, 0x03, 0x3a, 0x9d, 0xda, 0x91, 0x5e, 0xfe, 0x1e, 0x00, 0x01, 0x32, 0x24, 0x0f, 0x5e,
+	0x02, 0x00, 0x00,
 }
 
 func (m *Rate) Marshal() (dAtA []byte, err error) {
@@ -142,6 +224,62 @@ func (m *Rate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Disputed {
+		i--
+		if m.Disputed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintRate(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ReviewDate != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ReviewDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReviewDate):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintRate(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Outlook != 0 {
+		i = encodeVarintRate(dAtA, i, uint64(m.Outlook))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Grade) > 0 {
+		i -= len(m.Grade)
+		copy(dAtA[i:], m.Grade)
+		i = encodeVarintRate(dAtA, i, uint64(len(m.Grade)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Scale) > 0 {
+		i -= len(m.Scale)
+		copy(dAtA[i:], m.Scale)
+		i = encodeVarintRate(dAtA, i, uint64(len(m.Scale)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Withdrawn {
+		i--
+		if m.Withdrawn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -214,6 +352,31 @@ func (m *Rate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRate(uint64(l))
 	}
+	if m.Withdrawn {
+		n += 2
+	}
+	l = len(m.Scale)
+	if l > 0 {
+		n += 1 + l + sovRate(uint64(l))
+	}
+	l = len(m.Grade)
+	if l > 0 {
+		n += 1 + l + sovRate(uint64(l))
+	}
+	if m.Outlook != 0 {
+		n += 1 + sovRate(uint64(m.Outlook))
+	}
+	if m.ReviewDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReviewDate)
+		n += 1 + l + sovRate(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovRate(uint64(l))
+	}
+	if m.Disputed {
+		n += 2
+	}
 	return n
 }
 
@@ -399,6 +562,197 @@ func (m *Rate) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Withdrawn = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scale = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outlook", wireType)
+			}
+			m.Outlook = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outlook |= RatingOutlook(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReviewDate == nil {
+				m.ReviewDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ReviewDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disputed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRate(dAtA[iNdEx:])
@@ -0,0 +1,20 @@
+package types
+
+import "fmt"
+
+// ValidateRepayment validates the kind and the days late of a repayment
+// event. Only late repayments are late by a number of days.
+func ValidateRepayment(kind RepaymentKind, daysLate uint32) error {
+	if _, ok := RepaymentKind_name[int32(kind)]; !ok || kind == RepaymentKind_REPAYMENT_KIND_UNSPECIFIED {
+		return fmt.Errorf("invalid repayment kind %d", kind)
+	}
+
+	switch {
+	case kind == RepaymentKind_REPAYMENT_KIND_LATE && daysLate == 0:
+		return fmt.Errorf("late repayment requires days late")
+	case kind != RepaymentKind_REPAYMENT_KIND_LATE && daysLate != 0:
+		return fmt.Errorf("%s repayment cannot be late", kind)
+	}
+
+	return nil
+}
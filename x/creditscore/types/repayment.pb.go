@@ -0,0 +1,1324 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/creditscore/v1/repayment.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RepaymentKind defines the outcome of a repayment reported by a lender.
+type RepaymentKind int32
+
+const (
+	// REPAYMENT_KIND_UNSPECIFIED is an invalid kind.
+	RepaymentKind_REPAYMENT_KIND_UNSPECIFIED RepaymentKind = 0
+	// REPAYMENT_KIND_ON_TIME is a repayment made when due.
+	RepaymentKind_REPAYMENT_KIND_ON_TIME RepaymentKind = 1
+	// REPAYMENT_KIND_LATE is a repayment made days_late days after it was due.
+	RepaymentKind_REPAYMENT_KIND_LATE RepaymentKind = 2
+	// REPAYMENT_KIND_DEFAULT is a repayment that was never made.
+	RepaymentKind_REPAYMENT_KIND_DEFAULT RepaymentKind = 3
+	// REPAYMENT_KIND_RESTRUCTURED is a loan whose terms were renegotiated.
+	RepaymentKind_REPAYMENT_KIND_RESTRUCTURED RepaymentKind = 4
+)
+
+var RepaymentKind_name = map[int32]string{
+	0: "REPAYMENT_KIND_UNSPECIFIED",
+	1: "REPAYMENT_KIND_ON_TIME",
+	2: "REPAYMENT_KIND_LATE",
+	3: "REPAYMENT_KIND_DEFAULT",
+	4: "REPAYMENT_KIND_RESTRUCTURED",
+}
+
+var RepaymentKind_value = map[string]int32{
+	"REPAYMENT_KIND_UNSPECIFIED":  0,
+	"REPAYMENT_KIND_ON_TIME":      1,
+	"REPAYMENT_KIND_LATE":         2,
+	"REPAYMENT_KIND_DEFAULT":      3,
+	"REPAYMENT_KIND_RESTRUCTURED": 4,
+}
+
+func (x RepaymentKind) String() string {
+	return proto.EnumName(RepaymentKind_name, int32(x))
+}
+
+func (RepaymentKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_10a988c954044c13, []int{0}
+}
+
+// RepaymentEvent records a repayment of a borrower reported by a lender.
+type RepaymentEvent struct {
+	Borrower string        `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	Id       uint64        `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Lender   string        `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
+	Kind     RepaymentKind `protobuf:"varint,4,opt,name=kind,proto3,enum=realfin.creditscore.v1.RepaymentKind" json:"kind,omitempty"`
+	// days_late is the number of days a late repayment was made after it was
+	// due.
+	DaysLate uint32 `protobuf:"varint,5,opt,name=days_late,json=daysLate,proto3" json:"days_late,omitempty"`
+	// reference identifies the loan or installment at the lender.
+	Reference string    `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	Height    int64     `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Time      time.Time `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *RepaymentEvent) Reset()         { *m = RepaymentEvent{} }
+func (m *RepaymentEvent) String() string { return proto.CompactTextString(m) }
+func (*RepaymentEvent) ProtoMessage()    {}
+func (*RepaymentEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a988c954044c13, []int{0}
+}
+func (m *RepaymentEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepaymentEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepaymentEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepaymentEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepaymentEvent.Merge(m, src)
+}
+func (m *RepaymentEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepaymentEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepaymentEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepaymentEvent proto.InternalMessageInfo
+
+func (m *RepaymentEvent) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
+}
+
+func (m *RepaymentEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RepaymentEvent) GetLender() string {
+	if m != nil {
+		return m.Lender
+	}
+	return ""
+}
+
+func (m *RepaymentEvent) GetKind() RepaymentKind {
+	if m != nil {
+		return m.Kind
+	}
+	return RepaymentKind_REPAYMENT_KIND_UNSPECIFIED
+}
+
+func (m *RepaymentEvent) GetDaysLate() uint32 {
+	if m != nil {
+		return m.DaysLate
+	}
+	return 0
+}
+
+func (m *RepaymentEvent) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *RepaymentEvent) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RepaymentEvent) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// ScoreFactor defines the contribution of the repayment events of a kind to
+// a computed score.
+type ScoreFactor struct {
+	Kind RepaymentKind `protobuf:"varint,1,opt,name=kind,proto3,enum=realfin.creditscore.v1.RepaymentKind" json:"kind,omitempty"`
+	// count is the number of events of the kind.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// days_late is the total number of days late of the events of the kind.
+	DaysLate uint64 `protobuf:"varint,3,opt,name=days_late,json=daysLate,proto3" json:"days_late,omitempty"`
+	// impact is the decayed number of points the events add to the score.
+	Impact cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=impact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"impact"`
+}
+
+func (m *ScoreFactor) Reset()         { *m = ScoreFactor{} }
+func (m *ScoreFactor) String() string { return proto.CompactTextString(m) }
+func (*ScoreFactor) ProtoMessage()    {}
+func (*ScoreFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a988c954044c13, []int{1}
+}
+func (m *ScoreFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScoreFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScoreFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScoreFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreFactor.Merge(m, src)
+}
+func (m *ScoreFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScoreFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreFactor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoreFactor proto.InternalMessageInfo
+
+func (m *ScoreFactor) GetKind() RepaymentKind {
+	if m != nil {
+		return m.Kind
+	}
+	return RepaymentKind_REPAYMENT_KIND_UNSPECIFIED
+}
+
+func (m *ScoreFactor) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ScoreFactor) GetDaysLate() uint64 {
+	if m != nil {
+		return m.DaysLate
+	}
+	return 0
+}
+
+// ScoreBreakdown defines the factors that produced a computed score.
+type ScoreBreakdown struct {
+	BaseScore uint64        `protobuf:"varint,1,opt,name=base_score,json=baseScore,proto3" json:"base_score,omitempty"`
+	Factors   []ScoreFactor `protobuf:"bytes,2,rep,name=factors,proto3" json:"factors"`
+	// raw_score is the base score plus the impact of the factors, before the
+	// floor and ceiling of the score model are applied.
+	RawScore cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=raw_score,json=rawScore,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"raw_score"`
+	Score    uint64                      `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	// computed_at is the block time the decay of the events is computed at.
+	ComputedAt time.Time `protobuf:"bytes,5,opt,name=computed_at,json=computedAt,proto3,stdtime" json:"computed_at"`
+}
+
+func (m *ScoreBreakdown) Reset()         { *m = ScoreBreakdown{} }
+func (m *ScoreBreakdown) String() string { return proto.CompactTextString(m) }
+func (*ScoreBreakdown) ProtoMessage()    {}
+func (*ScoreBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a988c954044c13, []int{2}
+}
+func (m *ScoreBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScoreBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScoreBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScoreBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreBreakdown.Merge(m, src)
+}
+func (m *ScoreBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScoreBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoreBreakdown proto.InternalMessageInfo
+
+func (m *ScoreBreakdown) GetBaseScore() uint64 {
+	if m != nil {
+		return m.BaseScore
+	}
+	return 0
+}
+
+func (m *ScoreBreakdown) GetFactors() []ScoreFactor {
+	if m != nil {
+		return m.Factors
+	}
+	return nil
+}
+
+func (m *ScoreBreakdown) GetScore() uint64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *ScoreBreakdown) GetComputedAt() time.Time {
+	if m != nil {
+		return m.ComputedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("realfin.creditscore.v1.RepaymentKind", RepaymentKind_name, RepaymentKind_value)
+	proto.RegisterType((*RepaymentEvent)(nil), "realfin.creditscore.v1.RepaymentEvent")
+	proto.RegisterType((*ScoreFactor)(nil), "realfin.creditscore.v1.ScoreFactor")
+	proto.RegisterType((*ScoreBreakdown)(nil), "realfin.creditscore.v1.ScoreBreakdown")
+}
+
+func init() {
+	proto.RegisterFile("realfin/creditscore/v1/repayment.proto", fileDescriptor_10a988c954044c13)
+}
+
+var fileDescriptor_10a988c954044c13 = []byte{
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x6b, 0x1a, 0x41,
+	0x18, 0x76, 0x74, 0x35, 0x3a, 0x12, 0xb1, 0x53, 0x49, 0xb7, 0xda, 0xaa, 0xa4, 0xb4, 0x48, 0x20,
+	0xbb, 0x4d, 0xfa, 0x01, 0x3d, 0xf4, 0xa0, 0x71, 0x03, 0x36, 0xc6, 0x86, 0x51, 0x0f, 0xed, 0x45,
+	0xc6, 0xdd, 0xd1, 0x2c, 0x71, 0x77, 0x64, 0x76, 0x12, 0xeb, 0xbf, 0xc8, 0x5f, 0xe8, 0xad, 0xd0,
+	0x4b, 0x0f, 0xf9, 0x11, 0x81, 0x5e, 0x42, 0xa0, 0x50, 0x7a, 0x48, 0x4b, 0x72, 0xe8, 0xdf, 0x28,
+	0x3b, 0xbb, 0xe6, 0x8b, 0x94, 0x12, 0x7a, 0x59, 0xf6, 0x79, 0xdf, 0xe7, 0x9d, 0x79, 0x9e, 0xe7,
+	0x85, 0x81, 0x4f, 0x38, 0x25, 0xa3, 0x81, 0xed, 0xea, 0x26, 0xa7, 0x96, 0x2d, 0x3c, 0x93, 0x71,
+	0xaa, 0xef, 0xad, 0xe8, 0x9c, 0x8e, 0xc9, 0xd4, 0xa1, 0xae, 0xd0, 0xc6, 0x9c, 0x09, 0x86, 0x16,
+	0x42, 0x9e, 0x76, 0x89, 0xa7, 0xed, 0xad, 0xe4, 0xef, 0x10, 0xc7, 0x76, 0x99, 0x2e, 0xbf, 0x01,
+	0x35, 0x7f, 0xdf, 0x64, 0x9e, 0xc3, 0xbc, 0x9e, 0x44, 0x7a, 0x00, 0xc2, 0x56, 0x6e, 0xc8, 0x86,
+	0x2c, 0xa8, 0xfb, 0x7f, 0x61, 0xb5, 0x34, 0x64, 0x6c, 0x38, 0xa2, 0xba, 0x44, 0xfd, 0xdd, 0x81,
+	0x2e, 0x6c, 0x87, 0x7a, 0x82, 0x38, 0xe3, 0x80, 0xb0, 0xf8, 0x2d, 0x0a, 0x33, 0x78, 0x26, 0xc8,
+	0xd8, 0xa3, 0xae, 0x40, 0xcf, 0x61, 0xb2, 0xcf, 0x38, 0x67, 0x13, 0xca, 0x55, 0x50, 0x06, 0x95,
+	0x54, 0x4d, 0x3d, 0x3e, 0x58, 0xce, 0x85, 0xb7, 0x55, 0x2d, 0x8b, 0x53, 0xcf, 0x6b, 0x0b, 0x6e,
+	0xbb, 0x43, 0x7c, 0xce, 0x44, 0x19, 0x18, 0xb5, 0x2d, 0x35, 0x5a, 0x06, 0x15, 0x05, 0x47, 0x6d,
+	0x0b, 0x3d, 0x85, 0x89, 0x11, 0x75, 0x2d, 0xca, 0xd5, 0xd8, 0x3f, 0xce, 0x08, 0x79, 0xe8, 0x15,
+	0x54, 0x76, 0x6c, 0xd7, 0x52, 0x95, 0x32, 0xa8, 0x64, 0x56, 0x1f, 0x6b, 0x37, 0xc7, 0xa2, 0x9d,
+	0xab, 0xdd, 0xb0, 0x5d, 0x0b, 0xcb, 0x11, 0x54, 0x80, 0x29, 0x8b, 0x4c, 0xbd, 0xde, 0x88, 0x08,
+	0xaa, 0xc6, 0xcb, 0xa0, 0x32, 0x8f, 0x93, 0x7e, 0xa1, 0x49, 0x04, 0x45, 0x0f, 0x60, 0x8a, 0xd3,
+	0x01, 0xe5, 0xd4, 0x35, 0xa9, 0x9a, 0xf0, 0xc5, 0xe0, 0x8b, 0x02, 0x5a, 0x80, 0x89, 0x6d, 0x6a,
+	0x0f, 0xb7, 0x85, 0x3a, 0x57, 0x06, 0x95, 0x18, 0x0e, 0x11, 0x7a, 0x0d, 0x15, 0x3f, 0x2b, 0x35,
+	0x59, 0x06, 0x95, 0xf4, 0x6a, 0x5e, 0x0b, 0x82, 0xd4, 0x66, 0x41, 0x6a, 0x9d, 0x59, 0x90, 0xb5,
+	0xf9, 0xc3, 0x93, 0x52, 0x64, 0xff, 0x67, 0x09, 0x7c, 0xfa, 0xfd, 0x65, 0x09, 0x60, 0x39, 0xb6,
+	0xf8, 0x15, 0xc0, 0x74, 0xdb, 0x97, 0xbc, 0x4e, 0x4c, 0xc1, 0x2e, 0xcc, 0x81, 0xdb, 0x9b, 0xcb,
+	0xc1, 0xb8, 0xc9, 0x76, 0x5d, 0x11, 0x86, 0x1b, 0x80, 0xab, 0x96, 0x63, 0xb2, 0x73, 0x61, 0xb9,
+	0x05, 0x13, 0xb6, 0x33, 0x26, 0xa6, 0x90, 0x61, 0xa6, 0x6a, 0x2f, 0x7d, 0x89, 0x3f, 0x4e, 0x4a,
+	0x85, 0x60, 0x01, 0x9e, 0xb5, 0xa3, 0xd9, 0x4c, 0x77, 0x88, 0xd8, 0xd6, 0x9a, 0x74, 0x48, 0xcc,
+	0x69, 0x9d, 0x9a, 0xc7, 0x07, 0xcb, 0x30, 0xdc, 0x4f, 0x9d, 0x9a, 0x81, 0x97, 0xf0, 0x94, 0xc5,
+	0xcf, 0x51, 0x98, 0x91, 0x6e, 0x6a, 0x9c, 0x92, 0x1d, 0x8b, 0x4d, 0x5c, 0xf4, 0x10, 0xc2, 0x3e,
+	0xf1, 0x68, 0x4f, 0x4a, 0x97, 0xb6, 0x14, 0x9c, 0xf2, 0x2b, 0x92, 0x87, 0xd6, 0xe0, 0xdc, 0x40,
+	0x3a, 0xf7, 0xd4, 0x68, 0x39, 0x56, 0x49, 0xaf, 0x3e, 0xfa, 0x9b, 0xe5, 0x4b, 0x29, 0xd5, 0x14,
+	0x5f, 0x27, 0x9e, 0x4d, 0xa2, 0x36, 0x4c, 0x71, 0x32, 0x09, 0xaf, 0x88, 0xfd, 0x97, 0x93, 0x24,
+	0x27, 0x93, 0x40, 0x59, 0x0e, 0xc6, 0x83, 0x03, 0x95, 0x20, 0x4e, 0x09, 0xd0, 0x1b, 0x98, 0x36,
+	0x99, 0x33, 0xde, 0x15, 0xd4, 0xea, 0x11, 0xa1, 0xc6, 0x6f, 0xbb, 0x75, 0x38, 0x9b, 0xae, 0x8a,
+	0xa5, 0x8f, 0x00, 0xce, 0x5f, 0x59, 0x24, 0x2a, 0xc2, 0x3c, 0x36, 0xb6, 0xaa, 0xef, 0x36, 0x8d,
+	0x56, 0xa7, 0xb7, 0xd1, 0x68, 0xd5, 0x7b, 0xdd, 0x56, 0x7b, 0xcb, 0x58, 0x6b, 0xac, 0x37, 0x8c,
+	0x7a, 0x36, 0x82, 0xf2, 0x70, 0xe1, 0x5a, 0xff, 0x6d, 0xab, 0xd7, 0x69, 0x6c, 0x1a, 0x59, 0x80,
+	0xee, 0xc1, 0xbb, 0xd7, 0x7a, 0xcd, 0x6a, 0xc7, 0xc8, 0x46, 0x6f, 0x18, 0xaa, 0x1b, 0xeb, 0xd5,
+	0x6e, 0xb3, 0x93, 0x8d, 0xa1, 0x12, 0x2c, 0x5c, 0xeb, 0x61, 0xa3, 0xdd, 0xc1, 0xdd, 0xb5, 0x4e,
+	0x17, 0x1b, 0xf5, 0xac, 0x52, 0x7b, 0x71, 0x78, 0x5a, 0x04, 0x47, 0xa7, 0x45, 0xf0, 0xeb, 0xb4,
+	0x08, 0xf6, 0xcf, 0x8a, 0x91, 0xa3, 0xb3, 0x62, 0xe4, 0xfb, 0x59, 0x31, 0xf2, 0xbe, 0x30, 0x7b,
+	0xb6, 0x3e, 0x5c, 0x79, 0xb8, 0xc4, 0x74, 0x4c, 0xbd, 0x7e, 0x42, 0x26, 0xf1, 0xec, 0xcf, 0x00,
+	0xe6, 0x15, 0x70, 0x33, 0xdc, 0x04, 0x00, 0x00,
+}
+
+func (m *RepaymentEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepaymentEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepaymentEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRepayment(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintRepayment(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintRepayment(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DaysLate != 0 {
+		i = encodeVarintRepayment(dAtA, i, uint64(m.DaysLate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Kind != 0 {
+		i = encodeVarintRepayment(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintRepayment(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintRepayment(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintRepayment(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScoreFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScoreFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoreFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Impact.Size()
+		i -= size
+		if _, err := m.Impact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRepayment(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.DaysLate != 0 {
+		i = encodeVarintRepayment(dAtA, i, uint64(m.DaysLate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintRepayment(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Kind != 0 {
+		i = encodeVarintRepayment(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScoreBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScoreBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoreBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ComputedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ComputedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRepayment(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.Score != 0 {
+		i = encodeVarintRepayment(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.RawScore.Size()
+		i -= size
+		if _, err := m.RawScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRepayment(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Factors) > 0 {
+		for iNdEx := len(m.Factors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Factors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepayment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BaseScore != 0 {
+		i = encodeVarintRepayment(dAtA, i, uint64(m.BaseScore))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRepayment(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRepayment(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RepaymentEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovRepayment(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovRepayment(uint64(m.Id))
+	}
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovRepayment(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovRepayment(uint64(m.Kind))
+	}
+	if m.DaysLate != 0 {
+		n += 1 + sovRepayment(uint64(m.DaysLate))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovRepayment(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRepayment(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovRepayment(uint64(l))
+	return n
+}
+
+func (m *ScoreFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovRepayment(uint64(m.Kind))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRepayment(uint64(m.Count))
+	}
+	if m.DaysLate != 0 {
+		n += 1 + sovRepayment(uint64(m.DaysLate))
+	}
+	l = m.Impact.Size()
+	n += 1 + l + sovRepayment(uint64(l))
+	return n
+}
+
+func (m *ScoreBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseScore != 0 {
+		n += 1 + sovRepayment(uint64(m.BaseScore))
+	}
+	if len(m.Factors) > 0 {
+		for _, e := range m.Factors {
+			l = e.Size()
+			n += 1 + l + sovRepayment(uint64(l))
+		}
+	}
+	l = m.RawScore.Size()
+	n += 1 + l + sovRepayment(uint64(l))
+	if m.Score != 0 {
+		n += 1 + sovRepayment(uint64(m.Score))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ComputedAt)
+	n += 1 + l + sovRepayment(uint64(l))
+	return n
+}
+
+func sovRepayment(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRepayment(x uint64) (n int) {
+	return sovRepayment(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RepaymentEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepaymentEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepaymentEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= RepaymentKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaysLate", wireType)
+			}
+			m.DaysLate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaysLate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScoreFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScoreFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScoreFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= RepaymentKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaysLate", wireType)
+			}
+			m.DaysLate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaysLate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Impact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Impact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScoreBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScoreBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScoreBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseScore", wireType)
+			}
+			m.BaseScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseScore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Factors = append(m.Factors, ScoreFactor{})
+			if err := m.Factors[len(m.Factors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RawScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ComputedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRepayment(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRepayment
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRepayment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRepayment
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRepayment
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRepayment
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRepayment        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRepayment          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRepayment = fmt.Errorf("proto: unexpected end of group")
+)
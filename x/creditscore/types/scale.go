@@ -0,0 +1,113 @@
+package types
+
+import "fmt"
+
+// MaxRate is the maximum rate an agency can publish, above the ceiling of the
+// default score model, so that every rating scale grades rates on the same
+// range.
+const MaxRate = 1000
+
+// ValidateRate returns an error if the rate is out of the range of the rates,
+// from zero to MaxRate.
+func ValidateRate(rate uint64) error {
+	if rate > MaxRate {
+		return fmt.Errorf("rate %d is above the maximum of %d", rate, MaxRate)
+	}
+
+	return nil
+}
+
+// DefaultRatingScale returns the default rating scale, grading the scores of
+// the default score model from AAA to D.
+func DefaultRatingScale() RatingScale {
+	return RatingScale{
+		Name: "default",
+		Bands: []GradeBand{
+			{Grade: "AAA", Min: 800},
+			{Grade: "AA", Min: 750},
+			{Grade: "A", Min: 700},
+			{Grade: "BBB", Min: 650},
+			{Grade: "BB", Min: 600},
+			{Grade: "B", Min: 550},
+			{Grade: "CCC", Min: 500},
+			{Grade: "CC", Min: 450},
+			{Grade: "C", Min: 400},
+			{Grade: "D", Min: 0},
+		},
+	}
+}
+
+// Validate validates the rating scale: the bands are sorted from the best to
+// the worst grade by strictly decreasing minimum, and the worst band starts
+// at zero so that every rate has a grade.
+func (s RatingScale) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("rating scale name cannot be empty")
+	}
+	if len(s.Bands) == 0 {
+		return fmt.Errorf("rating scale %s has no grade", s.Name)
+	}
+
+	grades := make(map[string]struct{})
+	for i, band := range s.Bands {
+		if band.Grade == "" {
+			return fmt.Errorf("rating scale %s has an empty grade", s.Name)
+		}
+		if _, ok := grades[band.Grade]; ok {
+			return fmt.Errorf("rating scale %s has duplicated grade %s", s.Name, band.Grade)
+		}
+		grades[band.Grade] = struct{}{}
+
+		if i > 0 && band.Min >= s.Bands[i-1].Min {
+			return fmt.Errorf("rating scale %s grade %s minimum must be below the minimum of %s", s.Name, band.Grade, s.Bands[i-1].Grade)
+		}
+	}
+	if last := s.Bands[len(s.Bands)-1]; last.Min != 0 {
+		return fmt.Errorf("rating scale %s worst grade %s must start at zero", s.Name, last.Grade)
+	}
+
+	return nil
+}
+
+// Grade returns the grade of the rate and its rank on the scale, zero being
+// the best grade.
+func (s RatingScale) Grade(rate uint64) (string, int) {
+	for i, band := range s.Bands {
+		if rate >= band.Min {
+			return band.Grade, i
+		}
+	}
+
+	return "", len(s.Bands)
+}
+
+// Rank returns the rank of the grade on the scale, zero being the best grade,
+// and whether the grade belongs to the scale.
+func (s RatingScale) Rank(grade string) (int, bool) {
+	for i, band := range s.Bands {
+		if band.Grade == grade {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// Scale returns the rating scale with the given name, the default scale when
+// the name is empty, and whether it exists.
+func (p Params) Scale(name string) (RatingScale, bool) {
+	if len(p.Scales) == 0 {
+		return RatingScale{}, false
+	}
+	if name == "" {
+		return p.Scales[0], true
+	}
+
+	for _, scale := range p.Scales {
+		if scale.Name == name {
+			return scale, true
+		}
+	}
+
+	return RatingScale{}, false
+}
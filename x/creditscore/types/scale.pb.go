@@ -0,0 +1,701 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/creditscore/v1/scale.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RatingOutlook defines the expected direction of a rating.
+type RatingOutlook int32
+
+const (
+	// RATING_OUTLOOK_UNSPECIFIED is a rating without outlook.
+	RatingOutlook_RATING_OUTLOOK_UNSPECIFIED RatingOutlook = 0
+	// RATING_OUTLOOK_POSITIVE is a rating that may be upgraded.
+	RatingOutlook_RATING_OUTLOOK_POSITIVE RatingOutlook = 1
+	// RATING_OUTLOOK_STABLE is a rating that is not expected to change.
+	RatingOutlook_RATING_OUTLOOK_STABLE RatingOutlook = 2
+	// RATING_OUTLOOK_NEGATIVE is a rating that may be downgraded.
+	RatingOutlook_RATING_OUTLOOK_NEGATIVE RatingOutlook = 3
+	// RATING_OUTLOOK_WATCH is a rating under review.
+	RatingOutlook_RATING_OUTLOOK_WATCH RatingOutlook = 4
+)
+
+var RatingOutlook_name = map[int32]string{
+	0: "RATING_OUTLOOK_UNSPECIFIED",
+	1: "RATING_OUTLOOK_POSITIVE",
+	2: "RATING_OUTLOOK_STABLE",
+	3: "RATING_OUTLOOK_NEGATIVE",
+	4: "RATING_OUTLOOK_WATCH",
+}
+
+var RatingOutlook_value = map[string]int32{
+	"RATING_OUTLOOK_UNSPECIFIED": 0,
+	"RATING_OUTLOOK_POSITIVE":    1,
+	"RATING_OUTLOOK_STABLE":      2,
+	"RATING_OUTLOOK_NEGATIVE":    3,
+	"RATING_OUTLOOK_WATCH":       4,
+}
+
+func (x RatingOutlook) String() string {
+	return proto.EnumName(RatingOutlook_name, int32(x))
+}
+
+func (RatingOutlook) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ef5633302491092f, []int{0}
+}
+
+// RatingScale defines how rates are mapped to letter grades.
+type RatingScale struct {
+	// name identifies the scale in the rates graded with it.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// bands are the grades of the scale, from the best to the worst.
+	Bands []GradeBand `protobuf:"bytes,2,rep,name=bands,proto3" json:"bands"`
+}
+
+func (m *RatingScale) Reset()         { *m = RatingScale{} }
+func (m *RatingScale) String() string { return proto.CompactTextString(m) }
+func (*RatingScale) ProtoMessage()    {}
+func (*RatingScale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef5633302491092f, []int{0}
+}
+func (m *RatingScale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatingScale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatingScale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RatingScale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatingScale.Merge(m, src)
+}
+func (m *RatingScale) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatingScale) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatingScale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatingScale proto.InternalMessageInfo
+
+func (m *RatingScale) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RatingScale) GetBands() []GradeBand {
+	if m != nil {
+		return m.Bands
+	}
+	return nil
+}
+
+// GradeBand defines the grade of the rates from min up to the min of the
+// previous, better band.
+type GradeBand struct {
+	Grade string `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	Min   uint64 `protobuf:"varint,2,opt,name=min,proto3" json:"min,omitempty"`
+}
+
+func (m *GradeBand) Reset()         { *m = GradeBand{} }
+func (m *GradeBand) String() string { return proto.CompactTextString(m) }
+func (*GradeBand) ProtoMessage()    {}
+func (*GradeBand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef5633302491092f, []int{1}
+}
+func (m *GradeBand) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GradeBand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GradeBand.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GradeBand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GradeBand.Merge(m, src)
+}
+func (m *GradeBand) XXX_Size() int {
+	return m.Size()
+}
+func (m *GradeBand) XXX_DiscardUnknown() {
+	xxx_messageInfo_GradeBand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GradeBand proto.InternalMessageInfo
+
+func (m *GradeBand) GetGrade() string {
+	if m != nil {
+		return m.Grade
+	}
+	return ""
+}
+
+func (m *GradeBand) GetMin() uint64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("realfin.creditscore.v1.RatingOutlook", RatingOutlook_name, RatingOutlook_value)
+	proto.RegisterType((*RatingScale)(nil), "realfin.creditscore.v1.RatingScale")
+	proto.RegisterType((*GradeBand)(nil), "realfin.creditscore.v1.GradeBand")
+}
+
+func init() {
+	proto.RegisterFile("realfin/creditscore/v1/scale.proto", fileDescriptor_ef5633302491092f)
+}
+
+var fileDescriptor_ef5633302491092f = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x51, 0x4b, 0xf2, 0x50,
+	0x1c, 0xc6, 0x77, 0x74, 0xbe, 0xe0, 0x91, 0x17, 0xc6, 0xc1, 0x6a, 0x29, 0x1c, 0xcd, 0x2b, 0xe9,
+	0x62, 0xc3, 0xa2, 0x8b, 0x82, 0x2e, 0x36, 0x5b, 0x36, 0x12, 0x27, 0xdb, 0x2c, 0xe8, 0x46, 0x8e,
+	0xee, 0x38, 0x46, 0xba, 0x23, 0xdb, 0x92, 0xfa, 0x16, 0x7d, 0x82, 0xe8, 0xe3, 0x78, 0xe9, 0x65,
+	0x57, 0x11, 0x7a, 0xd3, 0xc7, 0x88, 0x6d, 0x16, 0x25, 0xde, 0x3d, 0x7f, 0x9e, 0xe7, 0x77, 0x9e,
+	0x03, 0x0f, 0xac, 0x05, 0x94, 0x8c, 0x47, 0x9e, 0x2f, 0x0f, 0x03, 0xea, 0x78, 0x51, 0x38, 0x64,
+	0x01, 0x95, 0x67, 0x0d, 0x39, 0x1c, 0x92, 0x31, 0x95, 0xa6, 0x01, 0x8b, 0x18, 0xda, 0x5d, 0x67,
+	0xa4, 0x5f, 0x19, 0x69, 0xd6, 0x28, 0x15, 0x5d, 0xe6, 0xb2, 0x24, 0x22, 0xc7, 0x2a, 0x4d, 0xd7,
+	0x46, 0xb0, 0x60, 0x92, 0xc8, 0xf3, 0x5d, 0x2b, 0x7e, 0x02, 0x21, 0xc8, 0xfb, 0x64, 0x42, 0x45,
+	0x50, 0x05, 0xf5, 0xbc, 0x99, 0x68, 0x74, 0x0e, 0x73, 0x03, 0xe2, 0x3b, 0xa1, 0x98, 0xa9, 0x66,
+	0xeb, 0x85, 0xa3, 0x03, 0x69, 0x7b, 0x81, 0xd4, 0x0a, 0x88, 0x43, 0x55, 0xe2, 0x3b, 0x2a, 0x3f,
+	0x7f, 0xaf, 0x70, 0x66, 0x4a, 0x9d, 0xf1, 0x9f, 0xaf, 0x15, 0x50, 0x3b, 0x85, 0xf9, 0x1f, 0x1f,
+	0x15, 0x61, 0xce, 0x8d, 0x8f, 0x75, 0x4d, 0x7a, 0x20, 0x01, 0x66, 0x27, 0x9e, 0x2f, 0x66, 0xaa,
+	0xa0, 0xce, 0x9b, 0xb1, 0x4c, 0xd1, 0xc3, 0x17, 0x00, 0xff, 0xa7, 0x7f, 0x34, 0x1e, 0xa2, 0x31,
+	0x63, 0xf7, 0x08, 0xc3, 0x92, 0xa9, 0xd8, 0x7a, 0xa7, 0xd5, 0x37, 0x7a, 0x76, 0xdb, 0x30, 0xae,
+	0xfb, 0xbd, 0x8e, 0xd5, 0xd5, 0x9a, 0xfa, 0xa5, 0xae, 0x5d, 0x08, 0x1c, 0x2a, 0xc3, 0xbd, 0x0d,
+	0xbf, 0x6b, 0x58, 0xba, 0xad, 0xdf, 0x68, 0x02, 0x40, 0xfb, 0x70, 0x67, 0xc3, 0xb4, 0x6c, 0x45,
+	0x6d, 0x6b, 0x42, 0x66, 0x0b, 0xd7, 0xd1, 0x5a, 0x4a, 0xc2, 0x65, 0x91, 0x08, 0x8b, 0x1b, 0xe6,
+	0xad, 0x62, 0x37, 0xaf, 0x04, 0x5e, 0x3d, 0x99, 0x2f, 0x31, 0x58, 0x2c, 0x31, 0xf8, 0x58, 0x62,
+	0xf0, 0xbc, 0xc2, 0xdc, 0x62, 0x85, 0xb9, 0xb7, 0x15, 0xe6, 0xee, 0xca, 0xdf, 0x7b, 0x3d, 0xfe,
+	0x59, 0x2c, 0x7a, 0x9a, 0xd2, 0x70, 0xf0, 0x2f, 0x59, 0xe0, 0xf8, 0x6b, 0x00, 0xcb, 0x31, 0x59,
+	0x6b, 0xd5, 0x01, 0x00, 0x00,
+}
+
+func (this *RatingScale) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RatingScale)
+	if !ok {
+		that2, ok := that.(RatingScale)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Bands) != len(that1.Bands) {
+		return false
+	}
+	for i := range this.Bands {
+		if !this.Bands[i].Equal(&that1.Bands[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GradeBand) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GradeBand)
+	if !ok {
+		that2, ok := that.(GradeBand)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Grade != that1.Grade {
+		return false
+	}
+	if this.Min != that1.Min {
+		return false
+	}
+	return true
+}
+func (m *RatingScale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RatingScale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatingScale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bands) > 0 {
+		for iNdEx := len(m.Bands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScale(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintScale(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GradeBand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GradeBand) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GradeBand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Min != 0 {
+		i = encodeVarintScale(dAtA, i, uint64(m.Min))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Grade) > 0 {
+		i -= len(m.Grade)
+		copy(dAtA[i:], m.Grade)
+		i = encodeVarintScale(dAtA, i, uint64(len(m.Grade)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScale(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScale(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RatingScale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovScale(uint64(l))
+	}
+	if len(m.Bands) > 0 {
+		for _, e := range m.Bands {
+			l = e.Size()
+			n += 1 + l + sovScale(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GradeBand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grade)
+	if l > 0 {
+		n += 1 + l + sovScale(uint64(l))
+	}
+	if m.Min != 0 {
+		n += 1 + sovScale(uint64(m.Min))
+	}
+	return n
+}
+
+func sovScale(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScale(x uint64) (n int) {
+	return sovScale(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RatingScale) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScale
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RatingScale: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RatingScale: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScale
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bands = append(m.Bands, GradeBand{})
+			if err := m.Bands[len(m.Bands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScale(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScale
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GradeBand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScale
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GradeBand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GradeBand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			m.Min = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Min |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScale(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScale
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScale(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScale
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScale
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScale
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScale
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScale
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScale
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScale        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScale          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScale = fmt.Errorf("proto: unexpected end of group")
+)
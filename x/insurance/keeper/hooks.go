@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/insurance/types"
+	oracletypes "realfin/x/oracle/types"
+)
+
+var _ oracletypes.OracleHooks = OracleHooks{}
+
+// OracleHooks re-checks the coverage of the policies when the oracle price of
+// their insured asset changes.
+type OracleHooks struct {
+	k Keeper
+}
+
+// OracleHooks returns the oracle hooks of the module.
+func (k Keeper) OracleHooks() OracleHooks {
+	return OracleHooks{k: k}
+}
+
+// AfterPriceUpdated emits a coverage_revalued event for every policy of the
+// asset when the rate of its price changes. Policies whose coverage percentage
+// is not a number between 0 and 100 cannot be valued and are skipped.
+func (h OracleHooks) AfterPriceUpdated(ctx context.Context, symbol string, oldPrice, newPrice oracletypes.Price) error {
+	if oldPrice.Rate == newPrice.Rate {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return h.walkAssetPolicies(ctx, symbol, func(policy types.Policy) error {
+		percentage, ok := coveragePercentage(policy)
+		if !ok {
+			return nil
+		}
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCoverageRevalued,
+			sdk.NewAttribute(types.AttributeKeyPolicyID, policy.PolicyId),
+			sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+			sdk.NewAttribute(types.AttributeKeyCoveragePercentage, policy.CoveragePercentage),
+			sdk.NewAttribute(types.AttributeKeyOldCoveredValue, coveredValue(oldPrice.Rate, percentage).String()),
+			sdk.NewAttribute(types.AttributeKeyNewCoveredValue, coveredValue(newPrice.Rate, percentage).String()),
+			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(uint64(newPrice.Decimals), 10)),
+			sdk.NewAttribute(types.AttributeKeyQuote, newPrice.Quote),
+		))
+		return nil
+	})
+}
+
+// AfterPriceDeleted emits a coverage_unpriced event for every policy of the
+// asset when its price is deleted, as their coverage can no longer be valued.
+func (h OracleHooks) AfterPriceDeleted(ctx context.Context, symbol string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return h.walkAssetPolicies(ctx, symbol, func(policy types.Policy) error {
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCoverageUnpriced,
+			sdk.NewAttribute(types.AttributeKeyPolicyID, policy.PolicyId),
+			sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		))
+		return nil
+	})
+}
+
+// walkAssetPolicies calls fn for every policy insuring the asset, in policy id
+// order.
+func (h OracleHooks) walkAssetPolicies(ctx context.Context, symbol string, fn func(types.Policy) error) error {
+	iter, err := h.k.Policy.Indexes.Asset.MatchExact(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		policyID, err := iter.PrimaryKey()
+		if err != nil {
+			return err
+		}
+		policy, err := h.k.Policy.Get(ctx, policyID)
+		if err != nil {
+			return err
+		}
+		if err := fn(policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// coveragePercentage returns the coverage percentage of the policy, or false
+// when it is not a number between 0 and 100.
+func coveragePercentage(policy types.Policy) (sdkmath.LegacyDec, bool) {
+	percentage, err := sdkmath.LegacyNewDecFromStr(policy.CoveragePercentage)
+	if err != nil || percentage.IsNegative() || percentage.GT(sdkmath.LegacyNewDec(100)) {
+		return sdkmath.LegacyDec{}, false
+	}
+
+	return percentage, true
+}
+
+// coveredValue returns the value covered by a policy of the percentage for an
+// asset of the rate, in the units of the rate.
+func coveredValue(rate uint64, percentage sdkmath.LegacyDec) sdkmath.Int {
+	return percentage.MulInt(sdkmath.NewIntFromUint64(rate)).QuoInt64(100).TruncateInt()
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/insurance/types"
+	oracletypes "realfin/x/oracle/types"
+)
+
+func TestOracleHooks(t *testing.T) {
+	f := initFixture(t)
+	hooks := f.keeper.OracleHooks()
+	require.NoError(t, f.keeper.Policy.Set(f.ctx, "p1", types.Policy{PolicyId: "p1", AssetSymbol: "VILLA", CoveragePercentage: "80"}))
+	require.NoError(t, f.keeper.Policy.Set(f.ctx, "p2", types.Policy{PolicyId: "p2", AssetSymbol: "VILLA", CoveragePercentage: "full"}))
+	require.NoError(t, f.keeper.Policy.Set(f.ctx, "p3", types.Policy{PolicyId: "p3", AssetSymbol: "LOFT", CoveragePercentage: "50"}))
+
+	events := func(run func(ctx sdk.Context)) sdk.Events {
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+		run(ctx)
+		return ctx.EventManager().Events()
+	}
+
+	old := oracletypes.Price{Symbol: "VILLA", Rate: 1000, Decimals: 2, Quote: "USD"}
+	updated := oracletypes.Price{Symbol: "VILLA", Rate: 1250, Decimals: 2, Quote: "USD"}
+	emitted := events(func(ctx sdk.Context) {
+		require.NoError(t, hooks.AfterPriceUpdated(ctx, "VILLA", old, updated))
+	})
+	// the policy with an invalid coverage percentage is skipped
+	require.Len(t, emitted, 1)
+	require.Equal(t, types.EventTypeCoverageRevalued, emitted[0].Type)
+	for key, expected := range map[string]string{
+		types.AttributeKeyPolicyID:        "p1",
+		types.AttributeKeyOldCoveredValue: "800",
+		types.AttributeKeyNewCoveredValue: "1000",
+		types.AttributeKeyQuote:           "USD",
+	} {
+		value, ok := emitted[0].GetAttribute(key)
+		require.True(t, ok, key)
+		require.Equal(t, expected, value.Value, key)
+	}
+
+	// unchanged rates and assets without policies are ignored
+	require.Empty(t, events(func(ctx sdk.Context) {
+		require.NoError(t, hooks.AfterPriceUpdated(ctx, "VILLA", updated, updated))
+		require.NoError(t, hooks.AfterPriceUpdated(ctx, "ETH", old, updated))
+		require.NoError(t, hooks.AfterPriceDeleted(ctx, "ETH"))
+	}))
+
+	// policies follow their asset when it changes
+	require.NoError(t, f.keeper.Policy.Set(f.ctx, "p3", types.Policy{PolicyId: "p3", AssetSymbol: "VILLA", CoveragePercentage: "50"}))
+	emitted = events(func(ctx sdk.Context) {
+		require.NoError(t, hooks.AfterPriceDeleted(ctx, "VILLA"))
+		require.NoError(t, hooks.AfterPriceDeleted(ctx, "LOFT"))
+	})
+	require.Len(t, emitted, 3)
+	for i, policyID := range []string{"p1", "p2", "p3"} {
+		require.Equal(t, types.EventTypeCoverageUnpriced, emitted[i].Type)
+		value, ok := emitted[i].GetAttribute(types.AttributeKeyPolicyID)
+		require.True(t, ok)
+		require.Equal(t, policyID, value.Value)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -11,6 +12,29 @@ import (
 	"realfin/x/insurance/types"
 )
 
+// PolicyIndexes are the indexes of the policies, keyed by policy id.
+type PolicyIndexes struct {
+	// Asset indexes the policies by the symbol of their insured asset.
+	Asset *indexes.Multi[string, string, types.Policy]
+}
+
+// IndexesList implements collections.Indexes.
+func (i PolicyIndexes) IndexesList() []collections.Index[string, types.Policy] {
+	return []collections.Index[string, types.Policy]{i.Asset}
+}
+
+func newPolicyIndexes(sb *collections.SchemaBuilder) PolicyIndexes {
+	return PolicyIndexes{
+		Asset: indexes.NewMulti(
+			sb, types.PolicyAssetIndexKey, "policy_by_asset",
+			collections.StringKey, collections.StringKey,
+			func(_ string, policy types.Policy) (string, error) {
+				return policy.AssetSymbol, nil
+			},
+		),
+	}
+}
+
 type Keeper struct {
 	storeService corestore.KVStoreService
 	cdc          codec.Codec
@@ -21,7 +45,7 @@ type Keeper struct {
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
-	Policy collections.Map[string, types.Policy]
+	Policy *collections.IndexedMap[string, types.Policy, PolicyIndexes]
 }
 
 func NewKeeper(
@@ -44,7 +68,12 @@ func NewKeeper(
 		authority:    authority,
 
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		Policy: collections.NewMap(sb, types.PolicyKey, "policy", collections.StringKey, codec.CollValue[types.Policy](cdc))}
+		Policy: collections.NewIndexedMap(
+			sb, types.PolicyKey, "policy",
+			collections.StringKey, codec.CollValue[types.Policy](cdc),
+			newPolicyIndexes(sb),
+		),
+	}
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/insurance/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, indexing the policies by the
+// symbol of their insured asset.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var policies []types.Policy
+	if err := m.keeper.Policy.Walk(ctx, nil, func(_ string, policy types.Policy) (bool, error) {
+		policies = append(policies, policy)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if err := m.keeper.Policy.Set(ctx, policy.PolicyId, policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/insurance/keeper"
+	"realfin/x/insurance/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	policies := []types.Policy{
+		{PolicyId: "p1", AssetSymbol: "VILLA", CoveragePercentage: "80"},
+		{PolicyId: "p2", AssetSymbol: "LOFT", CoveragePercentage: "50"},
+		{PolicyId: "p3", AssetSymbol: "VILLA", CoveragePercentage: "20"},
+	}
+	for _, policy := range policies {
+		require.NoError(t, f.keeper.Policy.Set(f.ctx, policy.PolicyId, policy))
+	}
+
+	// version 1 had no index of the policies by asset
+	for _, policy := range policies {
+		require.NoError(t, f.keeper.Policy.Indexes.Asset.Unreference(f.ctx, policy.PolicyId, func() (types.Policy, error) {
+			return policy, nil
+		}))
+	}
+	require.Empty(t, assetPolicies(t, f, "VILLA"))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(sdk.UnwrapSDKContext(f.ctx)))
+
+	require.Equal(t, []string{"p1", "p3"}, assetPolicies(t, f, "VILLA"))
+	require.Equal(t, []string{"p2"}, assetPolicies(t, f, "LOFT"))
+}
+
+func assetPolicies(t *testing.T, f *fixture, symbol string) []string {
+	t.Helper()
+
+	iter, err := f.keeper.Policy.Indexes.Asset.MatchExact(f.ctx, symbol)
+	require.NoError(t, err)
+	defer iter.Close()
+
+	policyIDs, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	return policyIDs
+}
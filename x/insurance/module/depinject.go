@@ -11,6 +11,7 @@ import (
 
 	"realfin/x/insurance/keeper"
 	"realfin/x/insurance/types"
+	oracletypes "realfin/x/oracle/types"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -42,6 +43,7 @@ type ModuleOutputs struct {
 
 	InsuranceKeeper keeper.Keeper
 	Module          appmodule.AppModule
+	OracleHooks     oracletypes.OracleHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -58,5 +60,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{InsuranceKeeper: k, Module: m}
+	return ModuleOutputs{
+		InsuranceKeeper: k,
+		Module:          m,
+		OracleHooks:     oracletypes.OracleHooksWrapper{OracleHooks: k.OracleHooks()},
+	}
 }
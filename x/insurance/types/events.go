@@ -0,0 +1,15 @@
+package types
+
+// insurance module event types
+const (
+	EventTypeCoverageRevalued = "coverage_revalued"
+	EventTypeCoverageUnpriced = "coverage_unpriced"
+
+	AttributeKeyPolicyID           = "policy_id"
+	AttributeKeySymbol             = "symbol"
+	AttributeKeyCoveragePercentage = "coverage_percentage"
+	AttributeKeyOldCoveredValue    = "old_covered_value"
+	AttributeKeyNewCoveredValue    = "new_covered_value"
+	AttributeKeyDecimals           = "decimals"
+	AttributeKeyQuote              = "quote"
+)
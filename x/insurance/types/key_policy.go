@@ -2,5 +2,9 @@ package types
 
 import "cosmossdk.io/collections"
 
-// PolicyKey is the prefix to retrieve all Policy
-var PolicyKey = collections.NewPrefix("policy/value/")
+var (
+	// PolicyKey is the prefix to retrieve all Policy
+	PolicyKey = collections.NewPrefix("policy/value/")
+	// PolicyAssetIndexKey is the prefix of the index of the policies by insured asset
+	PolicyAssetIndexKey = collections.NewPrefix("policy/asset/")
+)
@@ -0,0 +1,277 @@
+package abci
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+// ProposalHandler injects the prices aggregated from the vote extensions into
+// block proposals, verifies them in other validators' proposals and writes
+// them into the oracle state before the block is executed.
+type ProposalHandler struct {
+	logger          log.Logger
+	keeper          keeper.Keeper
+	valStore        baseapp.ValidatorStore
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+// NewProposalHandler returns a new ProposalHandler wrapping the given default
+// prepare and process proposal handlers, which keep handling the regular
+// transactions of the block.
+func NewProposalHandler(
+	logger log.Logger,
+	keeper keeper.Keeper,
+	valStore baseapp.ValidatorStore,
+	prepareProposal sdk.PrepareProposalHandler,
+	processProposal sdk.ProcessProposalHandler,
+) *ProposalHandler {
+	return &ProposalHandler{
+		logger:          logger.With("module", "x/"+types.ModuleName),
+		keeper:          keeper,
+		valStore:        valStore,
+		prepareProposal: prepareProposal,
+		processProposal: processProposal,
+	}
+}
+
+// PrepareProposal returns the handler injecting the aggregated oracle prices
+// as the first transaction of the proposal.
+func (h *ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return h.prepareProposal(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, err
+		}
+
+		commitBz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		injected := types.InjectedOraclePrices{
+			Prices:             AggregateVoteExtensions(h.logger, req.LocalLastCommit),
+			ExtendedCommitInfo: commitBz,
+		}
+		bz, err := injected.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		// leave room for the injected prices in the block
+		defaultReq := *req
+		defaultReq.MaxTxBytes -= int64(len(bz))
+
+		resp, err := h.prepareProposal(ctx, &defaultReq)
+		if err != nil {
+			return nil, err
+		}
+		resp.Txs = append([][]byte{bz}, resp.Txs...)
+
+		return resp, nil
+	}
+}
+
+// ProcessProposal returns the handler verifying the injected oracle prices
+// against the extended commit they claim to be computed from.
+func (h *ProposalHandler) ProcessProposal() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return h.processProposal(ctx, req)
+		}
+
+		if err := h.verifyInjectedPrices(ctx, req); err != nil {
+			h.logger.Error("rejecting proposal with invalid oracle prices", "height", req.Height, "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		defaultReq := *req
+		defaultReq.Txs = req.Txs[1:]
+
+		return h.processProposal(ctx, &defaultReq)
+	}
+}
+
+// PreBlocker returns a pre-blocker running next and then writing the prices
+// injected in the block into the oracle state. The prices are therefore
+// stored after the upgrade and auth pre-blockers, but before any begin
+// blocker or transaction of the block runs. The block was already accepted by
+// ProcessProposal, so an injection that cannot be decoded or a price that
+// cannot be written is logged and skipped rather than halting the chain: each
+// price is written on a cached context, discarded when it fails.
+//
+// The injected prices stay the first transaction of the block and are
+// delivered with the other transactions. They are not a valid transaction, so
+// every block with vote extensions records a failed transaction at index 0.
+func (h *ProposalHandler) PreBlocker(next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		res, err := next(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if !voteExtensionsEnabled(ctx, req.Height) || len(req.Txs) == 0 {
+			return res, nil
+		}
+
+		var injected types.InjectedOraclePrices
+		if err := injected.Unmarshal(req.Txs[0]); err != nil {
+			h.logger.Error("failed to decode injected oracle prices", "height", req.Height, "err", err)
+			return res, nil
+		}
+
+		for _, price := range injected.Prices {
+			cacheCtx, write := ctx.CacheContext()
+			if err := h.keeper.SetAggregatedRate(cacheCtx, price.Symbol, price.Rate); err != nil {
+				// validators may observe symbols that are not registered
+				if !errors.Is(err, types.ErrSymbolNotRegistered) {
+					h.logger.Error("failed to write injected oracle price", "height", req.Height, "symbol", price.Symbol, "err", err)
+				}
+				continue
+			}
+			write()
+		}
+
+		return res, nil
+	}
+}
+
+func (h *ProposalHandler) verifyInjectedPrices(ctx sdk.Context, req *abci.RequestProcessProposal) error {
+	if len(req.Txs) == 0 {
+		return errors.New("missing injected oracle prices")
+	}
+
+	var injected types.InjectedOraclePrices
+	if err := injected.Unmarshal(req.Txs[0]); err != nil {
+		return fmt.Errorf("failed to decode injected oracle prices: %w", err)
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(injected.ExtendedCommitInfo); err != nil {
+		return fmt.Errorf("failed to decode extended commit info: %w", err)
+	}
+
+	if err := validateProposedLastCommit(extCommit, req.ProposedLastCommit); err != nil {
+		return err
+	}
+	if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), extCommit); err != nil {
+		return err
+	}
+
+	expected := AggregateVoteExtensions(h.logger, extCommit)
+	if len(expected) != len(injected.Prices) {
+		return fmt.Errorf("expected %d injected prices, got %d", len(expected), len(injected.Prices))
+	}
+	for i := range expected {
+		if expected[i] != injected.Prices[i] {
+			return fmt.Errorf("injected price %s=%d does not match aggregate %s=%d",
+				injected.Prices[i].Symbol, injected.Prices[i].Rate, expected[i].Symbol, expected[i].Rate)
+		}
+	}
+
+	return nil
+}
+
+// validateProposedLastCommit returns an error unless the injected extended
+// commit holds the votes of the last commit of the proposal, with the same
+// block id flags. Otherwise a proposer could drop up to a third of the votes
+// and still pass the vote extension validation, moving the medians.
+func validateProposedLastCommit(extCommit abci.ExtendedCommitInfo, lastCommit abci.CommitInfo) error {
+	if extCommit.Round != lastCommit.Round {
+		return fmt.Errorf("extended commit round %d does not match last commit round %d", extCommit.Round, lastCommit.Round)
+	}
+	if len(extCommit.Votes) != len(lastCommit.Votes) {
+		return fmt.Errorf("extended commit has %d votes, last commit has %d", len(extCommit.Votes), len(lastCommit.Votes))
+	}
+
+	for i, vote := range extCommit.Votes {
+		expected := lastCommit.Votes[i]
+		if !bytes.Equal(vote.Validator.Address, expected.Validator.Address) || vote.Validator.Power != expected.Validator.Power {
+			return fmt.Errorf("extended commit vote %d of validator %X does not match last commit vote of validator %X",
+				i, vote.Validator.Address, expected.Validator.Address)
+		}
+		if vote.BlockIdFlag != expected.BlockIdFlag {
+			return fmt.Errorf("extended commit vote of validator %X has block id flag %s, last commit has %s",
+				vote.Validator.Address, vote.BlockIdFlag, expected.BlockIdFlag)
+		}
+	}
+
+	return nil
+}
+
+// AggregateVoteExtensions computes, for every symbol, the median of the
+// reported rates weighted by the voting power of the reporting validators.
+// A symbol is only aggregated when validators holding more than half of the
+// committed voting power reported it. Undecodable or invalid extensions are
+// ignored. The result is sorted by symbol.
+func AggregateVoteExtensions(logger log.Logger, extCommit abci.ExtendedCommitInfo) []types.VotePrice {
+	var (
+		totalPower int64
+		rates      = make(map[string][]types.WeightedRate)
+		power      = make(map[string]int64)
+	)
+	for _, vote := range extCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || vote.Validator.Power <= 0 {
+			continue
+		}
+		totalPower += vote.Validator.Power
+
+		if len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		var ve types.OracleVoteExtension
+		if err := ve.Unmarshal(vote.VoteExtension); err != nil {
+			logger.Debug("skipping undecodable oracle vote extension", "validator", fmt.Sprintf("%X", vote.Validator.Address), "err", err)
+			continue
+		}
+		if err := ve.Validate(); err != nil {
+			logger.Debug("skipping invalid oracle vote extension", "validator", fmt.Sprintf("%X", vote.Validator.Address), "err", err)
+			continue
+		}
+
+		for _, price := range ve.Prices {
+			rates[price.Symbol] = append(rates[price.Symbol], types.WeightedRate{Rate: price.Rate, Weight: uint64(vote.Validator.Power)})
+			power[price.Symbol] += vote.Validator.Power
+		}
+	}
+
+	prices := make([]types.VotePrice, 0, len(rates))
+	for symbol, symbolRates := range rates {
+		if power[symbol]*2 <= totalPower {
+			continue
+		}
+
+		rate, ok := types.WeightedMedian(symbolRates)
+		if !ok {
+			continue
+		}
+		prices = append(prices, types.VotePrice{Symbol: symbol, Rate: rate})
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].Symbol < prices[j].Symbol
+	})
+
+	return prices
+}
+
+// voteExtensionsEnabled reports whether the proposal at the given height
+// carries the vote extensions of the previous height.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
@@ -0,0 +1,391 @@
+package abci_test
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+	"testing"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/stretchr/testify/require"
+
+	keepertest "realfin/testutil/keeper"
+	oracleabci "realfin/x/oracle/abci"
+	"realfin/x/oracle/keeper"
+	module "realfin/x/oracle/module"
+	"realfin/x/oracle/types"
+)
+
+const (
+	chainID      = "realfin-test"
+	enableHeight = 2
+)
+
+type validator struct {
+	key   ed25519.PrivKey
+	power int64
+}
+
+type valStore map[string]cmtprotocrypto.PublicKey
+
+func (s valStore) GetPubKeyByConsAddr(_ context.Context, addr sdk.ConsAddress) (cmtprotocrypto.PublicKey, error) {
+	return s[string(addr)], nil
+}
+
+type blockInfo struct{ lastCommit abci.ExtendedCommitInfo }
+
+func (blockInfo) GetEvidence() comet.EvidenceList   { return nil }
+func (blockInfo) GetValidatorsHash() []byte         { return nil }
+func (blockInfo) GetProposerAddress() []byte        { return nil }
+func (b blockInfo) GetLastCommit() comet.CommitInfo { return commitInfo(b) }
+
+type commitInfo struct{ lastCommit abci.ExtendedCommitInfo }
+
+func (c commitInfo) Round() int32           { return c.lastCommit.Round }
+func (c commitInfo) Votes() comet.VoteInfos { return voteInfos(c) }
+
+type voteInfos struct{ lastCommit abci.ExtendedCommitInfo }
+
+func (v voteInfos) Len() int { return len(v.lastCommit.Votes) }
+func (v voteInfos) Get(i int) comet.VoteInfo {
+	return voteInfo{vote: v.lastCommit.Votes[i]}
+}
+
+type voteInfo struct{ vote abci.ExtendedVoteInfo }
+
+func (v voteInfo) Validator() comet.Validator        { return cometValidator{val: v.vote.Validator} }
+func (v voteInfo) GetBlockIDFlag() comet.BlockIDFlag { return comet.BlockIDFlag(v.vote.BlockIdFlag) }
+
+type cometValidator struct{ val abci.Validator }
+
+func (v cometValidator) Address() []byte { return v.val.Address }
+func (v cometValidator) Power() int64    { return v.val.Power }
+
+type proposalFixture struct {
+	ctx        sdk.Context
+	keeper     keeper.Keeper
+	validators []validator
+	valStore   valStore
+	handler    *oracleabci.ProposalHandler
+}
+
+func initProposalFixture(t *testing.T, powers ...int64) *proposalFixture {
+	t.Helper()
+
+	env := keepertest.NewEnv(t, types.StoreKey, module.AppModule{})
+	ctx := sdk.UnwrapSDKContext(env.Ctx)
+
+	k := keeper.NewKeeper(
+		env.StoreService,
+		env.Codec,
+		env.AddressCodec,
+		authtypes.NewModuleAddress(types.GovModuleName),
+		nil,
+		nil,
+	)
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+
+	store := make(valStore)
+	validators := make([]validator, len(powers))
+	for i, power := range powers {
+		key := ed25519.GenPrivKey()
+		pubKey, err := cryptoenc.PubKeyToProto(key.PubKey())
+		require.NoError(t, err)
+		store[string(key.PubKey().Address())] = pubKey
+		validators[i] = validator{key: key, power: power}
+	}
+
+	ctx = ctx.
+		WithChainID(chainID).
+		WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: enableHeight}})
+
+	noopPrepare := func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	}
+	acceptProcess := func(sdk.Context, *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+
+	return &proposalFixture{
+		ctx:        ctx,
+		keeper:     k,
+		validators: validators,
+		valStore:   store,
+		handler:    oracleabci.NewProposalHandler(log.NewNopLogger(), k, store, noopPrepare, acceptProcess),
+	}
+}
+
+// extendedCommit returns the signed extended commit of height-1 where every
+// validator reported the given prices.
+func (f *proposalFixture) extendedCommit(t *testing.T, height int64, prices ...map[string]uint64) abci.ExtendedCommitInfo {
+	t.Helper()
+
+	var extCommit abci.ExtendedCommitInfo
+	for i, val := range f.validators {
+		ve := types.OracleVoteExtension{}
+		for _, symbol := range []string{"BTC", "ETH"} {
+			if rate, ok := prices[i][symbol]; ok {
+				ve.Prices = append(ve.Prices, types.VotePrice{Symbol: symbol, Rate: rate})
+			}
+		}
+		bz, err := ve.Marshal()
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, protoio.NewDelimitedWriter(&buf).WriteMsg(&cmtproto.CanonicalVoteExtension{
+			Extension: bz,
+			Height:    height - 1,
+			ChainId:   chainID,
+		}))
+		sig, err := val.key.Sign(buf.Bytes())
+		require.NoError(t, err)
+
+		extCommit.Votes = append(extCommit.Votes, abci.ExtendedVoteInfo{
+			Validator:          abci.Validator{Address: val.key.PubKey().Address(), Power: val.power},
+			VoteExtension:      bz,
+			ExtensionSignature: sig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+	}
+
+	// comet orders votes by descending power, then by address
+	slices.SortFunc(extCommit.Votes, func(a, b abci.ExtendedVoteInfo) int {
+		if a.Validator.Power != b.Validator.Power {
+			return cmp.Compare(b.Validator.Power, a.Validator.Power)
+		}
+		return bytes.Compare(a.Validator.Address, b.Validator.Address)
+	})
+
+	return extCommit
+}
+
+// lastCommit returns the last commit of a proposal built on the extended
+// commit.
+func lastCommit(extCommit abci.ExtendedCommitInfo) abci.CommitInfo {
+	commit := abci.CommitInfo{Round: extCommit.Round}
+	for _, vote := range extCommit.Votes {
+		commit.Votes = append(commit.Votes, abci.VoteInfo{Validator: vote.Validator, BlockIdFlag: vote.BlockIdFlag})
+	}
+	return commit
+}
+
+func (f *proposalFixture) contextAt(height int64, lastCommit abci.ExtendedCommitInfo) sdk.Context {
+	return f.ctx.
+		WithBlockHeight(height).
+		WithHeaderInfo(header.Info{Height: height, ChainID: chainID}).
+		WithCometInfo(blockInfo{lastCommit: lastCommit})
+}
+
+func TestAggregateVoteExtensions(t *testing.T) {
+	f := initProposalFixture(t, 10, 20, 30, 40)
+	extCommit := f.extendedCommit(t, 5,
+		map[string]uint64{"ETH": 100, "BTC": 1000},
+		map[string]uint64{"ETH": 110},
+		map[string]uint64{"ETH": 120},
+		map[string]uint64{"ETH": 130, "BTC": 1100},
+	)
+
+	// BTC is reported by half of the power only
+	prices := oracleabci.AggregateVoteExtensions(log.NewNopLogger(), extCommit)
+	require.Equal(t, []types.VotePrice{{Symbol: "ETH", Rate: 120}}, prices)
+
+	// absent validators and garbage extensions do not count
+	extCommit.Votes[1].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+	extCommit.Votes[2].VoteExtension = []byte{0xff}
+	prices = oracleabci.AggregateVoteExtensions(log.NewNopLogger(), extCommit)
+	require.Equal(t, []types.VotePrice{
+		{Symbol: "BTC", Rate: 1100},
+		{Symbol: "ETH", Rate: 130},
+	}, prices)
+}
+
+func TestProposalLifecycle(t *testing.T) {
+	f := initProposalFixture(t, 10, 10, 10)
+	height := int64(5)
+	extCommit := f.extendedCommit(t, height,
+		map[string]uint64{"ETH": 100, "BTC": 1000},
+		map[string]uint64{"ETH": 101, "BTC": 1000},
+		map[string]uint64{"ETH": 150, "BTC": 1000},
+	)
+	ctx := f.contextAt(height, extCommit)
+	require.NoError(t, f.keeper.Symbol.Set(ctx, "ETH", types.SymbolInfo{Symbol: "ETH", Base: "ETH", Quote: "USD", Decimals: 2}))
+
+	prepared, err := f.handler.PrepareProposal()(ctx, &abci.RequestPrepareProposal{
+		Height:          height,
+		MaxTxBytes:      1 << 20,
+		Txs:             [][]byte{[]byte("tx")},
+		LocalLastCommit: extCommit,
+	})
+	require.NoError(t, err)
+	require.Len(t, prepared.Txs, 2)
+	require.Equal(t, []byte("tx"), prepared.Txs[1])
+
+	var injected types.InjectedOraclePrices
+	require.NoError(t, injected.Unmarshal(prepared.Txs[0]))
+	require.Equal(t, []types.VotePrice{{Symbol: "BTC", Rate: 1000}, {Symbol: "ETH", Rate: 101}}, injected.Prices)
+
+	processed, err := f.handler.ProcessProposal()(ctx, &abci.RequestProcessProposal{
+		Height:             height,
+		Txs:                prepared.Txs,
+		ProposedLastCommit: lastCommit(extCommit),
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processed.Status)
+
+	// the pre-blocker runs the next pre-blocker and stores the injected prices
+	var nextCalled bool
+	next := func(sdk.Context, *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		nextCalled = true
+		return &sdk.ResponsePreBlock{}, nil
+	}
+	_, err = f.handler.PreBlocker(next)(ctx, &abci.RequestFinalizeBlock{Height: height, Txs: prepared.Txs})
+	require.NoError(t, err)
+	require.True(t, nextCalled)
+
+	price, err := f.keeper.Price.Get(ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, uint64(101), price.Rate)
+	require.Equal(t, uint32(2), price.Decimals)
+	require.Equal(t, "USD", price.Quote)
+
+	// the prices of unregistered symbols are skipped
+	found, err := f.keeper.Price.Has(ctx, "BTC")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestPreBlockerSkipsFailures(t *testing.T) {
+	f := initProposalFixture(t, 10)
+	height := int64(5)
+	ctx := f.contextAt(height, f.extendedCommit(t, height, map[string]uint64{"ETH": 100}))
+	require.NoError(t, f.keeper.Symbol.Set(ctx, "ETH", types.SymbolInfo{Symbol: "ETH", Base: "ETH"}))
+	next := func(sdk.Context, *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		return &sdk.ResponsePreBlock{}, nil
+	}
+
+	injected, err := (&types.InjectedOraclePrices{Prices: []types.VotePrice{{Symbol: "ETH", Rate: 100}}}).Marshal()
+	require.NoError(t, err)
+
+	// the injection is delivered with the transactions of the block, as a
+	// transaction that fails to decode
+	_, err = moduletestutil.MakeTestEncodingConfig().TxConfig.TxDecoder()(injected)
+	require.Error(t, err)
+
+	// an undecodable injection does not halt the chain
+	_, err = f.handler.PreBlocker(next)(ctx, &abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{{0xff}}})
+	require.NoError(t, err)
+
+	// neither does a price that cannot be written, which is skipped
+	require.NoError(t, f.keeper.Params.Remove(ctx))
+	_, err = f.handler.PreBlocker(next)(ctx, &abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{injected}})
+	require.NoError(t, err)
+	found, err := f.keeper.Price.Has(ctx, "ETH")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestProcessProposalRejects(t *testing.T) {
+	f := initProposalFixture(t, 10, 10, 10)
+	height := int64(5)
+	extCommit := f.extendedCommit(t, height,
+		map[string]uint64{"ETH": 100},
+		map[string]uint64{"ETH": 101},
+		map[string]uint64{"ETH": 150},
+	)
+	ctx := f.contextAt(height, extCommit)
+	commitBz, err := extCommit.Marshal()
+	require.NoError(t, err)
+
+	tampered, err := (&types.InjectedOraclePrices{
+		Prices:             []types.VotePrice{{Symbol: "ETH", Rate: 150}},
+		ExtendedCommitInfo: commitBz,
+	}).Marshal()
+	require.NoError(t, err)
+
+	forgedCommit := extCommit
+	forgedCommit.Votes = append([]abci.ExtendedVoteInfo(nil), extCommit.Votes...)
+	forgedCommit.Votes[0].ExtensionSignature = []byte("forged")
+	forgedBz, err := forgedCommit.Marshal()
+	require.NoError(t, err)
+	forged, err := (&types.InjectedOraclePrices{
+		Prices:             oracleabci.AggregateVoteExtensions(log.NewNopLogger(), forgedCommit),
+		ExtendedCommitInfo: forgedBz,
+	}).Marshal()
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc string
+		txs  [][]byte
+	}{
+		{desc: "missing injection"},
+		{desc: "undecodable injection", txs: [][]byte{{0xff}}},
+		{desc: "tampered prices", txs: [][]byte{tampered}},
+		{desc: "forged signature", txs: [][]byte{forged}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := f.handler.ProcessProposal()(ctx, &abci.RequestProcessProposal{
+				Height:             height,
+				Txs:                tc.txs,
+				ProposedLastCommit: lastCommit(extCommit),
+			})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+		})
+	}
+
+	// before vote extensions are enabled proposals are passed through
+	res, err := f.handler.ProcessProposal()(f.contextAt(enableHeight, abci.ExtendedCommitInfo{}),
+		&abci.RequestProcessProposal{Height: enableHeight})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+}
+
+func TestProcessProposalRejectsDroppedVotes(t *testing.T) {
+	f := initProposalFixture(t, 10, 10, 10, 10)
+	height := int64(5)
+	extCommit := f.extendedCommit(t, height,
+		map[string]uint64{"ETH": 100},
+		map[string]uint64{"ETH": 101},
+		map[string]uint64{"ETH": 102},
+		map[string]uint64{"ETH": 150},
+	)
+	ctx := f.contextAt(height, extCommit)
+
+	// the proposer marks a committed vote absent: the remaining three
+	// quarters of the power still validate, but the median moves
+	dropped := extCommit
+	dropped.Votes = append([]abci.ExtendedVoteInfo(nil), extCommit.Votes...)
+	dropped.Votes[0].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+	dropped.Votes[0].VoteExtension = nil
+	dropped.Votes[0].ExtensionSignature = nil
+	require.NoError(t, baseapp.ValidateVoteExtensions(ctx, f.valStore, height, chainID, dropped))
+	droppedBz, err := dropped.Marshal()
+	require.NoError(t, err)
+	injected, err := (&types.InjectedOraclePrices{
+		Prices:             oracleabci.AggregateVoteExtensions(log.NewNopLogger(), dropped),
+		ExtendedCommitInfo: droppedBz,
+	}).Marshal()
+	require.NoError(t, err)
+
+	res, err := f.handler.ProcessProposal()(ctx, &abci.RequestProcessProposal{
+		Height:             height,
+		Txs:                [][]byte{injected},
+		ProposedLastCommit: lastCommit(extCommit),
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+}
@@ -0,0 +1,100 @@
+// Package abci implements the ABCI++ handlers through which validators report
+// oracle prices: vote extensions carry each validator's local observations,
+// the block proposer injects their aggregate into the proposal and the
+// pre-blocker writes it into the oracle state.
+package abci
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/oracle/provider"
+	"realfin/x/oracle/types"
+)
+
+// VoteExtensionHandler fills and verifies the oracle vote extensions.
+type VoteExtensionHandler struct {
+	logger   log.Logger
+	provider provider.PriceProvider
+	timeout  time.Duration
+}
+
+// NewVoteExtensionHandler returns a new VoteExtensionHandler. A nil provider
+// makes the node extend its votes with an empty extension.
+func NewVoteExtensionHandler(logger log.Logger, priceProvider provider.PriceProvider, timeout time.Duration) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		logger:   logger.With("module", "x/"+types.ModuleName),
+		provider: priceProvider,
+		timeout:  timeout,
+	}
+}
+
+// ExtendVote returns the handler attaching the local price observations to
+// the validator's precommit. Provider failures never fail the vote: the
+// validator simply reports no prices for that height.
+func (h *VoteExtensionHandler) ExtendVote() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		if h.provider == nil {
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		fetchCtx, cancel := context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+
+		prices, err := h.provider.Prices(fetchCtx)
+		if err != nil {
+			h.logger.Error("failed to fetch oracle prices", "height", req.Height, "err", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		ve := types.OracleVoteExtension{Prices: make([]types.VotePrice, 0, len(prices))}
+		for symbol, rate := range prices {
+			if types.ValidateSymbol(symbol) != nil || rate == 0 {
+				continue
+			}
+			ve.Prices = append(ve.Prices, types.VotePrice{Symbol: symbol, Rate: rate})
+		}
+		sort.Slice(ve.Prices, func(i, j int) bool {
+			return ve.Prices[i].Symbol < ve.Prices[j].Symbol
+		})
+		if len(ve.Prices) > types.MaxVotePrices {
+			h.logger.Error("too many oracle prices, truncating vote extension", "height", req.Height, "count", len(ve.Prices))
+			ve.Prices = ve.Prices[:types.MaxVotePrices]
+		}
+
+		bz, err := ve.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtension returns the handler rejecting malformed oracle vote
+// extensions from other validators. Empty extensions are accepted.
+func (h *VoteExtensionHandler) VerifyVoteExtension() sdk.VerifyVoteExtensionHandler {
+	return func(_ sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		var ve types.OracleVoteExtension
+		if err := ve.Unmarshal(req.VoteExtension); err != nil {
+			h.logger.Info("rejecting undecodable oracle vote extension", "height", req.Height, "err", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		if err := ve.Validate(); err != nil {
+			h.logger.Info("rejecting invalid oracle vote extension", "height", req.Height, "err", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
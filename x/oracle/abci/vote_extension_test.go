@@ -0,0 +1,104 @@
+package abci_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	oracleabci "realfin/x/oracle/abci"
+	"realfin/x/oracle/types"
+)
+
+type staticProvider struct {
+	prices map[string]uint64
+	err    error
+}
+
+func (p staticProvider) Prices(context.Context) (map[string]uint64, error) {
+	return p.prices, p.err
+}
+
+func TestExtendVote(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+
+	tests := []struct {
+		desc     string
+		handler  *oracleabci.VoteExtensionHandler
+		expected []types.VotePrice
+	}{
+		{
+			desc:    "no provider",
+			handler: oracleabci.NewVoteExtensionHandler(log.NewNopLogger(), nil, time.Second),
+		},
+		{
+			desc: "provider error",
+			handler: oracleabci.NewVoteExtensionHandler(log.NewNopLogger(),
+				staticProvider{err: errors.New("unreachable")}, time.Second),
+		},
+		{
+			desc: "sorted prices without empty entries",
+			handler: oracleabci.NewVoteExtensionHandler(log.NewNopLogger(),
+				staticProvider{prices: map[string]uint64{"ETH": 5001, "BTC": 98000, "": 1, "ZERO": 0}}, time.Second),
+			expected: []types.VotePrice{{Symbol: "BTC", Rate: 98000}, {Symbol: "ETH", Rate: 5001}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := tc.handler.ExtendVote()(ctx, &abci.RequestExtendVote{Height: 5})
+			require.NoError(t, err)
+			if tc.expected == nil {
+				require.Empty(t, res.VoteExtension)
+				return
+			}
+
+			var ve types.OracleVoteExtension
+			require.NoError(t, ve.Unmarshal(res.VoteExtension))
+			require.Equal(t, tc.expected, ve.Prices)
+		})
+	}
+}
+
+func TestVerifyVoteExtension(t *testing.T) {
+	h := oracleabci.NewVoteExtensionHandler(log.NewNopLogger(), nil, time.Second)
+
+	valid, err := (&types.OracleVoteExtension{Prices: []types.VotePrice{{Symbol: "ETH", Rate: 1}}}).Marshal()
+	require.NoError(t, err)
+	duplicated, err := (&types.OracleVoteExtension{Prices: []types.VotePrice{
+		{Symbol: "ETH", Rate: 1},
+		{Symbol: "ETH", Rate: 2},
+	}}).Marshal()
+	require.NoError(t, err)
+	longSymbol, err := (&types.OracleVoteExtension{Prices: []types.VotePrice{
+		{Symbol: strings.Repeat("X", types.MaxSymbolLength+1), Rate: 1},
+	}}).Marshal()
+	require.NoError(t, err)
+	nonCanonical, err := (&types.OracleVoteExtension{Prices: []types.VotePrice{{Symbol: "Eth ", Rate: 1}}}).Marshal()
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc      string
+		extension []byte
+		status    abci.ResponseVerifyVoteExtension_VerifyStatus
+	}{
+		{desc: "empty", status: abci.ResponseVerifyVoteExtension_ACCEPT},
+		{desc: "valid", extension: valid, status: abci.ResponseVerifyVoteExtension_ACCEPT},
+		{desc: "undecodable", extension: []byte{0xff, 0xff}, status: abci.ResponseVerifyVoteExtension_REJECT},
+		{desc: "duplicated symbol", extension: duplicated, status: abci.ResponseVerifyVoteExtension_REJECT},
+		{desc: "symbol too long", extension: longSymbol, status: abci.ResponseVerifyVoteExtension_REJECT},
+		{desc: "non-canonical symbol", extension: nonCanonical, status: abci.ResponseVerifyVoteExtension_REJECT},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := h.VerifyVoteExtension()(sdk.Context{}, &abci.RequestVerifyVoteExtension{VoteExtension: tc.extension})
+			require.NoError(t, err)
+			require.Equal(t, tc.status, res.Status)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"realfin/x/oracle/types"
+)
+
+// GetTxCmd returns the custom transaction commands of the module. AutoCLI
+// adds the generated commands to it.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Transactions commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdUpdatePrices())
+
+	return cmd
+}
+
+// CmdUpdatePrices returns the command updating several prices in a single
+// MsgUpdatePrices.
+func CmdUpdatePrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-prices [file]",
+		Short: "Update several prices atomically from a JSON or CSV file",
+		Long: `Update several prices owned by the sender in a single transaction. The
+updates are applied atomically: the transaction fails if any of them fails.
+
+Files with a .csv extension contain rows of symbol, rate and optional decimals,
+with an optional "symbol,rate,decimals" header. Other files contain a JSON
+array of updates:
+
+[{"symbol": "ETH", "rate": 350012, "decimals": 2}, {"symbol": "BTC", "rate": 98000}]`,
+		Example: "realfind tx oracle update-prices prices.csv --from alice",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			updates, err := ReadPriceUpdates(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdatePrices{
+				Creator: clientCtx.GetFromAddress().String(),
+				Updates: updates,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ReadPriceUpdates reads the price updates of a JSON or CSV file. The format
+// is chosen by the extension of the file.
+func ReadPriceUpdates(path string) ([]types.PriceUpdate, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []types.PriceUpdate
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		updates, err = parseCSVPriceUpdates(bz)
+	} else {
+		err = json.Unmarshal(bz, &updates)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read price updates from %s: %w", path, err)
+	}
+	if len(updates) == 0 {
+		return nil, fmt.Errorf("no price updates in %s", path)
+	}
+
+	return updates, nil
+}
+
+func parseCSVPriceUpdates(bz []byte) ([]types.PriceUpdate, error) {
+	r := csv.NewReader(bytes.NewReader(bz))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	var updates []types.PriceUpdate
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return updates, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "symbol") {
+			continue
+		}
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("line %d: expected symbol, rate and optional decimals", line)
+		}
+
+		update := types.PriceUpdate{Symbol: strings.TrimSpace(record[0])}
+		if update.Rate, err = strconv.ParseUint(strings.TrimSpace(record[1]), 10, 64); err != nil {
+			return nil, fmt.Errorf("line %d: invalid rate: %w", line, err)
+		}
+		if len(record) == 3 && strings.TrimSpace(record[2]) != "" {
+			decimals, err := strconv.ParseUint(strings.TrimSpace(record[2]), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid decimals: %w", line, err)
+			}
+			update.Decimals = uint32(decimals)
+		}
+		updates = append(updates, update)
+	}
+}
@@ -0,0 +1,46 @@
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/client/cli"
+	"realfin/x/oracle/types"
+)
+
+func TestReadPriceUpdates(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	expected := []types.PriceUpdate{{Symbol: "ETH", Rate: 350_012, Decimals: 2}, {Symbol: "BTC", Rate: 98_000}}
+
+	updates, err := cli.ReadPriceUpdates(write("prices.json", `[{"symbol": "ETH", "rate": 350012, "decimals": 2}, {"symbol": "BTC", "rate": 98000}]`))
+	require.NoError(t, err)
+	require.Equal(t, expected, updates)
+
+	updates, err = cli.ReadPriceUpdates(write("prices.csv", "symbol,rate,decimals\nETH,350012,2\nBTC, 98000\n"))
+	require.NoError(t, err)
+	require.Equal(t, expected, updates)
+
+	for name, content := range map[string]string{
+		"empty.json":   `[]`,
+		"invalid.json": `{"symbol": "ETH"}`,
+		"empty.csv":    "symbol,rate\n",
+		"rate.csv":     "ETH,-1\n",
+		"decimals.csv": "ETH,1,x\n",
+		"columns.csv":  "ETH\n",
+		"too_many.csv": "ETH,1,2,3\n",
+	} {
+		_, err := cli.ReadPriceUpdates(write(name, content))
+		require.Error(t, err, name)
+	}
+
+	_, err = cli.ReadPriceUpdates(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
@@ -0,0 +1,184 @@
+package feeder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// Node is the chain endpoint the feeder broadcasts its transactions to.
+type Node interface {
+	// Account returns the account number and sequence of the address.
+	Account(ctx context.Context, addr sdk.AccAddress) (uint64, uint64, error)
+	// Simulate returns the gas used by the encoded transaction.
+	Simulate(ctx context.Context, txBytes []byte) (uint64, error)
+	// BroadcastTx broadcasts the encoded transaction and returns the result of
+	// its CheckTx.
+	BroadcastTx(ctx context.Context, txBytes []byte) (*sdk.TxResponse, error)
+}
+
+type clientNode struct {
+	clientCtx client.Context
+}
+
+// NewClientNode returns the node the client context is connected to.
+func NewClientNode(clientCtx client.Context) Node {
+	return clientNode{clientCtx: clientCtx.WithBroadcastMode(flags.BroadcastSync)}
+}
+
+// Account implements Node.
+func (n clientNode) Account(_ context.Context, addr sdk.AccAddress) (uint64, uint64, error) {
+	return n.clientCtx.AccountRetriever.GetAccountNumberSequence(n.clientCtx, addr)
+}
+
+// Simulate implements Node.
+func (n clientNode) Simulate(ctx context.Context, txBytes []byte) (uint64, error) {
+	res, err := txtypes.NewServiceClient(n.clientCtx).Simulate(ctx, &txtypes.SimulateRequest{TxBytes: txBytes})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GasInfo.GasUsed, nil
+}
+
+// BroadcastTx implements Node.
+func (n clientNode) BroadcastTx(_ context.Context, txBytes []byte) (*sdk.TxResponse, error) {
+	return n.clientCtx.BroadcastTx(txBytes)
+}
+
+// Broadcaster signs transactions with the key of the feeder and broadcasts
+// them. It tracks the sequence of the account locally so that several
+// transactions can be broadcast in the same block, and reloads it from the
+// node after a sequence mismatch.
+type Broadcaster struct {
+	node          Node
+	txConfig      client.TxConfig
+	txf           tx.Factory
+	from          sdk.AccAddress
+	gasAdjustment float64
+	metrics       *Metrics
+
+	// loaded reports whether the account number and sequence of the factory
+	// are in sync with the node.
+	loaded bool
+}
+
+// NewBroadcaster returns a broadcaster signing with the key of the factory.
+func NewBroadcaster(node Node, txConfig client.TxConfig, txf tx.Factory, gasAdjustment float64, metrics *Metrics) (*Broadcaster, error) {
+	if txf.Keybase() == nil {
+		return nil, errors.New("feeder requires a keyring")
+	}
+
+	record, err := txf.Keybase().Key(txf.FromName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key %q: %w", txf.FromName(), err)
+	}
+	from, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Broadcaster{
+		node:     node,
+		txConfig: txConfig,
+		// the real public key of the feeder is used to simulate transactions
+		txf:           txf.WithTxConfig(txConfig).WithSimulateAndExecute(true),
+		from:          from,
+		gasAdjustment: gasAdjustment,
+		metrics:       metrics,
+	}, nil
+}
+
+// Address returns the address signing the transactions.
+func (b *Broadcaster) Address() sdk.AccAddress {
+	return b.from
+}
+
+// Broadcast signs and broadcasts the messages in a single transaction. A
+// transaction rejected for a sequence mismatch is rebuilt with the sequence
+// of the node and broadcast again once.
+func (b *Broadcaster) Broadcast(ctx context.Context, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	res, err := b.broadcast(ctx, msgs)
+	if isSequenceMismatch(res, err) {
+		b.metrics.SequenceMismatches.Inc()
+		b.loaded = false
+		res, err = b.broadcast(ctx, msgs)
+	}
+
+	switch {
+	case err != nil:
+		b.metrics.Txs.WithLabelValues("error").Inc()
+		return nil, err
+	case res.Code != 0:
+		// the sequence of the node is unknown after a failed CheckTx
+		b.loaded = false
+		b.metrics.Txs.WithLabelValues("rejected").Inc()
+		return res, fmt.Errorf("tx %s rejected with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	b.metrics.Txs.WithLabelValues("accepted").Inc()
+	b.metrics.LastSuccess.Set(float64(time.Now().Unix()))
+	return res, nil
+}
+
+func (b *Broadcaster) broadcast(ctx context.Context, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	if !b.loaded {
+		accNum, seq, err := b.node.Account(ctx, b.from)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load account %s: %w", b.from, err)
+		}
+		b.txf = b.txf.WithAccountNumber(accNum).WithSequence(seq)
+		b.loaded = true
+	}
+
+	simTx, err := b.txf.BuildSimTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	gasUsed, err := b.node.Simulate(ctx, simTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate tx: %w", err)
+	}
+	txf := b.txf.WithGas(uint64(b.gasAdjustment * float64(gasUsed)))
+
+	builder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(ctx, txf, txf.FromName(), builder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := b.txConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	b.metrics.GasWanted.Set(float64(txf.Gas()))
+	res, err := b.node.BroadcastTx(ctx, txBytes)
+	if err == nil && res.Code == 0 {
+		b.txf = b.txf.WithSequence(b.txf.Sequence() + 1)
+	}
+
+	return res, err
+}
+
+// isSequenceMismatch reports whether the transaction was rejected, either by
+// the simulation or by CheckTx, because of a wrong account sequence.
+func isSequenceMismatch(res *sdk.TxResponse, err error) bool {
+	if err != nil {
+		return errors.Is(err, sdkerrors.ErrWrongSequence) ||
+			strings.Contains(err.Error(), sdkerrors.ErrWrongSequence.Error())
+	}
+
+	return res.Codespace == sdkerrors.ErrWrongSequence.Codespace() &&
+		res.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
@@ -0,0 +1,203 @@
+// Package feeder implements the oracle price feeder, a sidecar process that
+// reads prices from local files or HTTP endpoints and periodically broadcasts
+// them to the chain in signed MsgUpdatePrice transactions.
+package feeder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"realfin/x/oracle/types"
+)
+
+const (
+	// SourceFile reads a local file.
+	SourceFile = "file"
+	// SourceHTTP fetches an HTTP endpoint.
+	SourceHTTP = "http"
+
+	// FormatJSON decodes the source as a JSON document.
+	FormatJSON = "json"
+	// FormatCSV decodes the source as CSV rows of key and value.
+	FormatCSV = "csv"
+
+	// DefaultInterval is the default interval between two price updates.
+	DefaultInterval = 30 * time.Second
+	// DefaultBatchSize is the default number of price updates per transaction.
+	DefaultBatchSize = 20
+	// DefaultGasAdjustment is the default factor applied to the simulated gas.
+	DefaultGasAdjustment = 1.5
+	// DefaultTimeout is the default timeout of a single source fetch.
+	DefaultTimeout = 5 * time.Second
+)
+
+// Duration is a time.Duration decoded from a JSON string such as "30s".
+type Duration time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"30s\": %w", err)
+	}
+
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Config defines the configuration of the feeder.
+type Config struct {
+	// Interval is the interval between two price updates.
+	Interval Duration `json:"interval"`
+	// BatchSize is the maximum number of price updates per transaction.
+	BatchSize int `json:"batch_size"`
+	// GasAdjustment is the factor applied to the simulated gas of a
+	// transaction.
+	GasAdjustment float64 `json:"gas_adjustment"`
+	// Timeout bounds a single source fetch.
+	Timeout Duration `json:"timeout"`
+	// Symbols are the prices to feed.
+	Symbols []SymbolConfig `json:"symbols"`
+}
+
+// SymbolConfig defines a price to feed and its sources.
+type SymbolConfig struct {
+	// Symbol is the symbol of the price on chain.
+	Symbol string `json:"symbol"`
+	// Name and Description are sent with every update of the price.
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Decimals must match the decimals the price was created with. The value
+	// read from the sources is scaled by 10^decimals.
+	Decimals uint32 `json:"decimals"`
+	// MinSources is the minimum number of sources that must return a value
+	// for the price to be updated. It defaults to one.
+	MinSources int `json:"min_sources"`
+	// Sources are the sources of the price. The value of the price is the
+	// median of the values of its sources.
+	Sources []SourceConfig `json:"sources"`
+}
+
+// SourceConfig defines a source of a price.
+type SourceConfig struct {
+	// Type is the source type, "file" or "http".
+	Type string `json:"type"`
+	// Location is the path of the file or the URL of the endpoint.
+	Location string `json:"location"`
+	// Format is the format of the source, "json" or "csv". It defaults to
+	// json.
+	Format string `json:"format"`
+	// Field selects the value in the source: a dot separated path in a JSON
+	// document, e.g. "data.ETH.usd", or the key of a CSV row. It defaults to
+	// the symbol.
+	Field string `json:"field"`
+}
+
+// LoadConfig reads a JSON configuration file and applies the defaults.
+func LoadConfig(path string) (Config, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(bz, &cfg); err != nil {
+		return Config{}, fmt.Errorf("failed to decode feeder config: %w", err)
+	}
+	cfg.applyDefaults()
+
+	return cfg, cfg.Validate()
+}
+
+func (cfg *Config) applyDefaults() {
+	if cfg.Interval == 0 {
+		cfg.Interval = Duration(DefaultInterval)
+	}
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.GasAdjustment == 0 {
+		cfg.GasAdjustment = DefaultGasAdjustment
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = Duration(DefaultTimeout)
+	}
+	for i := range cfg.Symbols {
+		sym := &cfg.Symbols[i]
+		if sym.MinSources == 0 {
+			sym.MinSources = 1
+		}
+		for j := range sym.Sources {
+			src := &sym.Sources[j]
+			if src.Format == "" {
+				src.Format = FormatJSON
+			}
+			if src.Field == "" {
+				src.Field = sym.Symbol
+			}
+		}
+	}
+}
+
+// Validate validates the configuration.
+func (cfg Config) Validate() error {
+	if cfg.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if cfg.BatchSize <= 0 {
+		return errors.New("batch size must be positive")
+	}
+	if cfg.GasAdjustment < 1 {
+		return errors.New("gas adjustment must be at least 1")
+	}
+	if len(cfg.Symbols) == 0 {
+		return errors.New("no symbols configured")
+	}
+
+	seen := make(map[string]struct{})
+	for _, sym := range cfg.Symbols {
+		if sym.Symbol == "" {
+			return errors.New("symbol cannot be empty")
+		}
+		if _, ok := seen[sym.Symbol]; ok {
+			return fmt.Errorf("duplicated symbol %s", sym.Symbol)
+		}
+		seen[sym.Symbol] = struct{}{}
+
+		if sym.Decimals > types.MaxDecimals {
+			return fmt.Errorf("symbol %s: decimals %d exceed the maximum of %d", sym.Symbol, sym.Decimals, types.MaxDecimals)
+		}
+		if len(sym.Sources) == 0 {
+			return fmt.Errorf("symbol %s has no sources", sym.Symbol)
+		}
+		if sym.MinSources < 1 || sym.MinSources > len(sym.Sources) {
+			return fmt.Errorf("symbol %s: min sources must be between 1 and %d", sym.Symbol, len(sym.Sources))
+		}
+		for _, src := range sym.Sources {
+			if src.Type != SourceFile && src.Type != SourceHTTP {
+				return fmt.Errorf("symbol %s: unknown source type %q", sym.Symbol, src.Type)
+			}
+			if src.Format != FormatJSON && src.Format != FormatCSV {
+				return fmt.Errorf("symbol %s: unknown source format %q", sym.Symbol, src.Format)
+			}
+			if src.Location == "" {
+				return fmt.Errorf("symbol %s: source location cannot be empty", sym.Symbol)
+			}
+		}
+	}
+
+	return nil
+}
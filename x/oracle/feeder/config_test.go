@@ -0,0 +1,52 @@
+package feeder_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/feeder"
+)
+
+func TestLoadConfig(t *testing.T) {
+	write := func(content string) string {
+		path := filepath.Join(t.TempDir(), "feeder.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	cfg, err := feeder.LoadConfig(write(`{
+		"interval": "1m",
+		"symbols": [{"symbol": "ETH", "sources": [{"type": "file", "location": "prices.csv", "format": "csv"}]}]
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, feeder.Duration(time.Minute), cfg.Interval)
+	require.Equal(t, feeder.DefaultBatchSize, cfg.BatchSize)
+	require.Equal(t, feeder.DefaultGasAdjustment, cfg.GasAdjustment)
+	require.Equal(t, 1, cfg.Symbols[0].MinSources)
+	require.Equal(t, "ETH", cfg.Symbols[0].Sources[0].Field)
+
+	for name, content := range map[string]string{
+		"invalid interval":  `{"interval": 30, "symbols": [{"symbol": "ETH", "sources": [{"type": "file", "location": "p.json"}]}]}`,
+		"no symbols":        `{}`,
+		"duplicated symbol": `{"symbols": [{"symbol": "ETH", "sources": [{"type": "file", "location": "p.json"}]}, {"symbol": "ETH", "sources": [{"type": "file", "location": "p.json"}]}]}`,
+		"no sources":        `{"symbols": [{"symbol": "ETH"}]}`,
+		"too many decimals": `{"symbols": [{"symbol": "ETH", "decimals": 19, "sources": [{"type": "file", "location": "p.json"}]}]}`,
+		"min sources":       `{"symbols": [{"symbol": "ETH", "min_sources": 2, "sources": [{"type": "file", "location": "p.json"}]}]}`,
+		"unknown type":      `{"symbols": [{"symbol": "ETH", "sources": [{"type": "grpc", "location": "localhost:9090"}]}]}`,
+		"unknown format":    `{"symbols": [{"symbol": "ETH", "sources": [{"type": "file", "location": "p.xml", "format": "xml"}]}]}`,
+		"no location":       `{"symbols": [{"symbol": "ETH", "sources": [{"type": "http"}]}]}`,
+		"low gas":           `{"gas_adjustment": 0.5, "symbols": [{"symbol": "ETH", "sources": [{"type": "file", "location": "p.json"}]}]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := feeder.LoadConfig(write(content))
+			require.Error(t, err)
+		})
+	}
+
+	_, err = feeder.LoadConfig(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package feeder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/oracle/types"
+)
+
+// Feeder periodically reads the prices of its symbols from their sources and
+// broadcasts them in MsgUpdatePrice transactions.
+type Feeder struct {
+	cfg         Config
+	broadcaster *Broadcaster
+	fetcher     *fetcher
+	metrics     *Metrics
+	logger      log.Logger
+}
+
+// New returns a feeder broadcasting the prices described by the
+// configuration.
+func New(cfg Config, broadcaster *Broadcaster, metrics *Metrics, logger log.Logger) *Feeder {
+	return &Feeder{
+		cfg:         cfg,
+		broadcaster: broadcaster,
+		fetcher:     newFetcher(&http.Client{Timeout: time.Duration(cfg.Timeout)}),
+		metrics:     metrics,
+		logger:      logger,
+	}
+}
+
+// Run feeds the prices every interval until the context is canceled. Failed
+// rounds are logged and retried at the next interval.
+func (f *Feeder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(time.Duration(f.cfg.Interval))
+	defer ticker.Stop()
+
+	for {
+		if n, err := f.Tick(ctx); err != nil {
+			f.logger.Error("failed to feed prices", "updated", n, "err", err)
+		} else {
+			f.logger.Info("fed prices", "updated", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick runs a single round: it reads the sources, computes the prices and
+// broadcasts them in batches of the configured size. It returns the number of
+// prices accepted by the node. A failed batch does not prevent the following
+// ones from being broadcast.
+func (f *Feeder) Tick(ctx context.Context) (int, error) {
+	msgs := f.updates(ctx)
+
+	var (
+		updated int
+		errs    []error
+	)
+	for start := 0; start < len(msgs); start += f.cfg.BatchSize {
+		end := min(start+f.cfg.BatchSize, len(msgs))
+		res, err := f.broadcaster.Broadcast(ctx, msgs[start:end])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		f.logger.Debug("broadcast price updates", "tx", res.TxHash, "count", end-start)
+		updated += end - start
+	}
+
+	return updated, errors.Join(errs...)
+}
+
+// updates returns the price updates of the round. Symbols for which too few
+// sources returned a value are skipped.
+func (f *Feeder) updates(ctx context.Context) []sdk.Msg {
+	f.fetcher.reset()
+
+	creator := f.broadcaster.Address().String()
+	msgs := make([]sdk.Msg, 0, len(f.cfg.Symbols))
+	for _, sym := range f.cfg.Symbols {
+		rate, err := f.rate(ctx, sym)
+		if err != nil {
+			f.metrics.SkippedPrices.WithLabelValues(sym.Symbol).Inc()
+			f.logger.Error("skipping price", "symbol", sym.Symbol, "err", err)
+			continue
+		}
+
+		f.metrics.Price.WithLabelValues(sym.Symbol).Set(float64(rate))
+		msgs = append(msgs, &types.MsgUpdatePrice{
+			Creator:     creator,
+			Symbol:      sym.Symbol,
+			Rate:        rate,
+			Name:        sym.Name,
+			Description: sym.Description,
+			Decimals:    sym.Decimals,
+		})
+	}
+
+	return msgs
+}
+
+// rate returns the median of the values of the sources of the symbol,
+// expressed with the decimals of the symbol.
+func (f *Feeder) rate(ctx context.Context, sym SymbolConfig) (uint64, error) {
+	values := make([]math.LegacyDec, 0, len(sym.Sources))
+	for _, src := range sym.Sources {
+		v, err := f.fetcher.value(ctx, src)
+		if err != nil {
+			f.metrics.SourceErrors.WithLabelValues(sym.Symbol, src.Location).Inc()
+			f.logger.Debug("failed to read source", "symbol", sym.Symbol, "source", src.Location, "err", err)
+			continue
+		}
+		values = append(values, v)
+	}
+	if len(values) < sym.MinSources {
+		return 0, fmt.Errorf("%d of %d sources returned a value, %d required", len(values), len(sym.Sources), sym.MinSources)
+	}
+
+	return scaleRate(median(values), sym.Decimals)
+}
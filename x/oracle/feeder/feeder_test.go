@@ -0,0 +1,184 @@
+package feeder_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/feeder"
+	"realfin/x/oracle/types"
+)
+
+// stubNode is an in-process node checking the sequence of the transactions
+// it receives.
+type stubNode struct {
+	txConfig  client.TxConfig
+	accNum    uint64
+	seq       uint64
+	gasPerMsg uint64
+
+	txs        []sdk.Tx
+	mismatches int
+}
+
+func (n *stubNode) Account(context.Context, sdk.AccAddress) (uint64, uint64, error) {
+	return n.accNum, n.seq, nil
+}
+
+func (n *stubNode) Simulate(_ context.Context, txBytes []byte) (uint64, error) {
+	sdkTx, err := n.txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	return n.gasPerMsg * uint64(len(sdkTx.GetMsgs())), nil
+}
+
+func (n *stubNode) BroadcastTx(_ context.Context, txBytes []byte) (*sdk.TxResponse, error) {
+	sdkTx, err := n.txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := sdkTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := fmt.Sprintf("%X", sha256.Sum256(txBytes))
+	if len(sigs) != 1 || sigs[0].Sequence != n.seq {
+		n.mismatches++
+		return &sdk.TxResponse{
+			TxHash:    hash,
+			Codespace: sdkerrors.ErrWrongSequence.Codespace(),
+			Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			RawLog:    fmt.Sprintf("account sequence mismatch, expected %d", n.seq),
+		}, nil
+	}
+
+	n.seq++
+	n.txs = append(n.txs, sdkTx)
+	return &sdk.TxResponse{TxHash: hash}, nil
+}
+
+func setupBroadcaster(t *testing.T) (*stubNode, *feeder.Broadcaster, *feeder.Metrics) {
+	t.Helper()
+
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	kr := keyring.NewInMemory(cdc)
+	_, _, err := kr.NewMnemonic("feeder", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	txf := tx.Factory{}.
+		WithKeybase(kr).
+		WithFromName("feeder").
+		WithChainID("realfin-test").
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	node := &stubNode{txConfig: txConfig, accNum: 7, seq: 3, gasPerMsg: 10_000}
+	metrics := feeder.NewMetrics(prometheus.NewRegistry())
+	broadcaster, err := feeder.NewBroadcaster(node, txConfig, txf, 1.5, metrics)
+	require.NoError(t, err)
+
+	return node, broadcaster, metrics
+}
+
+func TestFeederTick(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/prices":
+			_, _ = w.Write([]byte(`{"data": {"ETH": {"usd": "3500.125"}}, "BTC": 98000}`))
+		case "/prices.csv":
+			_, _ = w.Write([]byte("symbol,price\nETH,3499.875\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	pricesFile := filepath.Join(dir, "prices.json")
+	require.NoError(t, os.WriteFile(pricesFile, []byte(`{"ETH": 3501}`), 0o600))
+
+	cfgFile := filepath.Join(dir, "feeder.json")
+	require.NoError(t, os.WriteFile(cfgFile, []byte(fmt.Sprintf(`{
+		"batch_size": 1,
+		"symbols": [
+			{"symbol": "ETH", "name": "Ethereum", "decimals": 2, "min_sources": 2, "sources": [
+				{"type": "http", "location": "%[1]s/prices", "field": "data.ETH.usd"},
+				{"type": "http", "location": "%[1]s/prices.csv", "format": "csv"},
+				{"type": "file", "location": "%[2]s"}
+			]},
+			{"symbol": "BTC", "name": "Bitcoin", "sources": [
+				{"type": "http", "location": "%[1]s/prices"}
+			]},
+			{"symbol": "OSMO", "name": "Osmosis", "sources": [
+				{"type": "http", "location": "%[1]s/missing"}
+			]}
+		]
+	}`, srv.URL, pricesFile)), 0o600))
+	cfg, err := feeder.LoadConfig(cfgFile)
+	require.NoError(t, err)
+
+	node, broadcaster, metrics := setupBroadcaster(t)
+	f := feeder.New(cfg, broadcaster, metrics, log.NewNopLogger())
+
+	updated, err := f.Tick(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, updated)
+
+	// one transaction per batch, with the simulated gas adjusted
+	require.Len(t, node.txs, 2)
+	require.Equal(t, uint64(5), node.seq)
+	creator := broadcaster.Address().String()
+	require.Equal(t, []sdk.Msg{&types.MsgUpdatePrice{Creator: creator, Symbol: "ETH", Rate: 350_012, Name: "Ethereum", Decimals: 2}}, node.txs[0].GetMsgs())
+	require.Equal(t, []sdk.Msg{&types.MsgUpdatePrice{Creator: creator, Symbol: "BTC", Rate: 98_000, Name: "Bitcoin"}}, node.txs[1].GetMsgs())
+	require.Equal(t, uint64(15_000), node.txs[0].(sdk.FeeTx).GetGas())
+
+	require.Equal(t, float64(350_012), testutil.ToFloat64(metrics.Price.WithLabelValues("ETH")))
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.SkippedPrices.WithLabelValues("OSMO")))
+	require.Equal(t, float64(2), testutil.ToFloat64(metrics.Txs.WithLabelValues("accepted")))
+
+	// the account is used by another process: the feeder reloads its sequence
+	node.seq++
+	updated, err = f.Tick(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, updated)
+	require.Equal(t, 1, node.mismatches)
+	require.Equal(t, uint64(8), node.seq)
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.SequenceMismatches))
+
+	// symbols without enough sources are skipped
+	require.NoError(t, os.Remove(pricesFile))
+	srv.Close()
+	updated, err = f.Tick(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, updated)
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.SkippedPrices.WithLabelValues("ETH")))
+}
@@ -0,0 +1,72 @@
+package feeder
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsNamespace = "realfin_oracle_feeder"
+
+// Metrics are the Prometheus metrics of the feeder.
+type Metrics struct {
+	// Price is the last rate computed for a symbol.
+	Price *prometheus.GaugeVec
+	// SourceErrors counts the failed reads of a source of a symbol.
+	SourceErrors *prometheus.CounterVec
+	// SkippedPrices counts the rounds a symbol was not updated because too
+	// few of its sources returned a value.
+	SkippedPrices *prometheus.CounterVec
+	// Txs counts the broadcast transactions by result.
+	Txs *prometheus.CounterVec
+	// SequenceMismatches counts the account sequence mismatches recovered
+	// from.
+	SequenceMismatches prometheus.Counter
+	// GasWanted is the gas limit of the last broadcast transaction.
+	GasWanted prometheus.Gauge
+	// LastSuccess is the unix time of the last accepted transaction.
+	LastSuccess prometheus.Gauge
+}
+
+// NewMetrics creates the feeder metrics and registers them.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		Price: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "price",
+			Help:      "Last rate computed for a symbol.",
+		}, []string{"symbol"}),
+		SourceErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "source_errors_total",
+			Help:      "Failed reads of a source of a symbol.",
+		}, []string{"symbol", "source"}),
+		SkippedPrices: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "skipped_prices_total",
+			Help:      "Rounds a symbol was not updated because too few sources returned a value.",
+		}, []string{"symbol"}),
+		Txs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "txs_total",
+			Help:      "Broadcast transactions by result.",
+		}, []string{"result"}),
+		SequenceMismatches: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "sequence_mismatches_total",
+			Help:      "Account sequence mismatches recovered from.",
+		}),
+		GasWanted: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "gas_wanted",
+			Help:      "Gas limit of the last broadcast transaction.",
+		}),
+		LastSuccess: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "last_success_timestamp_seconds",
+			Help:      "Unix time of the last accepted transaction.",
+		}),
+	}
+
+	reg.MustRegister(m.Price, m.SourceErrors, m.SkippedPrices, m.Txs, m.SequenceMismatches, m.GasWanted, m.LastSuccess)
+
+	return m
+}
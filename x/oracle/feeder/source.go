@@ -0,0 +1,190 @@
+package feeder
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// fetcher reads the sources of the feeder. Sources shared by several symbols
+// are read once per round.
+type fetcher struct {
+	client *http.Client
+	cache  map[string][]byte
+}
+
+func newFetcher(client *http.Client) *fetcher {
+	return &fetcher{
+		client: client,
+		cache:  make(map[string][]byte),
+	}
+}
+
+// reset clears the sources read during the previous round.
+func (f *fetcher) reset() {
+	f.cache = make(map[string][]byte)
+}
+
+// value returns the value selected by the source.
+func (f *fetcher) value(ctx context.Context, src SourceConfig) (math.LegacyDec, error) {
+	bz, err := f.read(ctx, src)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	switch src.Format {
+	case FormatCSV:
+		return csvValue(bz, src.Field)
+	default:
+		return jsonValue(bz, src.Field)
+	}
+}
+
+func (f *fetcher) read(ctx context.Context, src SourceConfig) ([]byte, error) {
+	key := src.Type + "|" + src.Location
+	if bz, ok := f.cache[key]; ok {
+		return bz, nil
+	}
+
+	var (
+		bz  []byte
+		err error
+	)
+	switch src.Type {
+	case SourceFile:
+		bz, err = os.ReadFile(src.Location)
+	case SourceHTTP:
+		bz, err = f.get(ctx, src.Location)
+	default:
+		err = fmt.Errorf("unknown source type %q", src.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	f.cache[key] = bz
+	return bz, nil
+}
+
+func (f *fetcher) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// jsonValue returns the number at the dot separated path of the JSON
+// document. Numbers may be encoded as JSON numbers or strings.
+func jsonValue(bz []byte, field string) (math.LegacyDec, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return math.LegacyDec{}, fmt.Errorf("failed to decode json: %w", err)
+	}
+
+	for _, key := range strings.Split(field, ".") {
+		obj, ok := doc.(map[string]interface{})
+		if !ok {
+			return math.LegacyDec{}, fmt.Errorf("field %s not found", field)
+		}
+		if doc, ok = obj[key]; !ok {
+			return math.LegacyDec{}, fmt.Errorf("field %s not found", field)
+		}
+	}
+
+	switch v := doc.(type) {
+	case json.Number:
+		return parseValue(v.String())
+	case string:
+		return parseValue(v)
+	default:
+		return math.LegacyDec{}, fmt.Errorf("field %s is not a number", field)
+	}
+}
+
+// csvValue returns the value of the first CSV row whose first column is the
+// key. The value is read from the second column.
+func csvValue(bz []byte, key string) (math.LegacyDec, error) {
+	r := csv.NewReader(bytes.NewReader(bz))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return math.LegacyDec{}, fmt.Errorf("row %s not found", key)
+		}
+		if err != nil {
+			return math.LegacyDec{}, fmt.Errorf("failed to decode csv: %w", err)
+		}
+		if len(record) < 2 || strings.TrimSpace(record[0]) != key {
+			continue
+		}
+
+		return parseValue(strings.TrimSpace(record[1]))
+	}
+}
+
+func parseValue(s string) (math.LegacyDec, error) {
+	v, err := math.LegacyNewDecFromStr(s)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid value %q: %w", s, err)
+	}
+	if !v.IsPositive() {
+		return math.LegacyDec{}, fmt.Errorf("value %s must be positive", s)
+	}
+
+	return v, nil
+}
+
+// median returns the median of the values.
+func median(values []math.LegacyDec) math.LegacyDec {
+	sorted := make([]math.LegacyDec, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LT(sorted[j]) })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+
+	return sorted[mid-1].Add(sorted[mid]).QuoInt64(2)
+}
+
+// scaleRate converts a value into an on-chain rate expressed with the given
+// decimals.
+func scaleRate(value math.LegacyDec, decimals uint32) (uint64, error) {
+	rate := value.MulInt(math.NewIntWithDecimal(1, int(decimals))).TruncateInt()
+	if !rate.IsPositive() {
+		return 0, fmt.Errorf("value %s rounds to zero with %d decimals", value, decimals)
+	}
+	if !rate.IsUint64() {
+		return 0, fmt.Errorf("value %s overflows with %d decimals", value, decimals)
+	}
+
+	return rate.Uint64(), nil
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"realfin/x/oracle/types"
+)
+
+// EndBlocker aggregates the reporter submissions of every symbol that received
+// a new observation in the current block into its canonical Price, prunes
+// submissions that fell out of the submission window or belong to jailed
+// reporters, slashes misbehaving reporters, flags the prices that became stale
+// and pushes the updated prices to the subscribed IBC channels.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	jailed, err := k.jailedReporters(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	var (
+		expired  []collections.Pair[string, string]
+		eligible = make(map[string][]types.WeightedRate)
+		current  = make(map[string][]types.PriceSubmission)
+		updated  []string
+	)
+	err = k.Submission.Walk(ctx, nil, func(key collections.Pair[string, string], sub types.PriceSubmission) (bool, error) {
+		if params.SubmissionWindow > 0 && sub.Height+int64(params.SubmissionWindow) <= height {
+			expired = append(expired, key)
+			return false, nil
+		}
+
+		weight, ok := params.ReporterWeight(sub.Reporter)
+		if !ok {
+			// the reporter was removed from the whitelist
+			expired = append(expired, key)
+			return false, nil
+		}
+		if _, ok := jailed[sub.Reporter]; ok {
+			expired = append(expired, key)
+			return false, nil
+		}
+
+		eligible[sub.Symbol] = append(eligible[sub.Symbol], types.WeightedRate{Rate: sub.Rate, Weight: weight})
+		if sub.Height == height {
+			if len(current[sub.Symbol]) == 0 {
+				updated = append(updated, sub.Symbol)
+			}
+			current[sub.Symbol] = append(current[sub.Symbol], sub)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.Submission.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	for _, symbol := range updated {
+		minReporters, err := k.minReporters(ctx, params, symbol)
+		if err != nil {
+			return err
+		}
+
+		rates := eligible[symbol]
+		if len(rates) < minReporters {
+			continue
+		}
+
+		rate, ok := types.WeightedMedian(rates)
+		if !ok {
+			continue
+		}
+
+		if err := k.SetAggregatedRate(ctx, symbol, rate); err != nil {
+			return err
+		}
+
+		if err := k.trackOutliers(ctx, params, rate, current[symbol]); err != nil {
+			return err
+		}
+	}
+
+	if err := k.trackMissedWindows(ctx, params); err != nil {
+		return err
+	}
+
+	if err := k.flagStalePrices(ctx, params); err != nil {
+		return err
+	}
+
+	return k.pushSubscribedPrices(ctx, params)
+}
+
+// minReporters returns the minimum number of eligible submissions required to
+// aggregate the price of the symbol: the min_reporters of the registered
+// symbol when set, or the min_reporters param.
+func (k Keeper) minReporters(ctx context.Context, params types.Params, symbol string) (int, error) {
+	minReporters := params.MinReporters
+
+	info, err := k.Symbol.Get(ctx, symbol)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	} else if err == nil && info.MinReporters > 0 {
+		minReporters = info.MinReporters
+	}
+
+	return max(int(minReporters), 1), nil
+}
+
+// SetAggregatedRate writes the aggregated rate into the canonical Price of the
+// symbol, keeping its descriptive fields. Only registered symbols can be
+// aggregated: others return ErrSymbolNotRegistered. Prices that do not exist
+// yet are created in the unit of the registry, and all aggregated prices are
+// owned by the module account so that no single key controls them.
+// Rates outside the deviation band are queued as pending or recorded as
+// rejected, depending on the params, instead of being applied.
+func (k Keeper) SetAggregatedRate(ctx context.Context, symbol string, rate uint64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	price, err := k.getOrNewModulePrice(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if err := k.claimPrice(ctx, &price); err != nil {
+		return err
+	}
+
+	dev, err := k.checkDeviation(ctx, params, symbol, price.Rate, rate)
+	if err != nil {
+		return err
+	}
+	if dev != nil {
+		if params.QueueDeviatingUpdates {
+			return k.queuePendingPrice(ctx, types.PendingPrice{Symbol: symbol, Rate: rate}, *dev)
+		}
+
+		return k.recordRejection(ctx, params, symbol, rate, *dev)
+	}
+
+	price.Rate = rate
+
+	return k.setPrice(ctx, price)
+}
+
+// getOrNewModulePrice returns the price of the registered symbol, or a new
+// price in the unit of the registry owned by the module account when it does
+// not exist yet. Unregistered symbols return ErrSymbolNotRegistered.
+func (k Keeper) getOrNewModulePrice(ctx context.Context, symbol string) (types.Price, error) {
+	info, err := k.Symbol.Get(ctx, symbol)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Price{}, errorsmod.Wrap(types.ErrSymbolNotRegistered, symbol)
+		}
+		return types.Price{}, err
+	}
+
+	price, err := k.Price.Get(ctx, symbol)
+	if err == nil {
+		return price, nil
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return types.Price{}, err
+	}
+
+	creator, err := k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	if err != nil {
+		return types.Price{}, err
+	}
+
+	return types.Price{
+		Symbol:   symbol,
+		Name:     symbol,
+		Creator:  creator,
+		Decimals: info.Decimals,
+		Quote:    info.Quote,
+	}, nil
+}
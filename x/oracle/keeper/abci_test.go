@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func TestEndBlockerAggregation(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	reporters := make([]string, 3)
+	for i := range reporters {
+		addr, err := f.addressCodec.BytesToString([]byte(fmt.Sprintf("reporterAddr_______________%d", i)))
+		require.NoError(t, err)
+		reporters[i] = addr
+	}
+
+	params := types.NewParams([]types.Reporter{
+		{Address: reporters[0], Weight: 1},
+		{Address: reporters[1], Weight: 1},
+		{Address: reporters[2], Weight: 3},
+	}, 5, 2, types.DefaultHistoryRetention, types.DefaultMaxStaleness, nil,
+		types.DefaultMaxDeviationBps, types.DefaultMaxWindowDeviationBps, types.DefaultDeviationWindow, false,
+		types.DefaultBondDenom, math.ZeroInt(), types.DefaultMaxMissedWindows, types.DefaultOutlierThresholdBps, types.DefaultMaxOutliers,
+		types.DefaultSlashFraction, types.DefaultJailDuration, nil, types.DefaultMaxSubscriptions)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.registerSymbol(t, "ETH", 2, "USD")
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	// a single submission does not reach the quorum
+	_, err := srv.SubmitPrice(ctx, &types.MsgSubmitPrice{Reporter: reporters[0], Symbol: "ETH", Rate: 100})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	found, err := f.keeper.Price.Has(ctx, "ETH")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// the aggregate is created in the unit of the registry and owned by the
+	// module account
+	_, err = srv.SubmitPrice(ctx, &types.MsgSubmitPrice{Reporter: reporters[1], Symbol: "ETH", Rate: 110})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	price, err := f.keeper.Price.Get(ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), price.Rate)
+	require.Equal(t, uint32(2), price.Decimals)
+	require.Equal(t, "USD", price.Quote)
+	moduleAddr, err := f.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+	require.Equal(t, moduleAddr, price.Creator)
+
+	// the heaviest reporter moves the weighted median, metadata is kept
+	price.Name = "Ethereum"
+	require.NoError(t, f.keeper.Price.Set(ctx, "ETH", price))
+	ctx = ctx.WithBlockHeight(12)
+	_, err = srv.SubmitPrice(ctx, &types.MsgSubmitPrice{Reporter: reporters[2], Symbol: "ETH", Rate: 120})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	price, err = f.keeper.Price.Get(ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, uint64(120), price.Rate)
+	require.Equal(t, "Ethereum", price.Name)
+
+	// without new submissions the price is left untouched and old ones expire
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	for i, expired := range []bool{true, true, false} {
+		found, err := f.keeper.Submission.Has(ctx, collections.Join("ETH", reporters[i]))
+		require.NoError(t, err)
+		require.Equal(t, !expired, found)
+	}
+	price, err = f.keeper.Price.Get(ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, uint64(120), price.Rate)
+}
+
+func TestEndBlockerDropsRemovedReporters(t *testing.T) {
+	f := initFixture(t)
+
+	reporter, err := f.addressCodec.BytesToString([]byte("reporterAddr________________"))
+	require.NoError(t, err)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(3)
+	require.NoError(t, f.keeper.Submission.Set(ctx, collections.Join("ETH", reporter), types.PriceSubmission{
+		Symbol:   "ETH",
+		Reporter: reporter,
+		Rate:     100,
+		Height:   3,
+	}))
+
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	found, err := f.keeper.Submission.Has(ctx, collections.Join("ETH", reporter))
+	require.NoError(t, err)
+	require.False(t, found)
+	found, err = f.keeper.Price.Has(ctx, "ETH")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestEndBlockerSymbolMinReporters(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	reporters := make([]string, 2)
+	for i := range reporters {
+		addr, err := f.addressCodec.BytesToString([]byte(fmt.Sprintf("reporterAddr_______________%d", i)))
+		require.NoError(t, err)
+		reporters[i] = addr
+	}
+
+	params := types.DefaultParams()
+	params.Reporters = []types.Reporter{{Address: reporters[0], Weight: 1}, {Address: reporters[1], Weight: 1}}
+	params.MinReporters = 1
+	params.MinBond = math.ZeroInt()
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	require.NoError(t, f.keeper.Symbol.Set(f.ctx, "ETH", types.SymbolInfo{Symbol: "ETH", Base: "ETH", MinReporters: 2}))
+	f.registerSymbol(t, "BTC", 0, "")
+
+	// the registered symbol requires two submissions, others the param
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+	for _, symbol := range []string{"ETH", "BTC"} {
+		_, err := srv.SubmitPrice(ctx, &types.MsgSubmitPrice{Reporter: reporters[0], Symbol: symbol, Rate: 100})
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	found, err := f.keeper.Price.Has(ctx, "ETH")
+	require.NoError(t, err)
+	require.False(t, found)
+	found, err = f.keeper.Price.Has(ctx, "BTC")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(11)
+	_, err = srv.SubmitPrice(ctx, &types.MsgSubmitPrice{Reporter: reporters[1], Symbol: "ETH", Rate: 110})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	found, err = f.keeper.Price.Has(ctx, "ETH")
+	require.NoError(t, err)
+	require.True(t, found)
+}
+
+func TestSetAggregatedRateRequiresRegistration(t *testing.T) {
+	f := initFixture(t)
+
+	// vote extensions can carry prices of symbols that were never registered
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(3)
+	require.ErrorIs(t, f.keeper.SetAggregatedRate(ctx, "ETH", 100), types.ErrSymbolNotRegistered)
+	found, err := f.keeper.Price.Has(ctx, "ETH")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestAggregationClaimsUserPrice(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	reporter, err := f.addressCodec.BytesToString([]byte("reporterAddr________________"))
+	require.NoError(t, err)
+	moduleAddr, err := f.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.Reporters = []types.Reporter{{Address: reporter, Weight: 1}}
+	params.MinReporters = 1
+	params.MinBond = math.ZeroInt()
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.registerSymbol(t, "ETH", 0, "")
+	f.registerSymbol(t, "BTC", 0, "")
+
+	for _, symbol := range []string{"ETH", "BTC"} {
+		_, err = srv.CreatePrice(f.ctx, &types.MsgCreatePrice{Creator: creator, Symbol: symbol, Rate: 100, Name: symbol})
+		require.NoError(t, err)
+	}
+
+	// a reporter submission takes the price over from its creator, which can
+	// no longer override the aggregate
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+	_, err = srv.SubmitPrice(ctx, &types.MsgSubmitPrice{Reporter: reporter, Symbol: "ETH", Rate: 101})
+	require.NoError(t, err)
+	price, err := f.keeper.Price.Get(ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, moduleAddr, price.Creator)
+	require.Equal(t, uint64(100), price.Rate)
+
+	_, err = srv.UpdatePrice(ctx, &types.MsgUpdatePrice{Creator: creator, Symbol: "ETH", Rate: 90})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UpdatePrices(ctx, &types.MsgUpdatePrices{Creator: creator, Updates: []types.PriceUpdate{{Symbol: "ETH", Rate: 90}}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.DeletePrice(ctx, &types.MsgDeletePrice{Creator: creator, Symbol: "ETH"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	price, err = f.keeper.Price.Get(ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, uint64(101), price.Rate)
+	require.Equal(t, "ETH", price.Name)
+
+	// so does an aggregate of the vote extensions
+	require.NoError(t, f.keeper.SetAggregatedRate(ctx, "BTC", 102))
+	price, err = f.keeper.Price.Get(ctx, "BTC")
+	require.NoError(t, err)
+	require.Equal(t, moduleAddr, price.Creator)
+	_, err = srv.UpdatePrice(ctx, &types.MsgUpdatePrice{Creator: creator, Symbol: "BTC", Rate: 90})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/oracle/types"
+)
+
+// deviation describes a rate outside the deviation band.
+type deviation struct {
+	reference uint64
+	bps       uint64
+}
+
+// checkDeviation returns the deviation of rate when it falls outside the
+// deviation band of the symbol, or nil when it may be applied. The rate is
+// compared with the current rate of the symbol, if any, and with the oldest
+// observation within the deviation window.
+func (k Keeper) checkDeviation(ctx context.Context, params types.Params, symbol string, current uint64, rate uint64) (*deviation, error) {
+	if params.MaxDeviationBps > 0 && current > 0 {
+		if bps := deviationBps(current, rate); bps > params.MaxDeviationBps {
+			return &deviation{reference: current, bps: bps}, nil
+		}
+	}
+
+	if params.MaxWindowDeviationBps == 0 || params.DeviationWindow == 0 {
+		return nil, nil
+	}
+
+	start := sdk.UnwrapSDKContext(ctx).BlockHeight() - int64(params.DeviationWindow)
+	iter, err := k.History.Iterate(ctx, collections.NewPrefixedPairRange[string, int64](symbol).StartInclusive(start))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, nil
+	}
+	oldest, err := iter.Value()
+	if err != nil {
+		return nil, err
+	}
+	if oldest.Rate == 0 {
+		return nil, nil
+	}
+	if bps := deviationBps(oldest.Rate, rate); bps > params.MaxWindowDeviationBps {
+		return &deviation{reference: oldest.Rate, bps: bps}, nil
+	}
+
+	return nil, nil
+}
+
+// queuePendingPrice stores a rate outside the deviation band as the pending
+// update of its symbol, replacing any previous one.
+func (k Keeper) queuePendingPrice(ctx context.Context, pending types.PendingPrice, dev deviation) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pending.ReferenceRate = dev.reference
+	pending.DeviationBps = dev.bps
+	pending.Height = sdkCtx.BlockHeight()
+	pending.Time = sdkCtx.BlockTime()
+
+	if err := k.PendingPrice.Set(ctx, pending.Symbol, pending); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventPricePending{
+		Symbol:        pending.Symbol,
+		Proposer:      pending.Proposer,
+		Rate:          pending.Rate,
+		ReferenceRate: dev.reference,
+		DeviationBps:  dev.bps,
+	})
+}
+
+// recordRejection records an aggregated rate discarded by the deviation
+// checks. Rejections are pruned with the price history retention.
+func (k Keeper) recordRejection(ctx context.Context, params types.Params, symbol string, rate uint64, dev deviation) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	if err := k.Rejection.Set(ctx, collections.Join(symbol, height), types.PriceRejection{
+		Symbol:        symbol,
+		Height:        height,
+		Time:          sdkCtx.BlockTime(),
+		Rate:          rate,
+		ReferenceRate: dev.reference,
+		DeviationBps:  dev.bps,
+	}); err != nil {
+		return err
+	}
+	if params.HistoryRetention > 0 {
+		if err := pruneSymbolHistory(ctx, k.Rejection, symbol, height-int64(params.HistoryRetention)); err != nil {
+			return err
+		}
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventPriceRejected{
+		Symbol:        symbol,
+		Rate:          rate,
+		ReferenceRate: dev.reference,
+		DeviationBps:  dev.bps,
+	})
+}
+
+// deviationBps returns the absolute change from reference to rate in basis
+// points of reference, saturating at the maximum uint64.
+func deviationBps(reference, rate uint64) uint64 {
+	diff := new(big.Int).Sub(new(big.Int).SetUint64(rate), new(big.Int).SetUint64(reference))
+	diff.Abs(diff)
+	diff.Mul(diff, big.NewInt(10_000))
+	diff.Quo(diff, new(big.Int).SetUint64(reference))
+	if !diff.IsUint64() {
+		return ^uint64(0)
+	}
+
+	return diff.Uint64()
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestUpdatePriceDeviationRejected(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MaxDeviationBps = 1000       // 10% per update
+	params.MaxWindowDeviationBps = 1500 // 15% per window
+	params.DeviationWindow = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1)
+	f.registerSymbol(t, "ETH", 0, "")
+	_, err = srv.CreatePrice(ctx, &types.MsgCreatePrice{Creator: creator, Symbol: "ETH", Rate: 1000})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc   string
+		height int64
+		rate   uint64
+		err    error
+	}{
+		{desc: "beyond the update band", height: 2, rate: 1101, err: types.ErrPriceDeviation},
+		{desc: "within the update band", height: 2, rate: 1100},
+		{desc: "beyond the window band", height: 3, rate: 1151, err: types.ErrPriceDeviation},
+		{desc: "window moved past the reference", height: 12, rate: 1151},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.UpdatePrice(ctx.WithBlockHeight(tc.height), &types.MsgUpdatePrice{Creator: creator, Symbol: "ETH", Rate: tc.rate})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				price, err := f.keeper.Price.Get(ctx, "ETH")
+				require.NoError(t, err)
+				require.Equal(t, tc.rate, price.Rate)
+			}
+		})
+	}
+}
+
+func TestUpdatePriceDeviationPending(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	reporter, err := f.addressCodec.BytesToString([]byte("reporterAddr________________"))
+	require.NoError(t, err)
+	outsider, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
+	require.NoError(t, err)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.Reporters = []types.Reporter{{Address: creator, Weight: 1}, {Address: reporter, Weight: 1}}
+	params.QueueDeviatingUpdates = true
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1)
+	f.registerSymbol(t, "ETH", 0, "")
+	_, err = srv.CreatePrice(ctx, &types.MsgCreatePrice{Creator: creator, Symbol: "ETH", Rate: 1000, Name: "Ether"})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	resp, err := srv.UpdatePrice(ctx, &types.MsgUpdatePrice{Creator: creator, Symbol: "ETH", Rate: 2000, Name: "Ethereum"})
+	require.NoError(t, err)
+	require.True(t, resp.Pending)
+	require.True(t, hasEvent(ctx, "realfin.oracle.v1.EventPricePending"))
+
+	price, err := f.keeper.Price.Get(ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), price.Rate)
+
+	pending, err := qs.GetPendingPrice(ctx, &types.QueryGetPendingPriceRequest{Symbol: "ETH"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2000), pending.PendingPrice.Rate)
+	require.Equal(t, uint64(1000), pending.PendingPrice.ReferenceRate)
+	require.Equal(t, uint64(10000), pending.PendingPrice.DeviationBps)
+	require.Equal(t, creator, pending.PendingPrice.Proposer)
+
+	_, err = srv.ConfirmPendingPrice(ctx, &types.MsgConfirmPendingPrice{Signer: creator, Symbol: "ETH"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ConfirmPendingPrice(ctx, &types.MsgConfirmPendingPrice{Signer: outsider, Symbol: "ETH"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ConfirmPendingPrice(ctx, &types.MsgConfirmPendingPrice{Signer: reporter, Symbol: "BTC"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = srv.ConfirmPendingPrice(ctx, &types.MsgConfirmPendingPrice{Signer: reporter, Symbol: "ETH"})
+	require.NoError(t, err)
+	price, err = f.keeper.Price.Get(ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2000), price.Rate)
+	require.Equal(t, "Ethereum", price.Name)
+	require.Equal(t, creator, price.Creator)
+	found, err := f.keeper.PendingPrice.Has(ctx, "ETH")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// governance may confirm any pending update
+	_, err = srv.UpdatePrice(ctx, &types.MsgUpdatePrice{Creator: creator, Symbol: "ETH", Rate: 100, Name: "Ethereum"})
+	require.NoError(t, err)
+	_, err = srv.ConfirmPendingPrice(ctx, &types.MsgConfirmPendingPrice{Signer: authority, Symbol: "ETH"})
+	require.NoError(t, err)
+	price, err = f.keeper.Price.Get(ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), price.Rate)
+}
+
+func TestAggregatedRateDeviation(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	f.registerSymbol(t, "ETH", 0, "")
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1)
+	require.NoError(t, f.keeper.SetAggregatedRate(ctx, "ETH", 1000))
+
+	// rejected rates are recorded and the price is kept
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.SetAggregatedRate(ctx, "ETH", 5000))
+	require.True(t, hasEvent(ctx, "realfin.oracle.v1.EventPriceRejected"))
+
+	price, err := f.keeper.Price.Get(ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), price.Rate)
+
+	rejections, err := qs.ListPriceRejection(ctx, &types.QueryAllPriceRejectionRequest{Symbol: "ETH"})
+	require.NoError(t, err)
+	require.Len(t, rejections.PriceRejection, 1)
+	require.Equal(t, uint64(5000), rejections.PriceRejection[0].Rate)
+	require.Equal(t, uint64(40000), rejections.PriceRejection[0].DeviationBps)
+
+	// in queue mode the rate awaits confirmation instead
+	params := types.DefaultParams()
+	params.QueueDeviatingUpdates = true
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	require.NoError(t, f.keeper.SetAggregatedRate(ctx.WithBlockHeight(3), "ETH", 5000))
+
+	pending, err := qs.ListPendingPrice(ctx, &types.QueryAllPendingPriceRequest{})
+	require.NoError(t, err)
+	require.Len(t, pending.PendingPrice, 1)
+	require.Empty(t, pending.PendingPrice[0].Proposer)
+
+	// a rate within the band supersedes the pending one
+	require.NoError(t, f.keeper.SetAggregatedRate(ctx.WithBlockHeight(4), "ETH", 1100))
+	pending, err = qs.ListPendingPrice(ctx, &types.QueryAllPendingPriceRequest{})
+	require.NoError(t, err)
+	require.Empty(t, pending.PendingPrice)
+}
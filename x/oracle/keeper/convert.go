@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"realfin/x/oracle/types"
+)
+
+// ConvertAmount converts an amount of the from symbol into an amount of the to
+// symbol, truncating the result. Either side may also be a quote denom that
+// has no price of its own. The conversion uses fresh prices only and looks
+// for, in order:
+//   - a price of from quoted in to, or of to quoted in from;
+//   - prices of from and to quoted in the same denom;
+//   - a price of from quoted in a denom that is itself priced in to.
+func (k Keeper) ConvertAmount(ctx context.Context, amount sdkmath.Int, from, to string) (sdkmath.Int, error) {
+	if amount.IsNegative() {
+		return sdkmath.Int{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount cannot be negative")
+	}
+
+	rate, err := k.crossRate(ctx, from, to)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	value := new(big.Int).Mul(amount.BigInt(), rate.Num())
+	value.Quo(value, rate.Denom())
+
+	return sdkmath.NewIntFromBigInt(value), nil
+}
+
+// crossRate returns the value of one unit of from expressed in units of to.
+// The path is chosen from the quotes of the stored prices, and only the prices
+// of the chosen path must be fresh: a stale price on an unused side does not
+// block the conversion.
+func (k Keeper) crossRate(ctx context.Context, from, to string) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+
+	fromPrice, fromFound, err := k.findPrice(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toPrice, toFound, err := k.findPrice(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case fromFound && fromPrice.Quote == to:
+		return k.freshValue(ctx, fromPrice)
+	case toFound && toPrice.Quote == from:
+		value, err := k.freshValue(ctx, toPrice)
+		if err != nil {
+			return nil, err
+		}
+		return inverse(value)
+	case fromFound && toFound && fromPrice.Quote != "" && fromPrice.Quote == toPrice.Quote:
+		fromValue, err := k.freshValue(ctx, fromPrice)
+		if err != nil {
+			return nil, err
+		}
+		toValue, err := k.freshValue(ctx, toPrice)
+		if err != nil {
+			return nil, err
+		}
+		return quotient(fromValue, toValue)
+	case fromFound && fromPrice.Quote != "":
+		bridge, found, err := k.findPrice(ctx, fromPrice.Quote)
+		if err != nil {
+			return nil, err
+		}
+		if found && bridge.Quote == to {
+			fromValue, err := k.freshValue(ctx, fromPrice)
+			if err != nil {
+				return nil, err
+			}
+			bridgeValue, err := k.freshValue(ctx, bridge)
+			if err != nil {
+				return nil, err
+			}
+			return new(big.Rat).Mul(fromValue, bridgeValue), nil
+		}
+	}
+
+	return nil, errorsmod.Wrapf(types.ErrNoConversionPath, "%s to %s", from, to)
+}
+
+// findPrice returns the price of the symbol, fresh or not, and whether it
+// exists.
+func (k Keeper) findPrice(ctx context.Context, symbol string) (types.Price, bool, error) {
+	price, err := k.Price.Get(ctx, symbol)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Price{}, false, nil
+		}
+
+		return types.Price{}, false, err
+	}
+
+	return price, true, nil
+}
+
+// freshValue returns the value of the price, failing with ErrStalePrice when
+// it is stale.
+func (k Keeper) freshValue(ctx context.Context, price types.Price) (*big.Rat, error) {
+	price, err := k.GetFreshPrice(ctx, price.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return price.RatValue(), nil
+}
+
+func inverse(rate *big.Rat) (*big.Rat, error) {
+	if rate.Sign() == 0 {
+		return nil, errorsmod.Wrap(types.ErrNoConversionPath, "zero rate")
+	}
+
+	return new(big.Rat).Inv(rate), nil
+}
+
+func quotient(num, denom *big.Rat) (*big.Rat, error) {
+	if denom.Sign() == 0 {
+		return nil, errorsmod.Wrap(types.ErrNoConversionPath, "zero rate")
+	}
+
+	return new(big.Rat).Quo(num, denom), nil
+}
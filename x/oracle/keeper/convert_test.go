@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func TestConvertAmount(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1).WithBlockTime(now)
+	for _, price := range []types.MsgCreatePrice{
+		{Symbol: "ETH", Rate: 300000, Decimals: 2, Quote: "USD"}, // 3000.00 USD
+		{Symbol: "BTC", Rate: 60000, Decimals: 0, Quote: "USD"},  // 60000 USD
+		{Symbol: "USD", Rate: 25, Decimals: 1, Quote: "urlf"},    // 2.5 urlf
+		{Symbol: "HOUSE", Rate: 1, Quote: "EUR"},
+		{Symbol: "ZERO", Rate: 0, Quote: "USD"},
+	} {
+		price.Creator = creator
+		f.registerSymbol(t, price.Symbol, price.Decimals, price.Quote)
+		_, err := srv.CreatePrice(ctx, &price)
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		desc     string
+		amount   int64
+		from, to string
+		expected int64
+		err      error
+	}{
+		{desc: "same symbol", amount: 7, from: "ETH", to: "ETH", expected: 7},
+		{desc: "into its quote", amount: 3, from: "ETH", to: "USD", expected: 9000},
+		{desc: "from a quote", amount: 6000, from: "USD", to: "ETH", expected: 2},
+		{desc: "common quote", amount: 100, from: "ETH", to: "BTC", expected: 5},
+		{desc: "bridged quote", amount: 2, from: "ETH", to: "urlf", expected: 15000},
+		{desc: "truncated", amount: 1, from: "USD", to: "ETH", expected: 0},
+		{desc: "unrelated quotes", amount: 1, from: "HOUSE", to: "ETH", err: types.ErrNoConversionPath},
+		{desc: "unknown symbol", amount: 1, from: "SOL", to: "USD", err: types.ErrNoConversionPath},
+		{desc: "zero rate", amount: 1, from: "ETH", to: "ZERO", err: types.ErrNoConversionPath},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			converted, err := f.keeper.ConvertAmount(ctx, sdkmath.NewInt(tc.amount), tc.from, tc.to)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, sdkmath.NewInt(tc.expected), converted)
+			}
+		})
+	}
+
+	// stale prices are not used for conversions
+	_, err = f.keeper.ConvertAmount(ctx.WithBlockTime(now.Add(48*time.Hour)), sdkmath.NewInt(1), "ETH", "USD")
+	require.ErrorIs(t, err, types.ErrStalePrice)
+
+	// a stale price on a side the chosen path does not use is ignored
+	later := ctx.WithBlockTime(now.Add(48 * time.Hour))
+	_, err = srv.UpdatePrice(later, &types.MsgUpdatePrice{Creator: creator, Symbol: "ETH", Rate: 300000, Decimals: 2})
+	require.NoError(t, err)
+	converted, err := f.keeper.ConvertAmount(later, sdkmath.NewInt(3), "ETH", "USD")
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(9000), converted)
+	converted, err = f.keeper.ConvertAmount(later, sdkmath.NewInt(6000), "USD", "ETH")
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(2), converted)
+
+	// but every leg of the chosen path must be fresh
+	_, err = f.keeper.ConvertAmount(later, sdkmath.NewInt(1), "ETH", "urlf")
+	require.ErrorIs(t, err, types.ErrStalePrice)
+}
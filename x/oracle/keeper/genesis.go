@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	"realfin/x/oracle/types"
 )
 
@@ -13,6 +15,54 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 			return err
 		}
 	}
+	for _, elem := range genState.Submissions {
+		if err := k.Submission.Set(ctx, collections.Join(elem.Symbol, elem.Reporter), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.History {
+		if err := k.History.Set(ctx, collections.Join(elem.Symbol, elem.Height), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.PendingPrices {
+		if err := k.PendingPrice.Set(ctx, elem.Symbol, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.Rejections {
+		if err := k.Rejection.Set(ctx, collections.Join(elem.Symbol, elem.Height), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.ReporterInfos {
+		if err := k.ReporterInfo.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.ReporterSlashes {
+		if err := k.Slash.Set(ctx, collections.Join(elem.Reporter, elem.Height), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.RemotePrices {
+		if err := k.RemotePrice.Set(ctx, collections.Join(elem.ChannelId, elem.Symbol), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.Subscriptions {
+		if err := k.Subscription.Set(ctx, collections.Join(elem.ChannelId, elem.Symbol), elem); err != nil {
+			return err
+		}
+		if err := k.SymbolSubscription.Set(ctx, collections.Join(elem.Symbol, elem.ChannelId)); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.Symbols {
+		if err := k.Symbol.Set(ctx, elem.Symbol, elem); err != nil {
+			return err
+		}
+	}
 
 	return k.Params.Set(ctx, genState.Params)
 }
@@ -32,6 +82,61 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	}); err != nil {
 		return nil, err
 	}
+	if err := k.Submission.Walk(ctx, nil, func(_ collections.Pair[string, string], val types.PriceSubmission) (stop bool, err error) {
+		genesis.Submissions = append(genesis.Submissions, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.History.Walk(ctx, nil, func(_ collections.Pair[string, int64], val types.PriceObservation) (stop bool, err error) {
+		genesis.History = append(genesis.History, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.PendingPrice.Walk(ctx, nil, func(_ string, val types.PendingPrice) (stop bool, err error) {
+		genesis.PendingPrices = append(genesis.PendingPrices, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Rejection.Walk(ctx, nil, func(_ collections.Pair[string, int64], val types.PriceRejection) (stop bool, err error) {
+		genesis.Rejections = append(genesis.Rejections, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.ReporterInfo.Walk(ctx, nil, func(_ string, val types.ReporterInfo) (stop bool, err error) {
+		genesis.ReporterInfos = append(genesis.ReporterInfos, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Slash.Walk(ctx, nil, func(_ collections.Pair[string, int64], val types.ReporterSlash) (stop bool, err error) {
+		genesis.ReporterSlashes = append(genesis.ReporterSlashes, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.RemotePrice.Walk(ctx, nil, func(_ collections.Pair[string, string], val types.RemotePrice) (stop bool, err error) {
+		genesis.RemotePrices = append(genesis.RemotePrices, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Subscription.Walk(ctx, nil, func(_ collections.Pair[string, string], val types.Subscription) (stop bool, err error) {
+		genesis.Subscriptions = append(genesis.Subscriptions, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Symbol.Walk(ctx, nil, func(_ string, val types.SymbolInfo) (stop bool, err error) {
+		genesis.Symbols = append(genesis.Symbols, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
 
 	return genesis, nil
 }
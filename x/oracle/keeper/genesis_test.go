@@ -2,16 +2,33 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"realfin/x/oracle/types"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params:   types.DefaultParams(),
-		PriceMap: []types.Price{{Symbol: "0"}, {Symbol: "1"}}}
+		Params:      types.DefaultParams(),
+		PriceMap:    []types.Price{{Symbol: "0"}, {Symbol: "1"}},
+		Submissions: []types.PriceSubmission{{Symbol: "0", Reporter: "0"}, {Symbol: "0", Reporter: "1"}},
+		History: []types.PriceObservation{
+			{Symbol: "0", Height: 1, Time: time.Unix(100, 0).UTC(), Rate: 1},
+			{Symbol: "0", Height: 2, Time: time.Unix(106, 0).UTC(), Rate: 2},
+		},
+		PendingPrices: []types.PendingPrice{{Symbol: "0", Rate: 5, Time: time.Unix(106, 0).UTC()}},
+		Rejections:    []types.PriceRejection{{Symbol: "1", Height: 2, Time: time.Unix(106, 0).UTC(), Rate: 9}},
+		ReporterInfos: []types.ReporterInfo{
+			{Address: "0", Bond: math.NewInt(10), JailedUntil: time.Unix(200, 0).UTC()},
+			{Address: "1", Bond: math.NewInt(20), MissCounter: 1, JailedUntil: time.Time{}},
+		},
+		ReporterSlashes: []types.ReporterSlash{{Reporter: "0", Height: 2, Time: time.Unix(106, 0).UTC(), Amount: math.NewInt(1), Reason: types.SlashReasonOutliers}},
+		RemotePrices:    []types.RemotePrice{{ChannelId: "channel-0", Symbol: "ATOM", Rate: 7, SourceTime: time.Unix(90, 0).UTC()}},
+		Subscriptions:   []types.Subscription{{ChannelId: "channel-0", Symbol: "0", Height: 1}, {ChannelId: "channel-1", Symbol: "0", Height: 2}},
+		Symbols:         []types.SymbolInfo{{Symbol: "0", Base: "0"}, {Symbol: "ETH", Base: "ETH", Quote: "USD", Decimals: 2, MinReporters: 3, Category: "crypto"}}}
 
 	f := initFixture(t)
 	err := f.keeper.InitGenesis(f.ctx, genesisState)
@@ -22,5 +39,14 @@ func TestGenesis(t *testing.T) {
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
 	require.EqualExportedValues(t, genesisState.PriceMap, got.PriceMap)
+	require.EqualExportedValues(t, genesisState.Submissions, got.Submissions)
+	require.EqualExportedValues(t, genesisState.History, got.History)
+	require.EqualExportedValues(t, genesisState.PendingPrices, got.PendingPrices)
+	require.EqualExportedValues(t, genesisState.Rejections, got.Rejections)
+	require.EqualExportedValues(t, genesisState.ReporterInfos, got.ReporterInfos)
+	require.EqualExportedValues(t, genesisState.ReporterSlashes, got.ReporterSlashes)
+	require.EqualExportedValues(t, genesisState.RemotePrices, got.RemotePrices)
+	require.EqualExportedValues(t, genesisState.Subscriptions, got.Subscriptions)
+	require.EqualExportedValues(t, genesisState.Symbols, got.Symbols)
 
 }
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/oracle/types"
+)
+
+// recordObservation appends the rate of the symbol at the current block to
+// its price history, replacing any earlier observation of the same block, and
+// prunes the observations of the symbol that fell out of the retention period.
+func (k Keeper) recordObservation(ctx context.Context, symbol string, rate uint64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.HistoryRetention == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	if err := k.History.Set(ctx, collections.Join(symbol, height), types.PriceObservation{
+		Symbol: symbol,
+		Height: height,
+		Time:   sdkCtx.BlockTime(),
+		Rate:   rate,
+	}); err != nil {
+		return err
+	}
+
+	return pruneSymbolHistory(ctx, k.History, symbol, height-int64(params.HistoryRetention))
+}
+
+// pruneSymbolHistory removes the entries of the symbol recorded at or before
+// the cutoff height from a map keyed by (symbol, height).
+func pruneSymbolHistory[V any](ctx context.Context, m collections.Map[collections.Pair[string, int64], V], symbol string, cutoff int64) error {
+	if cutoff <= 0 {
+		return nil
+	}
+
+	rng := collections.NewPrefixedPairRange[string, int64](symbol).EndInclusive(cutoff)
+	keys, err := m.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	expired, err := keys.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range expired {
+		if err := m.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TWAP returns the time-weighted average rate of the symbol over the window
+// ending at the current block time, the start of the period actually covered
+// by the history and the number of observations used. Each observation is
+// weighted by the time it remained in effect within the window; the last
+// observation before the window start counts from the window start.
+func (k Keeper) TWAP(ctx context.Context, symbol string, window time.Duration) (uint64, time.Time, uint64, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	start := now.Add(-window)
+
+	var observations []types.PriceObservation
+	err := k.History.Walk(ctx, collections.NewPrefixedPairRange[string, int64](symbol), func(_ collections.Pair[string, int64], obs types.PriceObservation) (bool, error) {
+		if !obs.Time.After(start) {
+			// only the latest observation before the window start is kept
+			observations = observations[:0]
+		}
+		observations = append(observations, obs)
+		return false, nil
+	})
+	if err != nil {
+		return 0, time.Time{}, 0, err
+	}
+	if len(observations) == 0 {
+		return 0, time.Time{}, 0, collections.ErrNotFound
+	}
+
+	covered := observations[0].Time
+	if covered.Before(start) {
+		covered = start
+	}
+
+	var (
+		sum   = new(big.Int)
+		total int64
+	)
+	for i, obs := range observations {
+		from, to := obs.Time, now
+		if from.Before(start) {
+			from = start
+		}
+		if i+1 < len(observations) {
+			to = observations[i+1].Time
+		}
+		if !to.After(from) {
+			continue
+		}
+
+		elapsed := to.Sub(from).Nanoseconds()
+		sum.Add(sum, new(big.Int).Mul(new(big.Int).SetUint64(obs.Rate), big.NewInt(elapsed)))
+		total += elapsed
+	}
+
+	if total == 0 {
+		// every observation happened at the current block time
+		return observations[len(observations)-1].Rate, covered, uint64(len(observations)), nil
+	}
+
+	return sum.Quo(sum, big.NewInt(total)).Uint64(), covered, uint64(len(observations)), nil
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/oracle/types"
+)
+
+// ModuleHooks are the oracle hooks of a module.
+type ModuleHooks struct {
+	Module string
+	Hooks  types.OracleHooks
+}
+
+// SetHooks sets the oracle hooks of the modules. The hooks are called in the
+// given order. It panics if the hooks were already set.
+func (k Keeper) SetHooks(hooks ...ModuleHooks) {
+	if len(*k.hooks) != 0 {
+		panic("cannot set oracle hooks twice")
+	}
+
+	*k.hooks = hooks
+}
+
+// afterPriceUpdated calls the AfterPriceUpdated hook of the modules.
+func (k Keeper) afterPriceUpdated(ctx context.Context, oldPrice, newPrice types.Price) error {
+	for _, h := range *k.hooks {
+		if err := k.callHook(ctx, h.Module, types.HookAfterPriceUpdated, newPrice.Symbol, func(ctx context.Context) error {
+			return h.Hooks.AfterPriceUpdated(ctx, newPrice.Symbol, oldPrice, newPrice)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// afterPriceDeleted calls the AfterPriceDeleted hook of the modules.
+func (k Keeper) afterPriceDeleted(ctx context.Context, symbol string) error {
+	for _, h := range *k.hooks {
+		if err := k.callHook(ctx, h.Module, types.HookAfterPriceDeleted, symbol, func(ctx context.Context) error {
+			return h.Hooks.AfterPriceDeleted(ctx, symbol)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// callHook calls the hook of a module on a cache context. The state changes
+// of the hook are written only when it succeeds. A failure, or a panic other
+// than running out of gas, is logged and reported by an EventPriceHookFailed
+// so that it neither reverts the price change nor prevents the following
+// hooks from being called. Hooks consume the gas of the price change.
+func (k Keeper) callHook(ctx context.Context, module, hook, symbol string, fn func(context.Context) error) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+					panic(r)
+				}
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+
+		return fn(cacheCtx)
+	}()
+	if err == nil {
+		write()
+		return nil
+	}
+
+	sdkCtx.Logger().Error("oracle hook failed", "module", module, "hook", hook, "symbol", symbol, "err", err)
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventPriceHookFailed{
+		Module: module,
+		Hook:   hook,
+		Symbol: symbol,
+		Error:  err.Error(),
+	})
+}
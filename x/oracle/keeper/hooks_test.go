@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+// recordingHooks records its calls in a log shared with the other hooks.
+// Before failing, it writes a remote price to check that the writes of failed
+// hooks are discarded.
+type recordingHooks struct {
+	name   string
+	log    *[]string
+	k      keeper.Keeper
+	err    error
+	panics interface{}
+}
+
+func (h recordingHooks) call(ctx context.Context, entry string) error {
+	*h.log = append(*h.log, h.name+":"+entry)
+	if err := h.k.RemotePrice.Set(ctx, collections.Join(h.name, entry), types.RemotePrice{ChannelId: h.name}); err != nil {
+		return err
+	}
+	if h.panics != nil {
+		panic(h.panics)
+	}
+
+	return h.err
+}
+
+func (h recordingHooks) AfterPriceUpdated(ctx context.Context, symbol string, oldPrice, newPrice types.Price) error {
+	if oldPrice.Symbol == "" {
+		return h.call(ctx, "created "+symbol)
+	}
+	return h.call(ctx, "updated "+symbol)
+}
+
+func (h recordingHooks) AfterPriceDeleted(ctx context.Context, symbol string) error {
+	return h.call(ctx, "deleted "+symbol)
+}
+
+func TestOracleHooksOrder(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	var log []string
+	f.keeper.SetHooks(
+		keeper.ModuleHooks{Module: "b", Hooks: recordingHooks{name: "b", log: &log, k: f.keeper}},
+		keeper.ModuleHooks{Module: "a", Hooks: recordingHooks{name: "a", log: &log, k: f.keeper}},
+	)
+	require.Panics(t, func() { f.keeper.SetHooks() })
+
+	// the message server was created before the hooks were set
+	f.registerSymbol(t, "ETH", 0, "")
+	_, err = srv.CreatePrice(f.ctx, &types.MsgCreatePrice{Creator: creator, Symbol: "ETH", Rate: 100})
+	require.NoError(t, err)
+	_, err = srv.UpdatePrice(f.ctx, &types.MsgUpdatePrice{Creator: creator, Symbol: "ETH", Rate: 101})
+	require.NoError(t, err)
+	_, err = srv.DeletePrice(f.ctx, &types.MsgDeletePrice{Creator: creator, Symbol: "ETH"})
+	require.NoError(t, err)
+
+	// hooks are called in the order they were set, after every write
+	require.Equal(t, []string{
+		"b:created ETH", "a:created ETH",
+		"b:updated ETH", "a:updated ETH",
+		"b:deleted ETH", "a:deleted ETH",
+	}, log)
+}
+
+func TestOracleHooksErrorIsolation(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	var log []string
+	f.keeper.SetHooks(
+		keeper.ModuleHooks{Module: "failing", Hooks: recordingHooks{name: "failing", log: &log, k: f.keeper, err: errors.New("boom")}},
+		keeper.ModuleHooks{Module: "panicking", Hooks: recordingHooks{name: "panicking", log: &log, k: f.keeper, panics: "boom"}},
+		keeper.ModuleHooks{Module: "working", Hooks: recordingHooks{name: "working", log: &log, k: f.keeper}},
+	)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	f.registerSymbol(t, "ETH", 0, "")
+	_, err = srv.CreatePrice(ctx, &types.MsgCreatePrice{Creator: creator, Symbol: "ETH", Rate: 100})
+	require.NoError(t, err)
+
+	// the price change is kept and every hook is called
+	_, err = f.keeper.Price.Get(ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, []string{"failing:created ETH", "panicking:created ETH", "working:created ETH"}, log)
+
+	// only the writes of the successful hook are kept
+	for name, kept := range map[string]bool{"failing": false, "panicking": false, "working": true} {
+		found, err := f.keeper.RemotePrice.Has(ctx, collections.Join(name, "created ETH"))
+		require.NoError(t, err)
+		require.Equal(t, kept, found, name)
+	}
+
+	var failed []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "realfin.oracle.v1.EventPriceHookFailed" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == "module" {
+				failed = append(failed, attr.Value)
+			}
+		}
+	}
+	require.Equal(t, []string{`"failing"`, `"panicking"`}, failed)
+}
+
+func TestOracleHooksOutOfGas(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	var log []string
+	f.keeper.SetHooks(keeper.ModuleHooks{Module: "greedy", Hooks: recordingHooks{
+		name:   "greedy",
+		log:    &log,
+		k:      f.keeper,
+		panics: storetypes.ErrorOutOfGas{Descriptor: "hook"},
+	}})
+
+	// running out of gas aborts the price change
+	f.registerSymbol(t, "ETH", 0, "")
+	require.Panics(t, func() {
+		_, _ = srv.CreatePrice(f.ctx, &types.MsgCreatePrice{Creator: creator, Symbol: "ETH", Rate: 100})
+	})
+}
@@ -19,9 +19,36 @@ type Keeper struct {
 	// Typically, this should be the x/gov module account.
 	authority []byte
 
-	Schema collections.Schema
-	Params collections.Item[types.Params]
-	Price  collections.Map[string, types.Price]
+	bankKeeper types.BankKeeper
+	// channelKeeperFn returns the IBC channel keeper, which is created after
+	// the oracle keeper.
+	channelKeeperFn func() types.ChannelKeeper
+	// hooks is shared by the copies of the keeper, so that the hooks set
+	// after the keeper was handed out to the module and its servers are
+	// called by all of them.
+	hooks *[]ModuleHooks
+
+	Schema     collections.Schema
+	Params     collections.Item[types.Params]
+	Price      collections.Map[string, types.Price]
+	Submission collections.Map[collections.Pair[string, string], types.PriceSubmission]
+	History    collections.Map[collections.Pair[string, int64], types.PriceObservation]
+	// Stale holds the symbols whose price crossed its freshness threshold.
+	Stale        collections.KeySet[string]
+	PendingPrice collections.Map[string, types.PendingPrice]
+	Rejection    collections.Map[collections.Pair[string, int64], types.PriceRejection]
+	ReporterInfo collections.Map[string, types.ReporterInfo]
+	Slash        collections.Map[collections.Pair[string, int64], types.ReporterSlash]
+	// RemotePrice holds the prices received over IBC, keyed by channel.
+	RemotePrice  collections.Map[collections.Pair[string, string], types.RemotePrice]
+	Subscription collections.Map[collections.Pair[string, string], types.Subscription]
+	// SymbolSubscription indexes the subscriptions by symbol and channel.
+	SymbolSubscription collections.KeySet[collections.Pair[string, string]]
+	// PushQueue holds the subscribed symbols updated in the current block,
+	// whose prices are pushed to their subscribers at the end of the block.
+	PushQueue collections.KeySet[string]
+	// Symbol holds the registry of the symbols approved by governance.
+	Symbol collections.Map[string, types.SymbolInfo]
 }
 
 func NewKeeper(
@@ -29,7 +56,8 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
-
+	bankKeeper types.BankKeeper,
+	channelKeeperFn func() types.ChannelKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -38,13 +66,30 @@ func NewKeeper(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	k := Keeper{
-		storeService: storeService,
-		cdc:          cdc,
-		addressCodec: addressCodec,
-		authority:    authority,
+		storeService:    storeService,
+		cdc:             cdc,
+		addressCodec:    addressCodec,
+		authority:       authority,
+		bankKeeper:      bankKeeper,
+		channelKeeperFn: channelKeeperFn,
+		hooks:           new([]ModuleHooks),
 
-		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		Price:  collections.NewMap(sb, types.PriceKey, "price", collections.StringKey, codec.CollValue[types.Price](cdc))}
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Price:        collections.NewMap(sb, types.PriceKey, "price", collections.StringKey, codec.CollValue[types.Price](cdc)),
+		Submission:   collections.NewMap(sb, types.SubmissionKey, "submission", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.PriceSubmission](cdc)),
+		History:      collections.NewMap(sb, types.HistoryKey, "history", collections.PairKeyCodec(collections.StringKey, collections.Int64Key), codec.CollValue[types.PriceObservation](cdc)),
+		Stale:        collections.NewKeySet(sb, types.StaleKey, "stale", collections.StringKey),
+		PendingPrice: collections.NewMap(sb, types.PendingPriceKey, "pending_price", collections.StringKey, codec.CollValue[types.PendingPrice](cdc)),
+		Rejection:    collections.NewMap(sb, types.RejectionKey, "rejection", collections.PairKeyCodec(collections.StringKey, collections.Int64Key), codec.CollValue[types.PriceRejection](cdc)),
+		ReporterInfo: collections.NewMap(sb, types.ReporterInfoKey, "reporter_info", collections.StringKey, codec.CollValue[types.ReporterInfo](cdc)),
+		Slash:        collections.NewMap(sb, types.SlashKey, "slash", collections.PairKeyCodec(collections.StringKey, collections.Int64Key), codec.CollValue[types.ReporterSlash](cdc)),
+		RemotePrice:  collections.NewMap(sb, types.RemotePriceKey, "remote_price", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.RemotePrice](cdc)),
+		Subscription: collections.NewMap(sb, types.SubscriptionKey, "subscription", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.Subscription](cdc)),
+		Symbol:       collections.NewMap(sb, types.SymbolKey, "symbol", collections.StringKey, codec.CollValue[types.SymbolInfo](cdc)),
+
+		SymbolSubscription: collections.NewKeySet(sb, types.SymbolSubscriptionKey, "symbol_subscription", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		PushQueue:          collections.NewKeySet(sb, types.PushQueueKey, "push_queue", collections.StringKey),
+	}
 
 	schema, err := sb.Build()
 	if err != nil {
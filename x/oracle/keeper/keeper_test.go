@@ -5,52 +5,101 @@ import (
 	"testing"
 
 	"cosmossdk.io/core/address"
-	storetypes "cosmossdk.io/store/types"
-	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
-	"github.com/cosmos/cosmos-sdk/runtime"
-	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 
+	keepertest "realfin/testutil/keeper"
 	"realfin/x/oracle/keeper"
 	module "realfin/x/oracle/module"
 	"realfin/x/oracle/types"
 )
 
 type fixture struct {
-	ctx          context.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
+	ctx           context.Context
+	keeper        keeper.Keeper
+	addressCodec  address.Codec
+	bankKeeper    *keepertest.BankKeeper
+	channelKeeper *mockChannelKeeper
 }
 
-func initFixture(t *testing.T) *fixture {
-	t.Helper()
+// mockChannelKeeper records the packets sent over IBC.
+type mockChannelKeeper struct {
+	packets []sentPacket
+	err     error
+}
+
+type sentPacket struct {
+	channelID        string
+	timeoutTimestamp uint64
+	data             types.OraclePacketData
+}
+
+func (c *mockChannelKeeper) SendPacket(_ sdk.Context, _ string, sourceChannel string, _ clienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
 
-	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
-	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
-	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	var packet types.OraclePacketData
+	if err := types.ModuleCdc.UnmarshalJSON(data, &packet); err != nil {
+		return 0, err
+	}
+	c.packets = append(c.packets, sentPacket{channelID: sourceChannel, timeoutTimestamp: timeoutTimestamp, data: packet})
+	return uint64(len(c.packets)), nil
+}
 
-	storeService := runtime.NewKVStoreService(storeKey)
-	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
 
+	env := keepertest.NewEnv(t, types.StoreKey, module.AppModule{})
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := keepertest.NewBankKeeper()
+	channelKeeper := &mockChannelKeeper{}
 
 	k := keeper.NewKeeper(
-		storeService,
-		encCfg.Codec,
-		addressCodec,
+		env.StoreService,
+		env.Codec,
+		env.AddressCodec,
 		authority,
+		bankKeeper,
+		func() types.ChannelKeeper { return channelKeeper },
 	)
 
 	// Initialize params
-	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
+	if err := k.Params.Set(env.Ctx, types.DefaultParams()); err != nil {
 		t.Fatalf("failed to set params: %v", err)
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:           env.Ctx,
+		keeper:        k,
+		addressCodec:  env.AddressCodec,
+		bankKeeper:    bankKeeper,
+		channelKeeper: channelKeeper,
+	}
+}
+
+// registerSymbol adds a symbol to the registry, as governance does before a
+// price of the symbol can be created.
+func (f *fixture) registerSymbol(t *testing.T, symbol string, decimals uint32, quote string) {
+	t.Helper()
+
+	info := types.SymbolInfo{Symbol: symbol, Base: symbol, Quote: quote, Decimals: decimals}
+	if err := f.keeper.Symbol.Set(f.ctx, symbol, info); err != nil {
+		t.Fatalf("failed to register symbol: %v", err)
+	}
+}
+
+// allowChannels allows the channels to exchange prices with the oracle.
+func (f *fixture) allowChannels(t *testing.T, channels ...string) {
+	t.Helper()
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	if err != nil {
+		t.Fatalf("failed to get params: %v", err)
+	}
+	params.Channels = append(params.Channels, channels...)
+	if err := f.keeper.Params.Set(f.ctx, params); err != nil {
+		t.Fatalf("failed to set params: %v", err)
 	}
 }
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, setting the params introduced
+// since version 1, which had none, to their defaults, and moving the prices
+// to canonical symbols registered in the symbol registry.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.keeper.Params.Set(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+
+	return m.migratePrices(ctx)
+}
+
+// migratePrices keys the prices by the canonical form of their symbol, upper
+// case and trimmed, and registers the symbols missing from the registry with
+// the decimals and quote of their price. Of the prices sharing a canonical
+// symbol, the most recently updated is kept, preferring the one already
+// canonical; prices whose symbol has no canonical form are dropped.
+func (m Migrator) migratePrices(ctx sdk.Context) error {
+	var keys []string
+	prices := make(map[string]types.Price)
+	if err := m.keeper.Price.Walk(ctx, nil, func(key string, price types.Price) (bool, error) {
+		keys = append(keys, key)
+
+		symbol := strings.ToUpper(strings.TrimSpace(key))
+		info := types.SymbolInfo{Symbol: symbol, Base: symbol, Quote: price.Quote, Decimals: price.Decimals}
+		if err := info.Validate(); err != nil {
+			ctx.Logger().Info("dropping a price without canonical symbol", "symbol", key, "error", err)
+			return false, nil
+		}
+
+		if kept, ok := prices[symbol]; ok {
+			if price.LastUpdatedHeight < kept.LastUpdatedHeight ||
+				(price.LastUpdatedHeight == kept.LastUpdatedHeight && key != symbol) {
+				ctx.Logger().Info("dropping a price duplicating a canonical symbol", "symbol", key, "canonical", symbol)
+				return false, nil
+			}
+		}
+		price.Symbol = symbol
+		prices[symbol] = price
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := m.keeper.Price.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	for _, symbol := range slices.Sorted(maps.Keys(prices)) {
+		price := prices[symbol]
+		if err := m.keeper.Price.Set(ctx, symbol, price); err != nil {
+			return err
+		}
+
+		registered, err := m.keeper.Symbol.Has(ctx, symbol)
+		if err != nil {
+			return err
+		}
+		if registered {
+			continue
+		}
+		if err := m.keeper.Symbol.Set(ctx, symbol, types.SymbolInfo{
+			Symbol:   symbol,
+			Base:     symbol,
+			Quote:    price.Quote,
+			Decimals: price.Decimals,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+
+	// the params of version 1 have no fields
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(sdk.UnwrapSDKContext(f.ctx)))
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+	require.NoError(t, params.Validate())
+}
+
+func TestMigrate1to2Prices(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{}))
+
+	// the prices of version 1, keyed by symbols in any form
+	for key, price := range map[string]types.Price{
+		"ETH":         {Rate: 3000, Decimals: 2, Quote: "USD"},
+		"eth":         {Rate: 2900},
+		"btc":         {Rate: 60000, Quote: "USD"},
+		" usd ":       {Rate: 25, Decimals: 1, Quote: "urlf"},
+		"bad symbol!": {Rate: 1},
+		"SELF":        {Rate: 1, Quote: "SELF"},
+	} {
+		price.Symbol = key
+		price.Creator = creator
+		require.NoError(t, f.keeper.Price.Set(f.ctx, key, price))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(sdk.UnwrapSDKContext(f.ctx)))
+
+	// the prices are keyed by canonical symbols, without duplicates
+	var symbols []string
+	require.NoError(t, f.keeper.Price.Walk(f.ctx, nil, func(key string, price types.Price) (bool, error) {
+		require.Equal(t, key, price.Symbol)
+		symbols = append(symbols, key)
+		return false, nil
+	}))
+	require.Equal(t, []string{"BTC", "ETH", "USD"}, symbols)
+	eth, err := f.keeper.Price.Get(f.ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3000), eth.Rate)
+
+	// and registered with their decimals and quote
+	for _, expected := range []types.SymbolInfo{
+		{Symbol: "BTC", Base: "BTC", Quote: "USD"},
+		{Symbol: "ETH", Base: "ETH", Quote: "USD", Decimals: 2},
+		{Symbol: "USD", Base: "USD", Quote: "urlf", Decimals: 1},
+	} {
+		info, err := f.keeper.Symbol.Get(f.ctx, expected.Symbol)
+		require.NoError(t, err)
+		require.Equal(t, expected, info)
+	}
+
+	// so their owners can still update them
+	_, err = srv.UpdatePrice(f.ctx, &types.MsgUpdatePrice{Creator: creator, Symbol: "BTC", Rate: 61000})
+	require.NoError(t, err)
+	_, err = srv.UpdatePrice(f.ctx, &types.MsgUpdatePrice{Creator: creator, Symbol: "USD", Rate: 26, Decimals: 1})
+	require.NoError(t, err)
+}
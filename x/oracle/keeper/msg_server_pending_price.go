@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"realfin/x/oracle/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ConfirmPendingPrice(ctx context.Context, msg *types.MsgConfirmPendingPrice) (*types.MsgConfirmPendingPriceResponse, error) {
+	signer, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	pending, err := k.PendingPrice.Get(ctx, msg.Symbol)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "no pending price")
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// Checks that the signer is the authority or a second reporter
+	if !sdk.AccAddress(signer).Equals(sdk.AccAddress(k.GetAuthority())) {
+		if _, ok := params.ReporterWeight(msg.Signer); !ok {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "signer is neither the authority nor a whitelisted reporter")
+		}
+		if msg.Signer == pending.Proposer {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "the proposer cannot confirm its own update")
+		}
+	}
+
+	price, err := k.getOrNewModulePrice(ctx, msg.Symbol)
+	if err != nil {
+		if errors.Is(err, types.ErrSymbolNotRegistered) {
+			return nil, err
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	price.Rate = pending.Rate
+	if pending.Proposer != "" {
+		price.Name = pending.Name
+		price.Description = pending.Description
+	}
+
+	if err := k.setPrice(ctx, price); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPendingPriceConfirmed{
+		Symbol:    msg.Symbol,
+		Confirmer: msg.Signer,
+		Rate:      pending.Rate,
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgConfirmPendingPriceResponse{}, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -17,6 +18,34 @@ func (k msgServer) CreatePrice(ctx context.Context, msg *types.MsgCreatePrice) (
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
 	}
 
+	if err := types.ValidateSymbol(msg.Symbol); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSymbol, err.Error())
+	}
+
+	if err := types.ValidatePriceUnit(msg.Symbol, msg.Decimals, msg.Quote); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPriceUnit, err.Error())
+	}
+
+	// Only symbols registered by governance can be created, with the unit of
+	// the registry. An empty quote defaults to the registered one.
+	info, err := k.Symbol.Get(ctx, msg.Symbol)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrSymbolNotRegistered, msg.Symbol)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	quote := msg.Quote
+	if quote == "" {
+		quote = info.Quote
+	}
+	if msg.Decimals != info.Decimals {
+		return nil, errorsmod.Wrapf(types.ErrDecimalsMismatch, "%s is registered with %d decimals, got %d", msg.Symbol, info.Decimals, msg.Decimals)
+	}
+	if quote != info.Quote {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPriceUnit, "%s is registered quoted in %q, got %q", msg.Symbol, info.Quote, quote)
+	}
+
 	// Check if the value already exists
 	ok, err := k.Price.Has(ctx, msg.Symbol)
 	if err != nil {
@@ -31,9 +60,11 @@ func (k msgServer) CreatePrice(ctx context.Context, msg *types.MsgCreatePrice) (
 		Rate:        msg.Rate,
 		Name:        msg.Name,
 		Description: msg.Description,
+		Decimals:    msg.Decimals,
+		Quote:       quote,
 	}
 
-	if err := k.Price.Set(ctx, price.Symbol, price); err != nil {
+	if err := k.setPrice(ctx, price); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
@@ -45,34 +76,138 @@ func (k msgServer) UpdatePrice(ctx context.Context, msg *types.MsgUpdatePrice) (
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
 	}
 
+	val, err := k.ownedPrice(ctx, msg.Creator, msg.Symbol, msg.Decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	pending, err := k.applyPriceUpdate(ctx, params, val, msg.Creator, msg.Rate, msg.Name, msg.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdatePriceResponse{Pending: pending}, nil
+}
+
+func (k msgServer) UpdatePrices(ctx context.Context, msg *types.MsgUpdatePrices) (*types.MsgUpdatePricesResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	if len(msg.Updates) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no price updates")
+	}
+	if len(msg.Updates) > types.MaxPriceUpdates {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%d price updates exceed the maximum of %d", len(msg.Updates), types.MaxPriceUpdates)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// the updates are applied on a cache context so that none of them is
+	// written when one of them fails
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	seen := make(map[string]struct{}, len(msg.Updates))
+	results := make([]types.PriceUpdateResult, 0, len(msg.Updates))
+	for i, update := range msg.Updates {
+		if _, ok := seen[update.Symbol]; ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "update %d: duplicated symbol %s", i, update.Symbol)
+		}
+		seen[update.Symbol] = struct{}{}
+
+		val, err := k.ownedPrice(cacheCtx, msg.Creator, update.Symbol, update.Decimals)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "update %d (%s)", i, update.Symbol)
+		}
+
+		pending, err := k.applyPriceUpdate(cacheCtx, params, val, msg.Creator, update.Rate, val.Name, val.Description)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "update %d (%s)", i, update.Symbol)
+		}
+
+		results = append(results, types.PriceUpdateResult{Symbol: update.Symbol, Pending: pending})
+	}
+	write()
+
+	return &types.MsgUpdatePricesResponse{Results: results}, nil
+}
+
+// ownedPrice returns the price of the symbol after checking that it is owned
+// by the creator, that its symbol is still registered and that the decimals
+// match.
+func (k msgServer) ownedPrice(ctx context.Context, creator, symbol string, decimals uint32) (types.Price, error) {
 	// Check if the value exists
-	val, err := k.Price.Get(ctx, msg.Symbol)
+	val, err := k.Price.Get(ctx, symbol)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
-			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+			return types.Price{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
 		}
 
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		return types.Price{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
 	// Checks if the msg creator is the same as the current owner
-	if msg.Creator != val.Creator {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	if creator != val.Creator {
+		return types.Price{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	// Checks that the rate is expressed with the decimals of the price
+	if decimals != val.Decimals {
+		return types.Price{}, errorsmod.Wrapf(types.ErrDecimalsMismatch, "expected %d decimals, got %d", val.Decimals, decimals)
+	}
+
+	return val, nil
+}
+
+// applyPriceUpdate sets the new rate of the price, or queues it as the pending
+// price of the symbol when it falls outside the deviation band. It returns
+// whether the update was queued.
+func (k msgServer) applyPriceUpdate(ctx context.Context, params types.Params, val types.Price, creator string, rate uint64, name, description string) (bool, error) {
+	// Checks that the rate stays within the deviation band
+	dev, err := k.checkDeviation(ctx, params, val.Symbol, val.Rate, rate)
+	if err != nil {
+		return false, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if dev != nil {
+		if !params.QueueDeviatingUpdates {
+			return false, errorsmod.Wrapf(types.ErrPriceDeviation, "rate %d deviates %d bps from %d", rate, dev.bps, dev.reference)
+		}
+
+		if err := k.queuePendingPrice(ctx, types.PendingPrice{
+			Symbol:      val.Symbol,
+			Rate:        rate,
+			Name:        name,
+			Description: description,
+			Proposer:    creator,
+		}, *dev); err != nil {
+			return false, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+
+		return true, nil
 	}
 
 	var price = types.Price{
-		Creator:     msg.Creator,
-		Symbol:      msg.Symbol,
-		Rate:        msg.Rate,
-		Name:        msg.Name,
-		Description: msg.Description,
+		Creator:     creator,
+		Symbol:      val.Symbol,
+		Rate:        rate,
+		Name:        name,
+		Description: description,
+		Decimals:    val.Decimals,
+		Quote:       val.Quote,
 	}
 
-	if err := k.Price.Set(ctx, price.Symbol, price); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update price")
+	if err := k.setPrice(ctx, price); err != nil {
+		return false, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update price")
 	}
 
-	return &types.MsgUpdatePriceResponse{}, nil
+	return false, nil
 }
 
 func (k msgServer) DeletePrice(ctx context.Context, msg *types.MsgDeletePrice) (*types.MsgDeletePriceResponse, error) {
@@ -95,7 +230,7 @@ func (k msgServer) DeletePrice(ctx context.Context, msg *types.MsgDeletePrice) (
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	if err := k.Price.Remove(ctx, msg.Symbol); err != nil {
+	if err := k.removePrice(ctx, msg.Symbol); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove price")
 	}
 
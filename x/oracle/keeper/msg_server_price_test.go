@@ -21,6 +21,7 @@ func TestPriceMsgServerCreate(t *testing.T) {
 		expected := &types.MsgCreatePrice{Creator: creator,
 			Symbol: strconv.Itoa(i),
 		}
+		f.registerSymbol(t, expected.Symbol, 0, "")
 		_, err := srv.CreatePrice(f.ctx, expected)
 		require.NoError(t, err)
 		rst, err := f.keeper.Price.Get(f.ctx, expected.Symbol)
@@ -29,6 +30,49 @@ func TestPriceMsgServerCreate(t *testing.T) {
 	}
 }
 
+func TestPriceMsgServerCreateUnit(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	f.registerSymbol(t, "ETH", 2, "USD")
+
+	tests := []struct {
+		desc    string
+		request *types.MsgCreatePrice
+		err     error
+	}{
+		{
+			desc:    "too many decimals",
+			request: &types.MsgCreatePrice{Creator: creator, Symbol: "ETH", Decimals: types.MaxDecimals + 1},
+			err:     types.ErrInvalidPriceUnit,
+		},
+		{
+			desc:    "quoted in itself",
+			request: &types.MsgCreatePrice{Creator: creator, Symbol: "ETH", Quote: "ETH"},
+			err:     types.ErrInvalidPriceUnit,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgCreatePrice{Creator: creator, Symbol: "ETH", Rate: 500123, Decimals: 2, Quote: "USD"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.CreatePrice(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				rst, err := f.keeper.Price.Get(f.ctx, tc.request.Symbol)
+				require.NoError(t, err)
+				require.Equal(t, tc.request.Decimals, rst.Decimals)
+				require.Equal(t, tc.request.Quote, rst.Quote)
+			}
+		})
+	}
+}
+
 func TestPriceMsgServerUpdate(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
@@ -42,6 +86,7 @@ func TestPriceMsgServerUpdate(t *testing.T) {
 	expected := &types.MsgCreatePrice{Creator: creator,
 		Symbol: strconv.Itoa(0),
 	}
+	f.registerSymbol(t, expected.Symbol, 0, "")
 	_, err = srv.CreatePrice(f.ctx, expected)
 	require.NoError(t, err)
 
@@ -71,6 +116,14 @@ func TestPriceMsgServerUpdate(t *testing.T) {
 			},
 			err: sdkerrors.ErrKeyNotFound,
 		},
+		{
+			desc: "decimals mismatch",
+			request: &types.MsgUpdatePrice{Creator: creator,
+				Symbol:   strconv.Itoa(0),
+				Decimals: 6,
+			},
+			err: types.ErrDecimalsMismatch,
+		},
 		{
 			desc: "completed",
 			request: &types.MsgUpdatePrice{Creator: creator,
@@ -93,6 +146,101 @@ func TestPriceMsgServerUpdate(t *testing.T) {
 	}
 }
 
+func TestPriceMsgServerUpdatePrices(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	otherAddr, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+
+	for _, msg := range []*types.MsgCreatePrice{
+		{Creator: creator, Symbol: "ETH", Rate: 350_000, Name: "Ethereum", Decimals: 2},
+		{Creator: creator, Symbol: "BTC", Rate: 98_000, Name: "Bitcoin"},
+		{Creator: otherAddr, Symbol: "ATOM", Rate: 10},
+	} {
+		f.registerSymbol(t, msg.Symbol, msg.Decimals, msg.Quote)
+		_, err := srv.CreatePrice(f.ctx, msg)
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		desc    string
+		updates []types.PriceUpdate
+		err     error
+	}{
+		{
+			desc: "no updates",
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "duplicated symbol",
+			updates: []types.PriceUpdate{{Symbol: "BTC", Rate: 98_100}, {Symbol: "BTC", Rate: 98_200}},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "unauthorized",
+			updates: []types.PriceUpdate{{Symbol: "BTC", Rate: 98_100}, {Symbol: "ATOM", Rate: 11}},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "key not found",
+			updates: []types.PriceUpdate{{Symbol: "BTC", Rate: 98_100}, {Symbol: "OSMO", Rate: 1}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "decimals mismatch",
+			updates: []types.PriceUpdate{{Symbol: "BTC", Rate: 98_100}, {Symbol: "ETH", Rate: 3_510}},
+			err:     types.ErrDecimalsMismatch,
+		},
+		{
+			desc:    "deviation",
+			updates: []types.PriceUpdate{{Symbol: "BTC", Rate: 98_100}, {Symbol: "ETH", Rate: 700_000, Decimals: 2}},
+			err:     types.ErrPriceDeviation,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.UpdatePrices(f.ctx, &types.MsgUpdatePrices{Creator: creator, Updates: tc.updates})
+			require.ErrorIs(t, err, tc.err)
+
+			// failed messages apply none of their updates
+			btc, err := f.keeper.Price.Get(f.ctx, "BTC")
+			require.NoError(t, err)
+			require.Equal(t, uint64(98_000), btc.Rate)
+		})
+	}
+
+	res, err := srv.UpdatePrices(f.ctx, &types.MsgUpdatePrices{Creator: creator, Updates: []types.PriceUpdate{
+		{Symbol: "ETH", Rate: 351_000, Decimals: 2},
+		{Symbol: "BTC", Rate: 98_100},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceUpdateResult{{Symbol: "ETH"}, {Symbol: "BTC"}}, res.Results)
+
+	eth, err := f.keeper.Price.Get(f.ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, uint64(351_000), eth.Rate)
+	require.Equal(t, "Ethereum", eth.Name)
+	btc, err := f.keeper.Price.Get(f.ctx, "BTC")
+	require.NoError(t, err)
+	require.Equal(t, uint64(98_100), btc.Rate)
+
+	// deviating updates are queued when the params allow it
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.QueueDeviatingUpdates = true
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	res, err = srv.UpdatePrices(f.ctx, &types.MsgUpdatePrices{Creator: creator, Updates: []types.PriceUpdate{
+		{Symbol: "ETH", Rate: 700_000, Decimals: 2},
+		{Symbol: "BTC", Rate: 98_200},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceUpdateResult{{Symbol: "ETH", Pending: true}, {Symbol: "BTC"}}, res.Results)
+}
+
 func TestPriceMsgServerDelete(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
@@ -103,6 +251,7 @@ func TestPriceMsgServerDelete(t *testing.T) {
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
+	f.registerSymbol(t, strconv.Itoa(0), 0, "")
 	_, err = srv.CreatePrice(f.ctx, &types.MsgCreatePrice{Creator: creator,
 		Symbol: strconv.Itoa(0),
 	})
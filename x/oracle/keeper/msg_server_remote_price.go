@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"realfin/x/oracle/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+func (k msgServer) RequestRemotePrices(ctx context.Context, msg *types.MsgRequestRemotePrices) (*types.MsgRequestRemotePricesResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if err := validatePacketChannel(ctx, msg.ChannelId, msg.TimeoutTimestamp); err != nil {
+		return nil, err
+	}
+
+	packet := types.OraclePacketData{Packet: &types.OraclePacketData_PriceRequest{
+		PriceRequest: &types.PriceRequestPacketData{Symbols: msg.Symbols},
+	}}
+	sequence, err := k.TransmitOraclePacket(ctx, packet, msg.ChannelId, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestRemotePricesResponse{Sequence: sequence}, nil
+}
+
+func (k msgServer) SubscribeRemotePrices(ctx context.Context, msg *types.MsgSubscribeRemotePrices) (*types.MsgSubscribeRemotePricesResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if err := validatePacketChannel(ctx, msg.ChannelId, msg.TimeoutTimestamp); err != nil {
+		return nil, err
+	}
+
+	packet := types.OraclePacketData{Packet: &types.OraclePacketData_Subscription{
+		Subscription: &types.SubscriptionPacketData{Symbols: msg.Symbols, Unsubscribe: msg.Unsubscribe},
+	}}
+	sequence, err := k.TransmitOraclePacket(ctx, packet, msg.ChannelId, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubscribeRemotePricesResponse{Sequence: sequence}, nil
+}
+
+// validatePacketChannel checks the channel identifier and the timeout of an
+// outgoing oracle packet.
+func validatePacketChannel(ctx context.Context, channelID string, timeoutTimestamp uint64) error {
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if timeoutTimestamp != 0 && timeoutTimestamp <= uint64(sdk.UnwrapSDKContext(ctx).BlockTime().UnixNano()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "timeout timestamp must be in the future")
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"realfin/x/oracle/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) BondReporter(ctx context.Context, msg *types.MsgBondReporter) (*types.MsgBondReporterResponse, error) {
+	reporter, err := k.addressCodec.StringToBytes(msg.Reporter)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid reporter address: %s", err))
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := validateBondAmount(params, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	info, err := k.getReporterInfo(ctx, msg.Reporter)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, reporter, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	info.Bond = info.Bond.Add(msg.Amount.Amount)
+	if err := k.ReporterInfo.Set(ctx, msg.Reporter, info); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgBondReporterResponse{}, nil
+}
+
+func (k msgServer) UnbondReporter(ctx context.Context, msg *types.MsgUnbondReporter) (*types.MsgUnbondReporterResponse, error) {
+	reporter, err := k.addressCodec.StringToBytes(msg.Reporter)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid reporter address: %s", err))
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := validateBondAmount(params, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	info, err := k.getReporterInfo(ctx, msg.Reporter)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if info.Bond.LT(msg.Amount.Amount) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "bond %s is smaller than %s", info.Bond, msg.Amount.Amount)
+	}
+	remaining := info.Bond.Sub(msg.Amount.Amount)
+
+	// Whitelisted reporters must keep the minimum bond
+	if _, ok := params.ReporterWeight(msg.Reporter); ok && remaining.LT(params.MinBond) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientBond, "remaining bond %s is below the minimum bond %s", remaining, params.MinBond)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, reporter, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	info.Bond = remaining
+	if err := k.ReporterInfo.Set(ctx, msg.Reporter, info); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgUnbondReporterResponse{}, nil
+}
+
+func (k msgServer) UnjailReporter(ctx context.Context, msg *types.MsgUnjailReporter) (*types.MsgUnjailReporterResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Reporter); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid reporter address: %s", err))
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	info, err := k.getReporterInfo(ctx, msg.Reporter)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if !isJailed(info) {
+		return nil, errorsmod.Wrapf(types.ErrReporterNotJailed, "%s", msg.Reporter)
+	}
+	if sdk.UnwrapSDKContext(ctx).BlockTime().Before(info.JailedUntil) {
+		return nil, errorsmod.Wrapf(types.ErrReporterJailed, "jailed until %s", info.JailedUntil)
+	}
+	if info.Bond.LT(params.MinBond) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientBond, "bond %s is below the minimum bond %s", info.Bond, params.MinBond)
+	}
+
+	info.JailedUntil = time.Time{}
+	if err := k.ReporterInfo.Set(ctx, msg.Reporter, info); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgUnjailReporterResponse{}, nil
+}
+
+// validateBondAmount checks that the amount is a positive amount of the bond
+// denom.
+func validateBondAmount(params types.Params, amount sdk.Coin) error {
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", amount)
+	}
+	if amount.Denom != params.BondDenom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "expected %s, got %s", params.BondDenom, amount.Denom)
+	}
+
+	return nil
+}
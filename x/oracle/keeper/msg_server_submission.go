@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"realfin/x/oracle/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) SubmitPrice(ctx context.Context, msg *types.MsgSubmitPrice) (*types.MsgSubmitPriceResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Reporter); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid reporter address: %s", err))
+	}
+
+	if err := types.ValidateSymbol(msg.Symbol); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSymbol, err.Error())
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// Only symbols registered by governance can be aggregated
+	registered, err := k.Symbol.Has(ctx, msg.Symbol)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !registered {
+		return nil, errorsmod.Wrap(types.ErrSymbolNotRegistered, msg.Symbol)
+	}
+
+	// Only whitelisted reporters may contribute to the aggregate
+	if _, ok := params.ReporterWeight(msg.Reporter); !ok {
+		return nil, errorsmod.Wrapf(types.ErrReporterNotWhitelisted, "%s", msg.Reporter)
+	}
+
+	info, err := k.getReporterInfo(ctx, msg.Reporter)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if isJailed(info) {
+		return nil, errorsmod.Wrapf(types.ErrReporterJailed, "%s", msg.Reporter)
+	}
+	if info.Bond.LT(params.MinBond) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientBond, "bond %s is below the minimum bond %s", info.Bond, params.MinBond)
+	}
+
+	// Reporters take over the price from its creator as soon as they report it
+	price, err := k.Price.Get(ctx, msg.Symbol)
+	if err == nil {
+		err = k.claimPrice(ctx, &price)
+	} else if errors.Is(err, collections.ErrNotFound) {
+		err = nil
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	var submission = types.PriceSubmission{
+		Symbol:   msg.Symbol,
+		Reporter: msg.Reporter,
+		Rate:     msg.Rate,
+		Height:   sdk.UnwrapSDKContext(ctx).BlockHeight(),
+	}
+
+	if err := k.Submission.Set(ctx, collections.Join(submission.Symbol, submission.Reporter), submission); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	info.LastSubmissionHeight = submission.Height
+	if err := k.ReporterInfo.Set(ctx, msg.Reporter, info); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgSubmitPriceResponse{}, nil
+}
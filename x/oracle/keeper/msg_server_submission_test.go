@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func TestPriceSubmissionMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	reporter, err := f.addressCodec.BytesToString([]byte("reporterAddr________________"))
+	require.NoError(t, err)
+
+	outsider, err := f.addressCodec.BytesToString([]byte("outsiderAddr________________"))
+	require.NoError(t, err)
+
+	unbonded, err := f.addressCodec.BytesToString([]byte("unbondedAddr________________"))
+	require.NoError(t, err)
+
+	jailed, err := f.addressCodec.BytesToString([]byte("jailedAddr__________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.Reporters = []types.Reporter{{Address: reporter, Weight: 1}, {Address: unbonded, Weight: 1}, {Address: jailed, Weight: 1}}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.registerSymbol(t, "ETH", 0, "")
+	require.NoError(t, f.keeper.ReporterInfo.Set(f.ctx, reporter, types.ReporterInfo{Address: reporter, Bond: params.MinBond}))
+	require.NoError(t, f.keeper.ReporterInfo.Set(f.ctx, unbonded, types.ReporterInfo{Address: unbonded, Bond: params.MinBond.SubRaw(1)}))
+	require.NoError(t, f.keeper.ReporterInfo.Set(f.ctx, jailed, types.ReporterInfo{
+		Address:     jailed,
+		Bond:        params.MinBond,
+		JailedUntil: time.Unix(1, 0).UTC(),
+	}))
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(7)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgSubmitPrice
+		err     error
+	}{
+		{
+			desc:    "invalid address",
+			request: &types.MsgSubmitPrice{Reporter: "invalid", Symbol: "ETH", Rate: 10},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "empty symbol",
+			request: &types.MsgSubmitPrice{Reporter: reporter, Rate: 10},
+			err:     types.ErrInvalidSymbol,
+		},
+		{
+			desc:    "non-canonical symbol",
+			request: &types.MsgSubmitPrice{Reporter: reporter, Symbol: "Eth ", Rate: 10},
+			err:     types.ErrInvalidSymbol,
+		},
+		{
+			desc:    "unregistered symbol",
+			request: &types.MsgSubmitPrice{Reporter: reporter, Symbol: "BTC", Rate: 10},
+			err:     types.ErrSymbolNotRegistered,
+		},
+		{
+			desc:    "not whitelisted",
+			request: &types.MsgSubmitPrice{Reporter: outsider, Symbol: "ETH", Rate: 10},
+			err:     types.ErrReporterNotWhitelisted,
+		},
+		{
+			desc:    "insufficient bond",
+			request: &types.MsgSubmitPrice{Reporter: unbonded, Symbol: "ETH", Rate: 10},
+			err:     types.ErrInsufficientBond,
+		},
+		{
+			desc:    "jailed",
+			request: &types.MsgSubmitPrice{Reporter: jailed, Symbol: "ETH", Rate: 10},
+			err:     types.ErrReporterJailed,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgSubmitPrice{Reporter: reporter, Symbol: "ETH", Rate: 10},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SubmitPrice(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				rst, err := f.keeper.Submission.Get(ctx, collections.Join(tc.request.Symbol, tc.request.Reporter))
+				require.NoError(t, err)
+				require.Equal(t, tc.request.Rate, rst.Rate)
+				require.Equal(t, int64(7), rst.Height)
+				info, err := f.keeper.ReporterInfo.Get(ctx, tc.request.Reporter)
+				require.NoError(t, err)
+				require.Equal(t, int64(7), info.LastSubmissionHeight)
+			}
+		})
+	}
+}
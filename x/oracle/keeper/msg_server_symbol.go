@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"realfin/x/oracle/types"
+)
+
+func (k msgServer) RegisterSymbol(ctx context.Context, req *types.MsgRegisterSymbol) (*types.MsgRegisterSymbolResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(req.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	}
+
+	if err := req.Info.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSymbol, err.Error())
+	}
+
+	// the registry can only be extended: registered symbols are never
+	// overwritten
+	ok, err := k.Symbol.Has(ctx, req.Info.Symbol)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if ok {
+		return nil, errorsmod.Wrap(types.ErrSymbolRegistered, req.Info.Symbol)
+	}
+
+	if err := k.Symbol.Set(ctx, req.Info.Symbol, req.Info); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventSymbolRegistered{
+		Symbol:   req.Info.Symbol,
+		Base:     req.Info.Base,
+		Quote:    req.Info.Quote,
+		Decimals: req.Info.Decimals,
+		Category: req.Info.Category,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterSymbolResponse{}, nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func TestMsgRegisterSymbol(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+	eth := types.SymbolInfo{Symbol: "ETH", Base: "ETH", Quote: "USD", Decimals: 2, MinReporters: 3, Category: "crypto"}
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgRegisterSymbol
+		err  error
+	}{
+		{
+			desc: "invalid authority",
+			msg:  &types.MsgRegisterSymbol{Authority: other, Info: eth},
+			err:  types.ErrInvalidSigner,
+		},
+		{
+			desc: "lower case",
+			msg:  &types.MsgRegisterSymbol{Authority: authority, Info: types.SymbolInfo{Symbol: "eth", Base: "ETH"}},
+			err:  types.ErrInvalidSymbol,
+		},
+		{
+			desc: "whitespace",
+			msg:  &types.MsgRegisterSymbol{Authority: authority, Info: types.SymbolInfo{Symbol: "ETH ", Base: "ETH"}},
+			err:  types.ErrInvalidSymbol,
+		},
+		{
+			desc: "empty segment",
+			msg:  &types.MsgRegisterSymbol{Authority: authority, Info: types.SymbolInfo{Symbol: "EUR//USD", Base: "EUR"}},
+			err:  types.ErrInvalidSymbol,
+		},
+		{
+			desc: "no base",
+			msg:  &types.MsgRegisterSymbol{Authority: authority, Info: types.SymbolInfo{Symbol: "ETH"}},
+			err:  types.ErrInvalidSymbol,
+		},
+		{
+			desc: "quoted in itself",
+			msg:  &types.MsgRegisterSymbol{Authority: authority, Info: types.SymbolInfo{Symbol: "ETH", Base: "ETH", Quote: "ETH"}},
+			err:  types.ErrInvalidSymbol,
+		},
+		{
+			desc: "registered",
+			msg:  &types.MsgRegisterSymbol{Authority: authority, Info: eth},
+		},
+		{
+			desc: "already registered",
+			msg:  &types.MsgRegisterSymbol{Authority: authority, Info: types.SymbolInfo{Symbol: "ETH", Base: "ETH", Quote: "EUR"}},
+			err:  types.ErrSymbolRegistered,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+			_, err := srv.RegisterSymbol(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, hasEvent(ctx, "realfin.oracle.v1.EventSymbolRegistered"))
+		})
+	}
+
+	info, err := f.keeper.Symbol.Get(f.ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, eth, info)
+}
+
+func TestPriceMsgServerCreateRegisteredSymbol(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	f.registerSymbol(t, "ETH", 2, "USD")
+	f.registerSymbol(t, "EUR/USD", 4, "USD")
+
+	tests := []struct {
+		desc    string
+		request *types.MsgCreatePrice
+		err     error
+	}{
+		{
+			desc:    "not canonical",
+			request: &types.MsgCreatePrice{Creator: creator, Symbol: "eth", Decimals: 2},
+			err:     types.ErrInvalidSymbol,
+		},
+		{
+			desc:    "not registered",
+			request: &types.MsgCreatePrice{Creator: creator, Symbol: "BTC"},
+			err:     types.ErrSymbolNotRegistered,
+		},
+		{
+			desc:    "other decimals",
+			request: &types.MsgCreatePrice{Creator: creator, Symbol: "ETH", Decimals: 3},
+			err:     types.ErrDecimalsMismatch,
+		},
+		{
+			desc:    "other quote",
+			request: &types.MsgCreatePrice{Creator: creator, Symbol: "ETH", Decimals: 2, Quote: "EUR"},
+			err:     types.ErrInvalidPriceUnit,
+		},
+		{
+			desc:    "registered quote",
+			request: &types.MsgCreatePrice{Creator: creator, Symbol: "EUR/USD", Rate: 10_850, Decimals: 4},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.CreatePrice(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	price, err := f.keeper.Price.Get(f.ctx, "EUR/USD")
+	require.NoError(t, err)
+	require.Equal(t, "USD", price.Quote)
+}
@@ -24,6 +24,21 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	// Bonds are held in the bond denom, which cannot change under them
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Params.BondDenom != params.BondDenom {
+		bonded, err := k.hasBonds(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if bonded {
+			return nil, errorsmod.Wrapf(types.ErrBondDenomChange, "cannot change bond denom %s while reporters hold bonds", params.BondDenom)
+		}
+	}
+
 	if err := k.Params.Set(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"realfin/x/oracle/keeper"
@@ -36,12 +37,13 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "nil min bond and slash fraction",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
 				Params:    types.Params{},
 			},
-			expErr: false,
+			expErr:    true,
+			expErrMsg: "min bond cannot be nil",
 		},
 		{
 			name: "all good",
@@ -66,3 +68,31 @@ func TestMsgUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateParamsBondDenom(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	reporterAddr := sdk.AccAddress("reporterAddr________________")
+	reporter, err := f.addressCodec.BytesToString(reporterAddr)
+	require.NoError(t, err)
+	f.bankKeeper.Balances[reporterAddr.String()] = sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 1_000))
+	_, err = ms.BondReporter(f.ctx, &types.MsgBondReporter{Reporter: reporter, Amount: sdk.NewInt64Coin(params.BondDenom, 1_000)})
+	require.NoError(t, err)
+
+	// the bond denom cannot change while bonds are held in it
+	changed := params
+	changed.BondDenom = "stake"
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: changed})
+	require.ErrorIs(t, err, types.ErrBondDenomChange)
+
+	_, err = ms.UnbondReporter(f.ctx, &types.MsgUnbondReporter{Reporter: reporter, Amount: sdk.NewInt64Coin(params.BondDenom, 1_000)})
+	require.NoError(t, err)
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: changed})
+	require.NoError(t, err)
+}
@@ -0,0 +1,386 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"realfin/x/oracle/types"
+)
+
+// TransmitOraclePacket sends an oracle packet on the channel of the oracle
+// port and returns its sequence. The channel must be allowed by governance.
+// A zero timeout timestamp uses the default packet timeout.
+func (k Keeper) TransmitOraclePacket(ctx context.Context, packet types.OraclePacketData, channelID string, timeoutTimestamp uint64) (uint64, error) {
+	if k.channelKeeperFn == nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, "ibc is not available")
+	}
+	channelKeeper := k.channelKeeperFn()
+	if channelKeeper == nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, "ibc is not available")
+	}
+
+	if err := packet.ValidateBasic(); err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !params.ChannelAllowed(channelID) {
+		return 0, errorsmod.Wrap(types.ErrChannelNotAllowed, channelID)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(sdkCtx.BlockTime().Add(types.DefaultPacketTimeout).UnixNano())
+	}
+
+	return channelKeeper.SendPacket(sdkCtx, types.PortID, channelID, clienttypes.ZeroHeight(), timeoutTimestamp, packet.GetBytes())
+}
+
+// OnRecvOraclePacket processes an oracle packet received on the channel and
+// returns the result of its acknowledgement.
+func (k Keeper) OnRecvOraclePacket(ctx context.Context, packet channeltypes.Packet, data types.OraclePacketData) ([]byte, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.ChannelAllowed(packet.DestinationChannel) {
+		return nil, errorsmod.Wrap(types.ErrChannelNotAllowed, packet.DestinationChannel)
+	}
+
+	switch data := data.Packet.(type) {
+	case *types.OraclePacketData_PriceRequest:
+		return k.onRecvPriceRequest(ctx, data.PriceRequest)
+	case *types.OraclePacketData_PricePush:
+		if err := k.storeRemotePrices(ctx, packet.DestinationChannel, data.PricePush.Prices); err != nil {
+			return nil, err
+		}
+		return []byte{byte(1)}, nil
+	case *types.OraclePacketData_Subscription:
+		if err := k.onRecvSubscription(ctx, params, packet.DestinationChannel, data.Subscription); err != nil {
+			return nil, err
+		}
+		return []byte{byte(1)}, nil
+	default:
+		return nil, fmt.Errorf("unrecognized oracle packet type: %T", data)
+	}
+}
+
+// OnAcknowledgementOraclePacket processes the acknowledgement of an oracle
+// packet sent on the channel. The prices answering a price request are stored
+// as remote prices of the channel.
+func (k Keeper) OnAcknowledgementOraclePacket(ctx context.Context, packet channeltypes.Packet, data types.OraclePacketData, ack channeltypes.Acknowledgement) error {
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.emitPacketFailed(ctx, packet, resp.Error)
+	case *channeltypes.Acknowledgement_Result:
+		if _, ok := data.Packet.(*types.OraclePacketData_PriceRequest); !ok {
+			return nil
+		}
+
+		var response types.PriceResponsePacketData
+		if err := types.ModuleCdc.UnmarshalJSON(resp.Result, &response); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		if err := response.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		return k.storeRemotePrices(ctx, packet.SourceChannel, response.Prices)
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unrecognized acknowledgement type: %T", resp)
+	}
+}
+
+// OnTimeoutOraclePacket processes the timeout of an oracle packet sent on the
+// channel.
+func (k Keeper) OnTimeoutOraclePacket(ctx context.Context, packet channeltypes.Packet, _ types.OraclePacketData) error {
+	return k.emitPacketFailed(ctx, packet, "timeout")
+}
+
+// RemoveChannelSubscriptions removes the subscriptions of a closed channel.
+func (k Keeper) RemoveChannelSubscriptions(ctx context.Context, channelID string) error {
+	var symbols []string
+	if err := k.Subscription.Walk(ctx, collections.NewPrefixedPairRange[string, string](channelID), func(key collections.Pair[string, string], _ types.Subscription) (bool, error) {
+		symbols = append(symbols, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, symbol := range symbols {
+		if err := k.removeSubscription(ctx, channelID, symbol); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// onRecvPriceRequest answers a price request with the local prices of the
+// requested symbols.
+func (k Keeper) onRecvPriceRequest(ctx context.Context, request *types.PriceRequestPacketData) ([]byte, error) {
+	var response types.PriceResponsePacketData
+	for _, symbol := range request.Symbols {
+		price, err := k.Price.Get(ctx, symbol)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		response.Prices = append(response.Prices, packetPrice(price))
+	}
+
+	return types.ModuleCdc.MarshalJSON(&response)
+}
+
+// onRecvSubscription subscribes the channel to the symbols, or unsubscribes
+// it. Only registered symbols with a price can be subscribed to, up to the
+// max subscriptions of a channel.
+func (k Keeper) onRecvSubscription(ctx context.Context, params types.Params, channelID string, subscription *types.SubscriptionPacketData) error {
+	if subscription.Unsubscribe {
+		for _, symbol := range subscription.Symbols {
+			if err := k.removeSubscription(ctx, channelID, symbol); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	count, err := k.countSubscriptions(ctx, channelID)
+	if err != nil {
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	for _, symbol := range subscription.Symbols {
+		if err := k.checkSubscribable(ctx, symbol); err != nil {
+			return err
+		}
+
+		key := collections.Join(channelID, symbol)
+		subscribed, err := k.Subscription.Has(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !subscribed {
+			if count >= params.MaxSubscriptions {
+				return errorsmod.Wrapf(types.ErrTooManySubscriptions, "channel %s subscribes to at most %d symbols", channelID, params.MaxSubscriptions)
+			}
+			count++
+		}
+
+		if err := k.Subscription.Set(ctx, key, types.Subscription{
+			ChannelId: channelID,
+			Symbol:    symbol,
+			Height:    height,
+		}); err != nil {
+			return err
+		}
+		if err := k.SymbolSubscription.Set(ctx, collections.Join(symbol, channelID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkSubscribable returns an error unless the symbol is registered and has
+// a price.
+func (k Keeper) checkSubscribable(ctx context.Context, symbol string) error {
+	registered, err := k.Symbol.Has(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if !registered {
+		return errorsmod.Wrap(types.ErrSymbolNotRegistered, symbol)
+	}
+
+	priced, err := k.Price.Has(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if !priced {
+		return errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "price %s not found", symbol)
+	}
+
+	return nil
+}
+
+// countSubscriptions returns the number of symbols the channel subscribes to.
+func (k Keeper) countSubscriptions(ctx context.Context, channelID string) (uint64, error) {
+	iter, err := k.Subscription.Iterate(ctx, collections.NewPrefixedPairRange[string, string](channelID))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+
+	return count, nil
+}
+
+// removeSubscription removes the subscription of the channel to the symbol.
+func (k Keeper) removeSubscription(ctx context.Context, channelID, symbol string) error {
+	if err := k.Subscription.Remove(ctx, collections.Join(channelID, symbol)); err != nil {
+		return err
+	}
+
+	return k.SymbolSubscription.Remove(ctx, collections.Join(symbol, channelID))
+}
+
+// queuePush queues the price of the symbol for a push to its subscribers at
+// the end of the block, if it has any.
+func (k Keeper) queuePush(ctx context.Context, symbol string) error {
+	iter, err := k.SymbolSubscription.Iterate(ctx, collections.NewPrefixedPairRange[string, string](symbol))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+
+	return k.PushQueue.Set(ctx, symbol)
+}
+
+// storeRemotePrices stores prices received on the channel in the remote price
+// namespace. Prices older than the stored ones are ignored.
+func (k Keeper) storeRemotePrices(ctx context.Context, channelID string, prices []types.PacketPrice) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var symbols []string
+	for _, price := range prices {
+		key := collections.Join(channelID, price.Symbol)
+		current, err := k.RemotePrice.Get(ctx, key)
+		if err == nil && current.SourceHeight > price.Height {
+			continue
+		}
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		if err := k.RemotePrice.Set(ctx, key, types.RemotePrice{
+			ChannelId:      channelID,
+			Symbol:         price.Symbol,
+			Rate:           price.Rate,
+			Decimals:       price.Decimals,
+			Quote:          price.Quote,
+			SourceHeight:   price.Height,
+			SourceTime:     price.Time,
+			ReceivedHeight: sdkCtx.BlockHeight(),
+		}); err != nil {
+			return err
+		}
+		symbols = append(symbols, price.Symbol)
+	}
+	if len(symbols) == 0 {
+		return nil
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventRemotePricesReceived{
+		ChannelId: channelID,
+		Symbols:   symbols,
+	})
+}
+
+// pushSubscribedPrices sends the prices updated in the current block to the
+// allowed channels subscribed to them, and empties the push queue. Failures
+// to send are logged and do not halt the block.
+func (k Keeper) pushSubscribedPrices(ctx context.Context, params types.Params) error {
+	var symbols []string
+	if err := k.PushQueue.Walk(ctx, nil, func(symbol string) (bool, error) {
+		symbols = append(symbols, symbol)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	if err := k.PushQueue.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if k.channelKeeperFn == nil || k.channelKeeperFn() == nil {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pushes := make(map[string][]types.PacketPrice)
+	for _, symbol := range symbols {
+		price, err := k.Price.Get(ctx, symbol)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		if err := k.SymbolSubscription.Walk(ctx, collections.NewPrefixedPairRange[string, string](symbol), func(key collections.Pair[string, string]) (bool, error) {
+			if params.ChannelAllowed(key.K2()) {
+				pushes[key.K2()] = append(pushes[key.K2()], packetPrice(price))
+			}
+			return false, nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	channels := slices.Sorted(maps.Keys(pushes))
+	for _, channelID := range channels {
+		prices := pushes[channelID]
+		for start := 0; start < len(prices); start += types.MaxPacketSymbols {
+			end := min(start+types.MaxPacketSymbols, len(prices))
+			packet := types.OraclePacketData{Packet: &types.OraclePacketData_PricePush{
+				PricePush: &types.PricePushPacketData{Prices: prices[start:end]},
+			}}
+
+			cacheCtx, write := sdkCtx.CacheContext()
+			if _, err := k.TransmitOraclePacket(cacheCtx, packet, channelID, 0); err != nil {
+				sdkCtx.Logger().Error("failed to push oracle prices", "channel", channelID, "err", err)
+				continue
+			}
+			write()
+		}
+	}
+
+	return nil
+}
+
+// emitPacketFailed emits the failure of an oracle packet sent on the channel.
+func (k Keeper) emitPacketFailed(ctx context.Context, packet channeltypes.Packet, reason string) error {
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventOraclePacketFailed{
+		ChannelId: packet.SourceChannel,
+		Sequence:  packet.Sequence,
+		Error:     reason,
+	})
+}
+
+// packetPrice converts a local price into its IBC representation.
+func packetPrice(price types.Price) types.PacketPrice {
+	return types.PacketPrice{
+		Symbol:   price.Symbol,
+		Rate:     price.Rate,
+		Decimals: price.Decimals,
+		Quote:    price.Quote,
+		Height:   price.LastUpdatedHeight,
+		Time:     price.LastUpdatedTime,
+	}
+}
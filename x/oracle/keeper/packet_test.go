@@ -0,0 +1,270 @@
+package keeper_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func TestOnRecvPriceRequest(t *testing.T) {
+	f := initFixture(t)
+	f.allowChannels(t, "channel-0")
+
+	updated := time.Unix(1_700_000_000, 0).UTC()
+	require.NoError(t, f.keeper.Price.Set(f.ctx, "ETH", types.Price{
+		Symbol:            "ETH",
+		Rate:              350_000,
+		Decimals:          2,
+		Quote:             "USD",
+		LastUpdatedHeight: 4,
+		LastUpdatedTime:   updated,
+	}))
+
+	packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: "channel-0"}
+	result, err := f.keeper.OnRecvOraclePacket(f.ctx, packet, types.OraclePacketData{Packet: &types.OraclePacketData_PriceRequest{
+		PriceRequest: &types.PriceRequestPacketData{Symbols: []string{"ETH", "BTC"}},
+	}})
+	require.NoError(t, err)
+
+	var response types.PriceResponsePacketData
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(result, &response))
+	require.Equal(t, []types.PacketPrice{{
+		Symbol:   "ETH",
+		Rate:     350_000,
+		Decimals: 2,
+		Quote:    "USD",
+		Height:   4,
+		Time:     updated,
+	}}, response.Prices)
+
+	// the requester stores the response in the remote namespace
+	sent := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: "channel-7"}
+	ack := channeltypes.NewResultAcknowledgement(result)
+	require.NoError(t, f.keeper.OnAcknowledgementOraclePacket(f.ctx, sent, types.OraclePacketData{Packet: &types.OraclePacketData_PriceRequest{
+		PriceRequest: &types.PriceRequestPacketData{Symbols: []string{"ETH", "BTC"}},
+	}}, ack))
+
+	remote, err := f.keeper.RemotePrice.Get(f.ctx, collections.Join("channel-7", "ETH"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(350_000), remote.Rate)
+	require.Equal(t, int64(4), remote.SourceHeight)
+	require.Equal(t, updated, remote.SourceTime)
+
+	// channels not allowed by governance are rejected
+	_, err = f.keeper.OnRecvOraclePacket(f.ctx, channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: "channel-1"},
+		types.OraclePacketData{Packet: &types.OraclePacketData_PriceRequest{
+			PriceRequest: &types.PriceRequestPacketData{Symbols: []string{"ETH"}},
+		}})
+	require.ErrorIs(t, err, types.ErrChannelNotAllowed)
+
+	// remote prices never touch the local ones
+	found, err := f.keeper.Price.Has(f.ctx, "BTC")
+	require.NoError(t, err)
+	require.False(t, found)
+	_, err = f.keeper.RemotePrice.Get(f.ctx, collections.Join("channel-7", "BTC"))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestOnRecvPricePush(t *testing.T) {
+	f := initFixture(t)
+	f.allowChannels(t, "channel-1")
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(9)
+	packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: "channel-1"}
+	push := func(prices ...types.PacketPrice) {
+		t.Helper()
+		_, err := f.keeper.OnRecvOraclePacket(ctx, packet, types.OraclePacketData{Packet: &types.OraclePacketData_PricePush{
+			PricePush: &types.PricePushPacketData{Prices: prices},
+		}})
+		require.NoError(t, err)
+	}
+
+	push(types.PacketPrice{Symbol: "ATOM", Rate: 10, Height: 20}, types.PacketPrice{Symbol: "OSMO", Rate: 1, Height: 20})
+	require.True(t, hasEvent(ctx, "realfin.oracle.v1.EventRemotePricesReceived"))
+
+	// older updates are ignored
+	push(types.PacketPrice{Symbol: "ATOM", Rate: 9, Height: 19})
+	res, err := qs.GetRemotePrice(ctx, &types.QueryGetRemotePriceRequest{ChannelId: "channel-1", Symbol: "ATOM"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), res.RemotePrice.Rate)
+	require.Equal(t, int64(9), res.RemotePrice.ReceivedHeight)
+
+	push(types.PacketPrice{Symbol: "ATOM", Rate: 11, Height: 21})
+	res, err = qs.GetRemotePrice(ctx, &types.QueryGetRemotePriceRequest{ChannelId: "channel-1", Symbol: "ATOM"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), res.RemotePrice.Rate)
+
+	all, err := qs.ListRemotePrice(ctx, &types.QueryAllRemotePriceRequest{ChannelId: "channel-1"})
+	require.NoError(t, err)
+	require.Len(t, all.RemotePrice, 2)
+	all, err = qs.ListRemotePrice(ctx, &types.QueryAllRemotePriceRequest{ChannelId: "channel-2"})
+	require.NoError(t, err)
+	require.Empty(t, all.RemotePrice)
+
+	// invalid packets are rejected
+	_, err = f.keeper.OnRecvOraclePacket(ctx, packet, types.OraclePacketData{Packet: &types.OraclePacketData_PricePush{
+		PricePush: &types.PricePushPacketData{Prices: []types.PacketPrice{{Symbol: "ATOM"}, {Symbol: "ATOM"}}},
+	}})
+	require.Error(t, err)
+}
+
+func TestSubscriptionPush(t *testing.T) {
+	f := initFixture(t)
+	f.allowChannels(t, "channel-3", "channel-4")
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("creatorAddr_________________"))
+	require.NoError(t, err)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(5).WithBlockTime(now)
+
+	for _, symbol := range []string{"BTC", "ETH"} {
+		f.registerSymbol(t, symbol, 0, "")
+		_, err = srv.CreatePrice(ctx, &types.MsgCreatePrice{Creator: creator, Symbol: symbol, Rate: 100, Name: symbol})
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	require.Empty(t, f.channelKeeper.packets)
+
+	subscribe := func(channelID string, symbols ...string) error {
+		packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: channelID}
+		_, err := f.keeper.OnRecvOraclePacket(ctx, packet, types.OraclePacketData{Packet: &types.OraclePacketData_Subscription{
+			Subscription: &types.SubscriptionPacketData{Symbols: symbols},
+		}})
+		return err
+	}
+	require.NoError(t, subscribe("channel-3", "ETH", "BTC"))
+	require.NoError(t, subscribe("channel-4", "BTC"))
+
+	subscriptions, err := qs.ListSubscription(ctx, &types.QueryAllSubscriptionRequest{})
+	require.NoError(t, err)
+	require.Len(t, subscriptions.Subscription, 3)
+
+	// only registered symbols with a price can be subscribed to
+	require.ErrorIs(t, subscribe("channel-3", "ATOM"), types.ErrSymbolNotRegistered)
+	f.registerSymbol(t, "ATOM", 0, "")
+	require.ErrorIs(t, subscribe("channel-3", "ATOM"), sdkerrors.ErrKeyNotFound)
+	require.ErrorIs(t, subscribe("channel-5", "ETH"), types.ErrChannelNotAllowed)
+	require.Error(t, subscribe("channel-3", strings.Repeat("X", types.MaxSymbolLength+1)))
+
+	// channels subscribe to at most max subscriptions symbols
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MaxSubscriptions = 2
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	_, err = srv.CreatePrice(ctx, &types.MsgCreatePrice{Creator: creator, Symbol: "ATOM", Rate: 10, Name: "Cosmos"})
+	require.NoError(t, err)
+	require.ErrorIs(t, subscribe("channel-3", "ATOM"), types.ErrTooManySubscriptions)
+	require.NoError(t, subscribe("channel-3", "ETH"))
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	require.Empty(t, f.channelKeeper.packets)
+
+	// the prices updated in the block are pushed to their subscribers only
+	ctx = ctx.WithBlockHeight(6)
+	_, err = srv.UpdatePrice(ctx, &types.MsgUpdatePrice{Creator: creator, Symbol: "ETH", Rate: 101, Name: "Ethereum"})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	require.Len(t, f.channelKeeper.packets, 1)
+	sent := f.channelKeeper.packets[0]
+	require.Equal(t, "channel-3", sent.channelID)
+	require.Equal(t, uint64(now.Add(types.DefaultPacketTimeout).UnixNano()), sent.timeoutTimestamp)
+	pushed := sent.data.GetPricePush()
+	require.NotNil(t, pushed)
+	require.Len(t, pushed.Prices, 1)
+	require.Equal(t, "ETH", pushed.Prices[0].Symbol)
+	require.Equal(t, uint64(101), pushed.Prices[0].Rate)
+
+	// prices not updated in the block are not pushed
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(7)))
+	require.Len(t, f.channelKeeper.packets, 1)
+	has, err := f.keeper.PushQueue.Has(ctx, "ETH")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// send failures do not halt the block
+	f.channelKeeper.err = errors.New("channel closed")
+	_, err = srv.UpdatePrice(ctx.WithBlockHeight(8), &types.MsgUpdatePrice{Creator: creator, Symbol: "BTC", Rate: 101, Name: "BTC"})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(8)))
+	f.channelKeeper.err = nil
+
+	packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: "channel-3"}
+	_, err = f.keeper.OnRecvOraclePacket(ctx, packet, types.OraclePacketData{Packet: &types.OraclePacketData_Subscription{
+		Subscription: &types.SubscriptionPacketData{Symbols: []string{"ETH"}, Unsubscribe: true},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.RemoveChannelSubscriptions(ctx, "channel-3"))
+	subscriptions, err = qs.ListSubscription(ctx, &types.QueryAllSubscriptionRequest{})
+	require.NoError(t, err)
+	require.Len(t, subscriptions.Subscription, 1)
+	has, err = f.keeper.SymbolSubscription.Has(ctx, collections.Join("BTC", "channel-3"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestRemotePriceMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("creatorAddr_________________"))
+	require.NoError(t, err)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	// packets are only sent on the channels allowed by governance
+	_, err = srv.RequestRemotePrices(ctx, &types.MsgRequestRemotePrices{Creator: creator, ChannelId: "channel-0", Symbols: []string{"ETH"}})
+	require.ErrorIs(t, err, types.ErrChannelNotAllowed)
+	require.Empty(t, f.channelKeeper.packets)
+	f.allowChannels(t, "channel-0")
+
+	_, err = srv.RequestRemotePrices(ctx, &types.MsgRequestRemotePrices{Creator: "invalid", ChannelId: "channel-0", Symbols: []string{"ETH"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	_, err = srv.RequestRemotePrices(ctx, &types.MsgRequestRemotePrices{Creator: creator, ChannelId: "invalid channel", Symbols: []string{"ETH"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.RequestRemotePrices(ctx, &types.MsgRequestRemotePrices{Creator: creator, ChannelId: "channel-0"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.RequestRemotePrices(ctx, &types.MsgRequestRemotePrices{
+		Creator:          creator,
+		ChannelId:        "channel-0",
+		Symbols:          []string{"ETH"},
+		TimeoutTimestamp: uint64(now.UnixNano()),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	res, err := srv.RequestRemotePrices(ctx, &types.MsgRequestRemotePrices{Creator: creator, ChannelId: "channel-0", Symbols: []string{"ETH"}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Sequence)
+	require.Equal(t, []string{"ETH"}, f.channelKeeper.packets[0].data.GetPriceRequest().Symbols)
+
+	sub, err := srv.SubscribeRemotePrices(ctx, &types.MsgSubscribeRemotePrices{
+		Creator:          creator,
+		ChannelId:        "channel-0",
+		Symbols:          []string{"ATOM"},
+		TimeoutTimestamp: uint64(now.Add(time.Hour).UnixNano()),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), sub.Sequence)
+	require.Equal(t, uint64(now.Add(time.Hour).UnixNano()), f.channelKeeper.packets[1].timeoutTimestamp)
+	require.Equal(t, []string{"ATOM"}, f.channelKeeper.packets[1].data.GetSubscription().Symbols)
+
+	// failed packets are reported
+	failed := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: "channel-0", Sequence: 2}
+	require.NoError(t, f.keeper.OnTimeoutOraclePacket(ctx, failed, f.channelKeeper.packets[1].data))
+	require.True(t, hasEvent(ctx, "realfin.oracle.v1.EventOraclePacketFailed"))
+}
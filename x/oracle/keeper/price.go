@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"realfin/x/oracle/types"
+)
+
+// setPrice stamps the price with the current block, stores it, clears its
+// pending update, records its rate in the price history, queues it for its
+// subscribers and calls the AfterPriceUpdated hooks. Every write of a Price
+// goes through this method.
+func (k Keeper) setPrice(ctx context.Context, price types.Price) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	price.LastUpdatedHeight = sdkCtx.BlockHeight()
+	price.LastUpdatedTime = sdkCtx.BlockTime()
+
+	old, err := k.Price.Get(ctx, price.Symbol)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.Price.Set(ctx, price.Symbol, price); err != nil {
+		return err
+	}
+	if err := k.Stale.Remove(ctx, price.Symbol); err != nil {
+		return err
+	}
+	// an applied rate supersedes any update awaiting confirmation
+	if err := k.PendingPrice.Remove(ctx, price.Symbol); err != nil {
+		return err
+	}
+
+	if err := k.recordObservation(ctx, price.Symbol, price.Rate); err != nil {
+		return err
+	}
+	if err := k.queuePush(ctx, price.Symbol); err != nil {
+		return err
+	}
+
+	return k.afterPriceUpdated(ctx, old, price)
+}
+
+// claimPrice transfers the ownership of a price created by a user to the
+// module account once reporters aggregate it, so that its creator can no
+// longer override the aggregate with UpdatePrice or delete it. The rate and
+// the other fields of the price are left untouched.
+func (k Keeper) claimPrice(ctx context.Context, price *types.Price) error {
+	moduleAddr, err := k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	if err != nil {
+		return err
+	}
+	if price.Creator == moduleAddr {
+		return nil
+	}
+
+	price.Creator = moduleAddr
+	return k.Price.Set(ctx, price.Symbol, *price)
+}
+
+// removePrice removes the price of the symbol and its stale flag, and calls
+// the AfterPriceDeleted hooks.
+func (k Keeper) removePrice(ctx context.Context, symbol string) error {
+	if err := k.Price.Remove(ctx, symbol); err != nil {
+		return err
+	}
+	if err := k.Stale.Remove(ctx, symbol); err != nil {
+		return err
+	}
+
+	return k.afterPriceDeleted(ctx, symbol)
+}
+
+// GetFreshPrice returns the price of the symbol, failing with ErrStalePrice
+// when it was last updated longer ago than the max staleness of the symbol.
+func (k Keeper) GetFreshPrice(ctx context.Context, symbol string) (types.Price, error) {
+	price, err := k.Price.Get(ctx, symbol)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Price{}, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "price %s not found", symbol)
+		}
+
+		return types.Price{}, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.Price{}, err
+	}
+
+	maxStaleness := params.MaxStalenessOf(symbol)
+	if isStale(price, maxStaleness, sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return types.Price{}, errorsmod.Wrapf(types.ErrStalePrice, "%s was last updated at %s, max staleness %s",
+			symbol, price.LastUpdatedTime.Format(time.RFC3339), maxStaleness)
+	}
+
+	return price, nil
+}
+
+// flagStalePrices flags the prices that crossed their freshness threshold and
+// emits a price_stale event for each of them, once per crossing.
+func (k Keeper) flagStalePrices(ctx context.Context, params types.Params) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	var stale []types.Price
+	err := k.Price.Walk(ctx, nil, func(symbol string, price types.Price) (bool, error) {
+		if !isStale(price, params.MaxStalenessOf(symbol), now) {
+			return false, nil
+		}
+
+		flagged, err := k.Stale.Has(ctx, symbol)
+		if err != nil {
+			return true, err
+		}
+		if !flagged {
+			stale = append(stale, price)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, price := range stale {
+		if err := k.Stale.Set(ctx, price.Symbol); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePriceStale,
+			sdk.NewAttribute(types.AttributeKeySymbol, price.Symbol),
+			sdk.NewAttribute(types.AttributeKeyLastUpdatedHeight, strconv.FormatInt(price.LastUpdatedHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyLastUpdatedTime, price.LastUpdatedTime.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyMaxStaleness, fmt.Sprint(params.MaxStalenessOf(price.Symbol))),
+		))
+	}
+
+	return nil
+}
+
+// isStale reports whether the price is older than maxStaleness at now. A zero
+// maxStaleness disables the check.
+func isStale(price types.Price, maxStaleness time.Duration, now time.Time) bool {
+	return maxStaleness > 0 && now.Sub(price.LastUpdatedTime) > maxStaleness
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func TestGetFreshPrice(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.DefaultMaxStaleness = 60
+	params.MaxStaleness = []types.MaxStaleness{{Symbol: "HOUSE", Seconds: 3600}, {Symbol: "GOLD"}}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	updated := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(7).WithBlockTime(updated)
+	for _, symbol := range []string{"ETH", "HOUSE", "GOLD"} {
+		f.registerSymbol(t, symbol, 0, "")
+		_, err = srv.CreatePrice(ctx, &types.MsgCreatePrice{Creator: creator, Symbol: symbol, Rate: 1})
+		require.NoError(t, err)
+	}
+
+	price, err := f.keeper.GetFreshPrice(ctx, "ETH")
+	require.NoError(t, err)
+	require.Equal(t, int64(7), price.LastUpdatedHeight)
+	require.Equal(t, updated, price.LastUpdatedTime)
+
+	tests := []struct {
+		desc   string
+		symbol string
+		age    time.Duration
+		err    error
+	}{
+		{desc: "at the threshold", symbol: "ETH", age: time.Minute},
+		{desc: "past the default threshold", symbol: "ETH", age: time.Minute + time.Second, err: types.ErrStalePrice},
+		{desc: "symbol threshold", symbol: "HOUSE", age: time.Hour},
+		{desc: "past the symbol threshold", symbol: "HOUSE", age: time.Hour + time.Second, err: types.ErrStalePrice},
+		{desc: "never stale", symbol: "GOLD", age: 24 * 365 * time.Hour},
+		{desc: "not found", symbol: "BTC", err: sdkerrors.ErrKeyNotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := f.keeper.GetFreshPrice(ctx.WithBlockTime(updated.Add(tc.age)), tc.symbol)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEndBlockerPriceStale(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.DefaultMaxStaleness = 60
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	updated := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1).WithBlockTime(updated)
+	f.registerSymbol(t, "ETH", 0, "")
+	_, err = srv.CreatePrice(ctx, &types.MsgCreatePrice{Creator: creator, Symbol: "ETH", Rate: 1})
+	require.NoError(t, err)
+
+	staleEvents := func(ctx sdk.Context) int {
+		var count int
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypePriceStale {
+				count++
+			}
+		}
+		return count
+	}
+	endBlock := func(height int64, blockTime time.Time) sdk.Context {
+		ctx := ctx.WithBlockHeight(height).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, f.keeper.EndBlocker(ctx))
+		return ctx
+	}
+
+	// still fresh
+	require.Zero(t, staleEvents(endBlock(2, updated.Add(time.Minute))))
+
+	// the event is emitted only in the first stale block
+	require.Equal(t, 1, staleEvents(endBlock(3, updated.Add(time.Minute+time.Second))))
+	require.Zero(t, staleEvents(endBlock(4, updated.Add(2*time.Minute))))
+
+	// an update resets the flag
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(updated.Add(3 * time.Minute))
+	_, err = srv.UpdatePrice(ctx, &types.MsgUpdatePrice{Creator: creator, Symbol: "ETH", Rate: 1})
+	require.NoError(t, err)
+	require.Zero(t, staleEvents(endBlock(5, updated.Add(3*time.Minute))))
+	require.Equal(t, 1, staleEvents(endBlock(6, updated.Add(5*time.Minute))))
+}
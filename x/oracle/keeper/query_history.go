@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"realfin/x/oracle/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) PriceHistory(ctx context.Context, req *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	observations, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.History,
+		req.Pagination,
+		func(_ collections.Pair[string, int64], value types.PriceObservation) (types.PriceObservation, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, int64](req.Symbol),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPriceHistoryResponse{Observations: observations, Pagination: pageRes}, nil
+}
+
+func (q queryServer) TWAP(ctx context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Window == 0 || req.Window > math.MaxInt64/uint64(time.Second) {
+		return nil, status.Error(codes.InvalidArgument, "invalid window")
+	}
+
+	twap, start, count, err := q.k.TWAP(ctx, req.Symbol, time.Duration(req.Window)*time.Second)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "no price history")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTWAPResponse{Twap: twap, StartTime: start, Observations: count}, nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func TestPriceHistoryRecording(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.HistoryRetention = 3
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	genesisTime := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1).WithBlockTime(genesisTime)
+	f.registerSymbol(t, "ETH", 0, "")
+	_, err = srv.CreatePrice(ctx, &types.MsgCreatePrice{Creator: creator, Symbol: "ETH", Rate: 100})
+	require.NoError(t, err)
+
+	for height := int64(2); height <= 5; height++ {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(genesisTime.Add(time.Duration(height-1) * 6 * time.Second))
+		_, err = srv.UpdatePrice(ctx, &types.MsgUpdatePrice{Creator: creator, Symbol: "ETH", Rate: uint64(100 + height)})
+		require.NoError(t, err)
+	}
+	// a second update in the same block replaces the observation
+	_, err = srv.UpdatePrice(ctx, &types.MsgUpdatePrice{Creator: creator, Symbol: "ETH", Rate: 106})
+	require.NoError(t, err)
+
+	resp, err := qs.PriceHistory(ctx, &types.QueryPriceHistoryRequest{Symbol: "ETH"})
+	require.NoError(t, err)
+	require.EqualExportedValues(t, []types.PriceObservation{
+		{Symbol: "ETH", Height: 3, Time: genesisTime.Add(12 * time.Second), Rate: 103},
+		{Symbol: "ETH", Height: 4, Time: genesisTime.Add(18 * time.Second), Rate: 104},
+		{Symbol: "ETH", Height: 5, Time: genesisTime.Add(24 * time.Second), Rate: 106},
+	}, resp.Observations)
+
+	_, err = qs.PriceHistory(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestTWAPQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	start := time.Unix(1_700_000_000, 0).UTC()
+	for i, rate := range []uint64{100, 200, 400} {
+		obs := types.PriceObservation{Symbol: "ETH", Height: int64(i + 1), Time: start.Add(time.Duration(i) * 10 * time.Second), Rate: rate}
+		require.NoError(t, f.keeper.History.Set(f.ctx, collections.Join(obs.Symbol, obs.Height), obs))
+	}
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start.Add(30 * time.Second))
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryTWAPRequest
+		response *types.QueryTWAPResponse
+		err      error
+	}{
+		{
+			desc:     "whole history",
+			request:  &types.QueryTWAPRequest{Symbol: "ETH", Window: 30},
+			response: &types.QueryTWAPResponse{Twap: 233, StartTime: start, Observations: 3},
+		},
+		{
+			desc:     "window longer than history",
+			request:  &types.QueryTWAPRequest{Symbol: "ETH", Window: 3600},
+			response: &types.QueryTWAPResponse{Twap: 233, StartTime: start, Observations: 3},
+		},
+		{
+			desc:     "window starting between observations",
+			request:  &types.QueryTWAPRequest{Symbol: "ETH", Window: 15},
+			response: &types.QueryTWAPResponse{Twap: 333, StartTime: start.Add(15 * time.Second), Observations: 2},
+		},
+		{
+			desc:    "unknown symbol",
+			request: &types.QueryTWAPRequest{Symbol: "BTC", Window: 30},
+			err:     status.Error(codes.NotFound, "no price history"),
+		},
+		{
+			desc:    "zero window",
+			request: &types.QueryTWAPRequest{Symbol: "ETH"},
+			err:     status.Error(codes.InvalidArgument, "invalid window"),
+		},
+		{
+			desc: "invalid request",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.TWAP(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.EqualExportedValues(t, tc.response, response)
+			}
+		})
+	}
+}
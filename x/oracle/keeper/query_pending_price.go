@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"realfin/x/oracle/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListPendingPrice(ctx context.Context, req *types.QueryAllPendingPriceRequest) (*types.QueryAllPendingPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pendingPrices, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.PendingPrice,
+		req.Pagination,
+		func(_ string, value types.PendingPrice) (types.PendingPrice, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPendingPriceResponse{PendingPrice: pendingPrices, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetPendingPrice(ctx context.Context, req *types.QueryGetPendingPriceRequest) (*types.QueryGetPendingPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.PendingPrice.Get(ctx, req.Symbol)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetPendingPriceResponse{PendingPrice: val}, nil
+}
+
+func (q queryServer) ListPriceRejection(ctx context.Context, req *types.QueryAllPriceRejectionRequest) (*types.QueryAllPriceRejectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	rejections, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Rejection,
+		req.Pagination,
+		func(_ collections.Pair[string, int64], value types.PriceRejection) (types.PriceRejection, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, int64](req.Symbol),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPriceRejectionResponse{PriceRejection: rejections, Pagination: pageRes}, nil
+}
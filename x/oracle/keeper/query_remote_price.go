@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"realfin/x/oracle/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListRemotePrice(ctx context.Context, req *types.QueryAllRemotePriceRequest) (*types.QueryAllRemotePriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[string, string]])
+	if req.ChannelId != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, string](req.ChannelId))
+	}
+
+	remotePrices, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.RemotePrice,
+		req.Pagination,
+		func(_ collections.Pair[string, string], value types.RemotePrice) (types.RemotePrice, error) {
+			return value, nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRemotePriceResponse{RemotePrice: remotePrices, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetRemotePrice(ctx context.Context, req *types.QueryGetRemotePriceRequest) (*types.QueryGetRemotePriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.RemotePrice.Get(ctx, collections.Join(req.ChannelId, req.Symbol))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetRemotePriceResponse{RemotePrice: val}, nil
+}
+
+func (q queryServer) ListSubscription(ctx context.Context, req *types.QueryAllSubscriptionRequest) (*types.QueryAllSubscriptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	subscriptions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Subscription,
+		req.Pagination,
+		func(_ collections.Pair[string, string], value types.Subscription) (types.Subscription, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSubscriptionResponse{Subscription: subscriptions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"realfin/x/oracle/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ReporterStatus(ctx context.Context, req *types.QueryReporterStatusRequest) (*types.QueryReporterStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	info, err := q.k.ReporterInfo.Get(ctx, req.Address)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+		// whitelisted reporters have a status before their first bond
+		if _, ok := params.ReporterWeight(req.Address); !ok {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+		if info, err = q.k.getReporterInfo(ctx, req.Address); err != nil {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &types.QueryReporterStatusResponse{Status: reporterStatus(params, info)}, nil
+}
+
+func (q queryServer) ListReporterStatus(ctx context.Context, req *types.QueryAllReporterStatusRequest) (*types.QueryAllReporterStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	statuses, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ReporterInfo,
+		req.Pagination,
+		func(_ string, value types.ReporterInfo) (types.ReporterStatus, error) {
+			return reporterStatus(params, value), nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllReporterStatusResponse{Status: statuses, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListReporterSlash(ctx context.Context, req *types.QueryAllReporterSlashRequest) (*types.QueryAllReporterSlashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	slashes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Slash,
+		req.Pagination,
+		func(_ collections.Pair[string, int64], value types.ReporterSlash) (types.ReporterSlash, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, int64](req.Address),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllReporterSlashResponse{ReporterSlash: slashes, Pagination: pageRes}, nil
+}
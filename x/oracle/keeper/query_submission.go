@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"realfin/x/oracle/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListPriceSubmission(ctx context.Context, req *types.QueryAllPriceSubmissionRequest) (*types.QueryAllPriceSubmissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	submissions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Submission,
+		req.Pagination,
+		func(_ collections.Pair[string, string], value types.PriceSubmission) (types.PriceSubmission, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Symbol),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPriceSubmissionResponse{PriceSubmission: submissions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func TestPriceSubmissionQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	items := make([]types.PriceSubmission, 3)
+	for i := range items {
+		items[i] = types.PriceSubmission{Symbol: "ETH", Reporter: strconv.Itoa(i), Rate: uint64(i)}
+		require.NoError(t, f.keeper.Submission.Set(f.ctx, collections.Join(items[i].Symbol, items[i].Reporter), items[i]))
+	}
+	other := types.PriceSubmission{Symbol: "BTC", Reporter: "0", Rate: 1}
+	require.NoError(t, f.keeper.Submission.Set(f.ctx, collections.Join(other.Symbol, other.Reporter), other))
+
+	resp, err := qs.ListPriceSubmission(f.ctx, &types.QueryAllPriceSubmissionRequest{Symbol: "ETH"})
+	require.NoError(t, err)
+	require.EqualExportedValues(t, items, resp.PriceSubmission)
+
+	resp, err = qs.ListPriceSubmission(f.ctx, &types.QueryAllPriceSubmissionRequest{Symbol: "SOL"})
+	require.NoError(t, err)
+	require.Empty(t, resp.PriceSubmission)
+
+	_, err = qs.ListPriceSubmission(f.ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"realfin/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListSymbols(ctx context.Context, req *types.QueryAllSymbolsRequest) (*types.QueryAllSymbolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	symbols, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.Symbol,
+		req.Pagination,
+		func(_ string, value types.SymbolInfo) (bool, error) {
+			return req.Category == "" || value.Category == req.Category, nil
+		},
+		func(_ string, value types.SymbolInfo) (types.SymbolInfo, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSymbolsResponse{Symbols: symbols, Pagination: pageRes}, nil
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func TestListSymbols(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	symbols := []types.SymbolInfo{
+		{Symbol: "BTC", Base: "BTC", Quote: "USD", Category: "crypto"},
+		{Symbol: "ETH", Base: "ETH", Quote: "USD", Decimals: 2, Category: "crypto"},
+		{Symbol: "EUR/USD", Base: "EUR", Quote: "USD", Decimals: 4, Category: "fx"},
+	}
+	for _, s := range symbols {
+		require.NoError(t, f.keeper.Symbol.Set(f.ctx, s.Symbol, s))
+	}
+
+	resp, err := qs.ListSymbols(f.ctx, &types.QueryAllSymbolsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, symbols, resp.Symbols)
+
+	resp, err = qs.ListSymbols(f.ctx, &types.QueryAllSymbolsRequest{Category: "crypto", Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, symbols[:1], resp.Symbols)
+	require.Equal(t, uint64(2), resp.Pagination.Total)
+
+	_, err = qs.ListSymbols(f.ctx, nil)
+	require.Error(t, err)
+}
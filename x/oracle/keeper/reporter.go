@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/oracle/types"
+)
+
+// getReporterInfo returns the info of the reporter, or an empty info with no
+// bond when the reporter has none yet.
+func (k Keeper) getReporterInfo(ctx context.Context, reporter string) (types.ReporterInfo, error) {
+	info, err := k.ReporterInfo.Get(ctx, reporter)
+	if err == nil {
+		return info, nil
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return types.ReporterInfo{}, err
+	}
+
+	return types.ReporterInfo{Address: reporter, Bond: sdkmath.ZeroInt()}, nil
+}
+
+// hasBonds returns whether any reporter holds a bond.
+func (k Keeper) hasBonds(ctx context.Context) (bool, error) {
+	var bonded bool
+	err := k.ReporterInfo.Walk(ctx, nil, func(_ string, info types.ReporterInfo) (bool, error) {
+		bonded = info.Bond.IsPositive()
+		return bonded, nil
+	})
+
+	return bonded, err
+}
+
+// isJailed returns whether the reporter is jailed. Jailed reporters stay
+// jailed until they unjail themselves, even after their jail period is over.
+func isJailed(info types.ReporterInfo) bool {
+	return !info.JailedUntil.IsZero()
+}
+
+// jailedReporters returns the set of whitelisted reporters that are jailed.
+func (k Keeper) jailedReporters(ctx context.Context, params types.Params) (map[string]struct{}, error) {
+	jailed := make(map[string]struct{})
+	for _, reporter := range params.Reporters {
+		info, err := k.getReporterInfo(ctx, reporter.Address)
+		if err != nil {
+			return nil, err
+		}
+		if isJailed(info) {
+			jailed[reporter.Address] = struct{}{}
+		}
+	}
+
+	return jailed, nil
+}
+
+// trackOutliers compares the submissions of the current block with the
+// aggregate of their symbol. Outliers increase the outlier counter of their
+// reporter while submissions within the threshold decrease it.
+func (k Keeper) trackOutliers(ctx context.Context, params types.Params, aggregate uint64, submissions []types.PriceSubmission) error {
+	if params.MaxOutliers == 0 || aggregate == 0 {
+		return nil
+	}
+
+	for _, sub := range submissions {
+		info, err := k.getReporterInfo(ctx, sub.Reporter)
+		if err != nil {
+			return err
+		}
+		if isJailed(info) {
+			continue
+		}
+
+		if deviationBps(aggregate, sub.Rate) > params.OutlierThresholdBps {
+			info.OutlierCounter++
+			info.Outliers++
+		} else if info.OutlierCounter > 0 {
+			info.OutlierCounter--
+		}
+
+		if info.OutlierCounter >= params.MaxOutliers {
+			if err := k.slashReporter(ctx, params, info, types.SlashReasonOutliers); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.ReporterInfo.Set(ctx, info.Address, info); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// trackMissedWindows updates the miss counters of the bonded whitelisted
+// reporters at the end of every submission window. Reporters that did not
+// submit during the window increase their miss counter while the others
+// decrease it. Unbonded reporters cannot submit and have nothing to slash, so
+// their windows are not tracked.
+func (k Keeper) trackMissedWindows(ctx context.Context, params types.Params) error {
+	if params.MaxMissedWindows == 0 || params.SubmissionWindow == 0 {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if height%int64(params.SubmissionWindow) != 0 {
+		return nil
+	}
+
+	for _, reporter := range params.Reporters {
+		info, err := k.getReporterInfo(ctx, reporter.Address)
+		if err != nil {
+			return err
+		}
+		if isJailed(info) || !info.Bond.IsPositive() {
+			continue
+		}
+
+		if info.LastSubmissionHeight > height-int64(params.SubmissionWindow) {
+			if info.MissCounter == 0 {
+				continue
+			}
+			info.MissCounter--
+		} else {
+			info.MissCounter++
+			info.MissedWindows++
+		}
+
+		if info.MissCounter >= params.MaxMissedWindows {
+			if err := k.slashReporter(ctx, params, info, types.SlashReasonMissedWindows); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.ReporterInfo.Set(ctx, info.Address, info); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// slashReporter burns the slash fraction of the bond of the reporter, jails it
+// for the jail duration, resets its counters and records the slash.
+func (k Keeper) slashReporter(ctx context.Context, params types.Params, info types.ReporterInfo, reason string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	amount := params.SlashFraction.MulInt(info.Bond).TruncateInt()
+	if amount.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(params.BondDenom, amount))); err != nil {
+			return err
+		}
+		info.Bond = info.Bond.Sub(amount)
+	}
+
+	info.JailedUntil = sdkCtx.BlockTime().Add(params.JailDurationOf())
+	info.MissCounter = 0
+	info.OutlierCounter = 0
+	if err := k.ReporterInfo.Set(ctx, info.Address, info); err != nil {
+		return err
+	}
+
+	if err := k.Slash.Set(ctx, collections.Join(info.Address, sdkCtx.BlockHeight()), types.ReporterSlash{
+		Reporter: info.Address,
+		Height:   sdkCtx.BlockHeight(),
+		Time:     sdkCtx.BlockTime(),
+		Amount:   amount,
+		Reason:   reason,
+	}); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventReporterSlashed{
+		Reporter:    info.Address,
+		Amount:      amount,
+		Reason:      reason,
+		JailedUntil: info.JailedUntil.Unix(),
+	})
+}
+
+// reporterStatus returns the status of the reporter.
+func reporterStatus(params types.Params, info types.ReporterInfo) types.ReporterStatus {
+	_, whitelisted := params.ReporterWeight(info.Address)
+	return types.ReporterStatus{
+		Info:        info,
+		Whitelisted: whitelisted,
+		Jailed:      isJailed(info),
+	}
+}
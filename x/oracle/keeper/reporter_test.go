@@ -0,0 +1,262 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func TestReporterBonding(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	reporterAddr := sdk.AccAddress("reporterAddr________________")
+	reporter, err := f.addressCodec.BytesToString(reporterAddr)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.Reporters = []types.Reporter{{Address: reporter, Weight: 1}}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.bankKeeper.Balances[reporterAddr.String()] = sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 3_000_000), sdk.NewInt64Coin("stake", 10))
+
+	_, err = srv.BondReporter(f.ctx, &types.MsgBondReporter{Reporter: reporter, Amount: sdk.NewInt64Coin("stake", 10)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	_, err = srv.BondReporter(f.ctx, &types.MsgBondReporter{Reporter: reporter, Amount: sdk.NewInt64Coin(params.BondDenom, 1_500_000)})
+	require.NoError(t, err)
+	info, err := f.keeper.ReporterInfo.Get(f.ctx, reporter)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1_500_000), info.Bond)
+	require.Equal(t, math.NewInt(1_500_000), f.bankKeeper.Balances[authtypes.NewModuleAddress(types.ModuleName).String()].AmountOf(params.BondDenom))
+
+	// whitelisted reporters only withdraw the bond above the minimum
+	_, err = srv.UnbondReporter(f.ctx, &types.MsgUnbondReporter{Reporter: reporter, Amount: sdk.NewInt64Coin(params.BondDenom, 600_000)})
+	require.ErrorIs(t, err, types.ErrInsufficientBond)
+	_, err = srv.UnbondReporter(f.ctx, &types.MsgUnbondReporter{Reporter: reporter, Amount: sdk.NewInt64Coin(params.BondDenom, 500_000)})
+	require.NoError(t, err)
+
+	// removed reporters withdraw everything
+	params.Reporters = nil
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	_, err = srv.UnbondReporter(f.ctx, &types.MsgUnbondReporter{Reporter: reporter, Amount: sdk.NewInt64Coin(params.BondDenom, 1_000_001)})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	_, err = srv.UnbondReporter(f.ctx, &types.MsgUnbondReporter{Reporter: reporter, Amount: sdk.NewInt64Coin(params.BondDenom, 1_000_000)})
+	require.NoError(t, err)
+
+	info, err = f.keeper.ReporterInfo.Get(f.ctx, reporter)
+	require.NoError(t, err)
+	require.True(t, info.Bond.IsZero())
+	require.Equal(t, math.NewInt(3_000_000), f.bankKeeper.Balances[reporterAddr.String()].AmountOf(params.BondDenom))
+}
+
+func TestReporterMissedWindowsSlashing(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	reporter, err := f.addressCodec.BytesToString([]byte("reporterAddr________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.Reporters = []types.Reporter{{Address: reporter, Weight: 1}}
+	params.SubmissionWindow = 5
+	params.MaxMissedWindows = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.registerSymbol(t, "ETH", 0, "")
+	require.NoError(t, f.keeper.ReporterInfo.Set(f.ctx, reporter, types.ReporterInfo{Address: reporter, Bond: math.NewInt(2_000_000)}))
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	f.bankKeeper.Balances[moduleAddr] = sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 2_000_000))
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	// a submission during the window keeps the counter at zero
+	_, err = srv.SubmitPrice(ctx.WithBlockHeight(3), &types.MsgSubmitPrice{Reporter: reporter, Symbol: "ETH", Rate: 100})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(5)))
+	info, err := f.keeper.ReporterInfo.Get(ctx, reporter)
+	require.NoError(t, err)
+	require.Zero(t, info.MissCounter)
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(10)))
+	info, err = f.keeper.ReporterInfo.Get(ctx, reporter)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), info.MissCounter)
+
+	// the second missed window slashes and jails the reporter
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(15)))
+	res, err := qs.ReporterStatus(ctx, &types.QueryReporterStatusRequest{Address: reporter})
+	require.NoError(t, err)
+	require.True(t, res.Status.Jailed)
+	require.True(t, res.Status.Whitelisted)
+	require.Equal(t, math.NewInt(1_980_000), res.Status.Info.Bond)
+	require.Zero(t, res.Status.Info.MissCounter)
+	require.Equal(t, uint64(2), res.Status.Info.MissedWindows)
+	require.Equal(t, now.Add(params.JailDurationOf()), res.Status.Info.JailedUntil)
+	require.Equal(t, math.NewInt(1_980_000), f.bankKeeper.Balances[moduleAddr].AmountOf(params.BondDenom))
+	require.True(t, hasEvent(ctx, "realfin.oracle.v1.EventReporterSlashed"))
+
+	slashes, err := qs.ListReporterSlash(ctx, &types.QueryAllReporterSlashRequest{Address: reporter})
+	require.NoError(t, err)
+	require.Len(t, slashes.ReporterSlash, 1)
+	require.Equal(t, types.ReporterSlash{
+		Reporter: reporter,
+		Height:   15,
+		Time:     now,
+		Amount:   math.NewInt(20_000),
+		Reason:   types.SlashReasonMissedWindows,
+	}, slashes.ReporterSlash[0])
+
+	// jailed reporters neither submit nor keep missing windows
+	_, err = srv.SubmitPrice(ctx.WithBlockHeight(16), &types.MsgSubmitPrice{Reporter: reporter, Symbol: "ETH", Rate: 100})
+	require.ErrorIs(t, err, types.ErrReporterJailed)
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(20)))
+	info, err = f.keeper.ReporterInfo.Get(ctx, reporter)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), info.MissedWindows)
+
+	// unjailing requires the jail period to be over
+	_, err = srv.UnjailReporter(ctx, &types.MsgUnjailReporter{Reporter: reporter})
+	require.ErrorIs(t, err, types.ErrReporterJailed)
+	_, err = srv.UnjailReporter(ctx.WithBlockTime(info.JailedUntil), &types.MsgUnjailReporter{Reporter: reporter})
+	require.NoError(t, err)
+	_, err = srv.UnjailReporter(ctx.WithBlockTime(info.JailedUntil), &types.MsgUnjailReporter{Reporter: reporter})
+	require.ErrorIs(t, err, types.ErrReporterNotJailed)
+
+	res, err = qs.ReporterStatus(ctx, &types.QueryReporterStatusRequest{Address: reporter})
+	require.NoError(t, err)
+	require.False(t, res.Status.Jailed)
+}
+
+func TestReporterMissedWindowsRequireBond(t *testing.T) {
+	f := initFixture(t)
+
+	reporter, err := f.addressCodec.BytesToString([]byte("reporterAddr________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.Reporters = []types.Reporter{{Address: reporter, Weight: 1}}
+	params.SubmissionWindow = 5
+	params.MaxMissedWindows = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// a whitelisted reporter that never bonded is neither tracked nor jailed
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	for height := int64(5); height <= 20; height += 5 {
+		require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(height)))
+	}
+	found, err := f.keeper.ReporterInfo.Has(ctx, reporter)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.False(t, hasEvent(ctx, "realfin.oracle.v1.EventReporterSlashed"))
+}
+
+func TestReporterOutlierSlashing(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	reporters := make([]string, 3)
+	for i, name := range []string{"reporterAddr_______________0", "reporterAddr_______________1", "reporterAddr_______________2"} {
+		addr, err := f.addressCodec.BytesToString([]byte(name))
+		require.NoError(t, err)
+		reporters[i] = addr
+	}
+
+	params := types.DefaultParams()
+	params.Reporters = []types.Reporter{{Address: reporters[0], Weight: 1}, {Address: reporters[1], Weight: 1}, {Address: reporters[2], Weight: 1}}
+	params.MinBond = math.ZeroInt()
+	params.MaxOutliers = 2
+	params.MaxMissedWindows = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.registerSymbol(t, "ETH", 0, "")
+
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	submit := func(height int64, rates ...uint64) {
+		t.Helper()
+		ctx = ctx.WithBlockHeight(height)
+		for i, rate := range rates {
+			_, err := srv.SubmitPrice(ctx, &types.MsgSubmitPrice{Reporter: reporters[i], Symbol: "ETH", Rate: rate})
+			require.NoError(t, err)
+		}
+		require.NoError(t, f.keeper.EndBlocker(ctx))
+	}
+
+	submit(1, 100, 101, 150)
+	info, err := f.keeper.ReporterInfo.Get(ctx, reporters[2])
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), info.OutlierCounter)
+
+	// a submission close to the aggregate decreases the counter
+	submit(2, 100, 101, 100)
+	info, err = f.keeper.ReporterInfo.Get(ctx, reporters[2])
+	require.NoError(t, err)
+	require.Zero(t, info.OutlierCounter)
+
+	submit(3, 100, 101, 150)
+	submit(4, 100, 101, 150)
+	info, err = f.keeper.ReporterInfo.Get(ctx, reporters[2])
+	require.NoError(t, err)
+	require.False(t, info.JailedUntil.IsZero())
+	require.Equal(t, uint64(3), info.Outliers)
+	require.Zero(t, info.OutlierCounter)
+
+	// the submissions of jailed reporters are pruned
+	found, err := f.keeper.Submission.Has(ctx, collections.Join("ETH", reporters[2]))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(5)))
+	found, err = f.keeper.Submission.Has(ctx, collections.Join("ETH", reporters[2]))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	for _, reporter := range reporters[:2] {
+		info, err := f.keeper.ReporterInfo.Get(ctx, reporter)
+		require.NoError(t, err)
+		require.True(t, info.JailedUntil.IsZero())
+		require.Zero(t, info.Outliers)
+	}
+}
+
+func TestReporterStatusQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	reporter, err := f.addressCodec.BytesToString([]byte("reporterAddr________________"))
+	require.NoError(t, err)
+	outsider, err := f.addressCodec.BytesToString([]byte("outsiderAddr________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.Reporters = []types.Reporter{{Address: reporter, Weight: 1}}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	_, err = qs.ReporterStatus(f.ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	// whitelisted reporters have a status before their first bond
+	res, err := qs.ReporterStatus(f.ctx, &types.QueryReporterStatusRequest{Address: reporter})
+	require.NoError(t, err)
+	require.True(t, res.Status.Whitelisted)
+	require.True(t, res.Status.Info.Bond.IsZero())
+
+	_, err = qs.ReporterStatus(f.ctx, &types.QueryReporterStatusRequest{Address: outsider})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	require.NoError(t, f.keeper.ReporterInfo.Set(f.ctx, outsider, types.ReporterInfo{Address: outsider, Bond: math.NewInt(5)}))
+	all, err := qs.ListReporterStatus(f.ctx, &types.QueryAllReporterStatusRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Status, 1)
+	require.False(t, all.Status[0].Whitelisted)
+	require.Equal(t, math.NewInt(5), all.Status[0].Info.Bond)
+}
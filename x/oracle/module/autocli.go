@@ -29,6 +29,81 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-price"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
 				},
+				{
+					RpcMethod:      "ListPriceSubmission",
+					Use:            "list-price-submission [symbol]",
+					Short:          "List the reporter submissions of a price",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
+				},
+				{
+					RpcMethod:      "PriceHistory",
+					Use:            "price-history [symbol]",
+					Short:          "List the historical observations of a price",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
+				},
+				{
+					RpcMethod:      "TWAP",
+					Use:            "twap [symbol] [window]",
+					Short:          "Shows the time-weighted average price over a window in seconds",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}, {ProtoField: "window"}},
+				},
+				{
+					RpcMethod: "ListPendingPrice",
+					Use:       "list-pending-price",
+					Short:     "List all pending price updates",
+				},
+				{
+					RpcMethod:      "GetPendingPrice",
+					Use:            "get-pending-price [symbol]",
+					Short:          "Gets the pending price update of a symbol",
+					Alias:          []string{"show-pending-price"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
+				},
+				{
+					RpcMethod:      "ListPriceRejection",
+					Use:            "list-price-rejection [symbol]",
+					Short:          "List the aggregated rates of a price rejected by the deviation checks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
+				},
+				{
+					RpcMethod:      "ReporterStatus",
+					Use:            "reporter-status [address]",
+					Short:          "Shows the bond, miss and outlier counters and jail status of a reporter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "ListReporterStatus",
+					Use:       "list-reporter-status",
+					Short:     "List the bond, miss and outlier counters and jail status of all reporters",
+				},
+				{
+					RpcMethod:      "ListReporterSlash",
+					Use:            "list-reporter-slash [address]",
+					Short:          "List the slash history of a reporter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "ListRemotePrice",
+					Use:       "list-remote-price",
+					Short:     "List the prices received from other chains over IBC",
+				},
+				{
+					RpcMethod:      "GetRemotePrice",
+					Use:            "get-remote-price [channel-id] [symbol]",
+					Short:          "Gets a price received from another chain over IBC",
+					Alias:          []string{"show-remote-price"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "symbol"}},
+				},
+				{
+					RpcMethod: "ListSubscription",
+					Use:       "list-subscription",
+					Short:     "List the IBC channels subscribed to local prices",
+				},
+				{
+					RpcMethod: "ListSymbols",
+					Use:       "list-symbols",
+					Short:     "List the symbols registered by governance, optionally of a --category",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -40,6 +115,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "RegisterSymbol",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod:      "CreatePrice",
 					Use:            "create-price [symbol] [rate] [name] [description]",
@@ -52,12 +131,57 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Update price",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}, {ProtoField: "rate"}, {ProtoField: "name"}, {ProtoField: "description"}},
 				},
+				{
+					RpcMethod: "UpdatePrices",
+					Skip:      true, // custom command reading the updates from a file, see client/cli
+				},
 				{
 					RpcMethod:      "DeletePrice",
 					Use:            "delete-price [symbol]",
 					Short:          "Delete price",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
 				},
+				{
+					RpcMethod:      "SubmitPrice",
+					Use:            "submit-price [symbol] [rate]",
+					Short:          "Submit a reporter price observation",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}, {ProtoField: "rate"}},
+				},
+				{
+					RpcMethod:      "ConfirmPendingPrice",
+					Use:            "confirm-pending-price [symbol]",
+					Short:          "Confirm a pending price update outside the deviation band",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
+				},
+				{
+					RpcMethod:      "BondReporter",
+					Use:            "bond-reporter [amount]",
+					Short:          "Bond funds as a price reporter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "UnbondReporter",
+					Use:            "unbond-reporter [amount]",
+					Short:          "Withdraw bonded reporter funds",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "UnjailReporter",
+					Use:       "unjail-reporter",
+					Short:     "Unjail a reporter once its jail period is over",
+				},
+				{
+					RpcMethod:      "RequestRemotePrices",
+					Use:            "request-remote-prices [channel-id] [symbols]",
+					Short:          "Request the prices of symbols from the chain at the other end of an oracle channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "symbols", Varargs: true}},
+				},
+				{
+					RpcMethod:      "SubscribeRemotePrices",
+					Use:            "subscribe-remote-prices [channel-id] [symbols]",
+					Short:          "Subscribe to the price updates of symbols from the chain at the other end of an oracle channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "symbols", Varargs: true}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
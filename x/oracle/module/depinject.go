@@ -1,6 +1,9 @@
 package oracle
 
 import (
+	"fmt"
+	"sort"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -8,6 +11,7 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
 	"realfin/x/oracle/keeper"
 	"realfin/x/oracle/types"
@@ -22,6 +26,7 @@ func init() {
 	appconfig.Register(
 		&types.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetOracleHooks),
 	)
 }
 
@@ -35,6 +40,8 @@ type ModuleInputs struct {
 
 	AuthKeeper types.AuthKeeper
 	BankKeeper types.BankKeeper
+
+	IBCKeeperFn func() *ibckeeper.Keeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -55,8 +62,75 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.AddressCodec,
 		authority,
+		in.BankKeeper,
+		channelKeeperFn(in.IBCKeeperFn),
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
 	return ModuleOutputs{OracleKeeper: k, Module: m}
 }
+
+// channelKeeperFn returns the IBC channel keeper getter of the oracle keeper,
+// or nil when IBC is not wired.
+func channelKeeperFn(ibcKeeperFn func() *ibckeeper.Keeper) func() types.ChannelKeeper {
+	if ibcKeeperFn == nil {
+		return nil
+	}
+
+	return func() types.ChannelKeeper {
+		ibcKeeper := ibcKeeperFn()
+		if ibcKeeper == nil || ibcKeeper.ChannelKeeper == nil {
+			return nil
+		}
+		return ibcKeeper.ChannelKeeper
+	}
+}
+
+// InvokeSetOracleHooks sets the oracle hooks provided by the other modules.
+// Hooks are called in the order of the hooks_order of the module config, then
+// by module name.
+func InvokeSetOracleHooks(
+	config *types.Module,
+	k keeper.Keeper,
+	oracleHooks map[string]types.OracleHooksWrapper,
+) error {
+	order, err := hooksOrder(config.HooksOrder, oracleHooks)
+	if err != nil {
+		return err
+	}
+
+	hooks := make([]keeper.ModuleHooks, 0, len(order))
+	for _, module := range order {
+		hooks = append(hooks, keeper.ModuleHooks{Module: module, Hooks: oracleHooks[module]})
+	}
+	k.SetHooks(hooks...)
+
+	return nil
+}
+
+// hooksOrder returns the order in which the oracle hooks of the modules are
+// called: the modules of the configured order first, then the others by name.
+func hooksOrder(configured []string, oracleHooks map[string]types.OracleHooksWrapper) ([]string, error) {
+	order := make([]string, 0, len(oracleHooks))
+	listed := make(map[string]bool, len(configured))
+	for _, module := range configured {
+		if _, ok := oracleHooks[module]; !ok {
+			return nil, fmt.Errorf("can't find oracle hooks for module %s", module)
+		}
+		if listed[module] {
+			return nil, fmt.Errorf("module %s is listed twice in the oracle hooks order", module)
+		}
+		listed[module] = true
+		order = append(order, module)
+	}
+
+	var others []string
+	for module := range oracleHooks {
+		if !listed[module] {
+			others = append(others, module)
+		}
+	}
+	sort.Strings(others)
+
+	return append(order, others...), nil
+}
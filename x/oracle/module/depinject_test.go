@@ -0,0 +1,32 @@
+package oracle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/types"
+)
+
+func TestHooksOrder(t *testing.T) {
+	hooks := map[string]types.OracleHooksWrapper{
+		"tokenization": {},
+		"insurance":    {},
+		"realestate":   {},
+	}
+
+	order, err := hooksOrder(nil, hooks)
+	require.NoError(t, err)
+	require.Equal(t, []string{"insurance", "realestate", "tokenization"}, order)
+
+	// configured modules first, then the others by name
+	order, err = hooksOrder([]string{"tokenization"}, hooks)
+	require.NoError(t, err)
+	require.Equal(t, []string{"tokenization", "insurance", "realestate"}, order)
+
+	_, err = hooksOrder([]string{"creditscore"}, hooks)
+	require.ErrorContains(t, err, "can't find oracle hooks for module creditscore")
+
+	_, err = hooksOrder([]string{"insurance", "insurance"}, hooks)
+	require.ErrorContains(t, err, "listed twice")
+}
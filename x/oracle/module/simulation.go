@@ -3,6 +3,8 @@ package oracle
 import (
 	"math/rand"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -18,13 +20,27 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+	reporters := make([]types.Reporter, 0, 3)
+	for i := 0; i < len(accs) && i < cap(reporters); i++ {
+		reporters = append(reporters, types.Reporter{Address: accs[i], Weight: uint64(i + 1)})
+	}
 	oracleGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params: types.NewParams(reporters, types.DefaultSubmissionWindow, types.DefaultMinReporters, types.DefaultHistoryRetention, types.DefaultMaxStaleness, nil,
+			types.DefaultMaxDeviationBps, types.DefaultMaxWindowDeviationBps, types.DefaultDeviationWindow, false,
+			sdk.DefaultBondDenom, math.ZeroInt(), types.DefaultMaxMissedWindows, types.DefaultOutlierThresholdBps, types.DefaultMaxOutliers,
+			types.DefaultSlashFraction, types.DefaultJailDuration, nil, types.DefaultMaxSubscriptions),
 		PriceMap: []types.Price{{Creator: sample.AccAddress(),
 			Symbol: "0",
 		}, {Creator: sample.AccAddress(),
 			Symbol: "1",
-		}}}
+		}},
+		Symbols: []types.SymbolInfo{
+			{Symbol: "0", Base: "0"},
+			{Symbol: "1", Base: "1"},
+			{Symbol: "ETH", Base: "ETH", Quote: "USD", Decimals: 2, Category: "crypto"},
+			{Symbol: "BTC", Base: "BTC", Quote: "USD", Category: "crypto"},
+			{Symbol: "EUR/USD", Base: "EUR", Quote: "USD", Decimals: 4, Category: "fx"},
+		}}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&oracleGenesis)
 }
 
@@ -64,6 +80,21 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgUpdatePrice,
 		oraclesimulation.SimulateMsgUpdatePrice(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
+	const (
+		opWeightMsgUpdatePrices          = "op_weight_msg_oracle"
+		defaultWeightMsgUpdatePrices int = 100
+	)
+
+	var weightMsgUpdatePrices int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdatePrices, &weightMsgUpdatePrices, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdatePrices = defaultWeightMsgUpdatePrices
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdatePrices,
+		oraclesimulation.SimulateMsgUpdatePrices(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
 	const (
 		opWeightMsgDeletePrice          = "op_weight_msg_oracle"
 		defaultWeightMsgDeletePrice int = 100
@@ -79,11 +110,77 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgDeletePrice,
 		oraclesimulation.SimulateMsgDeletePrice(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
+	const (
+		opWeightMsgSubmitPrice          = "op_weight_msg_oracle"
+		defaultWeightMsgSubmitPrice int = 100
+	)
+
+	var weightMsgSubmitPrice int
+	simState.AppParams.GetOrGenerate(opWeightMsgSubmitPrice, &weightMsgSubmitPrice, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitPrice = defaultWeightMsgSubmitPrice
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitPrice,
+		oraclesimulation.SimulateMsgSubmitPrice(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgConfirmPendingPrice          = "op_weight_msg_oracle"
+		defaultWeightMsgConfirmPendingPrice int = 100
+	)
+
+	var weightMsgConfirmPendingPrice int
+	simState.AppParams.GetOrGenerate(opWeightMsgConfirmPendingPrice, &weightMsgConfirmPendingPrice, nil,
+		func(_ *rand.Rand) {
+			weightMsgConfirmPendingPrice = defaultWeightMsgConfirmPendingPrice
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgConfirmPendingPrice,
+		oraclesimulation.SimulateMsgConfirmPendingPrice(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgBondReporter          = "op_weight_msg_oracle"
+		defaultWeightMsgBondReporter int = 100
+	)
+
+	var weightMsgBondReporter int
+	simState.AppParams.GetOrGenerate(opWeightMsgBondReporter, &weightMsgBondReporter, nil,
+		func(_ *rand.Rand) {
+			weightMsgBondReporter = defaultWeightMsgBondReporter
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBondReporter,
+		oraclesimulation.SimulateMsgBondReporter(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgUnbondReporter          = "op_weight_msg_oracle"
+		defaultWeightMsgUnbondReporter int = 100
+	)
+
+	var weightMsgUnbondReporter int
+	simState.AppParams.GetOrGenerate(opWeightMsgUnbondReporter, &weightMsgUnbondReporter, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnbondReporter = defaultWeightMsgUnbondReporter
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnbondReporter,
+		oraclesimulation.SimulateMsgUnbondReporter(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
 
 	return operations
 }
 
 // ProposalMsgs returns msgs used for governance proposals for simulations.
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
-	return []simtypes.WeightedProposalMsg{}
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			"op_weight_msg_register_symbol",
+			100,
+			oraclesimulation.SimulateMsgRegisterSymbol,
+		),
+	}
 }
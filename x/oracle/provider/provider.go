@@ -0,0 +1,188 @@
+// Package provider defines the price providers a validator uses to fill its
+// oracle vote extensions.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// TypeNone disables price reporting for the node.
+	TypeNone = ""
+	// TypeFile reads prices from a local JSON file.
+	TypeFile = "file"
+	// TypeHTTP fetches prices from a local HTTP endpoint.
+	TypeHTTP = "http"
+
+	// DefaultTimeout is the default timeout of a single price fetch.
+	DefaultTimeout = 500 * time.Millisecond
+
+	flagProvider = "oracle.provider"
+	flagSource   = "oracle.source"
+	flagTimeout  = "oracle.timeout"
+)
+
+// DefaultConfigTemplate is the app.toml section configuring the price provider.
+const DefaultConfigTemplate = `
+###############################################################################
+###                          Oracle Price Provider                          ###
+###############################################################################
+
+[oracle]
+
+# Provider is the source of the prices this validator reports in its vote
+# extensions: "" (disabled), "file" or "http".
+provider = "{{ .Oracle.Provider }}"
+
+# Source is the path of the JSON price file or the URL of the price endpoint.
+# Both return a JSON object mapping symbols to rates, e.g. {"ETH": 5001}.
+source = "{{ .Oracle.Source }}"
+
+# Timeout bounds a single price fetch.
+timeout = "{{ .Oracle.Timeout }}"
+`
+
+// PriceProvider returns the current local price observations of a node,
+// keyed by symbol.
+type PriceProvider interface {
+	Prices(ctx context.Context) (map[string]uint64, error)
+}
+
+// Config defines the app.toml configuration of the oracle price provider.
+type Config struct {
+	// Provider is the provider type, one of "", "file" or "http".
+	Provider string `mapstructure:"provider"`
+	// Source is the path of the price file or the URL of the price endpoint.
+	Source string `mapstructure:"source"`
+	// Timeout bounds a single price fetch.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultConfig returns the default provider configuration, which disables
+// price reporting.
+func DefaultConfig() Config {
+	return Config{
+		Provider: TypeNone,
+		Timeout:  DefaultTimeout,
+	}
+}
+
+// ConfigFromAppOptions reads the provider configuration from the app options.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := appOpts.Get(flagProvider); v != nil {
+		cfg.Provider = cast.ToString(v)
+	}
+	if v := appOpts.Get(flagSource); v != nil {
+		cfg.Source = cast.ToString(v)
+	}
+	if v := appOpts.Get(flagTimeout); v != nil {
+		if timeout := cast.ToDuration(v); timeout > 0 {
+			cfg.Timeout = timeout
+		}
+	}
+
+	return cfg
+}
+
+// New returns the price provider described by the configuration. A nil
+// provider is returned when price reporting is disabled.
+func New(cfg Config) (PriceProvider, error) {
+	switch cfg.Provider {
+	case TypeNone:
+		return nil, nil
+	case TypeFile:
+		if cfg.Source == "" {
+			return nil, fmt.Errorf("oracle file provider requires a source path")
+		}
+		return NewFileProvider(cfg.Source), nil
+	case TypeHTTP:
+		if cfg.Source == "" {
+			return nil, fmt.Errorf("oracle http provider requires a source url")
+		}
+		return NewHTTPProvider(cfg.Source, cfg.Timeout), nil
+	default:
+		return nil, fmt.Errorf("unknown oracle price provider %q", cfg.Provider)
+	}
+}
+
+// FileProvider reads prices from a JSON file mapping symbols to rates, for
+// example {"ETH": 5001, "BTC": 98000}. The file is read on every call so it
+// can be rewritten by an external process.
+type FileProvider struct {
+	path string
+}
+
+// NewFileProvider returns a provider reading prices from the given file.
+func NewFileProvider(path string) *FileProvider {
+	return &FileProvider{path: path}
+}
+
+// Prices implements PriceProvider.
+func (p *FileProvider) Prices(_ context.Context) (map[string]uint64, error) {
+	bz, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodePrices(bz)
+}
+
+// HTTPProvider fetches prices from an HTTP endpoint returning the same JSON
+// document as the FileProvider.
+type HTTPProvider struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPProvider returns a provider fetching prices from the given URL.
+func NewHTTPProvider(url string, timeout time.Duration) *HTTPProvider {
+	return &HTTPProvider{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Prices implements PriceProvider.
+func (p *HTTPProvider) Prices(ctx context.Context) (map[string]uint64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, p.url)
+	}
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodePrices(bz)
+}
+
+func decodePrices(bz []byte) (map[string]uint64, error) {
+	var prices map[string]uint64
+	if err := json.Unmarshal(bz, &prices); err != nil {
+		return nil, fmt.Errorf("failed to decode prices: %w", err)
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,79 @@
+package provider_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/provider"
+)
+
+type appOptions map[string]interface{}
+
+func (o appOptions) Get(key string) interface{} {
+	return o[key]
+}
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"ETH": 5001, "BTC": 98000}`), 0o600))
+
+	prices, err := provider.NewFileProvider(path).Prices(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"ETH": 5001, "BTC": 98000}, prices)
+
+	require.NoError(t, os.WriteFile(path, []byte(`not json`), 0o600))
+	_, err = provider.NewFileProvider(path).Prices(context.Background())
+	require.Error(t, err)
+
+	_, err = provider.NewFileProvider(filepath.Join(t.TempDir(), "missing.json")).Prices(context.Background())
+	require.Error(t, err)
+}
+
+func TestHTTPProvider(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prices" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ETH": 5001}`))
+	}))
+	defer srv.Close()
+
+	prices, err := provider.NewHTTPProvider(srv.URL+"/prices", time.Second).Prices(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"ETH": 5001}, prices)
+
+	_, err = provider.NewHTTPProvider(srv.URL+"/missing", time.Second).Prices(context.Background())
+	require.ErrorContains(t, err, "unexpected status code 404")
+}
+
+func TestNewFromConfig(t *testing.T) {
+	cfg := provider.ConfigFromAppOptions(appOptions{})
+	require.Equal(t, provider.DefaultConfig(), cfg)
+	p, err := provider.New(cfg)
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	cfg = provider.ConfigFromAppOptions(appOptions{
+		"oracle.provider": "http",
+		"oracle.source":   "http://localhost:8080/prices",
+		"oracle.timeout":  "2s",
+	})
+	require.Equal(t, 2*time.Second, cfg.Timeout)
+	p, err = provider.New(cfg)
+	require.NoError(t, err)
+	require.IsType(t, &provider.HTTPProvider{}, p)
+
+	_, err = provider.New(provider.Config{Provider: provider.TypeFile})
+	require.Error(t, err)
+
+	_, err = provider.New(provider.Config{Provider: "grpc", Source: "localhost:9090"})
+	require.Error(t, err)
+}
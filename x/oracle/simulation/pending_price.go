@@ -0,0 +1,81 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func SimulateMsgConfirmPendingPrice(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgConfirmPendingPrice{}
+
+		var allPendingPrice []types.PendingPrice
+		err := k.PendingPrice.Walk(ctx, nil, func(_ string, value types.PendingPrice) (stop bool, err error) {
+			allPendingPrice = append(allPendingPrice, value)
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+		if len(allPendingPrice) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no pending price"), nil, nil
+		}
+		pending := allPendingPrice[r.Intn(len(allPendingPrice))]
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to get params"), nil, err
+		}
+
+		var (
+			simAccount simtypes.Account
+			found      bool
+		)
+		for _, reporter := range params.Reporters {
+			if reporter.Address == pending.Proposer {
+				continue
+			}
+			acc, err := ak.AddressCodec().StringToBytes(reporter.Address)
+			if err != nil {
+				return simtypes.OperationMsg{}, nil, err
+			}
+			if simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc)); found {
+				break
+			}
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no reporter can confirm"), nil, nil
+		}
+		msg.Signer = simAccount.Address.String()
+		msg.Symbol = pending.Symbol
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -2,7 +2,6 @@ package simulation
 
 import (
 	"math/rand"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -23,13 +22,27 @@ func SimulateMsgCreatePrice(
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-
-		i := r.Int()
 		msg := &types.MsgCreatePrice{
 			Creator: simAccount.Address.String(),
-			Symbol:  strconv.Itoa(i),
 		}
 
+		// only registered symbols can be created
+		var symbols []types.SymbolInfo
+		err := k.Symbol.Walk(ctx, nil, func(_ string, value types.SymbolInfo) (stop bool, err error) {
+			symbols = append(symbols, value)
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+		if len(symbols) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no registered symbol"), nil, nil
+		}
+		info := symbols[r.Intn(len(symbols))]
+		msg.Symbol = info.Symbol
+		msg.Decimals = info.Decimals
+		msg.Quote = info.Quote
+
 		found, err := k.Price.Has(ctx, msg.Symbol)
 		if err == nil && found {
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Price already exist"), nil, nil
@@ -93,6 +106,82 @@ func SimulateMsgUpdatePrice(
 		}
 		msg.Creator = simAccount.Address.String()
 		msg.Symbol = price.Symbol
+		msg.Decimals = price.Decimals
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgUpdatePrices(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			simAccount = simtypes.Account{}
+			msg        = &types.MsgUpdatePrices{}
+			found      = false
+		)
+
+		// group the prices by creator, keeping the creators that are
+		// simulation accounts
+		owned := make(map[string][]types.Price)
+		var creators []string
+		err := k.Price.Walk(ctx, nil, func(key string, value types.Price) (stop bool, err error) {
+			if _, ok := owned[value.Creator]; !ok {
+				creators = append(creators, value.Creator)
+			}
+			owned[value.Creator] = append(owned[value.Creator], value)
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		var prices []types.Price
+		for _, creator := range creators {
+			acc, err := ak.AddressCodec().StringToBytes(creator)
+			if err != nil {
+				return simtypes.OperationMsg{}, nil, err
+			}
+
+			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
+			if found {
+				prices = owned[creator]
+				break
+			}
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "price creator not found"), nil, nil
+		}
+
+		r.Shuffle(len(prices), func(i, j int) { prices[i], prices[j] = prices[j], prices[i] })
+		prices = prices[:1+r.Intn(min(len(prices), 10))]
+
+		msg.Creator = simAccount.Address.String()
+		for _, price := range prices {
+			msg.Updates = append(msg.Updates, types.PriceUpdate{
+				Symbol:   price.Symbol,
+				Rate:     price.Rate,
+				Decimals: price.Decimals,
+			})
+		}
 
 		txCtx := simulation.OperationInput{
 			R:               r,
@@ -152,6 +241,61 @@ func SimulateMsgDeletePrice(
 		}
 		msg.Creator = simAccount.Address.String()
 		msg.Symbol = price.Symbol
+		msg.Decimals = price.Decimals
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgSubmitPrice(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgSubmitPrice{
+			Reporter: simAccount.Address.String(),
+			Rate:     uint64(r.Int63n(1_000_000) + 1),
+		}
+
+		// only registered symbols can be submitted
+		var symbols []string
+		err := k.Symbol.Walk(ctx, nil, func(symbol string, _ types.SymbolInfo) (stop bool, err error) {
+			symbols = append(symbols, symbol)
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+		if len(symbols) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no registered symbol"), nil, nil
+		}
+		msg.Symbol = symbols[r.Intn(len(symbols))]
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to get params"), nil, err
+		}
+		if _, ok := params.ReporterWeight(msg.Reporter); !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "reporter not whitelisted"), nil, nil
+		}
 
 		txCtx := simulation.OperationInput{
 			R:               r,
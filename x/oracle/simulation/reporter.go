@@ -0,0 +1,130 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"realfin/x/oracle/keeper"
+	"realfin/x/oracle/types"
+)
+
+func SimulateMsgBondReporter(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgBondReporter{
+			Reporter: simAccount.Address.String(),
+		}
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to get params"), nil, err
+		}
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(params.BondDenom)
+		if !spendable.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no spendable bond denom"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, spendable.QuoRaw(2).AddRaw(1))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to generate amount"), nil, err
+		}
+		msg.Amount = sdk.NewCoin(params.BondDenom, amount)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(msg.Amount),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgUnbondReporter(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgUnbondReporter{}
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to get params"), nil, err
+		}
+
+		var allInfo []types.ReporterInfo
+		err = k.ReporterInfo.Walk(ctx, nil, func(_ string, value types.ReporterInfo) (stop bool, err error) {
+			withdrawable := value.Bond
+			if _, ok := params.ReporterWeight(value.Address); ok {
+				withdrawable = withdrawable.Sub(params.MinBond)
+			}
+			if withdrawable.IsPositive() {
+				allInfo = append(allInfo, value)
+			}
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+		if len(allInfo) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no withdrawable bond"), nil, nil
+		}
+		info := allInfo[r.Intn(len(allInfo))]
+
+		acc, err := ak.AddressCodec().StringToBytes(info.Address)
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(acc))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "reporter not found"), nil, nil
+		}
+
+		withdrawable := info.Bond
+		if _, ok := params.ReporterWeight(info.Address); ok {
+			withdrawable = withdrawable.Sub(params.MinBond)
+		}
+		amount, err := simtypes.RandPositiveInt(r, withdrawable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to generate amount"), nil, err
+		}
+		msg.Reporter = simAccount.Address.String()
+		msg.Amount = sdk.NewCoin(params.BondDenom, amount)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
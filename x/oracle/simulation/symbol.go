@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"realfin/x/oracle/types"
+)
+
+// SimulateMsgRegisterSymbol returns a governance proposal message registering
+// a random symbol.
+func SimulateMsgRegisterSymbol(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	base := strings.ToUpper(simtypes.RandStringOfLength(r, 3+r.Intn(4)))
+
+	return &types.MsgRegisterSymbol{
+		Authority: authtypes.NewModuleAddress(types.GovModuleName).String(),
+		Info: types.SymbolInfo{
+			Symbol:       base,
+			Base:         base,
+			Quote:        "USD",
+			Decimals:     uint32(r.Intn(types.MaxDecimals + 1)),
+			MinReporters: uint32(r.Intn(3)),
+			Category:     "crypto",
+		},
+	}
+}
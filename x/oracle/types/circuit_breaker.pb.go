@@ -0,0 +1,1050 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/oracle/v1/circuit_breaker.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingPrice defines a price update outside the deviation band awaiting
+// confirmation by a second reporter or governance.
+type PendingPrice struct {
+	Symbol      string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Rate        uint64 `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// proposer is the account that submitted the update, empty when the rate
+	// comes from the reporter or validator aggregation.
+	Proposer string `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// reference_rate is the rate the deviation was measured against.
+	ReferenceRate uint64    `protobuf:"varint,6,opt,name=reference_rate,json=referenceRate,proto3" json:"reference_rate,omitempty"`
+	DeviationBps  uint64    `protobuf:"varint,7,opt,name=deviation_bps,json=deviationBps,proto3" json:"deviation_bps,omitempty"`
+	Height        int64     `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Time          time.Time `protobuf:"bytes,9,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PendingPrice) Reset()         { *m = PendingPrice{} }
+func (m *PendingPrice) String() string { return proto.CompactTextString(m) }
+func (*PendingPrice) ProtoMessage()    {}
+func (*PendingPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ffdac276e5f6a07, []int{0}
+}
+func (m *PendingPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPrice.Merge(m, src)
+}
+func (m *PendingPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPrice proto.InternalMessageInfo
+
+func (m *PendingPrice) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *PendingPrice) GetRate() uint64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *PendingPrice) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PendingPrice) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PendingPrice) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *PendingPrice) GetReferenceRate() uint64 {
+	if m != nil {
+		return m.ReferenceRate
+	}
+	return 0
+}
+
+func (m *PendingPrice) GetDeviationBps() uint64 {
+	if m != nil {
+		return m.DeviationBps
+	}
+	return 0
+}
+
+func (m *PendingPrice) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PendingPrice) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// PriceRejection records an aggregated rate that was discarded because it
+// fell outside the deviation band.
+type PriceRejection struct {
+	Symbol        string    `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Height        int64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time          time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	Rate          uint64    `protobuf:"varint,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	ReferenceRate uint64    `protobuf:"varint,5,opt,name=reference_rate,json=referenceRate,proto3" json:"reference_rate,omitempty"`
+	DeviationBps  uint64    `protobuf:"varint,6,opt,name=deviation_bps,json=deviationBps,proto3" json:"deviation_bps,omitempty"`
+}
+
+func (m *PriceRejection) Reset()         { *m = PriceRejection{} }
+func (m *PriceRejection) String() string { return proto.CompactTextString(m) }
+func (*PriceRejection) ProtoMessage()    {}
+func (*PriceRejection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ffdac276e5f6a07, []int{1}
+}
+func (m *PriceRejection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRejection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRejection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceRejection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRejection.Merge(m, src)
+}
+func (m *PriceRejection) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRejection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRejection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRejection proto.InternalMessageInfo
+
+func (m *PriceRejection) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *PriceRejection) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PriceRejection) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *PriceRejection) GetRate() uint64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *PriceRejection) GetReferenceRate() uint64 {
+	if m != nil {
+		return m.ReferenceRate
+	}
+	return 0
+}
+
+func (m *PriceRejection) GetDeviationBps() uint64 {
+	if m != nil {
+		return m.DeviationBps
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PendingPrice)(nil), "realfin.oracle.v1.PendingPrice")
+	proto.RegisterType((*PriceRejection)(nil), "realfin.oracle.v1.PriceRejection")
+}
+
+func init() {
+	proto.RegisterFile("realfin/oracle/v1/circuit_breaker.proto", fileDescriptor_7ffdac276e5f6a07)
+}
+
+var fileDescriptor_7ffdac276e5f6a07 = []byte{
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x8e, 0xd3, 0x30,
+	0x14, 0xc6, 0xe3, 0x36, 0x13, 0x66, 0x3c, 0xd3, 0x91, 0xc6, 0x42, 0x23, 0x2b, 0x8b, 0x34, 0x1a,
+	0x84, 0x88, 0x58, 0x24, 0x0c, 0xac, 0xd9, 0xf4, 0x04, 0xa3, 0x88, 0x15, 0x9b, 0xca, 0x49, 0x5f,
+	0x33, 0x86, 0x24, 0xb6, 0x1c, 0xb7, 0xa2, 0xb7, 0xe8, 0x31, 0xd8, 0xc1, 0x31, 0xba, 0xec, 0x92,
+	0x15, 0x45, 0xed, 0x82, 0x6b, 0xa0, 0x38, 0x7f, 0xa8, 0x54, 0x90, 0x10, 0x9b, 0xe8, 0xbd, 0xcf,
+	0x5f, 0xfc, 0x3e, 0xff, 0x6c, 0xfc, 0x42, 0x01, 0xcb, 0xe7, 0xbc, 0x8c, 0x84, 0x62, 0x69, 0x0e,
+	0xd1, 0xf2, 0x3e, 0x4a, 0xb9, 0x4a, 0x17, 0x5c, 0x4f, 0x13, 0x05, 0xec, 0x23, 0xa8, 0x50, 0x2a,
+	0xa1, 0x05, 0xb9, 0x69, 0x8d, 0x61, 0x63, 0x0c, 0x97, 0xf7, 0xee, 0x0d, 0x2b, 0x78, 0x29, 0x22,
+	0xf3, 0x6d, 0x5c, 0xee, 0xd3, 0x4c, 0x64, 0xc2, 0x94, 0x51, 0x5d, 0xb5, 0xea, 0x38, 0x13, 0x22,
+	0xcb, 0x21, 0x32, 0x5d, 0xb2, 0x98, 0x47, 0x9a, 0x17, 0x50, 0x69, 0x56, 0xc8, 0xc6, 0x70, 0xf7,
+	0x65, 0x80, 0xaf, 0x1e, 0xa0, 0x9c, 0xf1, 0x32, 0x7b, 0x50, 0x3c, 0x05, 0x72, 0x8b, 0x9d, 0x6a,
+	0x55, 0x24, 0x22, 0xa7, 0xc8, 0x47, 0xc1, 0x45, 0xdc, 0x76, 0x84, 0x60, 0x5b, 0x31, 0x0d, 0x74,
+	0xe0, 0xa3, 0xc0, 0x8e, 0x4d, 0x5d, 0x6b, 0x25, 0x2b, 0x80, 0x0e, 0x8d, 0xd3, 0xd4, 0xc4, 0xc7,
+	0x97, 0x33, 0xa8, 0x52, 0xc5, 0xa5, 0xe6, 0xa2, 0xa4, 0xb6, 0x59, 0x3a, 0x96, 0x88, 0x8b, 0xcf,
+	0xa5, 0x12, 0x52, 0x54, 0xa0, 0xe8, 0x99, 0x59, 0xee, 0x7b, 0xf2, 0x1c, 0x5f, 0x2b, 0x98, 0x83,
+	0x82, 0x32, 0x85, 0xa9, 0x99, 0xe7, 0x98, 0x79, 0xa3, 0x5e, 0x8d, 0xeb, 0xc1, 0xcf, 0xf0, 0x68,
+	0x06, 0x4b, 0xce, 0xea, 0xfd, 0xa6, 0x89, 0xac, 0xe8, 0x13, 0xe3, 0xba, 0xea, 0xc5, 0x89, 0xac,
+	0xea, 0x93, 0x3c, 0x02, 0xcf, 0x1e, 0x35, 0x3d, 0xf7, 0x51, 0x30, 0x8c, 0xdb, 0x8e, 0xbc, 0xc5,
+	0x76, 0x4d, 0x81, 0x5e, 0xf8, 0x28, 0xb8, 0x7c, 0xed, 0x86, 0x0d, 0xa2, 0xb0, 0x43, 0x14, 0xbe,
+	0xeb, 0x10, 0x4d, 0x46, 0x9b, 0xef, 0x63, 0x6b, 0xbd, 0x1b, 0xa3, 0xcf, 0x3f, 0xbf, 0xbe, 0x44,
+	0xb1, 0xf9, 0xed, 0x6e, 0x87, 0xf0, 0xb5, 0x41, 0x15, 0xc3, 0x07, 0x48, 0xcd, 0x89, 0xfe, 0xc6,
+	0xec, 0x77, 0x82, 0xc1, 0x1f, 0x13, 0x0c, 0xff, 0x2b, 0x41, 0x7f, 0x15, 0xf6, 0xd1, 0x55, 0x9c,
+	0x82, 0x3b, 0xfb, 0x27, 0x70, 0xce, 0x29, 0xb8, 0xc9, 0xab, 0xcd, 0xde, 0x43, 0xdb, 0xbd, 0x87,
+	0x7e, 0xec, 0x3d, 0xb4, 0x3e, 0x78, 0xd6, 0xf6, 0xe0, 0x59, 0xdf, 0x0e, 0x9e, 0xf5, 0xfe, 0xb6,
+	0x7b, 0xb3, 0x9f, 0xba, 0x57, 0xab, 0x57, 0x12, 0xaa, 0xc4, 0x31, 0xd1, 0xdf, 0xfc, 0x1a, 0x00,
+	0x4e, 0x0f, 0x22, 0x37, 0xd4, 0x02, 0x00, 0x00,
+}
+
+func (m *PendingPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCircuitBreaker(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if m.Height != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DeviationBps != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.DeviationBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ReferenceRate != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.ReferenceRate))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Rate != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.Rate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceRejection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRejection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRejection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviationBps != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.DeviationBps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReferenceRate != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.ReferenceRate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Rate != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.Rate))
+		i--
+		dAtA[i] = 0x20
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCircuitBreaker(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuitBreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuitBreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	if m.Rate != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.Rate))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	if m.ReferenceRate != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.ReferenceRate))
+	}
+	if m.DeviationBps != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.DeviationBps))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	return n
+}
+
+func (m *PriceRejection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	if m.Rate != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.Rate))
+	}
+	if m.ReferenceRate != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.ReferenceRate))
+	}
+	if m.DeviationBps != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.DeviationBps))
+	}
+	return n
+}
+
+func sovCircuitBreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuitBreaker(x uint64) (n int) {
+	return sovCircuitBreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			m.Rate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceRate", wireType)
+			}
+			m.ReferenceRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationBps", wireType)
+			}
+			m.DeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceRejection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRejection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRejection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			m.Rate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceRate", wireType)
+			}
+			m.ReferenceRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationBps", wireType)
+			}
+			m.DeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuitBreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuitBreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuitBreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuitBreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuitBreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuitBreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuitBreaker = fmt.Errorf("proto: unexpected end of group")
+)
@@ -10,11 +10,20 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreatePrice{},
 		&MsgUpdatePrice{},
+		&MsgUpdatePrices{},
 		&MsgDeletePrice{},
+		&MsgSubmitPrice{},
+		&MsgConfirmPendingPrice{},
+		&MsgBondReporter{},
+		&MsgUnbondReporter{},
+		&MsgUnjailReporter{},
+		&MsgRequestRemotePrices{},
+		&MsgSubscribeRemotePrices{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRegisterSymbol{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
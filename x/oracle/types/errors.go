@@ -8,5 +8,20 @@ import (
 
 // x/oracle module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner          = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrReporterNotWhitelisted = errors.Register(ModuleName, 1101, "reporter is not whitelisted")
+	ErrStalePrice             = errors.Register(ModuleName, 1102, "price is stale")
+	ErrInvalidPriceUnit       = errors.Register(ModuleName, 1103, "invalid price unit")
+	ErrDecimalsMismatch       = errors.Register(ModuleName, 1104, "decimals do not match the price")
+	ErrNoConversionPath       = errors.Register(ModuleName, 1105, "no conversion path between symbols")
+	ErrPriceDeviation         = errors.Register(ModuleName, 1106, "price update exceeds the maximum deviation")
+	ErrInsufficientBond       = errors.Register(ModuleName, 1107, "reporter bond is below the minimum bond")
+	ErrReporterJailed         = errors.Register(ModuleName, 1108, "reporter is jailed")
+	ErrReporterNotJailed      = errors.Register(ModuleName, 1109, "reporter is not jailed")
+	ErrInvalidSymbol          = errors.Register(ModuleName, 1110, "invalid symbol")
+	ErrSymbolNotRegistered    = errors.Register(ModuleName, 1111, "symbol is not registered")
+	ErrSymbolRegistered       = errors.Register(ModuleName, 1112, "symbol is already registered")
+	ErrBondDenomChange        = errors.Register(ModuleName, 1113, "bond denom cannot change while bonds exist")
+	ErrChannelNotAllowed      = errors.Register(ModuleName, 1114, "channel is not allowed")
+	ErrTooManySubscriptions   = errors.Register(ModuleName, 1115, "too many subscriptions")
 )
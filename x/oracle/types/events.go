@@ -0,0 +1,23 @@
+package types
+
+// oracle module event types
+const (
+	EventTypePriceStale = "price_stale"
+
+	AttributeKeySymbol            = "symbol"
+	AttributeKeyLastUpdatedHeight = "last_updated_height"
+	AttributeKeyLastUpdatedTime   = "last_updated_time"
+	AttributeKeyMaxStaleness      = "max_staleness"
+)
+
+// reasons of a reporter slash
+const (
+	SlashReasonMissedWindows = "missed_windows"
+	SlashReasonOutliers      = "outliers"
+)
+
+// names of the oracle hooks
+const (
+	HookAfterPriceUpdated = "after_price_updated"
+	HookAfterPriceDeleted = "after_price_deleted"
+)
@@ -5,8 +5,17 @@ import "fmt"
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:   DefaultParams(),
-		PriceMap: []Price{}}
+		Params:          DefaultParams(),
+		PriceMap:        []Price{},
+		Submissions:     []PriceSubmission{},
+		History:         []PriceObservation{},
+		PendingPrices:   []PendingPrice{},
+		Rejections:      []PriceRejection{},
+		ReporterInfos:   []ReporterInfo{},
+		ReporterSlashes: []ReporterSlash{},
+		RemotePrices:    []RemotePrice{},
+		Subscriptions:   []Subscription{},
+		Symbols:         []SymbolInfo{}}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -22,5 +31,101 @@ func (gs GenesisState) Validate() error {
 		priceIndexMap[index] = struct{}{}
 	}
 
+	submissionIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.Submissions {
+		index := fmt.Sprintf("%s/%s", elem.Symbol, elem.Reporter)
+		if _, ok := submissionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for price submission")
+		}
+		submissionIndexMap[index] = struct{}{}
+	}
+
+	historyIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.History {
+		index := fmt.Sprintf("%s/%d", elem.Symbol, elem.Height)
+		if _, ok := historyIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for price observation")
+		}
+		historyIndexMap[index] = struct{}{}
+	}
+
+	pendingPriceIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PendingPrices {
+		index := fmt.Sprint(elem.Symbol)
+		if _, ok := pendingPriceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pending price")
+		}
+		pendingPriceIndexMap[index] = struct{}{}
+	}
+
+	rejectionIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.Rejections {
+		index := fmt.Sprintf("%s/%d", elem.Symbol, elem.Height)
+		if _, ok := rejectionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for price rejection")
+		}
+		rejectionIndexMap[index] = struct{}{}
+	}
+
+	reporterInfoIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ReporterInfos {
+		index := fmt.Sprint(elem.Address)
+		if _, ok := reporterInfoIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for reporter info")
+		}
+		if elem.Bond.IsNil() || elem.Bond.IsNegative() {
+			return fmt.Errorf("invalid bond for reporter %s", elem.Address)
+		}
+		reporterInfoIndexMap[index] = struct{}{}
+	}
+
+	reporterSlashIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ReporterSlashes {
+		index := fmt.Sprintf("%s/%d", elem.Reporter, elem.Height)
+		if _, ok := reporterSlashIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for reporter slash")
+		}
+		reporterSlashIndexMap[index] = struct{}{}
+	}
+
+	remotePriceIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RemotePrices {
+		index := fmt.Sprintf("%s/%s", elem.ChannelId, elem.Symbol)
+		if _, ok := remotePriceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for remote price")
+		}
+		remotePriceIndexMap[index] = struct{}{}
+	}
+
+	subscriptionIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.Subscriptions {
+		index := fmt.Sprintf("%s/%s", elem.ChannelId, elem.Symbol)
+		if _, ok := subscriptionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for subscription")
+		}
+		subscriptionIndexMap[index] = struct{}{}
+	}
+
+	symbolIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.Symbols {
+		index := fmt.Sprint(elem.Symbol)
+		if _, ok := symbolIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for symbol")
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid symbol %s: %w", elem.Symbol, err)
+		}
+		symbolIndexMap[index] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
@@ -27,8 +27,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the oracle module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params   Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PriceMap []Price `protobuf:"bytes,2,rep,name=price_map,json=priceMap,proto3" json:"price_map"`
+	Params          Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PriceMap        []Price            `protobuf:"bytes,2,rep,name=price_map,json=priceMap,proto3" json:"price_map"`
+	Submissions     []PriceSubmission  `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions"`
+	History         []PriceObservation `protobuf:"bytes,4,rep,name=history,proto3" json:"history"`
+	PendingPrices   []PendingPrice     `protobuf:"bytes,5,rep,name=pending_prices,json=pendingPrices,proto3" json:"pending_prices"`
+	Rejections      []PriceRejection   `protobuf:"bytes,6,rep,name=rejections,proto3" json:"rejections"`
+	ReporterInfos   []ReporterInfo     `protobuf:"bytes,7,rep,name=reporter_infos,json=reporterInfos,proto3" json:"reporter_infos"`
+	ReporterSlashes []ReporterSlash    `protobuf:"bytes,8,rep,name=reporter_slashes,json=reporterSlashes,proto3" json:"reporter_slashes"`
+	RemotePrices    []RemotePrice      `protobuf:"bytes,9,rep,name=remote_prices,json=remotePrices,proto3" json:"remote_prices"`
+	Subscriptions   []Subscription     `protobuf:"bytes,10,rep,name=subscriptions,proto3" json:"subscriptions"`
+	Symbols         []SymbolInfo       `protobuf:"bytes,11,rep,name=symbols,proto3" json:"symbols"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +87,69 @@ func (m *GenesisState) GetPriceMap() []Price {
 	return nil
 }
 
+func (m *GenesisState) GetSubmissions() []PriceSubmission {
+	if m != nil {
+		return m.Submissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHistory() []PriceObservation {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingPrices() []PendingPrice {
+	if m != nil {
+		return m.PendingPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRejections() []PriceRejection {
+	if m != nil {
+		return m.Rejections
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReporterInfos() []ReporterInfo {
+	if m != nil {
+		return m.ReporterInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReporterSlashes() []ReporterSlash {
+	if m != nil {
+		return m.ReporterSlashes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemotePrices() []RemotePrice {
+	if m != nil {
+		return m.RemotePrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubscriptions() []Subscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSymbols() []SymbolInfo {
+	if m != nil {
+		return m.Symbols
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "realfin.oracle.v1.GenesisState")
 }
@@ -85,22 +157,40 @@ func init() {
 func init() { proto.RegisterFile("realfin/oracle/v1/genesis.proto", fileDescriptor_716ec8b624dfd209) }
 
 var fileDescriptor_716ec8b624dfd209 = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0x4a, 0x4d, 0xcc,
-	0x49, 0xcb, 0xcc, 0xd3, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x2a, 0xd0,
-	0x83, 0x28, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10,
-	0x55, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95, 0xc3, 0x34,
-	0xbc, 0x20, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0xb6, 0x94, 0x2c, 0x16, 0xf9, 0xa2, 0xcc, 0xe4, 0x54,
-	0x88, 0xb4, 0x52, 0x27, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0x31, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42,
-	0x36, 0x5c, 0x6c, 0x10, 0xfd, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x92, 0x7a, 0x18, 0x8e,
-	0xd3, 0x0b, 0x00, 0x2b, 0x70, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c,
-	0x41, 0x50, 0x3d, 0x42, 0xd6, 0x5c, 0x9c, 0x60, 0xd3, 0xe3, 0x73, 0x13, 0x0b, 0x24, 0x98, 0x14,
-	0x98, 0x35, 0xb8, 0x8d, 0x24, 0xb0, 0x19, 0x00, 0x52, 0xe3, 0xc4, 0x02, 0xd2, 0x1f, 0xc4, 0x01,
-	0xd6, 0xe0, 0x9b, 0x58, 0xe0, 0x64, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
-	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
-	0x51, 0x62, 0x30, 0x4f, 0x54, 0xc0, 0xbc, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6,
-	0x84, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x75, 0x22, 0x26, 0x1a, 0x62, 0x01, 0x00, 0x00,
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0x87, 0x1b, 0x76, 0x69, 0xb7, 0xee, 0x16, 0x58, 0x0b, 0x21, 0x53, 0x69, 0xd3, 0x52, 0x90,
+	0x58, 0x71, 0x48, 0xd8, 0xe5, 0x08, 0x5c, 0xca, 0x61, 0xb5, 0xfc, 0x11, 0xd0, 0xde, 0xb8, 0x54,
+	0x4e, 0x70, 0xbb, 0x86, 0x26, 0x8e, 0x3c, 0x6e, 0x45, 0xdf, 0x82, 0xc7, 0x80, 0x1b, 0x8f, 0xb1,
+	0xc7, 0x1e, 0x39, 0x21, 0xd4, 0x1e, 0x78, 0x0d, 0x14, 0xc7, 0x69, 0x8d, 0x92, 0x70, 0xa9, 0xaa,
+	0xf1, 0xf7, 0xfb, 0x34, 0x33, 0xb1, 0x51, 0x57, 0x32, 0x3a, 0x9b, 0xf0, 0xd8, 0x17, 0x92, 0x86,
+	0x33, 0xe6, 0x2f, 0x4e, 0xfd, 0x29, 0x8b, 0x19, 0x70, 0xf0, 0x12, 0x29, 0x94, 0xc0, 0x47, 0x06,
+	0xf0, 0x32, 0xc0, 0x5b, 0x9c, 0x76, 0x8e, 0x68, 0xc4, 0x63, 0xe1, 0xeb, 0xdf, 0x8c, 0xea, 0xdc,
+	0x9e, 0x8a, 0xa9, 0xd0, 0x7f, 0xfd, 0xf4, 0x9f, 0xa9, 0x3e, 0x2c, 0xca, 0x43, 0x2e, 0xc3, 0x39,
+	0x57, 0xe3, 0x40, 0x32, 0xfa, 0x99, 0x49, 0x03, 0x96, 0x74, 0x71, 0xc9, 0x41, 0x09, 0xb9, 0x34,
+	0x80, 0x5b, 0x04, 0x12, 0x2a, 0x69, 0x64, 0xba, 0xec, 0x1c, 0x97, 0x9c, 0x4b, 0x1e, 0x32, 0x73,
+	0xfc, 0xa0, 0x78, 0x2c, 0x59, 0x24, 0x14, 0x1b, 0xdb, 0x54, 0xaf, 0x8c, 0x4a, 0x84, 0x54, 0xdb,
+	0x3e, 0xfb, 0x45, 0x02, 0xe6, 0x41, 0xc4, 0x01, 0xb8, 0x88, 0xab, 0x5b, 0x85, 0x65, 0x14, 0x88,
+	0x59, 0x76, 0xde, 0xff, 0x5e, 0x47, 0x87, 0xe7, 0xd9, 0x8a, 0x47, 0x8a, 0x2a, 0x86, 0x9f, 0xa1,
+	0x7a, 0x36, 0x0b, 0x71, 0x7a, 0xce, 0x49, 0xeb, 0xec, 0xae, 0x57, 0x58, 0xb9, 0xf7, 0x4e, 0x03,
+	0x83, 0xe6, 0xd5, 0xaf, 0x6e, 0xed, 0xdb, 0x9f, 0x1f, 0x8f, 0x9c, 0xa1, 0xc9, 0xe0, 0xa7, 0xa8,
+	0xa9, 0x67, 0x18, 0x47, 0x34, 0x21, 0xd7, 0x7a, 0x7b, 0x27, 0xad, 0x33, 0x52, 0x26, 0x48, 0x99,
+	0xc1, 0x7e, 0x9a, 0x1f, 0x1e, 0xe8, 0xc0, 0x1b, 0x9a, 0xe0, 0x97, 0xa8, 0xb5, 0xeb, 0x1f, 0xc8,
+	0x9e, 0x8e, 0xf7, 0xab, 0xe2, 0xa3, 0x2d, 0x6a, 0x44, 0x76, 0x18, 0xbf, 0x40, 0x0d, 0xf3, 0xcd,
+	0xc8, 0xbe, 0xf6, 0xdc, 0xaf, 0xf2, 0xbc, 0x0d, 0x80, 0xc9, 0x05, 0x55, 0x3b, 0x51, 0x9e, 0xc4,
+	0xaf, 0xd1, 0x8d, 0x84, 0xc5, 0x1f, 0x79, 0x3c, 0xcd, 0xbe, 0x0c, 0x90, 0xeb, 0xda, 0xd5, 0x2d,
+	0x73, 0x65, 0xa0, 0x3d, 0x59, 0x3b, 0xb1, 0x6a, 0x80, 0xcf, 0x11, 0x92, 0xec, 0x13, 0x0b, 0x95,
+	0x9e, 0xae, 0xae, 0x4d, 0xf7, 0xaa, 0xba, 0x1a, 0xe6, 0xa4, 0x71, 0x59, 0xd1, 0xb4, 0xad, 0xfc,
+	0x26, 0x8c, 0x79, 0x3c, 0x11, 0x40, 0x1a, 0x95, 0x6d, 0x0d, 0x0d, 0x78, 0x11, 0x4f, 0x44, 0xde,
+	0x96, 0xb4, 0x6a, 0x80, 0xdf, 0xa3, 0x5b, 0x5b, 0x1b, 0xcc, 0x28, 0x5c, 0x32, 0x20, 0x07, 0xda,
+	0xd7, 0xfb, 0x8f, 0x6f, 0x94, 0x92, 0x46, 0x78, 0x53, 0xda, 0x45, 0x06, 0xf8, 0x02, 0xb5, 0xed,
+	0x0b, 0x0d, 0xa4, 0xa9, 0x7d, 0x6e, 0xa9, 0x2f, 0xe5, 0xec, 0xad, 0x1d, 0xca, 0x5d, 0x09, 0xf0,
+	0x2b, 0xd4, 0x86, 0x79, 0x00, 0xa1, 0xe4, 0x49, 0xb6, 0x37, 0x54, 0x39, 0xea, 0xc8, 0xe2, 0xf2,
+	0x51, 0xff, 0xc9, 0xe2, 0xe7, 0xa8, 0x91, 0x5d, 0x7e, 0x20, 0x2d, 0xad, 0x39, 0x2e, 0xd3, 0x68,
+	0xc2, 0xda, 0x57, 0x9e, 0x19, 0x3c, 0xbe, 0x5a, 0xbb, 0xce, 0x6a, 0xed, 0x3a, 0xbf, 0xd7, 0xae,
+	0xf3, 0x75, 0xe3, 0xd6, 0x56, 0x1b, 0xb7, 0xf6, 0x73, 0xe3, 0xd6, 0x3e, 0xdc, 0xc9, 0x5f, 0xd9,
+	0x97, 0xfc, 0x9d, 0xa9, 0x65, 0xc2, 0x20, 0xa8, 0xeb, 0x47, 0xf6, 0xe4, 0xef, 0x00, 0x05, 0x3c,
+	0x60, 0xef, 0xd8, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +213,132 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Symbols) > 0 {
+		for iNdEx := len(m.Symbols) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Symbols[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RemotePrices) > 0 {
+		for iNdEx := len(m.RemotePrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemotePrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ReporterSlashes) > 0 {
+		for iNdEx := len(m.ReporterSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReporterSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ReporterInfos) > 0 {
+		for iNdEx := len(m.ReporterInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReporterInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Rejections) > 0 {
+		for iNdEx := len(m.Rejections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rejections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PendingPrices) > 0 {
+		for iNdEx := len(m.PendingPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Submissions) > 0 {
+		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PriceMap) > 0 {
 		for iNdEx := len(m.PriceMap) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -175,6 +391,60 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Submissions) > 0 {
+		for _, e := range m.Submissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingPrices) > 0 {
+		for _, e := range m.PendingPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Rejections) > 0 {
+		for _, e := range m.Rejections {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReporterInfos) > 0 {
+		for _, e := range m.ReporterInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReporterSlashes) > 0 {
+		for _, e := range m.ReporterSlashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RemotePrices) > 0 {
+		for _, e := range m.RemotePrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Symbols) > 0 {
+		for _, e := range m.Symbols {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -280,6 +550,312 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submissions = append(m.Submissions, PriceSubmission{})
+			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, PriceObservation{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPrices = append(m.PendingPrices, PendingPrice{})
+			if err := m.PendingPrices[len(m.PendingPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rejections = append(m.Rejections, PriceRejection{})
+			if err := m.Rejections[len(m.Rejections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReporterInfos = append(m.ReporterInfos, ReporterInfo{})
+			if err := m.ReporterInfos[len(m.ReporterInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterSlashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReporterSlashes = append(m.ReporterSlashes, ReporterSlash{})
+			if err := m.ReporterSlashes[len(m.ReporterSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemotePrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemotePrices = append(m.RemotePrices, RemotePrice{})
+			if err := m.RemotePrices[len(m.RemotePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbols = append(m.Symbols, SymbolInfo{})
+			if err := m.Symbols[len(m.Symbols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
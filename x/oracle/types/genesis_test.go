@@ -3,12 +3,15 @@ package types_test
 import (
 	"testing"
 
+	"realfin/testutil/sample"
 	"realfin/x/oracle/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	reporter := sample.AccAddress()
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -21,7 +24,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{PriceMap: []types.Price{{Symbol: "0"}, {Symbol: "1"}}},
+			genState: &types.GenesisState{Params: types.DefaultParams(), PriceMap: []types.Price{{Symbol: "0"}, {Symbol: "1"}}, Submissions: []types.PriceSubmission{{Symbol: "0", Reporter: "0"}, {Symbol: "0", Reporter: "1"}}},
 			valid:    true,
 		}, {
 			desc: "duplicated price",
@@ -37,6 +40,97 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated price submission",
+			genState: &types.GenesisState{
+				Submissions: []types.PriceSubmission{
+					{
+						Symbol:   "0",
+						Reporter: "0",
+					},
+					{
+						Symbol:   "0",
+						Reporter: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated price observation",
+			genState: &types.GenesisState{
+				History: []types.PriceObservation{
+					{
+						Symbol: "0",
+						Height: 1,
+					},
+					{
+						Symbol: "0",
+						Height: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated reporter in params",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]types.Reporter{
+					{Address: sample.AccAddress(), Weight: 1},
+					{Address: reporter, Weight: 1},
+					{Address: reporter, Weight: 2},
+				}, types.DefaultSubmissionWindow, types.DefaultMinReporters, types.DefaultHistoryRetention, types.DefaultMaxStaleness, nil,
+					types.DefaultMaxDeviationBps, types.DefaultMaxWindowDeviationBps, types.DefaultDeviationWindow, false,
+					types.DefaultBondDenom, types.DefaultMinBond, types.DefaultMaxMissedWindows, types.DefaultOutlierThresholdBps,
+					types.DefaultMaxOutliers, types.DefaultSlashFraction, types.DefaultJailDuration, nil, types.DefaultMaxSubscriptions),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated max staleness in params",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, types.DefaultSubmissionWindow, types.DefaultMinReporters, types.DefaultHistoryRetention,
+					types.DefaultMaxStaleness, []types.MaxStaleness{{Symbol: "ETH", Seconds: 1}, {Symbol: "ETH", Seconds: 2}},
+					types.DefaultMaxDeviationBps, types.DefaultMaxWindowDeviationBps, types.DefaultDeviationWindow, false,
+					types.DefaultBondDenom, types.DefaultMinBond, types.DefaultMaxMissedWindows, types.DefaultOutlierThresholdBps,
+					types.DefaultMaxOutliers, types.DefaultSlashFraction, types.DefaultJailDuration, nil, types.DefaultMaxSubscriptions),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero reporter weight",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]types.Reporter{
+					{Address: reporter, Weight: 0},
+				}, types.DefaultSubmissionWindow, types.DefaultMinReporters, types.DefaultHistoryRetention, types.DefaultMaxStaleness, nil,
+					types.DefaultMaxDeviationBps, types.DefaultMaxWindowDeviationBps, types.DefaultDeviationWindow, false,
+					types.DefaultBondDenom, types.DefaultMinBond, types.DefaultMaxMissedWindows, types.DefaultOutlierThresholdBps,
+					types.DefaultMaxOutliers, types.DefaultSlashFraction, types.DefaultJailDuration, nil, types.DefaultMaxSubscriptions),
+			},
+			valid: false,
+		},
+		{
+			desc:     "nil min bond and slash fraction in params",
+			genState: &types.GenesisState{Params: types.Params{}},
+			valid:    false,
+		},
+		{
+			desc: "duplicated symbol",
+			genState: &types.GenesisState{
+				Symbols: []types.SymbolInfo{
+					{Symbol: "ETH", Base: "ETH"},
+					{Symbol: "ETH", Base: "ETH"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "non canonical symbol",
+			genState: &types.GenesisState{
+				Symbols: []types.SymbolInfo{{Symbol: "Eth", Base: "ETH"}},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"context"
+)
+
+// OracleHooks are the callbacks of a module reacting to price changes. They
+// are called in the transaction, or block, of the price change. A hook that
+// fails does not revert the price change: its own state changes are
+// discarded and the failure is reported by an EventPriceHookFailed.
+type OracleHooks interface {
+	// AfterPriceUpdated is called after every write of a price. oldPrice is
+	// the zero Price when the price was created.
+	AfterPriceUpdated(ctx context.Context, symbol string, oldPrice, newPrice Price) error
+	// AfterPriceDeleted is called after a price is deleted.
+	AfterPriceDeleted(ctx context.Context, symbol string) error
+}
+
+// OracleHooksWrapper is a wrapper for modules to provide their OracleHooks
+// through depinject.
+type OracleHooksWrapper struct{ OracleHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (OracleHooksWrapper) IsOnePerModuleType() {}
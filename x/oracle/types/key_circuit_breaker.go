@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// PendingPriceKey is the prefix to retrieve all PendingPrice
+	PendingPriceKey = collections.NewPrefix("pending_price/value/")
+
+	// RejectionKey is the prefix to retrieve all PriceRejection
+	RejectionKey = collections.NewPrefix("rejection/value/")
+)
@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// HistoryKey is the prefix to retrieve all PriceObservation
+var HistoryKey = collections.NewPrefix("history/value/")
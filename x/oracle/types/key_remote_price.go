@@ -0,0 +1,19 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// RemotePriceKey is the prefix to retrieve all RemotePrice
+	RemotePriceKey = collections.NewPrefix("remote_price/value/")
+
+	// SubscriptionKey is the prefix to retrieve all Subscription
+	SubscriptionKey = collections.NewPrefix("subscription/value/")
+
+	// SymbolSubscriptionKey is the prefix to retrieve the subscriptions by
+	// symbol
+	SymbolSubscriptionKey = collections.NewPrefix("subscription/symbol/")
+
+	// PushQueueKey is the prefix to retrieve the symbols whose prices are
+	// pushed to their subscribers at the end of the block
+	PushQueueKey = collections.NewPrefix("push_queue/value/")
+)
@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// ReporterInfoKey is the prefix to retrieve all ReporterInfo
+	ReporterInfoKey = collections.NewPrefix("reporter_info/value/")
+
+	// SlashKey is the prefix to retrieve all ReporterSlash
+	SlashKey = collections.NewPrefix("slash/value/")
+)
@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// StaleKey is the prefix to retrieve the symbols flagged as stale
+var StaleKey = collections.NewPrefix("stale/value/")
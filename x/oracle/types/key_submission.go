@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// SubmissionKey is the prefix to retrieve all PriceSubmission
+var SubmissionKey = collections.NewPrefix("submission/value/")
@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// SymbolKey is the prefix to retrieve all SymbolInfo
+var SymbolKey = collections.NewPrefix("symbol/value/")
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"math/big"
+	"sort"
+)
+
+// WeightedRate is a single observation with its weight in the aggregation.
+type WeightedRate struct {
+	Rate   uint64
+	Weight uint64
+}
+
+// WeightedMedian returns the weighted median of the given observations, that
+// is the lowest rate at which the cumulative weight reaches half of the total
+// weight. Observations with a zero weight are ignored. The second return value
+// is false when there is nothing to aggregate.
+func WeightedMedian(rates []WeightedRate) (uint64, bool) {
+	sorted := make([]WeightedRate, 0, len(rates))
+	total := new(big.Int)
+	for _, r := range rates {
+		if r.Weight == 0 {
+			continue
+		}
+		sorted = append(sorted, r)
+		total.Add(total, new(big.Int).SetUint64(r.Weight))
+	}
+	if len(sorted) == 0 {
+		return 0, false
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Rate < sorted[j].Rate
+	})
+
+	cumulative := new(big.Int)
+	for _, r := range sorted {
+		cumulative.Add(cumulative, new(big.Int).SetUint64(r.Weight))
+		if new(big.Int).Lsh(cumulative, 1).Cmp(total) >= 0 {
+			return r.Rate, true
+		}
+	}
+
+	return sorted[len(sorted)-1].Rate, true
+}
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/types"
+)
+
+func TestWeightedMedian(t *testing.T) {
+	tests := []struct {
+		desc     string
+		rates    []types.WeightedRate
+		expected uint64
+		found    bool
+	}{
+		{
+			desc: "empty",
+		},
+		{
+			desc:  "only zero weights",
+			rates: []types.WeightedRate{{Rate: 10, Weight: 0}},
+		},
+		{
+			desc:     "single observation",
+			rates:    []types.WeightedRate{{Rate: 10, Weight: 1}},
+			expected: 10,
+			found:    true,
+		},
+		{
+			desc:     "equal weights odd count",
+			rates:    []types.WeightedRate{{Rate: 30, Weight: 1}, {Rate: 10, Weight: 1}, {Rate: 20, Weight: 1}},
+			expected: 20,
+			found:    true,
+		},
+		{
+			desc:     "equal weights even count picks lower median",
+			rates:    []types.WeightedRate{{Rate: 40, Weight: 1}, {Rate: 10, Weight: 1}, {Rate: 30, Weight: 1}, {Rate: 20, Weight: 1}},
+			expected: 20,
+			found:    true,
+		},
+		{
+			desc:     "heavy reporter dominates",
+			rates:    []types.WeightedRate{{Rate: 10, Weight: 1}, {Rate: 20, Weight: 1}, {Rate: 1000, Weight: 5}},
+			expected: 1000,
+			found:    true,
+		},
+		{
+			desc:     "single outlier is ignored",
+			rates:    []types.WeightedRate{{Rate: 100, Weight: 2}, {Rate: 101, Weight: 2}, {Rate: 1_000_000, Weight: 1}},
+			expected: 101,
+			found:    true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			median, found := types.WeightedMedian(tc.rates)
+			require.Equal(t, tc.found, found)
+			require.Equal(t, tc.expected, median)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+const (
+	// MaxPacketSymbols is the maximum number of symbols or prices in a packet.
+	MaxPacketSymbols = 100
+
+	// DefaultPacketTimeout is the packet timeout, relative to the block time,
+	// used when none is given.
+	DefaultPacketTimeout = 10 * time.Minute
+)
+
+// ModuleCdc encodes the oracle packets exchanged over IBC.
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// GetBytes returns the JSON encoding of the packet.
+func (p OraclePacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&p)
+}
+
+// ValidateBasic performs the stateless checks of the packet.
+func (p OraclePacketData) ValidateBasic() error {
+	switch packet := p.Packet.(type) {
+	case *OraclePacketData_PriceRequest:
+		return validatePacketSymbols(packet.PriceRequest.Symbols)
+	case *OraclePacketData_Subscription:
+		return validatePacketSymbols(packet.Subscription.Symbols)
+	case *OraclePacketData_PricePush:
+		return validatePacketPrices(packet.PricePush.Prices)
+	default:
+		return fmt.Errorf("unrecognized oracle packet type: %T", packet)
+	}
+}
+
+// ValidateBasic performs the stateless checks of the response.
+func (r PriceResponsePacketData) ValidateBasic() error {
+	return validatePacketPrices(r.Prices)
+}
+
+func validatePacketSymbols(symbols []string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("symbols cannot be empty")
+	}
+	if len(symbols) > MaxPacketSymbols {
+		return fmt.Errorf("too many symbols: %d > %d", len(symbols), MaxPacketSymbols)
+	}
+
+	seen := make(map[string]struct{}, len(symbols))
+	for _, symbol := range symbols {
+		if symbol == "" {
+			return fmt.Errorf("symbol cannot be empty")
+		}
+		if len(symbol) > MaxSymbolLength {
+			return fmt.Errorf("symbol %q exceeds the maximum length of %d", symbol, MaxSymbolLength)
+		}
+		if _, ok := seen[symbol]; ok {
+			return fmt.Errorf("duplicated symbol %s", symbol)
+		}
+		seen[symbol] = struct{}{}
+	}
+
+	return nil
+}
+
+func validatePacketPrices(prices []PacketPrice) error {
+	if len(prices) > MaxPacketSymbols {
+		return fmt.Errorf("too many prices: %d > %d", len(prices), MaxPacketSymbols)
+	}
+
+	seen := make(map[string]struct{}, len(prices))
+	for _, price := range prices {
+		if price.Symbol == "" {
+			return fmt.Errorf("symbol cannot be empty")
+		}
+		if len(price.Symbol) > MaxSymbolLength {
+			return fmt.Errorf("symbol %q exceeds the maximum length of %d", price.Symbol, MaxSymbolLength)
+		}
+		if _, ok := seen[price.Symbol]; ok {
+			return fmt.Errorf("duplicated symbol %s", price.Symbol)
+		}
+		seen[price.Symbol] = struct{}{}
+
+		if price.Decimals > MaxDecimals {
+			return fmt.Errorf("decimals of %s exceed %d", price.Symbol, MaxDecimals)
+		}
+	}
+
+	return nil
+}
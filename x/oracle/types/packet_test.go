@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/oracle/types"
+)
+
+func TestOraclePacketDataValidateBasic(t *testing.T) {
+	tests := []struct {
+		desc   string
+		packet types.OraclePacketData
+		valid  bool
+	}{
+		{
+			desc:   "price request",
+			packet: types.OraclePacketData{Packet: &types.OraclePacketData_PriceRequest{PriceRequest: &types.PriceRequestPacketData{Symbols: []string{"ETH", "BTC"}}}},
+			valid:  true,
+		},
+		{
+			desc:   "empty price request",
+			packet: types.OraclePacketData{Packet: &types.OraclePacketData_PriceRequest{PriceRequest: &types.PriceRequestPacketData{}}},
+		},
+		{
+			desc:   "duplicated subscription symbol",
+			packet: types.OraclePacketData{Packet: &types.OraclePacketData_Subscription{Subscription: &types.SubscriptionPacketData{Symbols: []string{"ETH", "ETH"}}}},
+		},
+		{
+			desc:   "too many symbols",
+			packet: types.OraclePacketData{Packet: &types.OraclePacketData_PriceRequest{PriceRequest: &types.PriceRequestPacketData{Symbols: make([]string, types.MaxPacketSymbols+1)}}},
+		},
+		{
+			desc:   "price push",
+			packet: types.OraclePacketData{Packet: &types.OraclePacketData_PricePush{PricePush: &types.PricePushPacketData{Prices: []types.PacketPrice{{Symbol: "ETH", Rate: 1, Decimals: 2}}}}},
+			valid:  true,
+		},
+		{
+			desc:   "price push with invalid decimals",
+			packet: types.OraclePacketData{Packet: &types.OraclePacketData_PricePush{PricePush: &types.PricePushPacketData{Prices: []types.PacketPrice{{Symbol: "ETH", Decimals: types.MaxDecimals + 1}}}}},
+		},
+		{
+			desc:   "no data",
+			packet: types.OraclePacketData{Packet: &types.OraclePacketData_NoData{NoData: &types.NoData{}}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.packet.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestOraclePacketDataGetBytes(t *testing.T) {
+	packet := types.OraclePacketData{Packet: &types.OraclePacketData_Subscription{Subscription: &types.SubscriptionPacketData{Symbols: []string{"ETH"}, Unsubscribe: true}}}
+
+	var decoded types.OraclePacketData
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(packet.GetBytes(), &decoded))
+	require.Equal(t, packet, decoded)
+}
@@ -1,17 +1,225 @@
 package types
 
+import (
+	"fmt"
+	stdmath "math"
+	"slices"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+const (
+	// DefaultSubmissionWindow is the default number of blocks a reporter
+	// submission remains eligible for aggregation.
+	DefaultSubmissionWindow uint64 = 10
+
+	// DefaultMinReporters is the default minimum number of submissions
+	// required to aggregate a canonical price.
+	DefaultMinReporters uint32 = 1
+
+	// DefaultHistoryRetention is the default number of blocks for which price
+	// observations are kept, about one day of 6 second blocks.
+	DefaultHistoryRetention uint64 = 14400
+
+	// DefaultMaxStaleness is the default number of seconds after its last
+	// update a price is considered stale.
+	DefaultMaxStaleness uint64 = 86400
+
+	// DefaultMaxDeviationBps is the default maximum change of a rate in a
+	// single update, in basis points.
+	DefaultMaxDeviationBps uint64 = 2000
+
+	// DefaultMaxWindowDeviationBps is the default maximum change of a rate
+	// within the deviation window, in basis points.
+	DefaultMaxWindowDeviationBps uint64 = 5000
+
+	// DefaultDeviationWindow is the default number of blocks covered by the
+	// window deviation check.
+	DefaultDeviationWindow uint64 = 100
+
+	// DefaultBondDenom is the default denom reporters bond.
+	DefaultBondDenom = "urlf"
+
+	// DefaultMaxMissedWindows is the default miss counter at which a reporter
+	// is slashed and jailed.
+	DefaultMaxMissedWindows uint64 = 10
+
+	// DefaultOutlierThresholdBps is the default deviation from the aggregate,
+	// in basis points, beyond which a submission counts as an outlier.
+	DefaultOutlierThresholdBps uint64 = 1000
+
+	// DefaultMaxOutliers is the default outlier counter at which a reporter is
+	// slashed and jailed.
+	DefaultMaxOutliers uint64 = 10
+
+	// DefaultJailDuration is the default number of seconds a slashed reporter
+	// stays jailed.
+	DefaultJailDuration uint64 = 86400
+
+	// DefaultMaxSubscriptions is the default maximum number of symbols a
+	// channel can subscribe to, which fit in a single push packet.
+	DefaultMaxSubscriptions uint64 = MaxPacketSymbols
+)
+
+var (
+	// DefaultMinBond is the default minimum bond of a whitelisted reporter.
+	DefaultMinBond = math.NewInt(1_000_000)
+
+	// DefaultSlashFraction is the default fraction of the bond burnt when a
+	// reporter is slashed.
+	DefaultSlashFraction = math.LegacyNewDecWithPrec(1, 2)
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	reporters []Reporter,
+	submissionWindow uint64,
+	minReporters uint32,
+	historyRetention uint64,
+	defaultMaxStaleness uint64,
+	maxStaleness []MaxStaleness,
+	maxDeviationBps uint64,
+	maxWindowDeviationBps uint64,
+	deviationWindow uint64,
+	queueDeviatingUpdates bool,
+	bondDenom string,
+	minBond math.Int,
+	maxMissedWindows uint64,
+	outlierThresholdBps uint64,
+	maxOutliers uint64,
+	slashFraction math.LegacyDec,
+	jailDuration uint64,
+	channels []string,
+	maxSubscriptions uint64,
+) Params {
+	return Params{
+		Reporters:             reporters,
+		SubmissionWindow:      submissionWindow,
+		MinReporters:          minReporters,
+		HistoryRetention:      historyRetention,
+		DefaultMaxStaleness:   defaultMaxStaleness,
+		MaxStaleness:          maxStaleness,
+		MaxDeviationBps:       maxDeviationBps,
+		MaxWindowDeviationBps: maxWindowDeviationBps,
+		DeviationWindow:       deviationWindow,
+		QueueDeviatingUpdates: queueDeviatingUpdates,
+		BondDenom:             bondDenom,
+		MinBond:               minBond,
+		MaxMissedWindows:      maxMissedWindows,
+		OutlierThresholdBps:   outlierThresholdBps,
+		MaxOutliers:           maxOutliers,
+		SlashFraction:         slashFraction,
+		JailDuration:          jailDuration,
+		Channels:              channels,
+		MaxSubscriptions:      maxSubscriptions,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(nil, DefaultSubmissionWindow, DefaultMinReporters, DefaultHistoryRetention, DefaultMaxStaleness, nil,
+		DefaultMaxDeviationBps, DefaultMaxWindowDeviationBps, DefaultDeviationWindow, false,
+		DefaultBondDenom, DefaultMinBond, DefaultMaxMissedWindows, DefaultOutlierThresholdBps, DefaultMaxOutliers,
+		DefaultSlashFraction, DefaultJailDuration, nil, DefaultMaxSubscriptions)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	seen := make(map[string]struct{}, len(p.Reporters))
+	for _, reporter := range p.Reporters {
+		if _, err := sdk.AccAddressFromBech32(reporter.Address); err != nil {
+			return fmt.Errorf("invalid reporter address %s: %w", reporter.Address, err)
+		}
+		if _, ok := seen[reporter.Address]; ok {
+			return fmt.Errorf("duplicated reporter %s", reporter.Address)
+		}
+		seen[reporter.Address] = struct{}{}
+
+		if reporter.Weight == 0 {
+			return fmt.Errorf("reporter %s must have a positive weight", reporter.Address)
+		}
+	}
+
+	symbols := make(map[string]struct{}, len(p.MaxStaleness))
+	for _, staleness := range p.MaxStaleness {
+		if staleness.Symbol == "" {
+			return fmt.Errorf("max staleness symbol cannot be empty")
+		}
+		if _, ok := symbols[staleness.Symbol]; ok {
+			return fmt.Errorf("duplicated max staleness for symbol %s", staleness.Symbol)
+		}
+		symbols[staleness.Symbol] = struct{}{}
+	}
+
+	if p.MaxWindowDeviationBps > 0 && p.DeviationWindow == 0 {
+		return fmt.Errorf("deviation window must be positive when the window deviation check is enabled")
+	}
+
+	// an empty bond denom disables bonding
+	if p.BondDenom != "" {
+		if err := sdk.ValidateDenom(p.BondDenom); err != nil {
+			return fmt.Errorf("invalid bond denom: %w", err)
+		}
+	}
+	if p.MinBond.IsNil() || p.MinBond.IsNegative() {
+		return fmt.Errorf("min bond cannot be nil or negative")
+	}
+	if p.SlashFraction.IsNil() || p.SlashFraction.IsNegative() || p.SlashFraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1")
+	}
+
+	channels := make(map[string]struct{}, len(p.Channels))
+	for _, channelID := range p.Channels {
+		if _, ok := channels[channelID]; ok {
+			return fmt.Errorf("duplicated channel %s", channelID)
+		}
+		channels[channelID] = struct{}{}
+
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid channel: %w", err)
+		}
+	}
 
 	return nil
 }
+
+// MaxStalenessOf returns the freshness threshold of the given symbol. Zero
+// means the price of the symbol never becomes stale.
+func (p Params) MaxStalenessOf(symbol string) time.Duration {
+	seconds := p.DefaultMaxStaleness
+	for _, staleness := range p.MaxStaleness {
+		if staleness.Symbol == symbol {
+			seconds = staleness.Seconds
+			break
+		}
+	}
+
+	return time.Duration(min(seconds, uint64(stdmath.MaxInt64/int64(time.Second)))) * time.Second
+}
+
+// ReporterWeight returns the aggregation weight of the given reporter and
+// whether it is part of the whitelisted set.
+func (p Params) ReporterWeight(address string) (uint64, bool) {
+	for _, reporter := range p.Reporters {
+		if reporter.Address == address {
+			return reporter.Weight, true
+		}
+	}
+
+	return 0, false
+}
+
+// ChannelAllowed reports whether the channel is allowed to exchange prices
+// with the oracle.
+func (p Params) ChannelAllowed(channelID string) bool {
+	return slices.Contains(p.Channels, channelID)
+}
+
+// JailDurationOf returns the jail duration as a time.Duration.
+func (p Params) JailDurationOf() time.Duration {
+	return time.Duration(min(p.JailDuration, uint64(stdmath.MaxInt64/int64(time.Second)))) * time.Second
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +28,63 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// reporters is the whitelisted set of accounts allowed to submit price
+	// observations, together with their weight in the aggregation.
+	Reporters []Reporter `protobuf:"bytes,1,rep,name=reporters,proto3" json:"reporters"`
+	// submission_window is the number of blocks a submission remains eligible
+	// for aggregation. Zero means submissions never expire.
+	SubmissionWindow uint64 `protobuf:"varint,2,opt,name=submission_window,json=submissionWindow,proto3" json:"submission_window,omitempty"`
+	// min_reporters is the minimum number of eligible submissions required
+	// before a canonical price is aggregated for a symbol.
+	MinReporters uint32 `protobuf:"varint,3,opt,name=min_reporters,json=minReporters,proto3" json:"min_reporters,omitempty"`
+	// history_retention is the number of blocks for which price observations
+	// are kept in the price history. Zero disables the history.
+	HistoryRetention uint64 `protobuf:"varint,4,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
+	// default_max_staleness is the number of seconds after its last update a
+	// price is considered stale, unless overridden for its symbol. Zero means
+	// prices never become stale.
+	DefaultMaxStaleness uint64 `protobuf:"varint,5,opt,name=default_max_staleness,json=defaultMaxStaleness,proto3" json:"default_max_staleness,omitempty"`
+	// max_staleness overrides default_max_staleness for individual symbols.
+	MaxStaleness []MaxStaleness `protobuf:"bytes,6,rep,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness"`
+	// max_deviation_bps is the maximum change of a rate in a single update, in
+	// basis points of the current rate. Zero disables the check.
+	MaxDeviationBps uint64 `protobuf:"varint,7,opt,name=max_deviation_bps,json=maxDeviationBps,proto3" json:"max_deviation_bps,omitempty"`
+	// max_window_deviation_bps is the maximum change of a rate, in basis points,
+	// relative to the oldest observation within the deviation window. Zero
+	// disables the check.
+	MaxWindowDeviationBps uint64 `protobuf:"varint,8,opt,name=max_window_deviation_bps,json=maxWindowDeviationBps,proto3" json:"max_window_deviation_bps,omitempty"`
+	// deviation_window is the number of blocks covered by the window deviation
+	// check.
+	DeviationWindow uint64 `protobuf:"varint,9,opt,name=deviation_window,json=deviationWindow,proto3" json:"deviation_window,omitempty"`
+	// queue_deviating_updates queues updates outside the deviation band as
+	// pending prices awaiting confirmation instead of rejecting them.
+	QueueDeviatingUpdates bool `protobuf:"varint,10,opt,name=queue_deviating_updates,json=queueDeviatingUpdates,proto3" json:"queue_deviating_updates,omitempty"`
+	// bond_denom is the denom reporters bond into the oracle module account.
+	BondDenom string `protobuf:"bytes,11,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// min_bond is the minimum bond a whitelisted reporter must hold to submit
+	// prices.
+	MinBond cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=min_bond,json=minBond,proto3,customtype=cosmossdk.io/math.Int" json:"min_bond"`
+	// max_missed_windows is the miss counter at which a reporter is slashed and
+	// jailed. A reporter misses a window when it submits nothing during a whole
+	// submission window. Zero disables the check.
+	MaxMissedWindows uint64 `protobuf:"varint,13,opt,name=max_missed_windows,json=maxMissedWindows,proto3" json:"max_missed_windows,omitempty"`
+	// outlier_threshold_bps is the deviation from the aggregate, in basis
+	// points, beyond which a submission counts as an outlier.
+	OutlierThresholdBps uint64 `protobuf:"varint,14,opt,name=outlier_threshold_bps,json=outlierThresholdBps,proto3" json:"outlier_threshold_bps,omitempty"`
+	// max_outliers is the outlier counter at which a reporter is slashed and
+	// jailed. Zero disables the check.
+	MaxOutliers uint64 `protobuf:"varint,15,opt,name=max_outliers,json=maxOutliers,proto3" json:"max_outliers,omitempty"`
+	// slash_fraction is the fraction of the bond burnt when a reporter is
+	// slashed.
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// jail_duration is the number of seconds a slashed reporter stays jailed.
+	JailDuration uint64 `protobuf:"varint,17,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+	// channels are the IBC channels allowed to exchange prices with the oracle.
+	// No channel can be opened when empty.
+	Channels []string `protobuf:"bytes,18,rep,name=channels,proto3" json:"channels,omitempty"`
+	// max_subscriptions is the maximum number of symbols a channel can
+	// subscribe to. Zero disables the subscriptions.
+	MaxSubscriptions uint64 `protobuf:"varint,19,opt,name=max_subscriptions,json=maxSubscriptions,proto3" json:"max_subscriptions,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,24 +120,291 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetReporters() []Reporter {
+	if m != nil {
+		return m.Reporters
+	}
+	return nil
+}
+
+func (m *Params) GetSubmissionWindow() uint64 {
+	if m != nil {
+		return m.SubmissionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMinReporters() uint32 {
+	if m != nil {
+		return m.MinReporters
+	}
+	return 0
+}
+
+func (m *Params) GetHistoryRetention() uint64 {
+	if m != nil {
+		return m.HistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetDefaultMaxStaleness() uint64 {
+	if m != nil {
+		return m.DefaultMaxStaleness
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStaleness() []MaxStaleness {
+	if m != nil {
+		return m.MaxStaleness
+	}
+	return nil
+}
+
+func (m *Params) GetMaxDeviationBps() uint64 {
+	if m != nil {
+		return m.MaxDeviationBps
+	}
+	return 0
+}
+
+func (m *Params) GetMaxWindowDeviationBps() uint64 {
+	if m != nil {
+		return m.MaxWindowDeviationBps
+	}
+	return 0
+}
+
+func (m *Params) GetDeviationWindow() uint64 {
+	if m != nil {
+		return m.DeviationWindow
+	}
+	return 0
+}
+
+func (m *Params) GetQueueDeviatingUpdates() bool {
+	if m != nil {
+		return m.QueueDeviatingUpdates
+	}
+	return false
+}
+
+func (m *Params) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+func (m *Params) GetMaxMissedWindows() uint64 {
+	if m != nil {
+		return m.MaxMissedWindows
+	}
+	return 0
+}
+
+func (m *Params) GetOutlierThresholdBps() uint64 {
+	if m != nil {
+		return m.OutlierThresholdBps
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOutliers() uint64 {
+	if m != nil {
+		return m.MaxOutliers
+	}
+	return 0
+}
+
+func (m *Params) GetJailDuration() uint64 {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *Params) GetMaxSubscriptions() uint64 {
+	if m != nil {
+		return m.MaxSubscriptions
+	}
+	return 0
+}
+
+// MaxStaleness defines the freshness threshold of a symbol.
+type MaxStaleness struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// seconds is the number of seconds after its last update the price of the
+	// symbol is considered stale. Zero means it never becomes stale.
+	Seconds uint64 `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (m *MaxStaleness) Reset()         { *m = MaxStaleness{} }
+func (m *MaxStaleness) String() string { return proto.CompactTextString(m) }
+func (*MaxStaleness) ProtoMessage()    {}
+func (*MaxStaleness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe727d45ead4cb16, []int{1}
+}
+func (m *MaxStaleness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxStaleness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxStaleness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxStaleness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxStaleness.Merge(m, src)
+}
+func (m *MaxStaleness) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxStaleness) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxStaleness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxStaleness proto.InternalMessageInfo
+
+func (m *MaxStaleness) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MaxStaleness) GetSeconds() uint64 {
+	if m != nil {
+		return m.Seconds
+	}
+	return 0
+}
+
+// Reporter defines a whitelisted price reporter and its aggregation weight.
+type Reporter struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight  uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *Reporter) Reset()         { *m = Reporter{} }
+func (m *Reporter) String() string { return proto.CompactTextString(m) }
+func (*Reporter) ProtoMessage()    {}
+func (*Reporter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe727d45ead4cb16, []int{2}
+}
+func (m *Reporter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reporter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reporter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reporter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reporter.Merge(m, src)
+}
+func (m *Reporter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reporter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reporter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reporter proto.InternalMessageInfo
+
+func (m *Reporter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Reporter) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "realfin.oracle.v1.Params")
+	proto.RegisterType((*MaxStaleness)(nil), "realfin.oracle.v1.MaxStaleness")
+	proto.RegisterType((*Reporter)(nil), "realfin.oracle.v1.Reporter")
 }
 
 func init() { proto.RegisterFile("realfin/oracle/v1/params.proto", fileDescriptor_fe727d45ead4cb16) }
 
 var fileDescriptor_fe727d45ead4cb16 = []byte{
-	// 157 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x4a, 0x4d, 0xcc,
-	0x49, 0xcb, 0xcc, 0xd3, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xca, 0xeb, 0x41,
-	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x95,
-	0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0xb4, 0xb8, 0xd8,
-	0x02, 0xc0, 0x66, 0x59, 0x29, 0xbc, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x38, 0xcc,
-	0xba, 0x0a, 0x98, 0x85, 0x10, 0x15, 0x4e, 0x06, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
-	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
-	0xc7, 0x10, 0x25, 0x86, 0xa1, 0xa5, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x6c, 0x89, 0x31,
-	0x20, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xe1, 0xae, 0xd6, 0xc2, 0x00, 0x00, 0x00,
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xf6, 0x90, 0xe0, 0x9f, 0x8e, 0xbd, 0x1b, 0xf7, 0xae, 0x77, 0x9b, 0xac, 0xb0, 0x4d, 0xb8,
+	0x98, 0xc0, 0xda, 0xbb, 0x41, 0x0a, 0x52, 0x6e, 0x58, 0x56, 0xa4, 0x08, 0xa2, 0xa0, 0x09, 0x08,
+	0x09, 0x81, 0x46, 0xed, 0x99, 0x8e, 0xdd, 0x30, 0xdd, 0x3d, 0x74, 0xf7, 0x24, 0xf6, 0x2b, 0x70,
+	0xe2, 0x11, 0x38, 0x72, 0xcc, 0x21, 0x0f, 0x91, 0x63, 0x94, 0x13, 0xe2, 0x10, 0xa1, 0xe4, 0x10,
+	0x9e, 0x81, 0x13, 0xea, 0x9f, 0x89, 0x1d, 0xc2, 0xc5, 0x72, 0x7d, 0x5f, 0xfd, 0x4c, 0x7d, 0x55,
+	0xd5, 0xa0, 0x2d, 0x09, 0x4e, 0x8f, 0x29, 0x1f, 0x08, 0x89, 0xe3, 0x94, 0x0c, 0x4e, 0xde, 0x0e,
+	0x32, 0x2c, 0x31, 0x53, 0xfd, 0x4c, 0x0a, 0x2d, 0x60, 0xd3, 0xf3, 0x7d, 0xc7, 0xf7, 0x4f, 0xde,
+	0x6e, 0x34, 0x31, 0xa3, 0x5c, 0x0c, 0xec, 0xaf, 0xf3, 0xda, 0x78, 0x2f, 0x16, 0x8a, 0x09, 0x15,
+	0x59, 0x6b, 0xe0, 0x0c, 0x4f, 0x3d, 0x9f, 0x88, 0x89, 0x70, 0xb8, 0xf9, 0xe7, 0xd0, 0xcd, 0x7f,
+	0x2a, 0xa0, 0xfc, 0x95, 0xad, 0x03, 0x47, 0xa0, 0x26, 0x49, 0x26, 0xa4, 0x26, 0x52, 0xa1, 0xa0,
+	0xbb, 0xd2, 0x5b, 0xdb, 0x7e, 0xd5, 0x7f, 0x54, 0xb5, 0x1f, 0x7a, 0x9f, 0x61, 0xed, 0xe2, 0xba,
+	0x53, 0xfa, 0xfd, 0xee, 0x6c, 0x2b, 0x08, 0x17, 0x81, 0xf0, 0x63, 0xd0, 0x54, 0xf9, 0x98, 0x51,
+	0xa5, 0xa8, 0xe0, 0xd1, 0x29, 0xe5, 0x89, 0x38, 0x45, 0xef, 0x74, 0x83, 0xde, 0x6a, 0xb8, 0xbe,
+	0x20, 0xbe, 0xb5, 0x38, 0xfc, 0x10, 0x34, 0x18, 0xe5, 0xd1, 0xa2, 0xec, 0x4a, 0x37, 0xe8, 0x35,
+	0xc2, 0x3a, 0xa3, 0x3c, 0x5c, 0xce, 0x38, 0xa5, 0x4a, 0x0b, 0x39, 0x8f, 0x24, 0xd1, 0x84, 0x6b,
+	0x2a, 0x38, 0x5a, 0x75, 0x19, 0x3d, 0x11, 0x16, 0x38, 0xdc, 0x06, 0xad, 0x84, 0x1c, 0xe3, 0x3c,
+	0xd5, 0x11, 0xc3, 0xb3, 0x48, 0x69, 0x9c, 0x12, 0x4e, 0x94, 0x42, 0xef, 0xda, 0x80, 0x67, 0x9e,
+	0x3c, 0xc0, 0xb3, 0xa3, 0x82, 0x82, 0x87, 0xa0, 0xf1, 0xd0, 0xb7, 0x6c, 0x9b, 0xef, 0xfc, 0x4f,
+	0xf3, 0xcb, 0x71, 0xcb, 0x02, 0xd4, 0xd9, 0x72, 0xc2, 0x2d, 0xd0, 0x34, 0x09, 0x13, 0x72, 0x42,
+	0xb1, 0xf9, 0xaa, 0x68, 0x9c, 0x29, 0x54, 0xb1, 0x1f, 0xf0, 0x94, 0xe1, 0xd9, 0xa8, 0xc0, 0x87,
+	0x99, 0x82, 0x9f, 0x01, 0x64, 0x7c, 0x9d, 0x50, 0xff, 0x09, 0xa9, 0xda, 0x90, 0x16, 0xc3, 0x33,
+	0xa7, 0xd7, 0x83, 0xc0, 0x8f, 0xc0, 0xfa, 0xc2, 0xdb, 0xeb, 0x5c, 0x73, 0x35, 0xee, 0x71, 0x2f,
+	0xf3, 0x0e, 0x78, 0xf9, 0x73, 0x4e, 0x72, 0x52, 0xa4, 0xe7, 0x93, 0x28, 0xcf, 0x12, 0xac, 0x89,
+	0x42, 0xa0, 0x1b, 0xf4, 0xaa, 0x61, 0xcb, 0xd2, 0xa3, 0x82, 0xfd, 0xc6, 0x91, 0xf0, 0x7d, 0x00,
+	0xc6, 0x82, 0x27, 0x51, 0x42, 0xb8, 0x60, 0x68, 0xad, 0x1b, 0xf4, 0x6a, 0x61, 0xcd, 0x20, 0x23,
+	0x03, 0xc0, 0x2f, 0x40, 0xd5, 0x4c, 0xcf, 0x00, 0xa8, 0x6e, 0xc8, 0xe1, 0x1b, 0xa3, 0xc8, 0x9f,
+	0xd7, 0x9d, 0x96, 0xdb, 0x3c, 0x95, 0xfc, 0xd4, 0xa7, 0x62, 0xc0, 0xb0, 0x9e, 0xf6, 0xf7, 0xb9,
+	0xbe, 0x3a, 0x7f, 0x0d, 0xfc, 0x4a, 0xee, 0x73, 0xed, 0x84, 0xab, 0x30, 0xca, 0x87, 0x82, 0x27,
+	0xf0, 0x13, 0x00, 0x8d, 0x0e, 0x66, 0x3f, 0x48, 0xe2, 0xfb, 0x51, 0xa8, 0xe1, 0xc6, 0xcc, 0xf0,
+	0xec, 0xc0, 0x12, 0xae, 0x21, 0x65, 0xc6, 0x2c, 0x72, 0x9d, 0x52, 0x22, 0x23, 0x3d, 0x95, 0x44,
+	0x4d, 0x45, 0x9a, 0x58, 0xc9, 0x9e, 0xb8, 0x31, 0x7b, 0xf2, 0xeb, 0x82, 0x33, 0x82, 0x7d, 0x00,
+	0xcc, 0x94, 0x22, 0x4f, 0x29, 0xf4, 0xd4, 0xba, 0xae, 0x31, 0x3c, 0x3b, 0xf4, 0x10, 0xfc, 0x01,
+	0x3c, 0x51, 0x29, 0x56, 0xd3, 0xe8, 0x58, 0xe2, 0xd8, 0xee, 0xd9, 0xba, 0xed, 0x6b, 0xc7, 0xf7,
+	0xf5, 0xea, 0x71, 0x5f, 0x5f, 0x92, 0x09, 0x8e, 0xe7, 0x23, 0x12, 0x2f, 0x75, 0x37, 0x22, 0xb1,
+	0xeb, 0xae, 0x61, 0xb3, 0xed, 0xf9, 0x64, 0x66, 0xdd, 0x7f, 0xc4, 0x34, 0x8d, 0x92, 0x5c, 0xda,
+	0xf1, 0xa0, 0xa6, 0xfd, 0x84, 0xba, 0x01, 0x47, 0x1e, 0x83, 0x1b, 0xa0, 0x1a, 0x4f, 0x31, 0xe7,
+	0x24, 0x55, 0x08, 0x76, 0x57, 0x7a, 0xb5, 0xf0, 0xde, 0x36, 0xa7, 0x60, 0x37, 0x35, 0x1f, 0xab,
+	0x58, 0xd2, 0xcc, 0xf8, 0x2b, 0xf4, 0xec, 0x5e, 0xa3, 0xa3, 0x65, 0x7c, 0xb7, 0xfb, 0xf7, 0x6f,
+	0x9d, 0xe0, 0x97, 0xbb, 0xb3, 0xad, 0x97, 0xc5, 0xd3, 0x32, 0x2b, 0x1e, 0x17, 0x77, 0xf1, 0x9b,
+	0x7b, 0xa0, 0xfe, 0xe0, 0x10, 0x5e, 0x80, 0xb2, 0x9a, 0xb3, 0xb1, 0x48, 0x51, 0x60, 0x67, 0xed,
+	0x2d, 0x88, 0x40, 0x45, 0x91, 0x58, 0xf0, 0x44, 0xf9, 0x4b, 0x2e, 0xcc, 0xdd, 0x55, 0x53, 0x63,
+	0xf3, 0x7b, 0x50, 0x2d, 0xce, 0x15, 0x6e, 0x83, 0x0a, 0x4e, 0x12, 0x69, 0xce, 0xc8, 0x26, 0x19,
+	0xa2, 0xab, 0xf3, 0xd7, 0xcf, 0xbd, 0x30, 0x9f, 0x3b, 0xe6, 0x48, 0x4b, 0xca, 0x27, 0x61, 0xe1,
+	0x68, 0xea, 0x9e, 0x12, 0x3a, 0x99, 0x6a, 0x9f, 0xde, 0x5b, 0x2e, 0xfb, 0xf0, 0xcd, 0xc5, 0x4d,
+	0x3b, 0xb8, 0xbc, 0x69, 0x07, 0x7f, 0xdd, 0xb4, 0x83, 0x5f, 0x6f, 0xdb, 0xa5, 0xcb, 0xdb, 0x76,
+	0xe9, 0x8f, 0xdb, 0x76, 0xe9, 0xbb, 0x17, 0x8f, 0x1a, 0xd3, 0xf3, 0x8c, 0xa8, 0x71, 0xd9, 0xbe,
+	0x6d, 0x9f, 0xfe, 0x3b, 0x00, 0x50, 0xb1, 0xd9, 0x8d, 0x54, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -100,6 +426,132 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if len(this.Reporters) != len(that1.Reporters) {
+		return false
+	}
+	for i := range this.Reporters {
+		if !this.Reporters[i].Equal(&that1.Reporters[i]) {
+			return false
+		}
+	}
+	if this.SubmissionWindow != that1.SubmissionWindow {
+		return false
+	}
+	if this.MinReporters != that1.MinReporters {
+		return false
+	}
+	if this.HistoryRetention != that1.HistoryRetention {
+		return false
+	}
+	if this.DefaultMaxStaleness != that1.DefaultMaxStaleness {
+		return false
+	}
+	if len(this.MaxStaleness) != len(that1.MaxStaleness) {
+		return false
+	}
+	for i := range this.MaxStaleness {
+		if !this.MaxStaleness[i].Equal(&that1.MaxStaleness[i]) {
+			return false
+		}
+	}
+	if this.MaxDeviationBps != that1.MaxDeviationBps {
+		return false
+	}
+	if this.MaxWindowDeviationBps != that1.MaxWindowDeviationBps {
+		return false
+	}
+	if this.DeviationWindow != that1.DeviationWindow {
+		return false
+	}
+	if this.QueueDeviatingUpdates != that1.QueueDeviatingUpdates {
+		return false
+	}
+	if this.BondDenom != that1.BondDenom {
+		return false
+	}
+	if !this.MinBond.Equal(that1.MinBond) {
+		return false
+	}
+	if this.MaxMissedWindows != that1.MaxMissedWindows {
+		return false
+	}
+	if this.OutlierThresholdBps != that1.OutlierThresholdBps {
+		return false
+	}
+	if this.MaxOutliers != that1.MaxOutliers {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if len(this.Channels) != len(that1.Channels) {
+		return false
+	}
+	for i := range this.Channels {
+		if this.Channels[i] != that1.Channels[i] {
+			return false
+		}
+	}
+	if this.MaxSubscriptions != that1.MaxSubscriptions {
+		return false
+	}
+	return true
+}
+func (this *MaxStaleness) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MaxStaleness)
+	if !ok {
+		that2, ok := that.(MaxStaleness)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Seconds != that1.Seconds {
+		return false
+	}
+	return true
+}
+func (this *Reporter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Reporter)
+	if !ok {
+		that2, ok := that.(Reporter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Weight != that1.Weight {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,31 +574,340 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSubscriptions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSubscriptions))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Channels[iNdEx])
+			copy(dAtA[i:], m.Channels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Channels[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.JailDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JailDuration))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.MaxOutliers != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOutliers))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.OutlierThresholdBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OutlierThresholdBps))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxMissedWindows != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedWindows))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.MinBond.Size()
+		i -= size
+		if _, err := m.MinBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.QueueDeviatingUpdates {
+		i--
+		if m.QueueDeviatingUpdates {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DeviationWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DeviationWindow))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxWindowDeviationBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxWindowDeviationBps))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxDeviationBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDeviationBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MaxStaleness) > 0 {
+		for iNdEx := len(m.MaxStaleness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxStaleness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.DefaultMaxStaleness != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultMaxStaleness))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HistoryRetention))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinReporters != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinReporters))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SubmissionWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SubmissionWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reporters) > 0 {
+		for iNdEx := len(m.Reporters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reporters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MaxStaleness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MaxStaleness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxStaleness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Seconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Reporter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reporter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reporters) > 0 {
+		for _, e := range m.Reporters {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.SubmissionWindow != 0 {
+		n += 1 + sovParams(uint64(m.SubmissionWindow))
+	}
+	if m.MinReporters != 0 {
+		n += 1 + sovParams(uint64(m.MinReporters))
+	}
+	if m.HistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.HistoryRetention))
+	}
+	if m.DefaultMaxStaleness != 0 {
+		n += 1 + sovParams(uint64(m.DefaultMaxStaleness))
+	}
+	if len(m.MaxStaleness) > 0 {
+		for _, e := range m.MaxStaleness {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxDeviationBps != 0 {
+		n += 1 + sovParams(uint64(m.MaxDeviationBps))
+	}
+	if m.MaxWindowDeviationBps != 0 {
+		n += 1 + sovParams(uint64(m.MaxWindowDeviationBps))
+	}
+	if m.DeviationWindow != 0 {
+		n += 1 + sovParams(uint64(m.DeviationWindow))
+	}
+	if m.QueueDeviatingUpdates {
+		n += 2
+	}
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MinBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxMissedWindows != 0 {
+		n += 1 + sovParams(uint64(m.MaxMissedWindows))
+	}
+	if m.OutlierThresholdBps != 0 {
+		n += 1 + sovParams(uint64(m.OutlierThresholdBps))
+	}
+	if m.MaxOutliers != 0 {
+		n += 1 + sovParams(uint64(m.MaxOutliers))
+	}
+	l = m.SlashFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.JailDuration != 0 {
+		n += 2 + sovParams(uint64(m.JailDuration))
+	}
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxSubscriptions != 0 {
+		n += 2 + sovParams(uint64(m.MaxSubscriptions))
+	}
+	return n
+}
+
+func (m *MaxStaleness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Seconds != 0 {
+		n += 1 + sovParams(uint64(m.Seconds))
+	}
+	return n
+}
+
+func (m *Reporter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovParams(uint64(m.Weight))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozParams(x uint64) (n int) {
 	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -180,6 +941,656 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporters = append(m.Reporters, Reporter{})
+			if err := m.Reporters[len(m.Reporters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionWindow", wireType)
+			}
+			m.SubmissionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReporters", wireType)
+			}
+			m.MinReporters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinReporters |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+			}
+			m.HistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMaxStaleness", wireType)
+			}
+			m.DefaultMaxStaleness = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultMaxStaleness |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxStaleness = append(m.MaxStaleness, MaxStaleness{})
+			if err := m.MaxStaleness[len(m.MaxStaleness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviationBps", wireType)
+			}
+			m.MaxDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWindowDeviationBps", wireType)
+			}
+			m.MaxWindowDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWindowDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationWindow", wireType)
+			}
+			m.DeviationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueDeviatingUpdates", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueueDeviatingUpdates = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedWindows", wireType)
+			}
+			m.MaxMissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierThresholdBps", wireType)
+			}
+			m.OutlierThresholdBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutlierThresholdBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutliers", wireType)
+			}
+			m.MaxOutliers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutliers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			m.JailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubscriptions", wireType)
+			}
+			m.MaxSubscriptions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubscriptions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaxStaleness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxStaleness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxStaleness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+			}
+			m.Seconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reporter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reporter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reporter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// MaxDecimals is the maximum number of decimal places of a price rate.
+const MaxDecimals = 18
+
+// MaxPriceUpdates is the maximum number of updates of a MsgUpdatePrices.
+const MaxPriceUpdates = 500
+
+// ValidatePriceUnit validates the decimals and quote of a price of symbol.
+func ValidatePriceUnit(symbol string, decimals uint32, quote string) error {
+	if decimals > MaxDecimals {
+		return fmt.Errorf("decimals %d exceed the maximum of %d", decimals, MaxDecimals)
+	}
+	if quote != "" && quote == symbol {
+		return fmt.Errorf("%s cannot be quoted in itself", symbol)
+	}
+
+	return nil
+}
+
+// RatValue returns the rate of the price as a rational number of quote units.
+func (p Price) RatValue() *big.Rat {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(p.Decimals)), nil)
+	return new(big.Rat).SetFrac(new(big.Int).SetUint64(p.Rate), scale)
+}
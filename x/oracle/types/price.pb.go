@@ -5,16 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +35,15 @@ type Price struct {
 	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	Creator     string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// last_updated_height is the height of the last write of the rate.
+	LastUpdatedHeight int64 `protobuf:"varint,6,opt,name=last_updated_height,json=lastUpdatedHeight,proto3" json:"last_updated_height,omitempty"`
+	// last_updated_time is the block time of the last write of the rate.
+	LastUpdatedTime time.Time `protobuf:"bytes,7,opt,name=last_updated_time,json=lastUpdatedTime,proto3,stdtime" json:"last_updated_time"`
+	// decimals is the number of decimal places of rate: a rate of 500123 with
+	// 2 decimals is 5001.23 units of quote.
+	Decimals uint32 `protobuf:"varint,8,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// quote is the denom or symbol the rate is expressed in, e.g. USD or urlf.
+	Quote string `protobuf:"bytes,9,opt,name=quote,proto3" json:"quote,omitempty"`
 }
 
 func (m *Price) Reset()         { *m = Price{} }
@@ -99,6 +114,34 @@ func (m *Price) GetCreator() string {
 	return ""
 }
 
+func (m *Price) GetLastUpdatedHeight() int64 {
+	if m != nil {
+		return m.LastUpdatedHeight
+	}
+	return 0
+}
+
+func (m *Price) GetLastUpdatedTime() time.Time {
+	if m != nil {
+		return m.LastUpdatedTime
+	}
+	return time.Time{}
+}
+
+func (m *Price) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *Price) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Price)(nil), "realfin.oracle.v1.Price")
 }
@@ -106,20 +149,30 @@ func init() {
 func init() { proto.RegisterFile("realfin/oracle/v1/price.proto", fileDescriptor_83a8756414288883) }
 
 var fileDescriptor_83a8756414288883 = []byte{
-	// 203 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x4a, 0x4d, 0xcc,
-	0x49, 0xcb, 0xcc, 0xd3, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0x28,
-	0xca, 0x4c, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x4a, 0xeb, 0x41, 0xa4,
-	0xf5, 0xca, 0x0c, 0x95, 0x9a, 0x19, 0xb9, 0x58, 0x03, 0x40, 0x4a, 0x84, 0xc4, 0xb8, 0xd8, 0x8a,
-	0x2b, 0x73, 0x93, 0xf2, 0x73, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xa0, 0x3c, 0x21, 0x21,
-	0x2e, 0x96, 0xa2, 0xc4, 0x92, 0x54, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x30, 0x1b, 0x24,
-	0x96, 0x97, 0x98, 0x9b, 0x2a, 0xc1, 0x0c, 0x56, 0x09, 0x66, 0x0b, 0x29, 0x70, 0x71, 0xa7, 0xa4,
-	0x16, 0x27, 0x17, 0x65, 0x16, 0x94, 0x64, 0xe6, 0xe7, 0x49, 0xb0, 0x80, 0xa5, 0x90, 0x85, 0x84,
-	0x24, 0xb8, 0xd8, 0x93, 0x8b, 0x52, 0x13, 0x4b, 0xf2, 0x8b, 0x24, 0x58, 0xc1, 0xb2, 0x30, 0xae,
-	0x93, 0xc1, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x89, 0xc1, 0x7c, 0x54,
-	0x01, 0xf3, 0x53, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x47, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x7d, 0x9d, 0xc1, 0x91, 0xf2, 0x00, 0x00, 0x00,
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xbf, 0x6e, 0xf2, 0x30,
+	0x14, 0xc5, 0x63, 0xfe, 0x63, 0x84, 0x3e, 0xc5, 0x1f, 0x42, 0x56, 0xa4, 0x86, 0xa8, 0x53, 0xd4,
+	0x21, 0x2e, 0xed, 0x1b, 0x30, 0x75, 0xac, 0xa2, 0xb2, 0x74, 0x41, 0x26, 0x31, 0xc1, 0x52, 0x12,
+	0xa7, 0x8e, 0x41, 0xe5, 0x2d, 0x78, 0x8c, 0x8e, 0x7d, 0x80, 0x3e, 0x00, 0x23, 0x63, 0xa7, 0xb6,
+	0x82, 0xa1, 0xaf, 0x51, 0xc5, 0x21, 0x15, 0x5d, 0xa2, 0x73, 0xee, 0xef, 0x28, 0xbe, 0x47, 0x17,
+	0x5e, 0x48, 0x46, 0xe3, 0x05, 0x4f, 0x89, 0x90, 0x34, 0x88, 0x19, 0x59, 0x8f, 0x49, 0x26, 0x79,
+	0xc0, 0xbc, 0x4c, 0x0a, 0x25, 0x90, 0x79, 0xc2, 0x5e, 0x89, 0xbd, 0xf5, 0xd8, 0x32, 0x69, 0xc2,
+	0x53, 0x41, 0xf4, 0xb7, 0x4c, 0x59, 0x83, 0x48, 0x44, 0x42, 0x4b, 0x52, 0xa8, 0xd3, 0x74, 0x14,
+	0x09, 0x11, 0xc5, 0x8c, 0x68, 0x37, 0x5f, 0x2d, 0x88, 0xe2, 0x09, 0xcb, 0x15, 0x4d, 0xb2, 0x32,
+	0x70, 0xf9, 0x56, 0x83, 0xcd, 0xfb, 0xe2, 0x31, 0x34, 0x84, 0xad, 0x7c, 0x93, 0xcc, 0x45, 0x8c,
+	0x81, 0x03, 0xdc, 0xae, 0x7f, 0x72, 0x08, 0xc1, 0x86, 0xa4, 0x8a, 0xe1, 0x9a, 0x03, 0xdc, 0x86,
+	0xaf, 0x75, 0x31, 0x4b, 0x69, 0xc2, 0x70, 0x5d, 0x27, 0xb5, 0x46, 0x0e, 0xec, 0x85, 0x2c, 0x0f,
+	0x24, 0xcf, 0x14, 0x17, 0x29, 0x6e, 0x68, 0x74, 0x3e, 0x42, 0x18, 0xb6, 0x03, 0xc9, 0xa8, 0x12,
+	0x12, 0x37, 0x35, 0xad, 0x2c, 0xf2, 0xe0, 0xff, 0x98, 0xe6, 0x6a, 0xb6, 0xca, 0x42, 0xaa, 0x58,
+	0x38, 0x5b, 0x32, 0x1e, 0x2d, 0x15, 0x6e, 0x39, 0xc0, 0xad, 0xfb, 0x66, 0x81, 0xa6, 0x25, 0xb9,
+	0xd3, 0x00, 0x4d, 0xa1, 0xf9, 0x27, 0x5f, 0xb4, 0xc2, 0x6d, 0x07, 0xb8, 0xbd, 0x1b, 0xcb, 0x2b,
+	0x2b, 0x7b, 0x55, 0x65, 0xef, 0xa1, 0xaa, 0x3c, 0xe9, 0xef, 0x3e, 0x46, 0xc6, 0xf6, 0x73, 0x04,
+	0x5e, 0xbe, 0x5f, 0xaf, 0x80, 0xff, 0xef, 0xec, 0xc7, 0x45, 0x08, 0x59, 0xb0, 0x13, 0xb2, 0x80,
+	0x27, 0x34, 0xce, 0x71, 0xc7, 0x01, 0x6e, 0xdf, 0xff, 0xf5, 0x68, 0x00, 0x9b, 0x4f, 0x2b, 0xa1,
+	0x18, 0xee, 0xea, 0xd5, 0x4b, 0x33, 0xb9, 0xde, 0x1d, 0x6c, 0xb0, 0x3f, 0xd8, 0xe0, 0xeb, 0x60,
+	0x83, 0xed, 0xd1, 0x36, 0xf6, 0x47, 0xdb, 0x78, 0x3f, 0xda, 0xc6, 0xe3, 0xb0, 0x3a, 0xea, 0x73,
+	0x75, 0x56, 0xb5, 0xc9, 0x58, 0x3e, 0x6f, 0xe9, 0xbd, 0x6e, 0x7f, 0x06, 0x00, 0xee, 0xfe, 0xb6,
+	0xbd, 0xf5, 0x01, 0x00, 0x00,
 }
 
 func (m *Price) Marshal() (dAtA []byte, err error) {
@@ -142,6 +195,31 @@ func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintPrice(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintPrice(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastUpdatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdatedTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPrice(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.LastUpdatedHeight != 0 {
+		i = encodeVarintPrice(dAtA, i, uint64(m.LastUpdatedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -214,6 +292,18 @@ func (m *Price) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPrice(uint64(l))
 	}
+	if m.LastUpdatedHeight != 0 {
+		n += 1 + sovPrice(uint64(m.LastUpdatedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastUpdatedTime)
+	n += 1 + l + sovPrice(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovPrice(uint64(m.Decimals))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovPrice(uint64(l))
+	}
 	return n
 }
 
@@ -399,6 +489,109 @@ func (m *Price) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedHeight", wireType)
+			}
+			m.LastUpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastUpdatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPrice(dAtA[iNdEx:])
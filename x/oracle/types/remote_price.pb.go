@@ -0,0 +1,894 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/oracle/v1/remote_price.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RemotePrice defines a price received from another chain over IBC. Remote
+// prices are kept apart from the locally reported prices.
+type RemotePrice struct {
+	// channel_id is the oracle channel the price was received on.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Symbol    string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Rate      uint64 `protobuf:"varint,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Decimals  uint32 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Quote     string `protobuf:"bytes,5,opt,name=quote,proto3" json:"quote,omitempty"`
+	// source_height is the height of the last update on the source chain.
+	SourceHeight int64 `protobuf:"varint,6,opt,name=source_height,json=sourceHeight,proto3" json:"source_height,omitempty"`
+	// source_time is the time of the last update on the source chain.
+	SourceTime time.Time `protobuf:"bytes,7,opt,name=source_time,json=sourceTime,proto3,stdtime" json:"source_time"`
+	// received_height is the local height at which the price was received.
+	ReceivedHeight int64 `protobuf:"varint,8,opt,name=received_height,json=receivedHeight,proto3" json:"received_height,omitempty"`
+}
+
+func (m *RemotePrice) Reset()         { *m = RemotePrice{} }
+func (m *RemotePrice) String() string { return proto.CompactTextString(m) }
+func (*RemotePrice) ProtoMessage()    {}
+func (*RemotePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cd5ddba5579d04e, []int{0}
+}
+func (m *RemotePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemotePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemotePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemotePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemotePrice.Merge(m, src)
+}
+func (m *RemotePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemotePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemotePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemotePrice proto.InternalMessageInfo
+
+func (m *RemotePrice) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RemotePrice) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *RemotePrice) GetRate() uint64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *RemotePrice) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *RemotePrice) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *RemotePrice) GetSourceHeight() int64 {
+	if m != nil {
+		return m.SourceHeight
+	}
+	return 0
+}
+
+func (m *RemotePrice) GetSourceTime() time.Time {
+	if m != nil {
+		return m.SourceTime
+	}
+	return time.Time{}
+}
+
+func (m *RemotePrice) GetReceivedHeight() int64 {
+	if m != nil {
+		return m.ReceivedHeight
+	}
+	return 0
+}
+
+// Subscription defines a counterparty channel subscribed to the updates of a
+// local price.
+type Subscription struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Symbol    string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Height    int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cd5ddba5579d04e, []int{1}
+}
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *Subscription) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *Subscription) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RemotePrice)(nil), "realfin.oracle.v1.RemotePrice")
+	proto.RegisterType((*Subscription)(nil), "realfin.oracle.v1.Subscription")
+}
+
+func init() {
+	proto.RegisterFile("realfin/oracle/v1/remote_price.proto", fileDescriptor_3cd5ddba5579d04e)
+}
+
+var fileDescriptor_3cd5ddba5579d04e = []byte{
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xbd, 0x8e, 0x9b, 0x40,
+	0x14, 0x85, 0x19, 0xff, 0x10, 0x7b, 0x6c, 0x27, 0xf2, 0xc8, 0xb2, 0x10, 0x52, 0x30, 0x72, 0x22,
+	0x05, 0xa5, 0x80, 0x38, 0x79, 0x03, 0x57, 0x49, 0xaa, 0x88, 0xa4, 0x8a, 0x14, 0x59, 0xfc, 0x5c,
+	0xe3, 0x91, 0x80, 0x21, 0xc3, 0x60, 0xc5, 0x6f, 0xe1, 0x87, 0x48, 0x91, 0x32, 0x8f, 0xe1, 0xd2,
+	0xe5, 0x56, 0xbb, 0x2b, 0xbb, 0xd8, 0xd7, 0x58, 0x31, 0x03, 0xfb, 0x00, 0xdb, 0xa0, 0x7b, 0xce,
+	0x3d, 0xf0, 0xe9, 0xa0, 0x8b, 0xdf, 0x72, 0x08, 0xd2, 0x2d, 0xcd, 0x3d, 0xc6, 0x83, 0x28, 0x05,
+	0x6f, 0xbf, 0xf2, 0x38, 0x64, 0x4c, 0xc0, 0xa6, 0xe0, 0x34, 0x02, 0xb7, 0xe0, 0x4c, 0x30, 0x32,
+	0x6d, 0x52, 0xae, 0x4a, 0xb9, 0xfb, 0x95, 0x39, 0x0d, 0x32, 0x9a, 0x33, 0x4f, 0x3e, 0x55, 0xca,
+	0x9c, 0x25, 0x2c, 0x61, 0x72, 0xf4, 0xea, 0xa9, 0x71, 0x17, 0x09, 0x63, 0x49, 0x0a, 0x9e, 0x54,
+	0x61, 0xb5, 0xf5, 0x04, 0xcd, 0xa0, 0x14, 0x41, 0x56, 0xa8, 0xc0, 0xf2, 0x6f, 0x07, 0x8f, 0x7c,
+	0xc9, 0xfc, 0x56, 0x23, 0xc9, 0x6b, 0x8c, 0xa3, 0x5d, 0x90, 0xe7, 0x90, 0x6e, 0x68, 0x6c, 0x20,
+	0x1b, 0x39, 0x43, 0x7f, 0xd8, 0x38, 0x5f, 0x62, 0x32, 0xc7, 0x7a, 0x79, 0xc8, 0x42, 0x96, 0x1a,
+	0x1d, 0xb9, 0x6a, 0x14, 0x21, 0xb8, 0xc7, 0x03, 0x01, 0x46, 0xd7, 0x46, 0x4e, 0xcf, 0x97, 0x33,
+	0x31, 0xf1, 0x20, 0x86, 0x88, 0x66, 0x41, 0x5a, 0x1a, 0x3d, 0x1b, 0x39, 0x13, 0xff, 0x49, 0x93,
+	0x19, 0xee, 0xff, 0xae, 0x98, 0x00, 0xa3, 0x2f, 0x3f, 0xa3, 0x04, 0x79, 0x83, 0x27, 0x25, 0xab,
+	0x78, 0x04, 0x9b, 0x1d, 0xd0, 0x64, 0x27, 0x0c, 0xdd, 0x46, 0x4e, 0xd7, 0x1f, 0x2b, 0xf3, 0xb3,
+	0xf4, 0xc8, 0x57, 0x3c, 0x6a, 0x42, 0x75, 0x17, 0xe3, 0x85, 0x8d, 0x9c, 0xd1, 0x47, 0xd3, 0x55,
+	0x45, 0xdd, 0xb6, 0xa8, 0xfb, 0xa3, 0x2d, 0xba, 0x9e, 0x9c, 0x6e, 0x17, 0xda, 0xf1, 0x6e, 0x81,
+	0xfe, 0x3d, 0xfc, 0x7f, 0x8f, 0x7c, 0xac, 0xde, 0xae, 0xf7, 0xe4, 0x1d, 0x7e, 0xc5, 0x21, 0x02,
+	0xba, 0x87, 0xb8, 0x45, 0x0e, 0x24, 0xf2, 0x65, 0x6b, 0x2b, 0xe8, 0xf2, 0x17, 0x1e, 0x7f, 0xaf,
+	0xc2, 0x32, 0xe2, 0xb4, 0x10, 0x94, 0xe5, 0xcf, 0xfd, 0x4d, 0x73, 0xac, 0x37, 0x98, 0xae, 0xc4,
+	0x34, 0x6a, 0xfd, 0xe1, 0x74, 0xb1, 0xd0, 0xf9, 0x62, 0xa1, 0xfb, 0x8b, 0x85, 0x8e, 0x57, 0x4b,
+	0x3b, 0x5f, 0x2d, 0xed, 0xe6, 0x6a, 0x69, 0x3f, 0xe7, 0xed, 0x89, 0xfc, 0x69, 0x8f, 0x44, 0x1c,
+	0x0a, 0x28, 0x43, 0x5d, 0x16, 0xfd, 0xf4, 0x38, 0x00, 0xd9, 0xf2, 0xe5, 0x1e, 0x43, 0x02, 0x00,
+	0x00,
+}
+
+func (m *RemotePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemotePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemotePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceivedHeight != 0 {
+		i = encodeVarintRemotePrice(dAtA, i, uint64(m.ReceivedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SourceTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SourceTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRemotePrice(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.SourceHeight != 0 {
+		i = encodeVarintRemotePrice(dAtA, i, uint64(m.SourceHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintRemotePrice(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintRemotePrice(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Rate != 0 {
+		i = encodeVarintRemotePrice(dAtA, i, uint64(m.Rate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintRemotePrice(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRemotePrice(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintRemotePrice(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintRemotePrice(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRemotePrice(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRemotePrice(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRemotePrice(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RemotePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRemotePrice(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovRemotePrice(uint64(l))
+	}
+	if m.Rate != 0 {
+		n += 1 + sovRemotePrice(uint64(m.Rate))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovRemotePrice(uint64(m.Decimals))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovRemotePrice(uint64(l))
+	}
+	if m.SourceHeight != 0 {
+		n += 1 + sovRemotePrice(uint64(m.SourceHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SourceTime)
+	n += 1 + l + sovRemotePrice(uint64(l))
+	if m.ReceivedHeight != 0 {
+		n += 1 + sovRemotePrice(uint64(m.ReceivedHeight))
+	}
+	return n
+}
+
+func (m *Subscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRemotePrice(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovRemotePrice(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRemotePrice(uint64(m.Height))
+	}
+	return n
+}
+
+func sovRemotePrice(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRemotePrice(x uint64) (n int) {
+	return sovRemotePrice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RemotePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemotePrice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemotePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemotePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			m.Rate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHeight", wireType)
+			}
+			m.SourceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SourceTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedHeight", wireType)
+			}
+			m.ReceivedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemotePrice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Subscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemotePrice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemotePrice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRemotePrice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRemotePrice(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRemotePrice
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemotePrice
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRemotePrice
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRemotePrice
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRemotePrice
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRemotePrice        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRemotePrice          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRemotePrice = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,984 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/oracle/v1/reporter.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReporterInfo defines the bond and the performance counters of a reporter.
+type ReporterInfo struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// bond is the amount of bond_denom bonded by the reporter.
+	Bond cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=bond,proto3,customtype=cosmossdk.io/math.Int" json:"bond"`
+	// last_submission_height is the height of the last submission.
+	LastSubmissionHeight int64 `protobuf:"varint,3,opt,name=last_submission_height,json=lastSubmissionHeight,proto3" json:"last_submission_height,omitempty"`
+	// miss_counter is increased for every missed submission window and
+	// decreased for every window with a submission.
+	MissCounter uint64 `protobuf:"varint,4,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty"`
+	// outlier_counter is increased for every outlier submission and decreased
+	// for every submission close to the aggregate.
+	OutlierCounter uint64 `protobuf:"varint,5,opt,name=outlier_counter,json=outlierCounter,proto3" json:"outlier_counter,omitempty"`
+	// missed_windows is the total number of missed submission windows.
+	MissedWindows uint64 `protobuf:"varint,6,opt,name=missed_windows,json=missedWindows,proto3" json:"missed_windows,omitempty"`
+	// outliers is the total number of outlier submissions.
+	Outliers uint64 `protobuf:"varint,7,opt,name=outliers,proto3" json:"outliers,omitempty"`
+	// jailed_until is the time until which the reporter cannot submit prices.
+	JailedUntil time.Time `protobuf:"bytes,8,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+}
+
+func (m *ReporterInfo) Reset()         { *m = ReporterInfo{} }
+func (m *ReporterInfo) String() string { return proto.CompactTextString(m) }
+func (*ReporterInfo) ProtoMessage()    {}
+func (*ReporterInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_190d7b6f4c40da3d, []int{0}
+}
+func (m *ReporterInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReporterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReporterInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReporterInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReporterInfo.Merge(m, src)
+}
+func (m *ReporterInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReporterInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReporterInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReporterInfo proto.InternalMessageInfo
+
+func (m *ReporterInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ReporterInfo) GetLastSubmissionHeight() int64 {
+	if m != nil {
+		return m.LastSubmissionHeight
+	}
+	return 0
+}
+
+func (m *ReporterInfo) GetMissCounter() uint64 {
+	if m != nil {
+		return m.MissCounter
+	}
+	return 0
+}
+
+func (m *ReporterInfo) GetOutlierCounter() uint64 {
+	if m != nil {
+		return m.OutlierCounter
+	}
+	return 0
+}
+
+func (m *ReporterInfo) GetMissedWindows() uint64 {
+	if m != nil {
+		return m.MissedWindows
+	}
+	return 0
+}
+
+func (m *ReporterInfo) GetOutliers() uint64 {
+	if m != nil {
+		return m.Outliers
+	}
+	return 0
+}
+
+func (m *ReporterInfo) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+// ReporterSlash records a slash of a reporter.
+type ReporterSlash struct {
+	Reporter string    `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Height   int64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time     time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// amount is the amount of bond burnt.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// reason is either "missed_windows" or "outliers".
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ReporterSlash) Reset()         { *m = ReporterSlash{} }
+func (m *ReporterSlash) String() string { return proto.CompactTextString(m) }
+func (*ReporterSlash) ProtoMessage()    {}
+func (*ReporterSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_190d7b6f4c40da3d, []int{1}
+}
+func (m *ReporterSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReporterSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReporterSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReporterSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReporterSlash.Merge(m, src)
+}
+func (m *ReporterSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReporterSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReporterSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReporterSlash proto.InternalMessageInfo
+
+func (m *ReporterSlash) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *ReporterSlash) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReporterSlash) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ReporterSlash) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ReporterInfo)(nil), "realfin.oracle.v1.ReporterInfo")
+	proto.RegisterType((*ReporterSlash)(nil), "realfin.oracle.v1.ReporterSlash")
+}
+
+func init() { proto.RegisterFile("realfin/oracle/v1/reporter.proto", fileDescriptor_190d7b6f4c40da3d) }
+
+var fileDescriptor_190d7b6f4c40da3d = []byte{
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x4d, 0x48, 0xd3, 0x4b, 0x52, 0xd4, 0x53, 0x88, 0x4c, 0x06, 0x27, 0x54, 0x42,
+	0x44, 0x48, 0xb5, 0xdb, 0xd2, 0x95, 0x81, 0xc0, 0xd0, 0x48, 0x4c, 0x0e, 0x08, 0x89, 0xc5, 0xba,
+	0xc4, 0x17, 0xe7, 0xc0, 0xbe, 0x8b, 0xee, 0xce, 0x2d, 0x7c, 0x8b, 0xee, 0x7c, 0x01, 0x46, 0x86,
+	0x7e, 0x88, 0x8e, 0x55, 0x27, 0xc4, 0x50, 0x50, 0x32, 0xf0, 0x2d, 0x10, 0xba, 0x3f, 0xce, 0x8a,
+	0xe8, 0x62, 0xf9, 0x7d, 0xde, 0xdf, 0x63, 0xbf, 0x7a, 0x9f, 0x3b, 0x38, 0x10, 0x04, 0x67, 0x73,
+	0xca, 0x42, 0x2e, 0xf0, 0x2c, 0x23, 0xe1, 0xd9, 0x51, 0x28, 0xc8, 0x92, 0x0b, 0x45, 0x44, 0xb0,
+	0x14, 0x5c, 0x71, 0xb4, 0xe7, 0x88, 0xc0, 0x12, 0xc1, 0xd9, 0x51, 0x6f, 0x0f, 0xe7, 0x94, 0xf1,
+	0xd0, 0x3c, 0x2d, 0xd5, 0x7b, 0x38, 0xe3, 0x32, 0xe7, 0x32, 0x36, 0x55, 0x68, 0x0b, 0xd7, 0xea,
+	0xa4, 0x3c, 0xe5, 0x56, 0xd7, 0x6f, 0x4e, 0xed, 0xa7, 0x9c, 0xa7, 0x19, 0x09, 0x4d, 0x35, 0x2d,
+	0xe6, 0xa1, 0xa2, 0x39, 0x91, 0x0a, 0xe7, 0x4b, 0x0b, 0xec, 0x7f, 0xa9, 0xc2, 0x56, 0xe4, 0x46,
+	0x19, 0xb3, 0x39, 0x47, 0xc7, 0x70, 0x1b, 0x27, 0x89, 0x20, 0x52, 0x7a, 0x60, 0x00, 0x86, 0x3b,
+	0x23, 0xef, 0xe6, 0xf2, 0xa0, 0xe3, 0x7e, 0xf5, 0xc2, 0x76, 0x26, 0x4a, 0x50, 0x96, 0x46, 0x25,
+	0x88, 0x5e, 0xc1, 0xda, 0x94, 0xb3, 0xc4, 0xdb, 0x32, 0x86, 0xc3, 0xab, 0xdb, 0x7e, 0xe5, 0xc7,
+	0x6d, 0xff, 0x81, 0x35, 0xc9, 0xe4, 0x63, 0x40, 0x79, 0x98, 0x63, 0xb5, 0x08, 0xc6, 0x4c, 0xdd,
+	0x5c, 0x1e, 0x40, 0xf7, 0xb5, 0x31, 0x53, 0x5f, 0x7f, 0x7f, 0x7b, 0x0a, 0x22, 0xe3, 0x46, 0x27,
+	0xb0, 0x9b, 0x61, 0xa9, 0x62, 0x59, 0x4c, 0x73, 0x2a, 0x25, 0xe5, 0x2c, 0x5e, 0x10, 0x9a, 0x2e,
+	0x94, 0x57, 0x1d, 0x80, 0x61, 0x35, 0xea, 0xe8, 0xee, 0x64, 0xd3, 0x3c, 0x35, 0x3d, 0xf4, 0x08,
+	0xb6, 0xb4, 0x10, 0xcf, 0x78, 0xc1, 0x14, 0x11, 0x5e, 0x6d, 0x00, 0x86, 0xb5, 0xa8, 0xa9, 0xb5,
+	0x97, 0x56, 0x42, 0x4f, 0xe0, 0x7d, 0x5e, 0xa8, 0x8c, 0x12, 0xb1, 0xa1, 0xee, 0x19, 0x6a, 0xd7,
+	0xc9, 0x25, 0xf8, 0x18, 0xee, 0x6a, 0x1f, 0x49, 0xe2, 0x73, 0xca, 0x12, 0x7e, 0x2e, 0xbd, 0xba,
+	0xe1, 0xda, 0x56, 0x7d, 0x67, 0x45, 0xd4, 0x83, 0x0d, 0x67, 0x94, 0xde, 0xb6, 0x01, 0x36, 0x35,
+	0x7a, 0x0d, 0x5b, 0x1f, 0x30, 0xcd, 0x48, 0x12, 0x17, 0x4c, 0xd1, 0xcc, 0x6b, 0x0c, 0xc0, 0xb0,
+	0x79, 0xdc, 0x0b, 0x6c, 0x0e, 0x41, 0x99, 0x43, 0xf0, 0xa6, 0xcc, 0x61, 0xd4, 0xd6, 0xeb, 0xba,
+	0xf8, 0xd9, 0x07, 0x76, 0x17, 0x4d, 0x6b, 0x7f, 0xab, 0xdd, 0xfb, 0x7f, 0x00, 0x6c, 0x97, 0xe9,
+	0x4c, 0x32, 0x2c, 0x17, 0xe8, 0x04, 0x36, 0xca, 0x93, 0xf3, 0xcf, 0x7c, 0x36, 0x24, 0xea, 0xc2,
+	0xba, 0x5b, 0xe5, 0x96, 0x59, 0xa5, 0xab, 0xd0, 0x73, 0x58, 0xd3, 0x07, 0xc2, 0xab, 0xfe, 0xef,
+	0x94, 0xc6, 0x86, 0x4e, 0x61, 0x1d, 0xe7, 0x7a, 0x77, 0x5e, 0xed, 0x8e, 0xc9, 0x3b, 0xbf, 0x1e,
+	0x50, 0x10, 0x2c, 0x39, 0x33, 0xc9, 0xec, 0x44, 0xae, 0x1a, 0x1d, 0x5e, 0xad, 0x7c, 0x70, 0xbd,
+	0xf2, 0xc1, 0xaf, 0x95, 0x0f, 0x2e, 0xd6, 0x7e, 0xe5, 0x7a, 0xed, 0x57, 0xbe, 0xaf, 0xfd, 0xca,
+	0xfb, 0x6e, 0x79, 0xa5, 0x3e, 0x95, 0x97, 0x4a, 0x7d, 0x5e, 0x12, 0x39, 0xad, 0x9b, 0xe1, 0x9f,
+	0xfd, 0x1d, 0x00, 0xec, 0xc2, 0x6c, 0x72, 0x73, 0x03, 0x00, 0x00,
+}
+
+func (m *ReporterInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReporterInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReporterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintReporter(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.Outliers != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.Outliers))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MissedWindows != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.MissedWindows))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OutlierCounter != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.OutlierCounter))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissCounter != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastSubmissionHeight != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.LastSubmissionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Bond.Size()
+		i -= size
+		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReporter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintReporter(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReporterSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReporterSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReporterSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintReporter(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReporter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintReporter(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintReporter(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReporter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReporter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReporterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovReporter(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovReporter(uint64(l))
+	if m.LastSubmissionHeight != 0 {
+		n += 1 + sovReporter(uint64(m.LastSubmissionHeight))
+	}
+	if m.MissCounter != 0 {
+		n += 1 + sovReporter(uint64(m.MissCounter))
+	}
+	if m.OutlierCounter != 0 {
+		n += 1 + sovReporter(uint64(m.OutlierCounter))
+	}
+	if m.MissedWindows != 0 {
+		n += 1 + sovReporter(uint64(m.MissedWindows))
+	}
+	if m.Outliers != 0 {
+		n += 1 + sovReporter(uint64(m.Outliers))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovReporter(uint64(l))
+	return n
+}
+
+func (m *ReporterSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovReporter(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovReporter(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovReporter(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovReporter(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovReporter(uint64(l))
+	}
+	return n
+}
+
+func sovReporter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReporter(x uint64) (n int) {
+	return sovReporter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReporterInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReporterInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReporterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSubmissionHeight", wireType)
+			}
+			m.LastSubmissionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSubmissionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierCounter", wireType)
+			}
+			m.OutlierCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutlierCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedWindows", wireType)
+			}
+			m.MissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outliers", wireType)
+			}
+			m.Outliers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outliers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReporterSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReporterSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReporterSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReporter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReporter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReporter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReporter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReporter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReporter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReporter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReporter = fmt.Errorf("proto: unexpected end of group")
+)
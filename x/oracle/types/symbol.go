@@ -0,0 +1,38 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// MaxSymbolLength is the maximum length of a registered symbol.
+const MaxSymbolLength = 32
+
+// canonicalSymbol matches the canonical form of symbols: upper case letters
+// and digits, optionally split into segments by a single '-', '.' or '/'.
+var canonicalSymbol = regexp.MustCompile(`^[A-Z0-9]+([-./][A-Z0-9]+)*$`)
+
+// ValidateSymbol returns an error if the symbol is not in canonical form.
+func ValidateSymbol(symbol string) error {
+	if len(symbol) > MaxSymbolLength {
+		return fmt.Errorf("symbol %q exceeds the maximum length of %d", symbol, MaxSymbolLength)
+	}
+	if !canonicalSymbol.MatchString(symbol) {
+		return fmt.Errorf("symbol %q is not canonical: expected upper case letters and digits separated by '-', '.' or '/'", symbol)
+	}
+
+	return nil
+}
+
+// Validate validates the symbol and its metadata.
+func (s SymbolInfo) Validate() error {
+	if err := ValidateSymbol(s.Symbol); err != nil {
+		return err
+	}
+	if s.Base == "" {
+		return errors.New("base cannot be empty")
+	}
+
+	return ValidatePriceUnit(s.Symbol, s.Decimals, s.Quote)
+}
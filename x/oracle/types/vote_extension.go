@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+)
+
+// MaxVotePrices is the maximum number of prices a single vote extension may carry.
+const MaxVotePrices = 256
+
+// Validate performs a stateless validation of a vote extension.
+func (ve OracleVoteExtension) Validate() error {
+	if len(ve.Prices) > MaxVotePrices {
+		return fmt.Errorf("vote extension carries %d prices, maximum is %d", len(ve.Prices), MaxVotePrices)
+	}
+
+	seen := make(map[string]struct{}, len(ve.Prices))
+	for _, price := range ve.Prices {
+		if err := ValidateSymbol(price.Symbol); err != nil {
+			return fmt.Errorf("invalid vote extension price symbol: %w", err)
+		}
+		if price.Rate == 0 {
+			return fmt.Errorf("vote extension price for %s must be positive", price.Symbol)
+		}
+		if _, ok := seen[price.Symbol]; ok {
+			return fmt.Errorf("duplicated vote extension price for %s", price.Symbol)
+		}
+		seen[price.Symbol] = struct{}{}
+	}
+
+	return nil
+}
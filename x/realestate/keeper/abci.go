@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker refreshes the regional indices and the indexed values of the
+// properties in the block starting an epoch of the index epoch identifier,
+// and expires the valuations whose validity ended in the block starting an
+// epoch of the expiry epoch identifier, or in every block without one.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	started, err := k.epochStarted(ctx, params.IndexEpochIdentifier)
+	if err != nil {
+		return err
+	} else if started {
+		if err := k.RefreshIndices(ctx); err != nil {
+			return err
+		}
+	}
+
+	if params.ExpiryEpochIdentifier != "" {
+		started, err := k.epochStarted(ctx, params.ExpiryEpochIdentifier)
+		if err != nil || !started {
+			return err
+		}
+	}
+
+	return k.ExpireRates(ctx)
+}
+
+// epochStarted reports whether an epoch of the x/epochs identifier started in
+// the current block. It is false for an empty or unknown identifier.
+func (k Keeper) epochStarted(ctx context.Context, identifier string) (bool, error) {
+	if identifier == "" {
+		return false, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	info, err := k.epochsKeeper.GetEpochInfo(sdkCtx, identifier)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return info.EpochCountingStarted && info.CurrentEpochStartHeight == sdkCtx.BlockHeight(), nil
+}
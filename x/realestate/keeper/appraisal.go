@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/realestate/types"
+)
+
+// IsAccredited reports whether the address is an appraiser accredited by
+// governance and not revoked.
+func (k Keeper) IsAccredited(ctx context.Context, address string) (bool, error) {
+	appraiser, err := k.Appraiser.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return !appraiser.Revoked, nil
+}
+
+// OpenCycle returns the valuation cycle of the property open to appraisals,
+// the one following the cycle of its rate.
+func (k Keeper) OpenCycle(ctx context.Context, propertyID uint64) (uint64, error) {
+	rate, err := k.Rate.Get(ctx, propertyID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 1, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	return rate.Cycle + 1, nil
+}
+
+// CycleAppraisals returns the appraisals of the property in the cycle.
+func (k Keeper) CycleAppraisals(ctx context.Context, propertyID, cycle uint64) ([]types.Appraisal, error) {
+	var appraisals []types.Appraisal
+	err := k.Appraisal.Walk(ctx, collections.NewSuperPrefixedTripleRange[uint64, uint64, uint64](propertyID, cycle), func(_ collections.Triple[uint64, uint64, uint64], appraisal types.Appraisal) (bool, error) {
+		appraisals = append(appraisals, appraisal)
+		return false, nil
+	})
+
+	return appraisals, err
+}
+
+// HasAppraisals reports whether the property has at least one appraisal.
+func (k Keeper) HasAppraisals(ctx context.Context, propertyID uint64) (bool, error) {
+	iter, err := k.Appraisal.Iterate(ctx, collections.NewPrefixedTripleRange[uint64, uint64, uint64](propertyID))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
+
+// finalizeCycle values the property at the trimmed mean of the appraisals of
+// the cycle once the appraisals of accredited appraisers reach the quorum,
+// flags the appraisals deviating from the valuation as outliers and opens the
+// next cycle. The appraisals of revoked appraisers are left out. It reports
+// whether the cycle was finalised.
+func (k Keeper) finalizeCycle(ctx context.Context, propertyID, cycle uint64) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	appraisals, err := k.CycleAppraisals(ctx, propertyID, cycle)
+	if err != nil {
+		return false, err
+	}
+	var (
+		counted []types.Appraisal
+		values  []uint64
+	)
+	for _, appraisal := range appraisals {
+		accredited, err := k.IsAccredited(ctx, appraisal.Appraiser)
+		if err != nil {
+			return false, err
+		} else if !accredited {
+			continue
+		}
+		counted = append(counted, appraisal)
+		values = append(values, appraisal.Value)
+	}
+	if len(counted) < int(params.Quorum()) {
+		return false, nil
+	}
+
+	valuation := types.TrimmedMean(values, params.AppraisalTrim)
+	var outliers []uint64
+	for _, appraisal := range counted {
+		if !types.IsOutlier(appraisal.Value, valuation, params.OutlierDeviation) {
+			continue
+		}
+		appraisal.Outlier = true
+		if err := k.Appraisal.Set(ctx, collections.Join3(propertyID, cycle, appraisal.Id), appraisal); err != nil {
+			return false, err
+		}
+		outliers = append(outliers, appraisal.Id)
+	}
+
+	moduleAddr, err := k.ModuleAddress()
+	if err != nil {
+		return false, err
+	}
+	// the name and description of the previous rate are kept
+	rate, err := k.Rate.Get(ctx, propertyID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rate.PropertyId = propertyID
+	rate.Rate = valuation
+	rate.Creator = moduleAddr
+	rate.Cycle = cycle
+	rate.Appraisals = uint32(len(counted))
+	rate.FinalizedAt = sdkCtx.BlockTime()
+	if err := k.rebaseRate(ctx, &rate); err != nil {
+		return false, err
+	}
+	if err := k.renewValidity(ctx, params, &rate); err != nil {
+		return false, err
+	}
+	if err := k.Rate.Set(ctx, propertyID, rate); err != nil {
+		return false, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventValuationFinalized{
+		PropertyId: propertyID,
+		Cycle:      cycle,
+		Value:      valuation,
+		Appraisals: rate.Appraisals,
+		Outliers:   outliers,
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
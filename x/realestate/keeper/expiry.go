@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/realestate/types"
+)
+
+// renewValidity restarts the validity of the rate, finalised in the current
+// block, for the validity period of the class of its property and schedules
+// its expiry. The rate never expires when the period is zero.
+func (k Keeper) renewValidity(ctx context.Context, params types.Params, rate *types.Rate) error {
+	if err := k.unscheduleExpiry(ctx, *rate); err != nil {
+		return err
+	}
+	property, err := k.Property.Get(ctx, rate.PropertyId)
+	if err != nil {
+		return err
+	}
+
+	rate.Expired = false
+	rate.ValidUntil = time.Time{}
+	if period := params.ValidityPeriodOf(property.PropertyClass); period > 0 {
+		rate.ValidUntil = sdk.UnwrapSDKContext(ctx).BlockTime().Add(period)
+	}
+
+	return k.scheduleExpiry(ctx, *rate)
+}
+
+// scheduleExpiry schedules the expiry of the rate, if it expires and has not
+// expired yet.
+func (k Keeper) scheduleExpiry(ctx context.Context, rate types.Rate) error {
+	if rate.ValidUntil.IsZero() || rate.Expired {
+		return nil
+	}
+
+	return k.RateExpiry.Set(ctx, collections.Join(rate.ValidUntil, rate.PropertyId))
+}
+
+// unscheduleExpiry removes the scheduled expiry of the rate, if any.
+func (k Keeper) unscheduleExpiry(ctx context.Context, rate types.Rate) error {
+	if rate.ValidUntil.IsZero() || rate.Expired {
+		return nil
+	}
+
+	return k.RateExpiry.Remove(ctx, collections.Join(rate.ValidUntil, rate.PropertyId))
+}
+
+// ExpireRates flags as expired the rates whose validity ended by the current
+// block time and emits a valuation_expired event for each of them.
+func (k Keeper) ExpireRates(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(sdkCtx.BlockTime(), uint64(math.MaxUint64)))
+
+	// the schedule is collected first as it is modified while expiring
+	var due []collections.Pair[time.Time, uint64]
+	if err := k.RateExpiry.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		due = append(due, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if err := k.RateExpiry.Remove(ctx, key); err != nil {
+			return err
+		}
+		rate, err := k.Rate.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		rate.Expired = true
+		if err := k.Rate.Set(ctx, rate.PropertyId, rate); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValuationExpired,
+				sdk.NewAttribute(types.AttributeKeyPropertyID, strconv.FormatUint(rate.PropertyId, 10)),
+				sdk.NewAttribute(types.AttributeKeyCycle, strconv.FormatUint(rate.Cycle, 10)),
+				sdk.NewAttribute(types.AttributeKeyValidUntil, rate.ValidUntil.Format(time.RFC3339)),
+			),
+		)
+	}
+
+	return nil
+}
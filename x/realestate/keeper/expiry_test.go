@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/realestate/keeper"
+	"realfin/x/realestate/types"
+)
+
+func TestExpireRates(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	appraisedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(appraisedAt)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	// a single appraisal finalises a cycle, and the residential valuations
+	// are valid for ten days
+	const day = 24 * 60 * 60
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.NewParams(1, 0, math.LegacyZeroDec(), "", 30*day,
+		[]types.ValidityPeriod{{PropertyClass: types.PropertyClass_PROPERTY_CLASS_RESIDENTIAL, Seconds: 10 * day}}, "hour")))
+	appraisers := registerAppraisers(t, f, 1)
+	id := createProperty(t, f, owner, "APN-001")
+
+	appraise := func() {
+		t.Helper()
+		_, err := srv.SubmitAppraisal(f.ctx, &types.MsgSubmitAppraisal{
+			Appraiser:    appraisers[0],
+			PropertyId:   id,
+			Value:        1_000_000,
+			Methodology:  types.Methodology_METHODOLOGY_COMPARABLES,
+			DocumentHash: documentHash,
+		})
+		require.NoError(t, err)
+	}
+	appraise()
+
+	validUntil := appraisedAt.Add(10 * 24 * time.Hour)
+	rate, err := f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, validUntil, rate.ValidUntil)
+	require.False(t, rate.Expired)
+
+	// the valuation is due for re-appraisal within a window reaching its end
+	due, err := qs.ListReappraisalDue(f.ctx, &types.QueryAllReappraisalDueRequest{Window: 10*day - 1})
+	require.NoError(t, err)
+	require.Empty(t, due.Rates)
+	due, err = qs.ListReappraisalDue(f.ctx, &types.QueryAllReappraisalDueRequest{Window: 10 * day})
+	require.NoError(t, err)
+	require.Len(t, due.Rates, 1)
+	require.Equal(t, id, due.Rates[0].PropertyId)
+
+	// the valuation expires at the start of the next expiry epoch
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(validUntil).WithBlockHeight(sdk.UnwrapSDKContext(f.ctx).BlockHeight() + 1)
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	rate, err = f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.False(t, rate.Expired)
+
+	startEpoch(t, f, "hour")
+	rate, err = f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.True(t, rate.Expired)
+	has, err := f.keeper.RateExpiry.Has(f.ctx, collections.Join(validUntil, id))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	var expired []sdk.Event
+	for _, event := range sdk.UnwrapSDKContext(f.ctx).EventManager().Events() {
+		if event.Type == types.EventTypeValuationExpired {
+			expired = append(expired, event)
+		}
+	}
+	require.Len(t, expired, 1)
+	require.Equal(t, sdk.NewEvent(types.EventTypeValuationExpired,
+		sdk.NewAttribute(types.AttributeKeyPropertyID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyCycle, "1"),
+		sdk.NewAttribute(types.AttributeKeyValidUntil, "2026-01-11T00:00:00Z"),
+	), expired[0])
+
+	// an expired valuation stays due until it is re-appraised
+	due, err = qs.ListReappraisalDue(f.ctx, &types.QueryAllReappraisalDueRequest{})
+	require.NoError(t, err)
+	require.Len(t, due.Rates, 1)
+
+	appraise()
+	rate, err = f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.False(t, rate.Expired)
+	require.Equal(t, validUntil.Add(10*24*time.Hour), rate.ValidUntil)
+	due, err = qs.ListReappraisalDue(f.ctx, &types.QueryAllReappraisalDueRequest{})
+	require.NoError(t, err)
+	require.Empty(t, due.Rates)
+
+	// deleting the valuation cancels its expiry
+	_, err = srv.DeleteRate(f.ctx, &types.MsgDeleteRate{Creator: rate.Creator, PropertyId: id})
+	require.NoError(t, err)
+	has, err = f.keeper.RateExpiry.Has(f.ctx, collections.Join(rate.ValidUntil, id))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestExpireRatesEveryBlock(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	appraisedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(appraisedAt)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	// without an expiry epoch the valuations expire in the first block past
+	// their validity
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.NewParams(1, 0, math.LegacyZeroDec(), "", 60, nil, "")))
+	appraisers := registerAppraisers(t, f, 1)
+	id := createProperty(t, f, owner, "APN-001")
+	_, err = srv.SubmitAppraisal(f.ctx, &types.MsgSubmitAppraisal{
+		Appraiser:    appraisers[0],
+		PropertyId:   id,
+		Value:        1_000_000,
+		Methodology:  types.Methodology_METHODOLOGY_COMPARABLES,
+		DocumentHash: documentHash,
+	})
+	require.NoError(t, err)
+
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(appraisedAt.Add(59 * time.Second))
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	rate, err := f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.False(t, rate.Expired)
+
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(appraisedAt.Add(time.Minute))
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	rate, err = f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.True(t, rate.Expired)
+}
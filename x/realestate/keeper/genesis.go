@@ -3,13 +3,65 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	"realfin/x/realestate/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, elem := range genState.Properties {
+		if err := k.Property.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.PropertySeq.Set(ctx, genState.PropertySeq); err != nil {
+		return err
+	}
+	for _, elem := range genState.Appraisers {
+		if err := k.Appraiser.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.Appraisals {
+		if err := k.Appraisal.Set(ctx, collections.Join3(elem.PropertyId, elem.Cycle, elem.Id), elem); err != nil {
+			return err
+		}
+	}
+	if err := k.AppraisalSeq.Set(ctx, genState.AppraisalSeq); err != nil {
+		return err
+	}
+	for _, elem := range genState.RegionalIndices {
+		if err := k.RegionalIndex.Set(ctx, elem.Jurisdiction, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.PropertyManagers {
+		if err := k.PropertyManager.Set(ctx, elem.PropertyId, elem.Manager); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.Leases {
+		if err := k.Lease.Set(ctx, collections.Join(elem.PropertyId, elem.Id), elem); err != nil {
+			return err
+		}
+	}
+	if err := k.LeaseSeq.Set(ctx, genState.LeaseSeq); err != nil {
+		return err
+	}
+	for _, elem := range genState.CashFlows {
+		if err := k.CashFlow.Set(ctx, collections.Join(elem.PropertyId, elem.Id), elem); err != nil {
+			return err
+		}
+	}
+	if err := k.CashFlowSeq.Set(ctx, genState.CashFlowSeq); err != nil {
+		return err
+	}
 	for _, elem := range genState.RateMap {
-		if err := k.Rate.Set(ctx, elem.Symbol, elem); err != nil {
+		if err := k.Rate.Set(ctx, elem.PropertyId, elem); err != nil {
+			return err
+		}
+		if err := k.scheduleExpiry(ctx, elem); err != nil {
 			return err
 		}
 	}
@@ -26,12 +78,70 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
-	if err := k.Rate.Walk(ctx, nil, func(_ string, val types.Rate) (stop bool, err error) {
+	if err := k.Rate.Walk(ctx, nil, func(_ uint64, val types.Rate) (stop bool, err error) {
 		genesis.RateMap = append(genesis.RateMap, val)
 		return false, nil
 	}); err != nil {
 		return nil, err
 	}
+	if err := k.Property.Walk(ctx, nil, func(_ uint64, val types.Property) (stop bool, err error) {
+		genesis.Properties = append(genesis.Properties, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.PropertySeq, err = k.PropertySeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Appraiser.Walk(ctx, nil, func(_ string, val types.Appraiser) (stop bool, err error) {
+		genesis.Appraisers = append(genesis.Appraisers, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Appraisal.Walk(ctx, nil, func(_ collections.Triple[uint64, uint64, uint64], val types.Appraisal) (stop bool, err error) {
+		genesis.Appraisals = append(genesis.Appraisals, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.AppraisalSeq, err = k.AppraisalSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.RegionalIndex.Walk(ctx, nil, func(_ string, val types.RegionalIndex) (stop bool, err error) {
+		genesis.RegionalIndices = append(genesis.RegionalIndices, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.PropertyManager.Walk(ctx, nil, func(propertyID uint64, manager string) (stop bool, err error) {
+		genesis.PropertyManagers = append(genesis.PropertyManagers, types.PropertyManager{PropertyId: propertyID, Manager: manager})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Lease.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], val types.Lease) (stop bool, err error) {
+		genesis.Leases = append(genesis.Leases, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.LeaseSeq, err = k.LeaseSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.CashFlow.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], val types.CashFlow) (stop bool, err error) {
+		genesis.CashFlows = append(genesis.CashFlows, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.CashFlowSeq, err = k.CashFlowSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	return genesis, nil
 }
@@ -2,6 +2,9 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
+
+	"cosmossdk.io/math"
 
 	"realfin/x/realestate/types"
 
@@ -9,9 +12,35 @@ import (
 )
 
 func TestGenesis(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
 	genesisState := types.GenesisState{
-		Params:  types.DefaultParams(),
-		RateMap: []types.Rate{{Symbol: "0"}, {Symbol: "1"}}}
+		Params: types.DefaultParams(),
+		Properties: []types.Property{
+			{Id: 0, ParcelId: "0", Jurisdiction: "US-CA", PropertyClass: types.PropertyClass_PROPERTY_CLASS_RESIDENTIAL},
+			{Id: 1, ParcelId: "1", Jurisdiction: "US-NY", PropertyClass: types.PropertyClass_PROPERTY_CLASS_COMMERCIAL},
+		},
+		PropertySeq: 2,
+		RateMap:     []types.Rate{{PropertyId: 0}, {PropertyId: 1}},
+		Appraisers:  []types.Appraiser{{Address: "appraiser-0"}, {Address: "appraiser-1", Revoked: true}},
+		Appraisals: []types.Appraisal{
+			{Id: 0, PropertyId: 0, Cycle: 1, Appraiser: "appraiser-0", Value: 100},
+			{Id: 1, PropertyId: 1, Cycle: 1, Appraiser: "appraiser-1", Value: 200, Outlier: true},
+		},
+		AppraisalSeq: 2,
+		RegionalIndices: []types.RegionalIndex{
+			{Jurisdiction: "US-CA", Symbol: "HPI-CA", Level: math.LegacyNewDec(300)},
+		},
+		PropertyManagers: []types.PropertyManager{{PropertyId: 1, Manager: "manager-1"}},
+		Leases: []types.Lease{
+			{Id: 0, PropertyId: 1, Start: start, End: start.AddDate(5, 0, 0), Rent: 120_000, Escalation: math.LegacyNewDecWithPrec(3, 2)},
+		},
+		LeaseSeq: 1,
+		CashFlows: []types.CashFlow{
+			{Id: 0, PropertyId: 1, Kind: types.CashFlowKind_CASH_FLOW_KIND_RENT, LeaseId: 0, Amount: 10_000, PeriodStart: start, PeriodEnd: start.AddDate(0, 1, 0)},
+			{Id: 1, PropertyId: 1, Kind: types.CashFlowKind_CASH_FLOW_KIND_EXPENSE, Amount: 2_000, PeriodStart: start, PeriodEnd: start.AddDate(0, 1, 0)},
+		},
+		CashFlowSeq: 2,
+	}
 
 	f := initFixture(t)
 	err := f.keeper.InitGenesis(f.ctx, genesisState)
@@ -22,5 +51,16 @@ func TestGenesis(t *testing.T) {
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
 	require.EqualExportedValues(t, genesisState.RateMap, got.RateMap)
+	require.EqualExportedValues(t, genesisState.Properties, got.Properties)
+	require.Equal(t, genesisState.PropertySeq, got.PropertySeq)
+	require.EqualExportedValues(t, genesisState.Appraisers, got.Appraisers)
+	require.EqualExportedValues(t, genesisState.Appraisals, got.Appraisals)
+	require.Equal(t, genesisState.AppraisalSeq, got.AppraisalSeq)
+	require.EqualExportedValues(t, genesisState.RegionalIndices, got.RegionalIndices)
+	require.EqualExportedValues(t, genesisState.PropertyManagers, got.PropertyManagers)
+	require.EqualExportedValues(t, genesisState.Leases, got.Leases)
+	require.Equal(t, genesisState.LeaseSeq, got.LeaseSeq)
+	require.EqualExportedValues(t, genesisState.CashFlows, got.CashFlows)
+	require.Equal(t, genesisState.CashFlowSeq, got.CashFlowSeq)
 
 }
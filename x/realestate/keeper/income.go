@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	stdmath "math"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"realfin/x/realestate/types"
+)
+
+// year is the period the net operating income is annualised over.
+const year = 365 * 24 * time.Hour
+
+// CanManage reports whether the address manages the property: whether it is
+// its owner or the manager appointed by the owner.
+func (k Keeper) CanManage(ctx context.Context, property types.Property, address string) (bool, error) {
+	if address == property.Creator {
+		return true, nil
+	}
+
+	manager, err := k.PropertyManager.Get(ctx, property.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return address == manager, nil
+}
+
+// HasIncomeRecord reports whether the property has at least one lease or cash
+// flow.
+func (k Keeper) HasIncomeRecord(ctx context.Context, propertyID uint64) (bool, error) {
+	leases, err := k.Lease.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](propertyID))
+	if err != nil {
+		return false, err
+	}
+	defer leases.Close()
+	if leases.Valid() {
+		return true, nil
+	}
+
+	cashFlows, err := k.CashFlow.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](propertyID))
+	if err != nil {
+		return false, err
+	}
+	defer cashFlows.Close()
+
+	return cashFlows.Valid(), nil
+}
+
+// Income returns the income metrics of the property at the current block
+// time: its occupancy and contract rent from its active leases, its net
+// operating income from the cash flows of the periods ending in the window
+// of seconds before the block time, and its capitalisation rate, the net
+// operating income annualised over the window divided by its valuation. A
+// zero window counts the cash flows of DefaultIncomeWindow.
+func (k Keeper) Income(ctx context.Context, property types.Property, window uint64) (types.QueryGetIncomeResponse, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if window == 0 {
+		window = types.DefaultIncomeWindow
+	}
+	income := types.QueryGetIncomeResponse{
+		PropertyId: property.Id,
+		Window:     window,
+		Occupancy:  math.LegacyZeroDec(),
+		CapRate:    math.LegacyZeroDec(),
+	}
+
+	manager, err := k.PropertyManager.Get(ctx, property.Id)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return income, err
+	}
+	income.Manager = manager
+
+	var (
+		leasedArea    uint64
+		wholeProperty bool
+		contractRent  = math.ZeroInt()
+	)
+	if err := k.Lease.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](property.Id), func(_ collections.Pair[uint64, uint64], lease types.Lease) (bool, error) {
+		if !lease.IsActive(now) {
+			return false, nil
+		}
+		income.ActiveLeases++
+		contractRent = contractRent.Add(math.NewIntFromUint64(lease.RentAt(now)))
+		if lease.Area == 0 {
+			wholeProperty = true
+		}
+		leasedArea += min(lease.Area, stdmath.MaxUint64-leasedArea)
+		return false, nil
+	}); err != nil {
+		return income, err
+	}
+	income.Vacant = income.ActiveLeases == 0
+	income.ContractRent = stdmath.MaxUint64
+	if contractRent.IsUint64() {
+		income.ContractRent = contractRent.Uint64()
+	}
+	// the occupancy of a property of unknown floor area is all or nothing
+	switch {
+	case income.Vacant:
+	case wholeProperty, property.FloorArea == 0, leasedArea >= property.FloorArea:
+		income.Occupancy = math.LegacyOneDec()
+	default:
+		income.Occupancy = math.LegacyNewDecFromInt(math.NewIntFromUint64(leasedArea)).QuoInt(math.NewIntFromUint64(property.FloorArea))
+	}
+
+	seconds := time.Duration(min(window, uint64(stdmath.MaxInt64/int64(time.Second)))) * time.Second
+	since := now.Add(-seconds)
+	gross, expenses := math.ZeroInt(), math.ZeroInt()
+	if err := k.CashFlow.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](property.Id), func(_ collections.Pair[uint64, uint64], cashFlow types.CashFlow) (bool, error) {
+		if !cashFlow.PeriodEnd.After(since) || cashFlow.PeriodEnd.After(now) {
+			return false, nil
+		}
+		switch cashFlow.Kind {
+		case types.CashFlowKind_CASH_FLOW_KIND_RENT:
+			gross = gross.Add(math.NewIntFromUint64(cashFlow.Amount))
+		case types.CashFlowKind_CASH_FLOW_KIND_EXPENSE:
+			expenses = expenses.Add(math.NewIntFromUint64(cashFlow.Amount))
+		}
+		return false, nil
+	}); err != nil {
+		return income, err
+	}
+	income.GrossIncome, income.OperatingExpenses = stdmath.MaxUint64, stdmath.MaxUint64
+	if gross.IsUint64() {
+		income.GrossIncome = gross.Uint64()
+	}
+	if expenses.IsUint64() {
+		income.OperatingExpenses = expenses.Uint64()
+	}
+	income.NetOperatingIncome = gross.Sub(expenses)
+
+	rate, err := k.Rate.Get(ctx, property.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return income, nil
+	} else if err != nil {
+		return income, err
+	}
+	income.Valuation = rate.IndexedValue
+	if rate.IndexedAt.IsZero() {
+		// a rate never indexed is valued at its appraisal
+		income.Valuation = rate.Rate
+	}
+	if income.Valuation > 0 {
+		income.CapRate = math.LegacyNewDecFromInt(income.NetOperatingIncome).
+			MulInt64(int64(year / time.Second)).
+			QuoInt64(int64(seconds / time.Second)).
+			QuoInt(math.NewIntFromUint64(income.Valuation))
+	}
+
+	return income, nil
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	oracletypes "realfin/x/oracle/types"
+	"realfin/x/realestate/types"
+)
+
+// RefreshIndices refreshes the level of every regional index from the fresh
+// price of its oracle symbol and marks to model the rates of the properties
+// of its jurisdiction. An index whose price is missing or stale keeps its
+// level, and the indexed values of its jurisdiction are left as they are.
+func (k Keeper) RefreshIndices(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var indices []types.RegionalIndex
+	if err := k.RegionalIndex.Walk(ctx, nil, func(_ string, index types.RegionalIndex) (bool, error) {
+		indices = append(indices, index)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, index := range indices {
+		price, err := k.oracleKeeper.GetFreshPrice(ctx, index.Symbol)
+		if errors.Is(err, sdkerrors.ErrKeyNotFound) || errors.Is(err, oracletypes.ErrStalePrice) {
+			sdkCtx.Logger().Info("regional index not refreshed", "jurisdiction", index.Jurisdiction, "symbol", index.Symbol, "err", err)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		index.Level = math.LegacyNewDecFromBigIntWithPrec(new(big.Int).SetUint64(price.Rate), int64(price.Decimals))
+		index.UpdatedAt = sdkCtx.BlockTime()
+		if err := k.RegionalIndex.Set(ctx, index.Jurisdiction, index); err != nil {
+			return err
+		}
+
+		properties, err := k.markJurisdiction(ctx, index)
+		if err != nil {
+			return err
+		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventRegionalIndexRefreshed{
+			Jurisdiction: index.Jurisdiction,
+			Symbol:       index.Symbol,
+			Level:        index.Level,
+			Properties:   properties,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// markJurisdiction marks to model the rates of the properties of the
+// jurisdiction of the index and returns their number.
+func (k Keeper) markJurisdiction(ctx context.Context, index types.RegionalIndex) (uint64, error) {
+	iter, err := k.Property.Indexes.Jurisdiction.MatchExact(ctx, index.Jurisdiction)
+	if err != nil {
+		return 0, err
+	}
+	ids, err := iter.PrimaryKeys()
+	if err != nil {
+		return 0, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	var marked uint64
+	for _, id := range ids {
+		rate, err := k.Rate.Get(ctx, id)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+
+		markToModel(&rate, index, now)
+		if err := k.Rate.Set(ctx, id, rate); err != nil {
+			return 0, err
+		}
+		marked++
+	}
+
+	return marked, nil
+}
+
+// rebaseRate restarts the indexed value of the rate from its appraised value,
+// based at the current level of the regional index of the jurisdiction of the
+// property. The rate is left unindexed when the jurisdiction has no index.
+func (k Keeper) rebaseRate(ctx context.Context, rate *types.Rate) error {
+	property, err := k.Property.Get(ctx, rate.PropertyId)
+	if err != nil {
+		return err
+	}
+	index, err := k.RegionalIndex.Get(ctx, property.Jurisdiction)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	rebase(rate, index, sdk.UnwrapSDKContext(ctx).BlockTime())
+	return nil
+}
+
+// markToModel scales the appraised value of the rate by the change of the
+// index since the base of the rate. A rate that is not based on a level of the
+// index, because the index had no level yet at the appraisal, was mapped to
+// another symbol since or the property moved to another jurisdiction, is
+// rebased at the current level instead.
+func markToModel(rate *types.Rate, index types.RegionalIndex, now time.Time) {
+	if rate.IndexSymbol != index.Symbol || rate.IndexBase.IsNil() || !rate.IndexBase.IsPositive() {
+		rebase(rate, index, now)
+		return
+	}
+
+	rate.IndexedValue = types.IndexedValue(rate.Rate, rate.IndexBase, index.Level)
+	rate.IndexedAt = now
+}
+
+// rebase sets the indexed value of the rate to its appraised value, based at
+// the level of the index.
+func rebase(rate *types.Rate, index types.RegionalIndex, now time.Time) {
+	rate.IndexSymbol = index.Symbol
+	rate.IndexBase = math.LegacyZeroDec()
+	if !index.Level.IsNil() {
+		rate.IndexBase = index.Level
+	}
+	rate.IndexedValue = rate.Rate
+	rate.IndexedAt = now
+}
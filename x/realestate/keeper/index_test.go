@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	oracletypes "realfin/x/oracle/types"
+	"realfin/x/realestate/keeper"
+	"realfin/x/realestate/types"
+)
+
+func TestRefreshIndices(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	appraisedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(appraisedAt)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	// a single appraisal finalises a cycle
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.NewParams(1, 0, math.LegacyZeroDec(), "day", 0, nil, "")))
+	appraisers := registerAppraisers(t, f, 1)
+	id := createProperty(t, f, owner, "APN-001")
+	createProperty(t, f, owner, "APN-002")
+
+	_, err = srv.SetRegionalIndex(f.ctx, &types.MsgSetRegionalIndex{Authority: authority, Jurisdiction: "US-CA", Symbol: "HPI-CA"})
+	require.NoError(t, err)
+	f.oracleKeeper.prices["HPI-CA"] = oracletypes.Price{Symbol: "HPI-CA", Rate: 30_000, Decimals: 2}
+	startEpoch(t, f, "day")
+
+	index, err := f.keeper.RegionalIndex.Get(f.ctx, "US-CA")
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(300), index.Level)
+	require.Equal(t, appraisedAt, index.UpdatedAt)
+
+	// the valuation is based at the level of the index at the appraisal
+	_, err = srv.SubmitAppraisal(f.ctx, &types.MsgSubmitAppraisal{
+		Appraiser:    appraisers[0],
+		PropertyId:   id,
+		Value:        1_000_000,
+		Methodology:  types.Methodology_METHODOLOGY_COMPARABLES,
+		DocumentHash: documentHash,
+	})
+	require.NoError(t, err)
+	rate, err := f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "HPI-CA", rate.IndexSymbol)
+	require.Equal(t, math.LegacyNewDec(300), rate.IndexBase)
+	require.Equal(t, uint64(1_000_000), rate.IndexedValue)
+
+	// the index rises by 10%, only the end of the index epoch refreshes it
+	indexedAt := appraisedAt.Add(24 * time.Hour)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(indexedAt)
+	f.oracleKeeper.prices["HPI-CA"] = oracletypes.Price{Symbol: "HPI-CA", Rate: 33_000, Decimals: 2}
+	startEpoch(t, f, "week")
+	rate, err = f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_000_000), rate.IndexedValue)
+
+	startEpoch(t, f, "day")
+	valuation, err := qs.GetValuation(f.ctx, &types.QueryGetValuationRequest{PropertyId: id})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryGetValuationResponse{
+		PropertyId:     id,
+		AppraisedValue: 1_000_000,
+		AppraisedAt:    appraisedAt,
+		IndexedValue:   1_100_000,
+		IndexedAt:      indexedAt,
+		IndexSymbol:    "HPI-CA",
+		IndexBase:      math.LegacyNewDec(300),
+		IndexLevel:     math.LegacyNewDec(330),
+	}, valuation)
+
+	// a stale price leaves the index and the indexed values as they are
+	f.oracleKeeper.prices["HPI-CA"] = oracletypes.Price{Symbol: "HPI-CA", Rate: 36_000, Decimals: 2}
+	f.oracleKeeper.stale["HPI-CA"] = true
+	startEpoch(t, f, "day")
+	rate, err = f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_100_000), rate.IndexedValue)
+
+	// mapping the jurisdiction to another symbol rebases the valuation at the
+	// appraised value
+	_, err = srv.SetRegionalIndex(f.ctx, &types.MsgSetRegionalIndex{Authority: authority, Jurisdiction: "US-CA", Symbol: "CSI-SF"})
+	require.NoError(t, err)
+	f.oracleKeeper.prices["CSI-SF"] = oracletypes.Price{Symbol: "CSI-SF", Rate: 150}
+	startEpoch(t, f, "day")
+	rate, err = f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "CSI-SF", rate.IndexSymbol)
+	require.Equal(t, math.LegacyNewDec(150), rate.IndexBase)
+	require.Equal(t, uint64(1_000_000), rate.IndexedValue)
+
+	// the refresh waits for the start of an index epoch, and an empty index
+	// epoch disables it
+	f.oracleKeeper.prices["CSI-SF"] = oracletypes.Price{Symbol: "CSI-SF", Rate: 300}
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(sdk.UnwrapSDKContext(f.ctx).BlockHeight() + 1)
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+	rate, err = f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_000_000), rate.IndexedValue)
+
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.NewParams(1, 0, math.LegacyZeroDec(), "", 0, nil, "")))
+	startEpoch(t, f, "")
+	rate, err = f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_000_000), rate.IndexedValue)
+}
@@ -2,15 +2,61 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"realfin/x/realestate/types"
 )
 
+// PropertyIndexes are the indexes of the properties, keyed by id.
+type PropertyIndexes struct {
+	// Jurisdiction indexes the properties by jurisdiction.
+	Jurisdiction *indexes.Multi[string, uint64, types.Property]
+	// Class indexes the properties by class.
+	Class *indexes.Multi[int32, uint64, types.Property]
+	// Parcel indexes the properties by jurisdiction and parcel id, which are
+	// unique.
+	Parcel *indexes.Unique[collections.Pair[string, string], uint64, types.Property]
+}
+
+// IndexesList implements collections.Indexes.
+func (i PropertyIndexes) IndexesList() []collections.Index[uint64, types.Property] {
+	return []collections.Index[uint64, types.Property]{i.Jurisdiction, i.Class, i.Parcel}
+}
+
+func newPropertyIndexes(sb *collections.SchemaBuilder) PropertyIndexes {
+	return PropertyIndexes{
+		Jurisdiction: indexes.NewMulti(
+			sb, types.PropertyJurisdictionIndexKey, "property_by_jurisdiction",
+			collections.StringKey, collections.Uint64Key,
+			func(_ uint64, property types.Property) (string, error) {
+				return property.Jurisdiction, nil
+			},
+		),
+		Class: indexes.NewMulti(
+			sb, types.PropertyClassIndexKey, "property_by_class",
+			collections.Int32Key, collections.Uint64Key,
+			func(_ uint64, property types.Property) (int32, error) {
+				return int32(property.PropertyClass), nil
+			},
+		),
+		Parcel: indexes.NewUnique(
+			sb, types.PropertyParcelIndexKey, "property_by_parcel",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Key,
+			func(_ uint64, property types.Property) (collections.Pair[string, string], error) {
+				return collections.Join(property.Jurisdiction, property.ParcelId), nil
+			},
+		),
+	}
+}
+
 type Keeper struct {
 	storeService corestore.KVStoreService
 	cdc          codec.Codec
@@ -19,9 +65,29 @@ type Keeper struct {
 	// Typically, this should be the x/gov module account.
 	authority []byte
 
+	oracleKeeper types.OracleKeeper
+	epochsKeeper types.EpochsKeeper
+
 	Schema collections.Schema
 	Params collections.Item[types.Params]
-	Rate   collections.Map[string, types.Rate]
+	Rate   collections.Map[uint64, types.Rate]
+	// RateExpiry schedules the unexpired rates by the end of their validity.
+	RateExpiry collections.KeySet[collections.Pair[time.Time, uint64]]
+
+	Property    *collections.IndexedMap[uint64, types.Property, PropertyIndexes]
+	PropertySeq collections.Sequence
+
+	Appraiser    collections.Map[string, types.Appraiser]
+	Appraisal    collections.Map[collections.Triple[uint64, uint64, uint64], types.Appraisal]
+	AppraisalSeq collections.Sequence
+
+	RegionalIndex collections.Map[string, types.RegionalIndex]
+
+	PropertyManager collections.Map[uint64, string]
+	Lease           collections.Map[collections.Pair[uint64, uint64], types.Lease]
+	LeaseSeq        collections.Sequence
+	CashFlow        collections.Map[collections.Pair[uint64, uint64], types.CashFlow]
+	CashFlowSeq     collections.Sequence
 }
 
 func NewKeeper(
@@ -29,7 +95,8 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
-
+	oracleKeeper types.OracleKeeper,
+	epochsKeeper types.EpochsKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -43,8 +110,36 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 
+		oracleKeeper: oracleKeeper,
+		epochsKeeper: epochsKeeper,
+
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		Rate:   collections.NewMap(sb, types.RateKey, "rate", collections.StringKey, codec.CollValue[types.Rate](cdc))}
+		Rate:   collections.NewMap(sb, types.RateKey, "rate", collections.Uint64Key, codec.CollValue[types.Rate](cdc)),
+		RateExpiry: collections.NewKeySet(sb, types.RateExpiryKey, "rate_expiry",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+
+		Property: collections.NewIndexedMap(
+			sb, types.PropertyKey, "property",
+			collections.Uint64Key, codec.CollValue[types.Property](cdc),
+			newPropertyIndexes(sb),
+		),
+		PropertySeq: collections.NewSequence(sb, types.PropertySeqKey, "property_seq"),
+
+		Appraiser: collections.NewMap(sb, types.AppraiserKey, "appraiser", collections.StringKey, codec.CollValue[types.Appraiser](cdc)),
+		Appraisal: collections.NewMap(sb, types.AppraisalKey, "appraisal",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.Appraisal](cdc)),
+		AppraisalSeq: collections.NewSequence(sb, types.AppraisalSeqKey, "appraisal_seq"),
+
+		RegionalIndex: collections.NewMap(sb, types.RegionalIndexKey, "regional_index", collections.StringKey, codec.CollValue[types.RegionalIndex](cdc)),
+
+		PropertyManager: collections.NewMap(sb, types.PropertyManagerKey, "property_manager", collections.Uint64Key, collections.StringValue),
+		Lease: collections.NewMap(sb, types.LeaseKey, "lease",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.Lease](cdc)),
+		LeaseSeq: collections.NewSequence(sb, types.LeaseSeqKey, "lease_seq"),
+		CashFlow: collections.NewMap(sb, types.CashFlowKey, "cash_flow",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.CashFlow](cdc)),
+		CashFlowSeq: collections.NewSequence(sb, types.CashFlowSeqKey, "cash_flow_seq"),
+	}
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -59,3 +154,9 @@ func NewKeeper(
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
+
+// ModuleAddress returns the address of the module, the creator of the rates
+// finalised from appraisals.
+func (k Keeper) ModuleAddress() (string, error) {
+	return k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+}
@@ -4,15 +4,18 @@ import (
 	"context"
 	"testing"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
-	storetypes "cosmossdk.io/store/types"
-	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
-	"github.com/cosmos/cosmos-sdk/runtime"
-	"github.com/cosmos/cosmos-sdk/testutil"
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	"github.com/stretchr/testify/require"
 
+	keepertest "realfin/testutil/keeper"
+	oracletypes "realfin/x/oracle/types"
 	"realfin/x/realestate/keeper"
 	module "realfin/x/realestate/module"
 	"realfin/x/realestate/types"
@@ -22,35 +25,87 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	storeService corestore.KVStoreService
+	oracleKeeper *mockOracleKeeper
+	epochsKeeper *mockEpochsKeeper
 }
 
-func initFixture(t *testing.T) *fixture {
+// mockEpochsKeeper keeps the epoch infos in memory.
+type mockEpochsKeeper struct {
+	epochs map[string]epochstypes.EpochInfo
+}
+
+func (e *mockEpochsKeeper) GetEpochInfo(_ sdk.Context, identifier string) (epochstypes.EpochInfo, error) {
+	info, ok := e.epochs[identifier]
+	if !ok {
+		return epochstypes.EpochInfo{}, collections.ErrNotFound
+	}
+	return info, nil
+}
+
+// startEpoch starts an epoch of the identifier in the next block and runs the
+// end blocker of the module.
+func startEpoch(t *testing.T, f *fixture, identifier string) {
 	t.Helper()
 
-	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
-	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
-	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+	f.ctx = sdkCtx.WithBlockHeight(sdkCtx.BlockHeight() + 1)
+
+	info := f.epochsKeeper.epochs[identifier]
+	info.Identifier = identifier
+	info.EpochCountingStarted = true
+	info.CurrentEpoch++
+	info.CurrentEpochStartHeight = sdk.UnwrapSDKContext(f.ctx).BlockHeight()
+	f.epochsKeeper.epochs[identifier] = info
+
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+}
 
-	storeService := runtime.NewKVStoreService(storeKey)
-	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+// mockOracleKeeper keeps the prices in memory, failing on the stale ones.
+type mockOracleKeeper struct {
+	prices map[string]oracletypes.Price
+	stale  map[string]bool
+}
+
+func (o *mockOracleKeeper) GetFreshPrice(_ context.Context, symbol string) (oracletypes.Price, error) {
+	price, ok := o.prices[symbol]
+	if !ok {
+		return oracletypes.Price{}, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "price %s not found", symbol)
+	}
+	if o.stale[symbol] {
+		return oracletypes.Price{}, errorsmod.Wrap(oracletypes.ErrStalePrice, symbol)
+	}
+	return price, nil
+}
+
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
 
+	env := keepertest.NewEnv(t, types.StoreKey, module.AppModule{})
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	oracleKeeper := &mockOracleKeeper{prices: make(map[string]oracletypes.Price), stale: make(map[string]bool)}
+	epochsKeeper := &mockEpochsKeeper{epochs: make(map[string]epochstypes.EpochInfo)}
 
 	k := keeper.NewKeeper(
-		storeService,
-		encCfg.Codec,
-		addressCodec,
+		env.StoreService,
+		env.Codec,
+		env.AddressCodec,
 		authority,
+		oracleKeeper,
+		epochsKeeper,
 	)
 
 	// Initialize params
-	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
+	if err := k.Params.Set(env.Ctx, types.DefaultParams()); err != nil {
 		t.Fatalf("failed to set params: %v", err)
 	}
 
 	return &fixture{
-		ctx:          ctx,
+		ctx:          env.Ctx,
 		keeper:       k,
-		addressCodec: addressCodec,
+		addressCodec: env.AddressCodec,
+		storeService: env.StoreService,
+		oracleKeeper: oracleKeeper,
+		epochsKeeper: epochsKeeper,
 	}
 }
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "realfin/x/realestate/migrations/v2"
+	"realfin/x/realestate/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, linking the rates to properties.
+// Each symbol keyed rate gets a property of the rate creator whose parcel id
+// is the symbol, in the unknown jurisdiction and unclassified, for the creator
+// to complete with UpdateProperty. The rate is valid from the upgrade for the
+// validity period of the class of its property, and its expiry is scheduled.
+// The params introduced since version 1, which had none, are set to their
+// defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	return v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc, func(ctx context.Context, symbol string, rate types.Rate) error {
+		id, err := m.keeper.PropertySeq.Next(ctx)
+		if err != nil {
+			return err
+		}
+
+		property := types.Property{
+			Id:           id,
+			ParcelId:     symbol,
+			Jurisdiction: types.UnknownJurisdiction,
+			Creator:      rate.Creator,
+		}
+		if err := m.keeper.Property.Set(ctx, id, property); err != nil {
+			return err
+		}
+
+		rate.PropertyId = id
+		if err := m.keeper.renewValidity(ctx, params, &rate); err != nil {
+			return err
+		}
+		return m.keeper.Rate.Set(ctx, id, rate)
+	})
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/realestate/keeper"
+	v2 "realfin/x/realestate/migrations/v2"
+	"realfin/x/realestate/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	// the params of version 1 have no fields
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{}))
+
+	// rates of version 1 are keyed by symbol, which is no longer a field of
+	// the rate
+	store := runtime.KVStoreAdapter(f.storeService.OpenKVStore(f.ctx))
+	legacy := map[string]types.Rate{
+		"PROP-SF-101": {Rate: 2_500_000, Name: "123 Main St", Creator: creator, IndexBase: math.LegacyZeroDec()},
+		"PROP-NY-202": {Rate: 4_100_000, Creator: creator, IndexBase: math.LegacyZeroDec()},
+	}
+	for symbol, rate := range legacy {
+		key, err := collections.EncodeKeyWithPrefix(v2.RateKey, collections.StringKey, symbol)
+		require.NoError(t, err)
+		bz, err := rate.Marshal()
+		require.NoError(t, err)
+		store.Set(key, bz)
+	}
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+	validUntil := now.Add(types.DefaultParams().ValidityPeriodOf(types.PropertyClass_PROPERTY_CLASS_UNSPECIFIED))
+	require.True(t, validUntil.After(now))
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+	require.NoError(t, params.Validate())
+
+	for symbol, rate := range legacy {
+		id, err := f.keeper.Property.Indexes.Parcel.MatchExact(f.ctx, collections.Join(types.UnknownJurisdiction, symbol))
+		require.NoError(t, err)
+
+		property, err := f.keeper.Property.Get(f.ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, creator, property.Creator)
+		require.Equal(t, types.PropertyClass_PROPERTY_CLASS_UNSPECIFIED, property.PropertyClass)
+		require.NoError(t, property.Validate())
+
+		got, err := f.keeper.Rate.Get(ctx, id)
+		require.NoError(t, err)
+		rate.PropertyId = id
+		rate.ValidUntil = validUntil
+		require.Equal(t, rate, got)
+
+		// the valuation expires like any other
+		scheduled, err := f.keeper.RateExpiry.Has(ctx, collections.Join(validUntil, id))
+		require.NoError(t, err)
+		require.True(t, scheduled)
+
+		key, err := collections.EncodeKeyWithPrefix(v2.RateKey, collections.StringKey, symbol)
+		require.NoError(t, err)
+		require.False(t, store.Has(key))
+	}
+
+	// no key of version 1 remains
+	iter := storetypes.KVStorePrefixIterator(store, v2.RateKey)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	seq, err := f.keeper.PropertySeq.Peek(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), seq)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"realfin/x/realestate/types"
+)
+
+func (k msgServer) SubmitAppraisal(ctx context.Context, msg *types.MsgSubmitAppraisal) (*types.MsgSubmitAppraisalResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Appraiser); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid appraiser address: %s", err))
+	}
+
+	accredited, err := k.IsAccredited(ctx, msg.Appraiser)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if !accredited {
+		return nil, errorsmod.Wrap(types.ErrNotAccredited, msg.Appraiser)
+	}
+
+	property, err := k.Property.Get(ctx, msg.PropertyId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "property not found")
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	// Appraisals are independent from the owner of the property
+	if msg.Appraiser == property.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "appraiser cannot appraise its own property")
+	}
+
+	cycle, err := k.OpenCycle(ctx, msg.PropertyId)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	appraisals, err := k.CycleAppraisals(ctx, msg.PropertyId, cycle)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	for _, appraisal := range appraisals {
+		if appraisal.Appraiser == msg.Appraiser {
+			return nil, errorsmod.Wrapf(types.ErrAlreadyAppraised, "property %d in cycle %d", msg.PropertyId, cycle)
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	appraisal := types.Appraisal{
+		PropertyId:   msg.PropertyId,
+		Cycle:        cycle,
+		Appraiser:    msg.Appraiser,
+		Value:        msg.Value,
+		Methodology:  msg.Methodology,
+		DocumentHash: msg.DocumentHash,
+		SubmittedAt:  sdkCtx.BlockTime(),
+	}
+	if err := appraisal.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAppraisal, err.Error())
+	}
+
+	appraisal.Id, err = k.AppraisalSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.Appraisal.Set(ctx, collections.Join3(appraisal.PropertyId, appraisal.Cycle, appraisal.Id), appraisal); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventAppraisalSubmitted{
+		Id:          appraisal.Id,
+		PropertyId:  appraisal.PropertyId,
+		Cycle:       appraisal.Cycle,
+		Appraiser:   appraisal.Appraiser,
+		Value:       appraisal.Value,
+		Methodology: appraisal.Methodology,
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	finalized, err := k.finalizeCycle(ctx, appraisal.PropertyId, cycle)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgSubmitAppraisalResponse{Id: appraisal.Id, Cycle: cycle, Finalized: finalized}, nil
+}
@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/realestate/keeper"
+	"realfin/x/realestate/types"
+)
+
+const documentHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// registerAppraisers accredits n appraisers and returns their addresses.
+func registerAppraisers(t *testing.T, f *fixture, n int) []string {
+	t.Helper()
+
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	appraisers := make([]string, n)
+	for i := range appraisers {
+		appraisers[i], err = f.addressCodec.BytesToString([]byte(fmt.Sprintf("appraiserAddr%015d", i)))
+		require.NoError(t, err)
+		_, err = srv.RegisterAppraiser(f.ctx, &types.MsgRegisterAppraiser{Authority: authority, Appraiser: types.Appraiser{Address: appraisers[i]}})
+		require.NoError(t, err)
+	}
+
+	return appraisers
+}
+
+func TestMsgSubmitAppraisal(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	outsider, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
+	require.NoError(t, err)
+
+	// quorum of 4 appraisals, trimming the lowest and the highest, outliers
+	// beyond 20%
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.NewParams(4, 1, math.LegacyNewDecWithPrec(20, 2), types.DefaultIndexEpochIdentifier,
+		types.DefaultValidityPeriod, nil, types.DefaultExpiryEpochIdentifier)))
+	appraisers := registerAppraisers(t, f, 5)
+	id := createProperty(t, f, owner, "APN-001")
+
+	appraisal := func(appraiser string, value uint64) *types.MsgSubmitAppraisal {
+		return &types.MsgSubmitAppraisal{
+			Appraiser:    appraiser,
+			PropertyId:   id,
+			Value:        value,
+			Methodology:  types.Methodology_METHODOLOGY_COMPARABLES,
+			DocumentHash: documentHash,
+		}
+	}
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgSubmitAppraisal
+		err  error
+	}{
+		{
+			desc: "invalid address",
+			msg:  appraisal("invalid", 1_000_000),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc: "not accredited",
+			msg:  appraisal(outsider, 1_000_000),
+			err:  types.ErrNotAccredited,
+		},
+		{
+			desc: "unknown property",
+			msg:  &types.MsgSubmitAppraisal{Appraiser: appraisers[0], PropertyId: 100000, Value: 1, Methodology: types.Methodology_METHODOLOGY_COST, DocumentHash: documentHash},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "zero value",
+			msg:  appraisal(appraisers[0], 0),
+			err:  types.ErrInvalidAppraisal,
+		},
+		{
+			desc: "no methodology",
+			msg:  &types.MsgSubmitAppraisal{Appraiser: appraisers[0], PropertyId: id, Value: 1, DocumentHash: documentHash},
+			err:  types.ErrInvalidAppraisal,
+		},
+		{
+			desc: "no document hash",
+			msg:  &types.MsgSubmitAppraisal{Appraiser: appraisers[0], PropertyId: id, Value: 1, Methodology: types.Methodology_METHODOLOGY_INCOME},
+			err:  types.ErrInvalidAppraisal,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SubmitAppraisal(f.ctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	// the owner of the property cannot appraise it, even when accredited
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = srv.RegisterAppraiser(f.ctx, &types.MsgRegisterAppraiser{Authority: authority, Appraiser: types.Appraiser{Address: owner}})
+	require.NoError(t, err)
+	_, err = srv.SubmitAppraisal(f.ctx, appraisal(owner, 1_000_000))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the appraisals of a revoked appraiser do not count
+	_, err = srv.SubmitAppraisal(f.ctx, appraisal(appraisers[4], 9_000_000))
+	require.NoError(t, err)
+	_, err = srv.RevokeAppraiser(f.ctx, &types.MsgRevokeAppraiser{Authority: authority, Address: appraisers[4]})
+	require.NoError(t, err)
+
+	values := []uint64{1_000_000, 1_100_000, 1_200_000, 2_000_000}
+	for i, value := range values {
+		resp, err := srv.SubmitAppraisal(f.ctx, appraisal(appraisers[i], value))
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), resp.Cycle)
+		require.Equal(t, i == len(values)-1, resp.Finalized)
+
+		if i == 0 {
+			_, err = srv.SubmitAppraisal(f.ctx, appraisal(appraisers[0], value))
+			require.ErrorIs(t, err, types.ErrAlreadyAppraised)
+		}
+	}
+
+	// the trimmed mean leaves out 1,000,000 and 2,000,000
+	moduleAddr, err := f.keeper.ModuleAddress()
+	require.NoError(t, err)
+	rate, err := f.keeper.Rate.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_150_000), rate.Rate)
+	require.Equal(t, uint64(1), rate.Cycle)
+	require.Equal(t, uint32(4), rate.Appraisals)
+	require.Equal(t, moduleAddr, rate.Creator)
+	require.Equal(t, sdk.UnwrapSDKContext(f.ctx).BlockTime(), rate.FinalizedAt)
+
+	// 2,000,000 deviates by more than 20%, the revoked appraisal is not
+	// flagged
+	appraisals, err := f.keeper.CycleAppraisals(f.ctx, id, 1)
+	require.NoError(t, err)
+	require.Len(t, appraisals, 5)
+	for _, appraisal := range appraisals {
+		require.Equal(t, appraisal.Value == 2_000_000, appraisal.Outlier, appraisal.Value)
+	}
+
+	// the next cycle is open
+	cycle, err := f.keeper.OpenCycle(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), cycle)
+	resp, err := srv.SubmitAppraisal(f.ctx, appraisal(appraisers[0], 1_300_000))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Cycle)
+	require.False(t, resp.Finalized)
+
+	// appraisals are keyed by cycle, so that each cycle is read on its own
+	appraisals, err = f.keeper.CycleAppraisals(f.ctx, id, 2)
+	require.NoError(t, err)
+	require.Len(t, appraisals, 1)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	for cycle, expected := range map[uint64]int{0: 6, 1: 5, 2: 1, 3: 0} {
+		listed, err := qs.ListAppraisal(f.ctx, &types.QueryAllAppraisalRequest{PropertyId: id, Cycle: cycle})
+		require.NoError(t, err)
+		require.Len(t, listed.Appraisals, expected, cycle)
+		require.Equal(t, uint64(2), listed.OpenCycle)
+	}
+
+	// an appraised property is kept for the record
+	_, err = srv.DeleteProperty(f.ctx, &types.MsgDeleteProperty{Creator: owner, Id: id})
+	require.ErrorIs(t, err, types.ErrPropertyValued)
+}
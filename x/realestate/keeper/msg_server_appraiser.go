@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"realfin/x/realestate/types"
+)
+
+func (k msgServer) RegisterAppraiser(ctx context.Context, req *types.MsgRegisterAppraiser) (*types.MsgRegisterAppraiserResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(req.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	}
+	if _, err := k.addressCodec.StringToBytes(req.Appraiser.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid appraiser address: %s", err))
+	}
+
+	// a revoked appraiser can be accredited again, its appraisals count again
+	// in the cycles still open
+	accredited, err := k.IsAccredited(ctx, req.Appraiser.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if accredited {
+		return nil, errorsmod.Wrap(types.ErrAppraiserRegistered, req.Appraiser.Address)
+	}
+
+	appraiser := types.Appraiser{Address: req.Appraiser.Address, Name: req.Appraiser.Name}
+	if err := k.Appraiser.Set(ctx, appraiser.Address, appraiser); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAppraiserRegistered{
+		Address: appraiser.Address,
+		Name:    appraiser.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterAppraiserResponse{}, nil
+}
+
+func (k msgServer) RevokeAppraiser(ctx context.Context, req *types.MsgRevokeAppraiser) (*types.MsgRevokeAppraiserResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(req.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	}
+
+	appraiser, err := k.Appraiser.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrNotAccredited, req.Address)
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if appraiser.Revoked {
+		return nil, errorsmod.Wrapf(types.ErrNotAccredited, "appraiser %s already revoked", req.Address)
+	}
+
+	// the appraisals of the appraiser are kept for the record, and no longer
+	// count in the cycles still open
+	appraiser.Revoked = true
+	if err := k.Appraiser.Set(ctx, appraiser.Address, appraiser); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAppraiserRevoked{
+		Address: appraiser.Address,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeAppraiserResponse{}, nil
+}
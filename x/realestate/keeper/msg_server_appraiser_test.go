@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/realestate/keeper"
+	"realfin/x/realestate/types"
+)
+
+func TestMsgRegisterAppraiser(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	appraiser, err := f.addressCodec.BytesToString([]byte("appraiserAddr_______________"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgRegisterAppraiser
+		err  error
+	}{
+		{
+			desc: "invalid authority",
+			msg:  &types.MsgRegisterAppraiser{Authority: appraiser, Appraiser: types.Appraiser{Address: appraiser}},
+			err:  types.ErrInvalidSigner,
+		},
+		{
+			desc: "invalid address",
+			msg:  &types.MsgRegisterAppraiser{Authority: authority, Appraiser: types.Appraiser{Address: "invalid"}},
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc: "registered",
+			msg:  &types.MsgRegisterAppraiser{Authority: authority, Appraiser: types.Appraiser{Address: appraiser, Name: "Acme Appraisals", Revoked: true}},
+		},
+		{
+			desc: "already registered",
+			msg:  &types.MsgRegisterAppraiser{Authority: authority, Appraiser: types.Appraiser{Address: appraiser}},
+			err:  types.ErrAppraiserRegistered,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.RegisterAppraiser(f.ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := f.keeper.Appraiser.Get(f.ctx, tc.msg.Appraiser.Address)
+			require.NoError(t, err)
+			require.Equal(t, types.Appraiser{Address: appraiser, Name: "Acme Appraisals"}, got)
+		})
+	}
+}
+
+func TestMsgRevokeAppraiser(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	appraiser, err := f.addressCodec.BytesToString([]byte("appraiserAddr_______________"))
+	require.NoError(t, err)
+
+	_, err = srv.RevokeAppraiser(f.ctx, &types.MsgRevokeAppraiser{Authority: authority, Address: appraiser})
+	require.ErrorIs(t, err, types.ErrNotAccredited)
+
+	_, err = srv.RegisterAppraiser(f.ctx, &types.MsgRegisterAppraiser{Authority: authority, Appraiser: types.Appraiser{Address: appraiser}})
+	require.NoError(t, err)
+
+	_, err = srv.RevokeAppraiser(f.ctx, &types.MsgRevokeAppraiser{Authority: appraiser, Address: appraiser})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = srv.RevokeAppraiser(f.ctx, &types.MsgRevokeAppraiser{Authority: authority, Address: appraiser})
+	require.NoError(t, err)
+	accredited, err := f.keeper.IsAccredited(f.ctx, appraiser)
+	require.NoError(t, err)
+	require.False(t, accredited)
+
+	_, err = srv.RevokeAppraiser(f.ctx, &types.MsgRevokeAppraiser{Authority: authority, Address: appraiser})
+	require.ErrorIs(t, err, types.ErrNotAccredited)
+
+	// a revoked appraiser can be accredited again
+	_, err = srv.RegisterAppraiser(f.ctx, &types.MsgRegisterAppraiser{Authority: authority, Appraiser: types.Appraiser{Address: appraiser}})
+	require.NoError(t, err)
+}
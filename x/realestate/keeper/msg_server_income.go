@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"realfin/x/realestate/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) SetPropertyManager(ctx context.Context, msg *types.MsgSetPropertyManager) (*types.MsgSetPropertyManagerResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if msg.Manager != "" {
+		if _, err := k.addressCodec.StringToBytes(msg.Manager); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid manager address: %s", err))
+		}
+	}
+
+	property, err := k.Property.Get(ctx, msg.PropertyId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "property not found")
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// Only the owner appoints the manager
+	if msg.Creator != property.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	if msg.Manager == "" {
+		err = k.PropertyManager.Remove(ctx, msg.PropertyId)
+	} else {
+		err = k.PropertyManager.Set(ctx, msg.PropertyId, msg.Manager)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPropertyManagerSet{
+		PropertyId: msg.PropertyId,
+		Manager:    msg.Manager,
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgSetPropertyManagerResponse{}, nil
+}
+
+func (k msgServer) CreateLease(ctx context.Context, msg *types.MsgCreateLease) (*types.MsgCreateLeaseResponse, error) {
+	if err := k.checkManager(ctx, msg.Creator, msg.PropertyId); err != nil {
+		return nil, err
+	}
+
+	lease := types.Lease{
+		PropertyId: msg.PropertyId,
+		TenantHash: msg.TenantHash,
+		Start:      msg.Start,
+		End:        msg.End,
+		Rent:       msg.Rent,
+		Escalation: msg.Escalation,
+		Area:       msg.Area,
+		Creator:    msg.Creator,
+	}
+	if lease.Escalation.IsNil() {
+		lease.Escalation = math.LegacyZeroDec()
+	}
+	if err := lease.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidLease, err.Error())
+	}
+
+	id, err := k.LeaseSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	lease.Id = id
+	if err := k.Lease.Set(ctx, collections.Join(lease.PropertyId, lease.Id), lease); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventLeaseCreated{
+		Id:         lease.Id,
+		PropertyId: lease.PropertyId,
+		Rent:       lease.Rent,
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgCreateLeaseResponse{Id: id}, nil
+}
+
+func (k msgServer) TerminateLease(ctx context.Context, msg *types.MsgTerminateLease) (*types.MsgTerminateLeaseResponse, error) {
+	if err := k.checkManager(ctx, msg.Creator, msg.PropertyId); err != nil {
+		return nil, err
+	}
+
+	lease, err := k.Lease.Get(ctx, collections.Join(msg.PropertyId, msg.LeaseId))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "lease not found")
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if !now.Before(lease.End) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidLease, "lease %d already ended", lease.Id)
+	}
+	// a lease terminated before its start never runs
+	lease.End = now
+	if now.Before(lease.Start) {
+		lease.End = lease.Start
+	}
+	lease.Terminated = true
+	if err := k.Lease.Set(ctx, collections.Join(lease.PropertyId, lease.Id), lease); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventLeaseTerminated{
+		Id:         lease.Id,
+		PropertyId: lease.PropertyId,
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgTerminateLeaseResponse{}, nil
+}
+
+func (k msgServer) ReportCashFlow(ctx context.Context, msg *types.MsgReportCashFlow) (*types.MsgReportCashFlowResponse, error) {
+	if err := k.checkManager(ctx, msg.Creator, msg.PropertyId); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cashFlow := types.CashFlow{
+		PropertyId:  msg.PropertyId,
+		Kind:        msg.Kind,
+		Amount:      msg.Amount,
+		PeriodStart: msg.PeriodStart,
+		PeriodEnd:   msg.PeriodEnd,
+		Reporter:    msg.Creator,
+		ReportedAt:  sdkCtx.BlockTime(),
+	}
+	if err := cashFlow.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidCashFlow, err.Error())
+	}
+	if cashFlow.PeriodStart.After(cashFlow.ReportedAt) {
+		return nil, errorsmod.Wrap(types.ErrInvalidCashFlow, "period not started")
+	}
+
+	// Rents are received for a lease of the property
+	if cashFlow.Kind == types.CashFlowKind_CASH_FLOW_KIND_RENT {
+		has, err := k.Lease.Has(ctx, collections.Join(msg.PropertyId, msg.LeaseId))
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		} else if !has {
+			return nil, errorsmod.Wrapf(types.ErrInvalidCashFlow, "lease %d of property %d not found", msg.LeaseId, msg.PropertyId)
+		}
+		cashFlow.LeaseId = msg.LeaseId
+	}
+
+	id, err := k.CashFlowSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	cashFlow.Id = id
+	if err := k.CashFlow.Set(ctx, collections.Join(cashFlow.PropertyId, cashFlow.Id), cashFlow); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventCashFlowReported{
+		Id:         cashFlow.Id,
+		PropertyId: cashFlow.PropertyId,
+		Kind:       cashFlow.Kind,
+		Amount:     cashFlow.Amount,
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgReportCashFlowResponse{Id: id}, nil
+}
+
+// checkManager returns an error unless the signer manages the property.
+func (k msgServer) checkManager(ctx context.Context, signer string, propertyID uint64) error {
+	if _, err := k.addressCodec.StringToBytes(signer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	property, err := k.Property.Get(ctx, propertyID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "property not found")
+		}
+
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	manages, err := k.CanManage(ctx, property, signer)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if !manages {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "neither owner nor manager of the property")
+	}
+
+	return nil
+}
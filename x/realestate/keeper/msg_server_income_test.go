@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/realestate/keeper"
+	"realfin/x/realestate/types"
+)
+
+const tenantHash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func TestMsgSetPropertyManager(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	manager, err := f.addressCodec.BytesToString([]byte("managerAddr_________________"))
+	require.NoError(t, err)
+	id := createProperty(t, f, owner, "APN-001")
+
+	// only the owner appoints the manager
+	_, err = srv.SetPropertyManager(f.ctx, &types.MsgSetPropertyManager{Creator: manager, PropertyId: id, Manager: manager})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.SetPropertyManager(f.ctx, &types.MsgSetPropertyManager{Creator: owner, PropertyId: id, Manager: "invalid"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	_, err = srv.SetPropertyManager(f.ctx, &types.MsgSetPropertyManager{Creator: owner, PropertyId: 42, Manager: manager})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = srv.SetPropertyManager(f.ctx, &types.MsgSetPropertyManager{Creator: owner, PropertyId: id, Manager: manager})
+	require.NoError(t, err)
+	got, err := f.keeper.PropertyManager.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, manager, got)
+
+	// an empty manager dismisses it
+	_, err = srv.SetPropertyManager(f.ctx, &types.MsgSetPropertyManager{Creator: owner, PropertyId: id})
+	require.NoError(t, err)
+	has, err := f.keeper.PropertyManager.Has(f.ctx, id)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestMsgLease(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	manager, err := f.addressCodec.BytesToString([]byte("managerAddr_________________"))
+	require.NoError(t, err)
+	id := createProperty(t, f, owner, "APN-001")
+
+	msg := &types.MsgCreateLease{
+		Creator:    manager,
+		PropertyId: id,
+		TenantHash: tenantHash,
+		Start:      now,
+		End:        now.AddDate(5, 0, 0),
+		Rent:       120_000,
+		Escalation: math.LegacyNewDecWithPrec(3, 2),
+		Area:       60,
+	}
+	// the manager records leases once appointed
+	_, err = srv.CreateLease(f.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.SetPropertyManager(f.ctx, &types.MsgSetPropertyManager{Creator: owner, PropertyId: id, Manager: manager})
+	require.NoError(t, err)
+	resp, err := srv.CreateLease(f.ctx, msg)
+	require.NoError(t, err)
+
+	lease, err := f.keeper.Lease.Get(f.ctx, collections.Join(id, resp.Id))
+	require.NoError(t, err)
+	require.Equal(t, manager, lease.Creator)
+	require.Equal(t, uint64(120_000), lease.Rent)
+
+	invalid := *msg
+	invalid.End = invalid.Start
+	_, err = srv.CreateLease(f.ctx, &invalid)
+	require.ErrorIs(t, err, types.ErrInvalidLease)
+
+	// a lease without escalation keeps its rent
+	noEscalation := *msg
+	noEscalation.Escalation = math.LegacyDec{}
+	_, err = srv.CreateLease(f.ctx, &noEscalation)
+	require.NoError(t, err)
+
+	// the owner terminates it early, once
+	terminatedAt := now.AddDate(1, 0, 0)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(terminatedAt)
+	_, err = srv.TerminateLease(f.ctx, &types.MsgTerminateLease{Creator: owner, PropertyId: id, LeaseId: resp.Id})
+	require.NoError(t, err)
+	lease, err = f.keeper.Lease.Get(f.ctx, collections.Join(id, resp.Id))
+	require.NoError(t, err)
+	require.True(t, lease.Terminated)
+	require.Equal(t, terminatedAt, lease.End)
+	_, err = srv.TerminateLease(f.ctx, &types.MsgTerminateLease{Creator: owner, PropertyId: id, LeaseId: resp.Id})
+	require.ErrorIs(t, err, types.ErrInvalidLease)
+	_, err = srv.TerminateLease(f.ctx, &types.MsgTerminateLease{Creator: owner, PropertyId: id, LeaseId: 42})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// a property with leases cannot be deleted
+	_, err = srv.DeleteProperty(f.ctx, &types.MsgDeleteProperty{Creator: owner, Id: id})
+	require.ErrorIs(t, err, types.ErrPropertyLeased)
+}
+
+func TestMsgReportCashFlow(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+	id := createProperty(t, f, owner, "APN-001")
+	otherID := createProperty(t, f, owner, "APN-002")
+
+	lease, err := srv.CreateLease(f.ctx, &types.MsgCreateLease{
+		Creator:    owner,
+		PropertyId: id,
+		TenantHash: tenantHash,
+		Start:      now.AddDate(0, -1, 0),
+		End:        now.AddDate(1, 0, 0),
+		Rent:       120_000,
+	})
+	require.NoError(t, err)
+
+	msg := &types.MsgReportCashFlow{
+		Creator:     owner,
+		PropertyId:  id,
+		Kind:        types.CashFlowKind_CASH_FLOW_KIND_RENT,
+		Amount:      10_000,
+		PeriodStart: now.AddDate(0, -1, 0),
+		PeriodEnd:   now,
+		LeaseId:     lease.Id,
+	}
+	resp, err := srv.ReportCashFlow(f.ctx, msg)
+	require.NoError(t, err)
+	cashFlow, err := f.keeper.CashFlow.Get(f.ctx, collections.Join(id, resp.Id))
+	require.NoError(t, err)
+	require.Equal(t, owner, cashFlow.Reporter)
+	require.Equal(t, now, cashFlow.ReportedAt)
+
+	// the lease id of the expenses is dropped
+	expense := *msg
+	expense.Kind = types.CashFlowKind_CASH_FLOW_KIND_EXPENSE
+	expense.LeaseId = 42
+	resp, err = srv.ReportCashFlow(f.ctx, &expense)
+	require.NoError(t, err)
+	cashFlow, err = f.keeper.CashFlow.Get(f.ctx, collections.Join(id, resp.Id))
+	require.NoError(t, err)
+	require.Zero(t, cashFlow.LeaseId)
+
+	for _, tc := range []struct {
+		desc   string
+		modify func(*types.MsgReportCashFlow)
+		err    error
+	}{
+		{desc: "not a manager", modify: func(m *types.MsgReportCashFlow) { m.Creator = other }, err: sdkerrors.ErrUnauthorized},
+		{desc: "unknown lease", modify: func(m *types.MsgReportCashFlow) { m.LeaseId = 42 }, err: types.ErrInvalidCashFlow},
+		{desc: "lease of another property", modify: func(m *types.MsgReportCashFlow) { m.PropertyId = otherID }, err: types.ErrInvalidCashFlow},
+		{desc: "no amount", modify: func(m *types.MsgReportCashFlow) { m.Amount = 0 }, err: types.ErrInvalidCashFlow},
+		{desc: "unspecified kind", modify: func(m *types.MsgReportCashFlow) { m.Kind = types.CashFlowKind_CASH_FLOW_KIND_UNSPECIFIED }, err: types.ErrInvalidCashFlow},
+		{desc: "period not started", modify: func(m *types.MsgReportCashFlow) {
+			m.PeriodStart, m.PeriodEnd = now.Add(time.Hour), now.AddDate(0, 1, 0)
+		}, err: types.ErrInvalidCashFlow},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			invalid := *msg
+			tc.modify(&invalid)
+			_, err := srv.ReportCashFlow(f.ctx, &invalid)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"realfin/x/realestate/types"
+)
+
+func (k msgServer) SetRegionalIndex(ctx context.Context, req *types.MsgSetRegionalIndex) (*types.MsgSetRegionalIndexResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(req.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	}
+
+	// the level of an index mapped to another symbol is dropped: it is set
+	// from the price of the new symbol at the next refresh, which rebases the
+	// rates of the jurisdiction
+	index := types.RegionalIndex{Jurisdiction: req.Jurisdiction, Symbol: req.Symbol, Level: math.LegacyZeroDec()}
+	previous, err := k.RegionalIndex.Get(ctx, req.Jurisdiction)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if err == nil && previous.Symbol == req.Symbol {
+		index = previous
+	}
+	if err := index.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidIndex, err.Error())
+	}
+
+	if err := k.RegionalIndex.Set(ctx, index.Jurisdiction, index); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventRegionalIndexSet{
+		Jurisdiction: index.Jurisdiction,
+		Symbol:       index.Symbol,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRegionalIndexResponse{}, nil
+}
+
+func (k msgServer) RemoveRegionalIndex(ctx context.Context, req *types.MsgRemoveRegionalIndex) (*types.MsgRemoveRegionalIndexResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(req.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	}
+
+	found, err := k.RegionalIndex.Has(ctx, req.Jurisdiction)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no regional index of %s", req.Jurisdiction)
+	}
+
+	// the rates of the jurisdiction keep their last indexed value
+	if err := k.RegionalIndex.Remove(ctx, req.Jurisdiction); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventRegionalIndexRemoved{
+		Jurisdiction: req.Jurisdiction,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveRegionalIndexResponse{}, nil
+}
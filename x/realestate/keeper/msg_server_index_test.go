@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/realestate/keeper"
+	"realfin/x/realestate/types"
+)
+
+func TestMsgSetRegionalIndex(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	outsider, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgSetRegionalIndex
+		err  error
+	}{
+		{
+			desc: "invalid authority",
+			msg:  &types.MsgSetRegionalIndex{Authority: outsider, Jurisdiction: "US-CA", Symbol: "HPI-CA"},
+			err:  types.ErrInvalidSigner,
+		},
+		{
+			desc: "invalid jurisdiction",
+			msg:  &types.MsgSetRegionalIndex{Authority: authority, Jurisdiction: "california", Symbol: "HPI-CA"},
+			err:  types.ErrInvalidIndex,
+		},
+		{
+			desc: "invalid symbol",
+			msg:  &types.MsgSetRegionalIndex{Authority: authority, Jurisdiction: "US-CA", Symbol: "hpi ca"},
+			err:  types.ErrInvalidIndex,
+		},
+		{
+			desc: "set",
+			msg:  &types.MsgSetRegionalIndex{Authority: authority, Jurisdiction: "US-CA", Symbol: "HPI-CA"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SetRegionalIndex(f.ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := f.keeper.RegionalIndex.Get(f.ctx, tc.msg.Jurisdiction)
+			require.NoError(t, err)
+			require.Equal(t, tc.msg.Symbol, got.Symbol)
+			require.True(t, got.Level.IsZero())
+		})
+	}
+
+	// setting the same symbol again keeps the level, mapping another symbol
+	// drops it
+	index, err := f.keeper.RegionalIndex.Get(f.ctx, "US-CA")
+	require.NoError(t, err)
+	index.Level = math.LegacyNewDec(300)
+	require.NoError(t, f.keeper.RegionalIndex.Set(f.ctx, "US-CA", index))
+
+	_, err = srv.SetRegionalIndex(f.ctx, &types.MsgSetRegionalIndex{Authority: authority, Jurisdiction: "US-CA", Symbol: "HPI-CA"})
+	require.NoError(t, err)
+	index, err = f.keeper.RegionalIndex.Get(f.ctx, "US-CA")
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(300), index.Level)
+
+	_, err = srv.SetRegionalIndex(f.ctx, &types.MsgSetRegionalIndex{Authority: authority, Jurisdiction: "US-CA", Symbol: "CSI-SF"})
+	require.NoError(t, err)
+	index, err = f.keeper.RegionalIndex.Get(f.ctx, "US-CA")
+	require.NoError(t, err)
+	require.Equal(t, "CSI-SF", index.Symbol)
+	require.True(t, index.Level.IsZero())
+}
+
+func TestMsgRemoveRegionalIndex(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	outsider, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
+	require.NoError(t, err)
+
+	_, err = srv.SetRegionalIndex(f.ctx, &types.MsgSetRegionalIndex{Authority: authority, Jurisdiction: "US-CA", Symbol: "HPI-CA"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgRemoveRegionalIndex
+		err  error
+	}{
+		{
+			desc: "invalid authority",
+			msg:  &types.MsgRemoveRegionalIndex{Authority: outsider, Jurisdiction: "US-CA"},
+			err:  types.ErrInvalidSigner,
+		},
+		{
+			desc: "unknown jurisdiction",
+			msg:  &types.MsgRemoveRegionalIndex{Authority: authority, Jurisdiction: "US-NY"},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "removed",
+			msg:  &types.MsgRemoveRegionalIndex{Authority: authority, Jurisdiction: "US-CA"},
+		},
+		{
+			desc: "already removed",
+			msg:  &types.MsgRemoveRegionalIndex{Authority: authority, Jurisdiction: "US-CA"},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.RemoveRegionalIndex(f.ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			found, err := f.keeper.RegionalIndex.Has(f.ctx, tc.msg.Jurisdiction)
+			require.NoError(t, err)
+			require.False(t, found)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"realfin/x/realestate/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateProperty(ctx context.Context, msg *types.MsgCreateProperty) (*types.MsgCreatePropertyResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	property := types.Property{
+		ParcelId:         msg.ParcelId,
+		Latitude:         msg.Latitude,
+		Longitude:        msg.Longitude,
+		Jurisdiction:     msg.Jurisdiction,
+		PropertyClass:    msg.PropertyClass,
+		FloorArea:        msg.FloorArea,
+		YearBuilt:        msg.YearBuilt,
+		EncumbrancesHash: msg.EncumbrancesHash,
+		Creator:          msg.Creator,
+	}
+	if err := k.validateProperty(ctx, property); err != nil {
+		return nil, err
+	}
+	if id, found, err := k.parcelProperty(ctx, property); err != nil {
+		return nil, err
+	} else if found {
+		return nil, errorsmod.Wrapf(types.ErrDuplicateParcel, "parcel %s registered by property %d", property.ParcelId, id)
+	}
+
+	id, err := k.PropertySeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	property.Id = id
+
+	if err := k.Property.Set(ctx, id, property); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPropertyCreated{
+		Id:            id,
+		Creator:       property.Creator,
+		Jurisdiction:  property.Jurisdiction,
+		ParcelId:      property.ParcelId,
+		PropertyClass: property.PropertyClass,
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgCreatePropertyResponse{Id: id}, nil
+}
+
+func (k msgServer) UpdateProperty(ctx context.Context, msg *types.MsgUpdateProperty) (*types.MsgUpdatePropertyResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	val, err := k.Property.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "property not found")
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// Checks if the msg creator is the same as the current owner
+	if msg.Creator != val.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	property := types.Property{
+		Id:               msg.Id,
+		ParcelId:         msg.ParcelId,
+		Latitude:         msg.Latitude,
+		Longitude:        msg.Longitude,
+		Jurisdiction:     msg.Jurisdiction,
+		PropertyClass:    msg.PropertyClass,
+		FloorArea:        msg.FloorArea,
+		YearBuilt:        msg.YearBuilt,
+		EncumbrancesHash: msg.EncumbrancesHash,
+		Creator:          msg.Creator,
+	}
+	if err := k.validateProperty(ctx, property); err != nil {
+		return nil, err
+	}
+	if id, found, err := k.parcelProperty(ctx, property); err != nil {
+		return nil, err
+	} else if found && id != property.Id {
+		return nil, errorsmod.Wrapf(types.ErrDuplicateParcel, "parcel %s registered by property %d", property.ParcelId, id)
+	}
+
+	if err := k.Property.Set(ctx, property.Id, property); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update property")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPropertyUpdated{
+		Id:            property.Id,
+		Jurisdiction:  property.Jurisdiction,
+		ParcelId:      property.ParcelId,
+		PropertyClass: property.PropertyClass,
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgUpdatePropertyResponse{}, nil
+}
+
+func (k msgServer) DeleteProperty(ctx context.Context, msg *types.MsgDeleteProperty) (*types.MsgDeletePropertyResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	val, err := k.Property.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "property not found")
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// Checks if the msg creator is the same as the current owner
+	if msg.Creator != val.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	// The valuation of the property must be deleted first, and appraised
+	// properties are kept for the record
+	valued, err := k.Rate.Has(ctx, msg.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if valued {
+		return nil, errorsmod.Wrapf(types.ErrPropertyValued, "property %d", msg.Id)
+	}
+	appraised, err := k.HasAppraisals(ctx, msg.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if appraised {
+		return nil, errorsmod.Wrapf(types.ErrPropertyValued, "property %d has appraisals", msg.Id)
+	}
+	// so are the properties with leases or cash flows
+	leased, err := k.HasIncomeRecord(ctx, msg.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if leased {
+		return nil, errorsmod.Wrapf(types.ErrPropertyLeased, "property %d", msg.Id)
+	}
+
+	if err := k.PropertyManager.Remove(ctx, msg.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove property manager")
+	}
+	if err := k.Property.Remove(ctx, msg.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove property")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPropertyDeleted{Id: msg.Id}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgDeletePropertyResponse{}, nil
+}
+
+// validateProperty validates a new or updated property: the property must be
+// classified and must not be built after the current year.
+func (k msgServer) validateProperty(ctx context.Context, property types.Property) error {
+	if err := property.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidProperty, err.Error())
+	}
+	if property.PropertyClass == types.PropertyClass_PROPERTY_CLASS_UNSPECIFIED {
+		return errorsmod.Wrap(types.ErrInvalidProperty, "property class must be specified")
+	}
+	if year := sdk.UnwrapSDKContext(ctx).BlockTime().Year(); int(property.YearBuilt) > year {
+		return errorsmod.Wrapf(types.ErrInvalidProperty, "year built %d is after the current year %d", property.YearBuilt, year)
+	}
+
+	return nil
+}
+
+// parcelProperty returns the id of the property registered for the parcel of
+// the property in its jurisdiction, if any.
+func (k msgServer) parcelProperty(ctx context.Context, property types.Property) (uint64, bool, error) {
+	id, err := k.Property.Indexes.Parcel.MatchExact(ctx, collections.Join(property.Jurisdiction, property.ParcelId))
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return id, true, nil
+}
@@ -0,0 +1,259 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/realestate/keeper"
+	"realfin/x/realestate/types"
+)
+
+// createProperty registers a residential property of the creator in the
+// US-CA jurisdiction and returns its id.
+func createProperty(t *testing.T, f *fixture, creator, parcelID string) uint64 {
+	t.Helper()
+
+	resp, err := keeper.NewMsgServerImpl(f.keeper).CreateProperty(f.ctx, &types.MsgCreateProperty{
+		Creator:       creator,
+		ParcelId:      parcelID,
+		Latitude:      37_774_929,
+		Longitude:     -122_419_416,
+		Jurisdiction:  "US-CA",
+		PropertyClass: types.PropertyClass_PROPERTY_CLASS_RESIDENTIAL,
+		FloorArea:     120,
+		YearBuilt:     1998,
+	})
+	require.NoError(t, err)
+
+	return resp.Id
+}
+
+func TestPropertyMsgServerCreate(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	id := createProperty(t, f, creator, "APN-001")
+	require.Equal(t, uint64(0), id)
+	require.Equal(t, uint64(1), createProperty(t, f, creator, "APN-002"))
+
+	property, err := f.keeper.Property.Get(f.ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "APN-001", property.ParcelId)
+	require.Equal(t, "US-CA", property.Jurisdiction)
+	require.Equal(t, creator, property.Creator)
+
+	valid := types.MsgCreateProperty{
+		Creator:       creator,
+		ParcelId:      "APN-003",
+		Jurisdiction:  "US-CA",
+		PropertyClass: types.PropertyClass_PROPERTY_CLASS_COMMERCIAL,
+	}
+	tests := []struct {
+		desc   string
+		modify func(*types.MsgCreateProperty)
+		err    error
+	}{
+		{
+			desc:   "invalid address",
+			modify: func(msg *types.MsgCreateProperty) { msg.Creator = "invalid" },
+			err:    sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:   "empty parcel id",
+			modify: func(msg *types.MsgCreateProperty) { msg.ParcelId = "" },
+			err:    types.ErrInvalidProperty,
+		},
+		{
+			desc:   "invalid jurisdiction",
+			modify: func(msg *types.MsgCreateProperty) { msg.Jurisdiction = "us-ca" },
+			err:    types.ErrInvalidProperty,
+		},
+		{
+			desc:   "unspecified class",
+			modify: func(msg *types.MsgCreateProperty) { msg.PropertyClass = types.PropertyClass_PROPERTY_CLASS_UNSPECIFIED },
+			err:    types.ErrInvalidProperty,
+		},
+		{
+			desc:   "longitude out of range",
+			modify: func(msg *types.MsgCreateProperty) { msg.Longitude = -180_000_001 },
+			err:    types.ErrInvalidProperty,
+		},
+		{
+			desc: "built in the future",
+			modify: func(msg *types.MsgCreateProperty) {
+				msg.YearBuilt = uint32(sdk.UnwrapSDKContext(f.ctx).BlockTime().Year() + 1)
+			},
+			err: types.ErrInvalidProperty,
+		},
+		{
+			desc:   "duplicate parcel",
+			modify: func(msg *types.MsgCreateProperty) { msg.ParcelId = "APN-001" },
+			err:    types.ErrDuplicateParcel,
+		},
+		{
+			desc:   "same parcel id in another jurisdiction",
+			modify: func(msg *types.MsgCreateProperty) { msg.ParcelId = "APN-001"; msg.Jurisdiction = "US-NV" },
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := valid
+			tc.modify(&msg)
+			_, err := srv.CreateProperty(f.ctx, &msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPropertyMsgServerUpdate(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
+	require.NoError(t, err)
+
+	id := createProperty(t, f, creator, "APN-001")
+	createProperty(t, f, creator, "APN-002")
+
+	hash := "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	tests := []struct {
+		desc    string
+		request *types.MsgUpdateProperty
+		err     error
+	}{
+		{
+			desc:    "invalid address",
+			request: &types.MsgUpdateProperty{Creator: "invalid", Id: id},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "unauthorized",
+			request: &types.MsgUpdateProperty{Creator: unauthorizedAddr, Id: id},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "key not found",
+			request: &types.MsgUpdateProperty{Creator: creator, Id: 100000},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "parcel of another property",
+			request: &types.MsgUpdateProperty{Creator: creator, Id: id,
+				ParcelId: "APN-002", Jurisdiction: "US-CA", PropertyClass: types.PropertyClass_PROPERTY_CLASS_RESIDENTIAL,
+			},
+			err: types.ErrDuplicateParcel,
+		},
+		{
+			desc: "invalid encumbrances hash",
+			request: &types.MsgUpdateProperty{Creator: creator, Id: id,
+				ParcelId: "APN-001", Jurisdiction: "US-CA", PropertyClass: types.PropertyClass_PROPERTY_CLASS_RESIDENTIAL,
+				EncumbrancesHash: "not-hex",
+			},
+			err: types.ErrInvalidProperty,
+		},
+		{
+			desc: "completed",
+			request: &types.MsgUpdateProperty{Creator: creator, Id: id,
+				ParcelId: "APN-001", Jurisdiction: "US-CA", PropertyClass: types.PropertyClass_PROPERTY_CLASS_MIXED_USE,
+				FloorArea: 250, EncumbrancesHash: hash,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.UpdateProperty(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				property, err := f.keeper.Property.Get(f.ctx, id)
+				require.NoError(t, err)
+				require.Equal(t, types.PropertyClass_PROPERTY_CLASS_MIXED_USE, property.PropertyClass)
+				require.Equal(t, hash, property.EncumbrancesHash)
+			}
+		})
+	}
+
+	// the class index follows the update
+	iter, err := f.keeper.Property.Indexes.Class.MatchExact(f.ctx, int32(types.PropertyClass_PROPERTY_CLASS_MIXED_USE))
+	require.NoError(t, err)
+	ids, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{id}, ids)
+}
+
+func TestPropertyMsgServerDelete(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
+	require.NoError(t, err)
+
+	id := createProperty(t, f, creator, "APN-001")
+	require.NoError(t, f.keeper.Rate.Set(f.ctx, id, types.Rate{Creator: creator, PropertyId: id, Rate: 2_500_000}))
+
+	// a valued property is not deleted
+	_, err = srv.DeleteProperty(f.ctx, &types.MsgDeleteProperty{Creator: creator, Id: id})
+	require.ErrorIs(t, err, types.ErrPropertyValued)
+
+	_, err = srv.DeleteRate(f.ctx, &types.MsgDeleteRate{Creator: creator, PropertyId: id})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgDeleteProperty
+		err     error
+	}{
+		{
+			desc:    "invalid address",
+			request: &types.MsgDeleteProperty{Creator: "invalid", Id: id},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "unauthorized",
+			request: &types.MsgDeleteProperty{Creator: unauthorizedAddr, Id: id},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "key not found",
+			request: &types.MsgDeleteProperty{Creator: creator, Id: 100000},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgDeleteProperty{Creator: creator, Id: id},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.DeleteProperty(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				found, err := f.keeper.Property.Has(f.ctx, id)
+				require.NoError(t, err)
+				require.False(t, found)
+			}
+		})
+	}
+
+	// the parcel can be registered again once deleted
+	createProperty(t, f, creator, "APN-001")
+}
@@ -1,7 +1,6 @@
 package keeper_test
 
 import (
-	"strconv"
 	"testing"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -11,88 +10,6 @@ import (
 	"realfin/x/realestate/types"
 )
 
-func TestRateMsgServerCreate(t *testing.T) {
-	f := initFixture(t)
-	srv := keeper.NewMsgServerImpl(f.keeper)
-	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
-	require.NoError(t, err)
-
-	for i := 0; i < 5; i++ {
-		expected := &types.MsgCreateRate{Creator: creator,
-			Symbol: strconv.Itoa(i),
-		}
-		_, err := srv.CreateRate(f.ctx, expected)
-		require.NoError(t, err)
-		rst, err := f.keeper.Rate.Get(f.ctx, expected.Symbol)
-		require.NoError(t, err)
-		require.Equal(t, expected.Creator, rst.Creator)
-	}
-}
-
-func TestRateMsgServerUpdate(t *testing.T) {
-	f := initFixture(t)
-	srv := keeper.NewMsgServerImpl(f.keeper)
-
-	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
-	require.NoError(t, err)
-
-	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
-	require.NoError(t, err)
-
-	expected := &types.MsgCreateRate{Creator: creator,
-		Symbol: strconv.Itoa(0),
-	}
-	_, err = srv.CreateRate(f.ctx, expected)
-	require.NoError(t, err)
-
-	tests := []struct {
-		desc    string
-		request *types.MsgUpdateRate
-		err     error
-	}{
-		{
-			desc: "invalid address",
-			request: &types.MsgUpdateRate{Creator: "invalid",
-				Symbol: strconv.Itoa(0),
-			},
-			err: sdkerrors.ErrInvalidAddress,
-		},
-		{
-			desc: "unauthorized",
-			request: &types.MsgUpdateRate{Creator: unauthorizedAddr,
-				Symbol: strconv.Itoa(0),
-			},
-			err: sdkerrors.ErrUnauthorized,
-		},
-		{
-			desc: "key not found",
-			request: &types.MsgUpdateRate{Creator: creator,
-				Symbol: strconv.Itoa(100000),
-			},
-			err: sdkerrors.ErrKeyNotFound,
-		},
-		{
-			desc: "completed",
-			request: &types.MsgUpdateRate{Creator: creator,
-				Symbol: strconv.Itoa(0),
-			},
-		},
-	}
-	for _, tc := range tests {
-		t.Run(tc.desc, func(t *testing.T) {
-			_, err = srv.UpdateRate(f.ctx, tc.request)
-			if tc.err != nil {
-				require.ErrorIs(t, err, tc.err)
-			} else {
-				require.NoError(t, err)
-				rst, err := f.keeper.Rate.Get(f.ctx, expected.Symbol)
-				require.NoError(t, err)
-				require.Equal(t, expected.Creator, rst.Creator)
-			}
-		})
-	}
-}
-
 func TestRateMsgServerDelete(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
@@ -103,10 +20,9 @@ func TestRateMsgServerDelete(t *testing.T) {
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
-	_, err = srv.CreateRate(f.ctx, &types.MsgCreateRate{Creator: creator,
-		Symbol: strconv.Itoa(0),
-	})
-	require.NoError(t, err)
+	// rates published before appraisals are deleted by their creator
+	id := createProperty(t, f, creator, "0")
+	require.NoError(t, f.keeper.Rate.Set(f.ctx, id, types.Rate{Creator: creator, PropertyId: id, Rate: 2_500_000}))
 
 	tests := []struct {
 		desc    string
@@ -116,28 +32,28 @@ func TestRateMsgServerDelete(t *testing.T) {
 		{
 			desc: "invalid address",
 			request: &types.MsgDeleteRate{Creator: "invalid",
-				Symbol: strconv.Itoa(0),
+				PropertyId: id,
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
 		{
 			desc: "unauthorized",
 			request: &types.MsgDeleteRate{Creator: unauthorizedAddr,
-				Symbol: strconv.Itoa(0),
+				PropertyId: id,
 			},
 			err: sdkerrors.ErrUnauthorized,
 		},
 		{
 			desc: "key not found",
 			request: &types.MsgDeleteRate{Creator: creator,
-				Symbol: strconv.Itoa(100000),
+				PropertyId: 100000,
 			},
 			err: sdkerrors.ErrKeyNotFound,
 		},
 		{
 			desc: "completed",
 			request: &types.MsgDeleteRate{Creator: creator,
-				Symbol: strconv.Itoa(0),
+				PropertyId: id,
 			},
 		},
 	}
@@ -148,7 +64,7 @@ func TestRateMsgServerDelete(t *testing.T) {
 				require.ErrorIs(t, err, tc.err)
 			} else {
 				require.NoError(t, err)
-				found, err := f.keeper.Rate.Has(f.ctx, tc.request.Symbol)
+				found, err := f.keeper.Rate.Has(f.ctx, tc.request.PropertyId)
 				require.NoError(t, err)
 				require.False(t, found)
 			}
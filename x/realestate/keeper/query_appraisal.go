@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"realfin/x/realestate/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListAppraiser(ctx context.Context, req *types.QueryAllAppraiserRequest) (*types.QueryAllAppraiserResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	appraisers, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Appraiser,
+		req.Pagination,
+		func(_ string, value types.Appraiser) (types.Appraiser, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAppraiserResponse{Appraisers: appraisers, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetAppraiser(ctx context.Context, req *types.QueryGetAppraiserRequest) (*types.QueryGetAppraiserResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Appraiser.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetAppraiserResponse{Appraiser: val}, nil
+}
+
+func (q queryServer) ListAppraisal(ctx context.Context, req *types.QueryAllAppraisalRequest) (*types.QueryAllAppraisalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// appraisals are keyed by property, cycle and id
+	prefix := collections.TriplePrefix[uint64, uint64, uint64](req.PropertyId)
+	if req.Cycle != 0 {
+		prefix = collections.TripleSuperPrefix[uint64, uint64, uint64](req.PropertyId, req.Cycle)
+	}
+
+	appraisals, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Appraisal,
+		req.Pagination,
+		func(_ collections.Triple[uint64, uint64, uint64], value types.Appraisal) (types.Appraisal, error) {
+			return value, nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[uint64, uint64, uint64]]) {
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	cycle, err := q.k.OpenCycle(ctx, req.PropertyId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAppraisalResponse{Appraisals: appraisals, OpenCycle: cycle, Pagination: pageRes}, nil
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"realfin/x/realestate/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListLease(ctx context.Context, req *types.QueryAllLeaseRequest) (*types.QueryAllLeaseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	leases, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Lease,
+		req.Pagination,
+		func(_ collections.Pair[uint64, uint64], value types.Lease) (types.Lease, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.PropertyId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllLeaseResponse{Leases: leases, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListCashFlow(ctx context.Context, req *types.QueryAllCashFlowRequest) (*types.QueryAllCashFlowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	cashFlows, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.CashFlow,
+		req.Pagination,
+		func(_ collections.Pair[uint64, uint64], value types.CashFlow) (bool, error) {
+			return req.Kind == types.CashFlowKind_CASH_FLOW_KIND_UNSPECIFIED || value.Kind == req.Kind, nil
+		},
+		func(_ collections.Pair[uint64, uint64], value types.CashFlow) (types.CashFlow, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.PropertyId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllCashFlowResponse{CashFlows: cashFlows, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetIncome(ctx context.Context, req *types.QueryGetIncomeRequest) (*types.QueryGetIncomeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	property, err := q.k.Property.Get(ctx, req.PropertyId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	income, err := q.k.Income(ctx, property, req.Window)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &income, nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"realfin/x/realestate/keeper"
+	"realfin/x/realestate/types"
+)
+
+func TestGetIncome(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	// the property has a floor area of 120 square metres
+	id := createProperty(t, f, owner, "APN-001")
+
+	income, err := qs.GetIncome(f.ctx, &types.QueryGetIncomeRequest{PropertyId: id})
+	require.NoError(t, err)
+	require.True(t, income.Vacant)
+	require.Equal(t, math.LegacyZeroDec(), income.Occupancy)
+	require.Equal(t, types.DefaultIncomeWindow, income.Window)
+
+	// a lease of half of the property in its second year, and a lease not
+	// started yet
+	createLease := func(start time.Time, rent, area uint64, escalation math.LegacyDec) uint64 {
+		t.Helper()
+		resp, err := srv.CreateLease(f.ctx, &types.MsgCreateLease{
+			Creator:    owner,
+			PropertyId: id,
+			TenantHash: tenantHash,
+			Start:      start,
+			End:        start.AddDate(5, 0, 0),
+			Rent:       rent,
+			Escalation: escalation,
+			Area:       area,
+		})
+		require.NoError(t, err)
+		return resp.Id
+	}
+	leaseID := createLease(now.AddDate(-1, 0, -1), 100_000, 60, math.LegacyNewDecWithPrec(3, 2))
+	createLease(now.AddDate(0, 1, 0), 50_000, 30, math.LegacyZeroDec())
+
+	// the cash flows of the periods ending in the last year are counted
+	report := func(kind types.CashFlowKind, amount uint64, end time.Time) {
+		t.Helper()
+		_, err := srv.ReportCashFlow(f.ctx, &types.MsgReportCashFlow{
+			Creator:     owner,
+			PropertyId:  id,
+			Kind:        kind,
+			Amount:      amount,
+			PeriodStart: end.AddDate(0, -1, 0),
+			PeriodEnd:   end,
+			LeaseId:     leaseID,
+		})
+		require.NoError(t, err)
+	}
+	report(types.CashFlowKind_CASH_FLOW_KIND_RENT, 100_000, now.AddDate(0, -1, 0))
+	report(types.CashFlowKind_CASH_FLOW_KIND_RENT, 90_000, now.AddDate(-2, 0, 0))
+	report(types.CashFlowKind_CASH_FLOW_KIND_EXPENSE, 40_000, now.AddDate(0, -1, 0))
+
+	require.NoError(t, f.keeper.Rate.Set(f.ctx, id, types.Rate{PropertyId: id, Rate: 1_000_000}))
+
+	income, err = qs.GetIncome(f.ctx, &types.QueryGetIncomeRequest{PropertyId: id})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryGetIncomeResponse{
+		PropertyId:         id,
+		ActiveLeases:       1,
+		Occupancy:          math.LegacyNewDecWithPrec(5, 1),
+		ContractRent:       103_000,
+		Window:             types.DefaultIncomeWindow,
+		GrossIncome:        100_000,
+		OperatingExpenses:  40_000,
+		NetOperatingIncome: math.NewInt(60_000),
+		Valuation:          1_000_000,
+		CapRate:            math.LegacyNewDecWithPrec(6, 2),
+	}, income)
+
+	// the net operating income of a shorter window is annualised
+	income, err = qs.GetIncome(f.ctx, &types.QueryGetIncomeRequest{PropertyId: id, Window: types.DefaultIncomeWindow / 2})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(60_000), income.NetOperatingIncome)
+	require.Equal(t, math.LegacyNewDecWithPrec(12, 2), income.CapRate)
+
+	_, err = qs.GetIncome(f.ctx, &types.QueryGetIncomeRequest{PropertyId: 42})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
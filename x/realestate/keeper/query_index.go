@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"realfin/x/realestate/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListRegionalIndex(ctx context.Context, req *types.QueryAllRegionalIndexRequest) (*types.QueryAllRegionalIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	indices, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.RegionalIndex,
+		req.Pagination,
+		func(_ string, value types.RegionalIndex) (types.RegionalIndex, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRegionalIndexResponse{RegionalIndices: indices, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetRegionalIndex(ctx context.Context, req *types.QueryGetRegionalIndexRequest) (*types.QueryGetRegionalIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.RegionalIndex.Get(ctx, req.Jurisdiction)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetRegionalIndexResponse{RegionalIndex: val}, nil
+}
+
+func (q queryServer) GetValuation(ctx context.Context, req *types.QueryGetValuationRequest) (*types.QueryGetValuationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	rate, err := q.k.Rate.Get(ctx, req.PropertyId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	property, err := q.k.Property.Get(ctx, req.PropertyId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	// the level is reported only while the index the rate is based on is
+	// still the index of the jurisdiction of the property
+	level := math.LegacyZeroDec()
+	index, err := q.k.RegionalIndex.Get(ctx, property.Jurisdiction)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "internal error")
+	} else if err == nil && rate.IndexSymbol != "" && index.Symbol == rate.IndexSymbol {
+		level = index.Level
+	}
+
+	base := rate.IndexBase
+	if base.IsNil() {
+		base = math.LegacyZeroDec()
+	}
+	indexed := rate.IndexedValue
+	if rate.IndexedAt.IsZero() {
+		// a rate never indexed is valued at its appraisal
+		indexed = rate.Rate
+	}
+
+	return &types.QueryGetValuationResponse{
+		PropertyId:     req.PropertyId,
+		AppraisedValue: rate.Rate,
+		AppraisedAt:    rate.FinalizedAt,
+		IndexedValue:   indexed,
+		IndexedAt:      rate.IndexedAt,
+		IndexSymbol:    rate.IndexSymbol,
+		IndexBase:      base,
+		IndexLevel:     level,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"realfin/x/realestate/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListProperty(ctx context.Context, req *types.QueryAllPropertyRequest) (*types.QueryAllPropertyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	properties, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.Property,
+		req.Pagination,
+		func(_ uint64, value types.Property) (bool, error) {
+			if req.Jurisdiction != "" && value.Jurisdiction != req.Jurisdiction {
+				return false, nil
+			}
+			return req.PropertyClass == types.PropertyClass_PROPERTY_CLASS_UNSPECIFIED || value.PropertyClass == req.PropertyClass, nil
+		},
+		func(_ uint64, value types.Property) (types.Property, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPropertyResponse{Properties: properties, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetProperty(ctx context.Context, req *types.QueryGetPropertyRequest) (*types.QueryGetPropertyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Property.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetPropertyResponse{Property: val}, nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"realfin/x/realestate/keeper"
+	"realfin/x/realestate/types"
+)
+
+func TestPropertyQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	id := createProperty(t, f, creator, "APN-001")
+	createProperty(t, f, creator, "APN-002")
+	_, err = srv.CreateProperty(f.ctx, &types.MsgCreateProperty{
+		Creator:       creator,
+		ParcelId:      "BLK-7",
+		Jurisdiction:  "SG",
+		PropertyClass: types.PropertyClass_PROPERTY_CLASS_COMMERCIAL,
+	})
+	require.NoError(t, err)
+
+	resp, err := qs.GetProperty(f.ctx, &types.QueryGetPropertyRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, "APN-001", resp.Property.ParcelId)
+
+	_, err = qs.GetProperty(f.ctx, &types.QueryGetPropertyRequest{Id: 100000})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+	_, err = qs.GetProperty(f.ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	tests := []struct {
+		desc         string
+		jurisdiction string
+		class        types.PropertyClass
+		parcels      []string
+	}{
+		{
+			desc:    "all",
+			parcels: []string{"APN-001", "APN-002", "BLK-7"},
+		},
+		{
+			desc:         "by jurisdiction",
+			jurisdiction: "US-CA",
+			parcels:      []string{"APN-001", "APN-002"},
+		},
+		{
+			desc:    "by class",
+			class:   types.PropertyClass_PROPERTY_CLASS_COMMERCIAL,
+			parcels: []string{"BLK-7"},
+		},
+		{
+			desc:         "by jurisdiction and class",
+			jurisdiction: "US-CA",
+			class:        types.PropertyClass_PROPERTY_CLASS_COMMERCIAL,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := qs.ListProperty(f.ctx, &types.QueryAllPropertyRequest{
+				Jurisdiction:  tc.jurisdiction,
+				PropertyClass: tc.class,
+			})
+			require.NoError(t, err)
+
+			var parcels []string
+			for _, property := range resp.Properties {
+				parcels = append(parcels, property.ParcelId)
+			}
+			require.Equal(t, tc.parcels, parcels)
+		})
+	}
+}
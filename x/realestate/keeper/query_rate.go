@@ -3,10 +3,13 @@ package keeper
 import (
 	"context"
 	"errors"
+	stdmath "math"
+	"time"
 
 	"realfin/x/realestate/types"
 
 	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,7 +24,7 @@ func (q queryServer) ListRate(ctx context.Context, req *types.QueryAllRateReques
 		ctx,
 		q.k.Rate,
 		req.Pagination,
-		func(_ string, value types.Rate) (types.Rate, error) {
+		func(_ uint64, value types.Rate) (types.Rate, error) {
 			return value, nil
 		},
 	)
@@ -37,7 +40,7 @@ func (q queryServer) GetRate(ctx context.Context, req *types.QueryGetRateRequest
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, err := q.k.Rate.Get(ctx, req.Symbol)
+	val, err := q.k.Rate.Get(ctx, req.PropertyId)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "not found")
@@ -48,3 +51,30 @@ func (q queryServer) GetRate(ctx context.Context, req *types.QueryGetRateRequest
 
 	return &types.QueryGetRateResponse{Rate: val}, nil
 }
+
+func (q queryServer) ListReappraisalDue(ctx context.Context, req *types.QueryAllReappraisalDueRequest) (*types.QueryAllReappraisalDueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// the rates valid until the end of the window are due, the expired ones
+	// included
+	window := time.Duration(min(req.Window, uint64(stdmath.MaxInt64/int64(time.Second)))) * time.Second
+	deadline := sdk.UnwrapSDKContext(ctx).BlockTime().Add(window)
+	rates, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.Rate,
+		req.Pagination,
+		func(_ uint64, value types.Rate) (bool, error) {
+			return !value.ValidUntil.IsZero() && !value.ValidUntil.After(deadline), nil
+		},
+		func(_ uint64, value types.Rate) (types.Rate, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllReappraisalDueResponse{Rates: rates, Pagination: pageRes}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -17,11 +18,12 @@ import (
 func createNRate(keeper keeper.Keeper, ctx context.Context, n int) []types.Rate {
 	items := make([]types.Rate, n)
 	for i := range items {
-		items[i].Symbol = strconv.Itoa(i)
+		items[i].PropertyId = uint64(i)
 		items[i].Rate = uint64(i)
 		items[i].Name = strconv.Itoa(i)
 		items[i].Description = strconv.Itoa(i)
-		_ = keeper.Rate.Set(ctx, items[i].Symbol, items[i])
+		items[i].IndexBase = math.LegacyZeroDec()
+		_ = keeper.Rate.Set(ctx, items[i].PropertyId, items[i])
 	}
 	return items
 }
@@ -39,21 +41,21 @@ func TestRateQuerySingle(t *testing.T) {
 		{
 			desc: "First",
 			request: &types.QueryGetRateRequest{
-				Symbol: msgs[0].Symbol,
+				PropertyId: msgs[0].PropertyId,
 			},
 			response: &types.QueryGetRateResponse{Rate: msgs[0]},
 		},
 		{
 			desc: "Second",
 			request: &types.QueryGetRateRequest{
-				Symbol: msgs[1].Symbol,
+				PropertyId: msgs[1].PropertyId,
 			},
 			response: &types.QueryGetRateResponse{Rate: msgs[1]},
 		},
 		{
 			desc: "KeyNotFound",
 			request: &types.QueryGetRateRequest{
-				Symbol: strconv.Itoa(100000),
+				PropertyId: 100000,
 			},
 			err: status.Error(codes.NotFound, "not found"),
 		},
@@ -0,0 +1,53 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"realfin/x/realestate/types"
+)
+
+// RateKey is the prefix of the rates of version 1, keyed by symbol.
+var RateKey = collections.NewPrefix("rate/value/")
+
+// MigrateStore migrates the rates of version 1, keyed by a free-form symbol,
+// to the valuations of registered properties: every rate is removed from the
+// store of version 1 and passed to setRate with its symbol, which registers
+// the property the rate values and keys the rate by the property id.
+func MigrateStore(
+	ctx context.Context,
+	storeService corestore.KVStoreService,
+	cdc codec.BinaryCodec,
+	setRate func(ctx context.Context, symbol string, rate types.Rate) error,
+) error {
+	legacy := collections.NewMap(
+		collections.NewSchemaBuilder(storeService), RateKey, "rate",
+		collections.StringKey, codec.CollValue[types.Rate](cdc),
+	)
+
+	var (
+		symbols []string
+		rates   []types.Rate
+	)
+	if err := legacy.Walk(ctx, nil, func(symbol string, rate types.Rate) (bool, error) {
+		symbols = append(symbols, symbol)
+		rates = append(rates, rate)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for i, symbol := range symbols {
+		if err := legacy.Remove(ctx, symbol); err != nil {
+			return err
+		}
+		if err := setRate(ctx, symbol, rates[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -24,10 +24,84 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "GetRate",
-					Use:            "get-rate [id]",
-					Short:          "Gets a rate",
+					Use:            "get-rate [property-id]",
+					Short:          "Gets the rate of a property",
 					Alias:          []string{"show-rate"},
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}},
+				},
+				{
+					RpcMethod: "ListProperty",
+					Use:       "list-property",
+					Short:     "List the properties, optionally of a jurisdiction and of a class",
+				},
+				{
+					RpcMethod:      "GetProperty",
+					Use:            "get-property [id]",
+					Short:          "Gets a property",
+					Alias:          []string{"show-property"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "ListAppraiser",
+					Use:       "list-appraiser",
+					Short:     "List the appraisers",
+				},
+				{
+					RpcMethod:      "GetAppraiser",
+					Use:            "get-appraiser [address]",
+					Short:          "Gets an appraiser",
+					Alias:          []string{"show-appraiser"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "ListAppraisal",
+					Use:            "list-appraisal [property-id]",
+					Short:          "List the appraisals of a property, optionally of a valuation cycle",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}},
+				},
+				{
+					RpcMethod: "ListRegionalIndex",
+					Use:       "list-regional-index",
+					Short:     "List the regional price indices",
+				},
+				{
+					RpcMethod:      "GetRegionalIndex",
+					Use:            "get-regional-index [jurisdiction]",
+					Short:          "Gets the regional price index of a jurisdiction",
+					Alias:          []string{"show-regional-index"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "jurisdiction"}},
+				},
+				{
+					RpcMethod:      "GetValuation",
+					Use:            "get-valuation [property-id]",
+					Short:          "Gets the appraised and the indexed values of a property",
+					Alias:          []string{"show-valuation"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}},
+				},
+				{
+					RpcMethod:      "ListReappraisalDue",
+					Use:            "list-reappraisal-due [window]",
+					Short:          "List the valuations expired or expiring within window seconds",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "window"}},
+				},
+				{
+					RpcMethod:      "ListLease",
+					Use:            "list-lease [property-id]",
+					Short:          "List the leases of a property",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}},
+				},
+				{
+					RpcMethod:      "ListCashFlow",
+					Use:            "list-cash-flow [property-id]",
+					Short:          "List the cash flows of a property, optionally of a --kind",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}},
+				},
+				{
+					RpcMethod:      "GetIncome",
+					Use:            "get-income [property-id]",
+					Short:          "Gets the occupancy, net operating income and cap rate of a property, over a --window of seconds",
+					Alias:          []string{"show-income"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}},
 				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
@@ -41,22 +115,74 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Skip:      true, // skipped because authority gated
 				},
 				{
-					RpcMethod:      "CreateRate",
-					Use:            "create-rate [symbol] [rate] [name] [description]",
-					Short:          "Create a new rate",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}, {ProtoField: "rate"}, {ProtoField: "name"}, {ProtoField: "description"}},
+					RpcMethod:      "DeleteRate",
+					Use:            "delete-rate [property-id]",
+					Short:          "Delete the rate of a property published before appraisals",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}},
+				},
+				{
+					RpcMethod:      "CreateProperty",
+					Use:            "create-property [parcel-id] [jurisdiction] [property-class]",
+					Short:          "Register a property, with its coordinates, floor area, year built and encumbrances hash as flags",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "parcel_id"}, {ProtoField: "jurisdiction"}, {ProtoField: "property_class"}},
+				},
+				{
+					RpcMethod:      "UpdateProperty",
+					Use:            "update-property [id] [parcel-id] [jurisdiction] [property-class]",
+					Short:          "Update a property, with its coordinates, floor area, year built and encumbrances hash as flags",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "parcel_id"}, {ProtoField: "jurisdiction"}, {ProtoField: "property_class"}},
+				},
+				{
+					RpcMethod:      "DeleteProperty",
+					Use:            "delete-property [id]",
+					Short:          "Delete a property without rate",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "RegisterAppraiser",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RevokeAppraiser",
+					Skip:      true, // skipped because authority gated
 				},
 				{
-					RpcMethod:      "UpdateRate",
-					Use:            "update-rate [symbol] [rate] [name] [description]",
-					Short:          "Update rate",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}, {ProtoField: "rate"}, {ProtoField: "name"}, {ProtoField: "description"}},
+					RpcMethod:      "SubmitAppraisal",
+					Use:            "submit-appraisal [property-id] [value] [methodology] [document-hash]",
+					Short:          "Submit the appraisal of a property in its open valuation cycle",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}, {ProtoField: "value"}, {ProtoField: "methodology"}, {ProtoField: "document_hash"}},
 				},
 				{
-					RpcMethod:      "DeleteRate",
-					Use:            "delete-rate [symbol]",
-					Short:          "Delete rate",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
+					RpcMethod: "SetRegionalIndex",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveRegionalIndex",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "SetPropertyManager",
+					Use:            "set-property-manager [property-id] [manager]",
+					Short:          "Appoint the manager of a property, or dismiss it without manager",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}, {ProtoField: "manager", Optional: true}},
+				},
+				{
+					RpcMethod:      "CreateLease",
+					Use:            "create-lease [property-id] [tenant-hash] [start] [end] [rent]",
+					Short:          "Record a lease of a property, with its escalation and leased area as flags",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}, {ProtoField: "tenant_hash"}, {ProtoField: "start"}, {ProtoField: "end"}, {ProtoField: "rent"}},
+				},
+				{
+					RpcMethod:      "TerminateLease",
+					Use:            "terminate-lease [property-id] [lease-id]",
+					Short:          "Terminate a lease of a property before the end of its term",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}, {ProtoField: "lease_id"}},
+				},
+				{
+					RpcMethod:      "ReportCashFlow",
+					Use:            "report-cash-flow [property-id] [kind] [amount] [period-start] [period-end]",
+					Short:          "Report a rent receipt, of the --lease-id, or an operating expense of a property",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}, {ProtoField: "kind"}, {ProtoField: "amount"}, {ProtoField: "period_start"}, {ProtoField: "period_end"}},
 				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
 
 	"realfin/x/realestate/keeper"
 	"realfin/x/realestate/types"
@@ -33,8 +34,10 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper
-	BankKeeper types.BankKeeper
+	AuthKeeper   types.AuthKeeper
+	BankKeeper   types.BankKeeper
+	OracleKeeper types.OracleKeeper
+	EpochsKeeper epochskeeper.Keeper
 }
 
 type ModuleOutputs struct {
@@ -55,6 +58,8 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.AddressCodec,
 		authority,
+		in.OracleKeeper,
+		&in.EpochsKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
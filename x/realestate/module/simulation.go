@@ -2,7 +2,9 @@ package realestate
 
 import (
 	"math/rand"
+	"strconv"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -18,12 +20,35 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+	// the first accounts are accredited appraisers
+	appraisers := make([]types.Appraiser, 0, 4)
+	for i := 0; i < len(accs) && i < cap(appraisers); i++ {
+		appraisers = append(appraisers, types.Appraiser{Address: accs[i], Name: "appraiser-" + strconv.Itoa(i)})
+	}
 	realestateGenesis := types.GenesisState{
 		Params: types.DefaultParams(),
+		Properties: []types.Property{{Creator: sample.AccAddress(),
+			Id:            0,
+			ParcelId:      "0",
+			Jurisdiction:  "US",
+			PropertyClass: types.PropertyClass_PROPERTY_CLASS_RESIDENTIAL,
+		}, {Creator: sample.AccAddress(),
+			Id:            1,
+			ParcelId:      "1",
+			Jurisdiction:  "US",
+			PropertyClass: types.PropertyClass_PROPERTY_CLASS_COMMERCIAL,
+		}},
+		PropertySeq: 2,
+		Appraisers:  appraisers,
+		RegionalIndices: []types.RegionalIndex{{
+			Jurisdiction: "US",
+			Symbol:       "0",
+			Level:        math.LegacyZeroDec(),
+		}},
 		RateMap: []types.Rate{{Creator: sample.AccAddress(),
-			Symbol: "0",
+			PropertyId: 0,
 		}, {Creator: sample.AccAddress(),
-			Symbol: "1",
+			PropertyId: 1,
 		}}}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&realestateGenesis)
 }
@@ -35,49 +60,109 @@ func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
 	const (
-		opWeightMsgCreateRate          = "op_weight_msg_realestate"
-		defaultWeightMsgCreateRate int = 100
+		opWeightMsgDeleteRate          = "op_weight_msg_realestate"
+		defaultWeightMsgDeleteRate int = 100
 	)
 
-	var weightMsgCreateRate int
-	simState.AppParams.GetOrGenerate(opWeightMsgCreateRate, &weightMsgCreateRate, nil,
+	var weightMsgDeleteRate int
+	simState.AppParams.GetOrGenerate(opWeightMsgDeleteRate, &weightMsgDeleteRate, nil,
 		func(_ *rand.Rand) {
-			weightMsgCreateRate = defaultWeightMsgCreateRate
+			weightMsgDeleteRate = defaultWeightMsgDeleteRate
 		},
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgCreateRate,
-		realestatesimulation.SimulateMsgCreateRate(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+		weightMsgDeleteRate,
+		realestatesimulation.SimulateMsgDeleteRate(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgUpdateRate          = "op_weight_msg_realestate"
-		defaultWeightMsgUpdateRate int = 100
+		opWeightMsgSubmitAppraisal          = "op_weight_msg_realestate"
+		defaultWeightMsgSubmitAppraisal int = 100
 	)
 
-	var weightMsgUpdateRate int
-	simState.AppParams.GetOrGenerate(opWeightMsgUpdateRate, &weightMsgUpdateRate, nil,
+	var weightMsgSubmitAppraisal int
+	simState.AppParams.GetOrGenerate(opWeightMsgSubmitAppraisal, &weightMsgSubmitAppraisal, nil,
 		func(_ *rand.Rand) {
-			weightMsgUpdateRate = defaultWeightMsgUpdateRate
+			weightMsgSubmitAppraisal = defaultWeightMsgSubmitAppraisal
 		},
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgUpdateRate,
-		realestatesimulation.SimulateMsgUpdateRate(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+		weightMsgSubmitAppraisal,
+		realestatesimulation.SimulateMsgSubmitAppraisal(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgDeleteRate          = "op_weight_msg_realestate"
-		defaultWeightMsgDeleteRate int = 100
+		opWeightMsgCreateProperty          = "op_weight_msg_realestate"
+		defaultWeightMsgCreateProperty int = 100
 	)
 
-	var weightMsgDeleteRate int
-	simState.AppParams.GetOrGenerate(opWeightMsgDeleteRate, &weightMsgDeleteRate, nil,
+	var weightMsgCreateProperty int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateProperty, &weightMsgCreateProperty, nil,
 		func(_ *rand.Rand) {
-			weightMsgDeleteRate = defaultWeightMsgDeleteRate
+			weightMsgCreateProperty = defaultWeightMsgCreateProperty
 		},
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgDeleteRate,
-		realestatesimulation.SimulateMsgDeleteRate(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+		weightMsgCreateProperty,
+		realestatesimulation.SimulateMsgCreateProperty(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgUpdateProperty          = "op_weight_msg_realestate"
+		defaultWeightMsgUpdateProperty int = 50
+	)
+
+	var weightMsgUpdateProperty int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdateProperty, &weightMsgUpdateProperty, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateProperty = defaultWeightMsgUpdateProperty
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateProperty,
+		realestatesimulation.SimulateMsgUpdateProperty(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgDeleteProperty          = "op_weight_msg_realestate"
+		defaultWeightMsgDeleteProperty int = 20
+	)
+
+	var weightMsgDeleteProperty int
+	simState.AppParams.GetOrGenerate(opWeightMsgDeleteProperty, &weightMsgDeleteProperty, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeleteProperty = defaultWeightMsgDeleteProperty
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDeleteProperty,
+		realestatesimulation.SimulateMsgDeleteProperty(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgCreateLease          = "op_weight_msg_realestate"
+		defaultWeightMsgCreateLease int = 50
+	)
+
+	var weightMsgCreateLease int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateLease, &weightMsgCreateLease, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateLease = defaultWeightMsgCreateLease
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateLease,
+		realestatesimulation.SimulateMsgCreateLease(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgReportCashFlow          = "op_weight_msg_realestate"
+		defaultWeightMsgReportCashFlow int = 50
+	)
+
+	var weightMsgReportCashFlow int
+	simState.AppParams.GetOrGenerate(opWeightMsgReportCashFlow, &weightMsgReportCashFlow, nil,
+		func(_ *rand.Rand) {
+			weightMsgReportCashFlow = defaultWeightMsgReportCashFlow
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgReportCashFlow,
+		realestatesimulation.SimulateMsgReportCashFlow(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 
 	return operations
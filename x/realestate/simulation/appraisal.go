@@ -0,0 +1,105 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"realfin/x/realestate/keeper"
+	"realfin/x/realestate/types"
+)
+
+func SimulateMsgSubmitAppraisal(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			simAccount = simtypes.Account{}
+			msg        = &types.MsgSubmitAppraisal{}
+			found      = false
+		)
+
+		err := k.Appraiser.Walk(ctx, nil, func(address string, value types.Appraiser) (stop bool, err error) {
+			if value.Revoked {
+				return false, nil
+			}
+			acc, err := ak.AddressCodec().StringToBytes(address)
+			if err != nil {
+				return true, err
+			}
+			account, ok := simtypes.FindAccount(accs, sdk.AccAddress(acc))
+			// pick a random accredited appraiser
+			if ok && (!found || r.Intn(2) == 0) {
+				simAccount, found = account, true
+			}
+			return false, nil
+		})
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no accredited appraiser"), nil, nil
+		}
+		appraiser := simAccount.Address.String()
+
+		// properties of others the appraiser has not appraised in their open
+		// cycle
+		var candidates []uint64
+		err = k.Property.Walk(ctx, nil, func(id uint64, value types.Property) (stop bool, err error) {
+			if value.Creator == appraiser {
+				return false, nil
+			}
+			cycle, err := k.OpenCycle(ctx, id)
+			if err != nil {
+				return true, err
+			}
+			appraisals, err := k.CycleAppraisals(ctx, id, cycle)
+			if err != nil {
+				return true, err
+			}
+			for _, appraisal := range appraisals {
+				if appraisal.Appraiser == appraiser {
+					return false, nil
+				}
+			}
+			candidates = append(candidates, id)
+			return false, nil
+		})
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no property to appraise"), nil, nil
+		}
+
+		msg.Appraiser = appraiser
+		msg.PropertyId = candidates[r.Intn(len(candidates))]
+		msg.Value = uint64(1_000_000 + r.Int63n(9_000_000))
+		msg.Methodology = types.Methodology(1 + r.Intn(len(types.Methodology_name)-1))
+		msg.DocumentHash = hex.EncodeToString([]byte(simtypes.RandStringOfLength(r, types.DocumentHashLength)))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
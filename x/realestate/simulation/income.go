@@ -0,0 +1,147 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"realfin/x/realestate/keeper"
+	"realfin/x/realestate/types"
+)
+
+func SimulateMsgCreateLease(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgCreateLease{}
+		simAccount, property, found, err := randomOwnedProperty(r, ak, k, ctx, accs)
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no property of a known creator"), nil, nil
+		}
+
+		msg.Creator = property.Creator
+		msg.PropertyId = property.Id
+		msg.TenantHash = hex.EncodeToString([]byte(simtypes.RandStringOfLength(r, types.TenantHashLength)))
+		msg.Start = ctx.BlockTime().Add(-time.Duration(r.Int63n(365*24)) * time.Hour)
+		msg.End = msg.Start.AddDate(1+r.Intn(10), 0, 0)
+		msg.Rent = uint64(10_000 + r.Int63n(990_000))
+		msg.Escalation = math.LegacyNewDecWithPrec(r.Int63n(6), 2)
+		msg.Area = uint64(r.Int63n(int64(min(property.FloorArea, 1_000_000)) + 1))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgReportCashFlow(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgReportCashFlow{}
+		simAccount, property, found, err := randomOwnedProperty(r, ak, k, ctx, accs)
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no property of a known creator"), nil, nil
+		}
+
+		// the rents are received for a random lease of the property, the
+		// properties without lease only report expenses
+		var leases []uint64
+		err = k.Lease.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](property.Id), func(key collections.Pair[uint64, uint64], _ types.Lease) (bool, error) {
+			leases = append(leases, key.K2())
+			return false, nil
+		})
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		msg.Kind = types.CashFlowKind_CASH_FLOW_KIND_EXPENSE
+		if len(leases) > 0 && r.Intn(3) > 0 {
+			msg.Kind = types.CashFlowKind_CASH_FLOW_KIND_RENT
+			msg.LeaseId = leases[r.Intn(len(leases))]
+		}
+
+		msg.Creator = property.Creator
+		msg.PropertyId = property.Id
+		msg.Amount = uint64(1_000 + r.Int63n(99_000))
+		msg.PeriodStart = ctx.BlockTime().AddDate(0, -1, 0)
+		msg.PeriodEnd = ctx.BlockTime()
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomOwnedProperty returns a random property whose owner is a simulation
+// account, with the account.
+func randomOwnedProperty(
+	r *rand.Rand,
+	ak types.AuthKeeper,
+	k keeper.Keeper,
+	ctx sdk.Context,
+	accs []simtypes.Account,
+) (simtypes.Account, types.Property, bool, error) {
+	var (
+		simAccount = simtypes.Account{}
+		property   = types.Property{}
+		found      = false
+	)
+
+	err := k.Property.Walk(ctx, nil, func(_ uint64, value types.Property) (stop bool, err error) {
+		acc, err := ak.AddressCodec().StringToBytes(value.Creator)
+		if err != nil {
+			return true, err
+		}
+		account, ok := simtypes.FindAccount(accs, sdk.AccAddress(acc))
+		if ok && (!found || r.Intn(2) == 0) {
+			simAccount, property, found = account, value, true
+		}
+		return false, nil
+	})
+
+	return simAccount, property, found, err
+}
@@ -0,0 +1,193 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"realfin/x/realestate/keeper"
+	"realfin/x/realestate/types"
+)
+
+// simJurisdictions are the jurisdictions of the simulated properties.
+var simJurisdictions = []string{"US", "US-CA", "US-NY", "GB", "DE-BY", "SG"}
+
+// randomProperty returns a random property of the creator.
+func randomProperty(r *rand.Rand, creator string) types.Property {
+	return types.Property{
+		ParcelId:      simtypes.RandStringOfLength(r, 12),
+		Latitude:      r.Int63n(2*types.MaxLatitude+1) - types.MaxLatitude,
+		Longitude:     r.Int63n(2*types.MaxLongitude+1) - types.MaxLongitude,
+		Jurisdiction:  simJurisdictions[r.Intn(len(simJurisdictions))],
+		PropertyClass: types.PropertyClass(1 + r.Intn(len(types.PropertyClass_name)-1)),
+		FloorArea:     uint64(r.Int63n(10_000)),
+		YearBuilt:     uint32(1900 + r.Intn(100)),
+		Creator:       creator,
+	}
+}
+
+func SimulateMsgCreateProperty(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		property := randomProperty(r, simAccount.Address.String())
+		msg := &types.MsgCreateProperty{
+			Creator:       property.Creator,
+			ParcelId:      property.ParcelId,
+			Latitude:      property.Latitude,
+			Longitude:     property.Longitude,
+			Jurisdiction:  property.Jurisdiction,
+			PropertyClass: property.PropertyClass,
+			FloorArea:     property.FloorArea,
+			YearBuilt:     property.YearBuilt,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgUpdateProperty(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			simAccount = simtypes.Account{}
+			property   = types.Property{}
+			msg        = &types.MsgUpdateProperty{}
+			found      = false
+		)
+
+		err := k.Property.Walk(ctx, nil, func(_ uint64, value types.Property) (stop bool, err error) {
+			acc, err := ak.AddressCodec().StringToBytes(value.Creator)
+			if err != nil {
+				return true, err
+			}
+			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
+			property = value
+			return found, nil
+		})
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "property creator not found"), nil, nil
+		}
+
+		// Keep the parcel and move the property to another class
+		updated := randomProperty(r, property.Creator)
+		msg.Creator = property.Creator
+		msg.Id = property.Id
+		msg.ParcelId = property.ParcelId
+		msg.Jurisdiction = property.Jurisdiction
+		msg.Latitude = property.Latitude
+		msg.Longitude = property.Longitude
+		msg.PropertyClass = updated.PropertyClass
+		msg.FloorArea = updated.FloorArea
+		msg.YearBuilt = property.YearBuilt
+		msg.EncumbrancesHash = property.EncumbrancesHash
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgDeleteProperty(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			simAccount = simtypes.Account{}
+			property   = types.Property{}
+			msg        = &types.MsgDeleteProperty{}
+			found      = false
+		)
+
+		err := k.Property.Walk(ctx, nil, func(id uint64, value types.Property) (stop bool, err error) {
+			valued, err := k.Rate.Has(ctx, id)
+			if err != nil || valued {
+				return false, err
+			}
+			appraised, err := k.HasAppraisals(ctx, id)
+			if err != nil || appraised {
+				return false, err
+			}
+			leased, err := k.HasIncomeRecord(ctx, id)
+			if err != nil || leased {
+				return false, err
+			}
+			acc, err := ak.AddressCodec().StringToBytes(value.Creator)
+			if err != nil {
+				return true, err
+			}
+			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
+			property = value
+			return found, nil
+		})
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no property without rate, appraisals and income record of a known creator"), nil, nil
+		}
+		msg.Creator = property.Creator
+		msg.Id = property.Id
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
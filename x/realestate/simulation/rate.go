@@ -2,7 +2,6 @@ package simulation
 
 import (
 	"math/rand"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -14,103 +13,6 @@ import (
 	"realfin/x/realestate/types"
 )
 
-func SimulateMsgCreateRate(
-	ak types.AuthKeeper,
-	bk types.BankKeeper,
-	k keeper.Keeper,
-	txGen client.TxConfig,
-) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-
-		i := r.Int()
-		msg := &types.MsgCreateRate{
-			Creator: simAccount.Address.String(),
-			Symbol:  strconv.Itoa(i),
-		}
-
-		found, err := k.Rate.Has(ctx, msg.Symbol)
-		if err == nil && found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Rate already exist"), nil, nil
-		}
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
-	}
-}
-
-func SimulateMsgUpdateRate(
-	ak types.AuthKeeper,
-	bk types.BankKeeper,
-	k keeper.Keeper,
-	txGen client.TxConfig,
-) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount = simtypes.Account{}
-			rate       = types.Rate{}
-			msg        = &types.MsgUpdateRate{}
-			found      = false
-		)
-
-		var allRate []types.Rate
-		err := k.Rate.Walk(ctx, nil, func(key string, value types.Rate) (stop bool, err error) {
-			allRate = append(allRate, value)
-			return false, nil
-		})
-		if err != nil {
-			panic(err)
-		}
-
-		for _, obj := range allRate {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
-
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				rate = obj
-				break
-			}
-		}
-		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "rate creator not found"), nil, nil
-		}
-		msg.Creator = simAccount.Address.String()
-		msg.Symbol = rate.Symbol
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
-	}
-}
-
 func SimulateMsgDeleteRate(
 	ak types.AuthKeeper,
 	bk types.BankKeeper,
@@ -122,12 +24,12 @@ func SimulateMsgDeleteRate(
 		var (
 			simAccount = simtypes.Account{}
 			rate       = types.Rate{}
-			msg        = &types.MsgUpdateRate{}
+			msg        = &types.MsgDeleteRate{}
 			found      = false
 		)
 
 		var allRate []types.Rate
-		err := k.Rate.Walk(ctx, nil, func(key string, value types.Rate) (stop bool, err error) {
+		err := k.Rate.Walk(ctx, nil, func(key uint64, value types.Rate) (stop bool, err error) {
 			allRate = append(allRate, value)
 			return false, nil
 		})
@@ -151,7 +53,7 @@ func SimulateMsgDeleteRate(
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "rate creator not found"), nil, nil
 		}
 		msg.Creator = simAccount.Address.String()
-		msg.Symbol = rate.Symbol
+		msg.PropertyId = rate.PropertyId
 
 		txCtx := simulation.OperationInput{
 			R:               r,
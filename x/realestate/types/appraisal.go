@@ -0,0 +1,69 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/math"
+)
+
+// DocumentHashLength is the length in bytes of the hash of an appraisal
+// report.
+const DocumentHashLength = 32
+
+// Validate performs basic validation of the appraisal value, methodology and
+// document hash.
+func (a Appraisal) Validate() error {
+	if a.Value == 0 {
+		return fmt.Errorf("value must be positive")
+	}
+	if _, ok := Methodology_name[int32(a.Methodology)]; !ok || a.Methodology == Methodology_METHODOLOGY_UNSPECIFIED {
+		return fmt.Errorf("invalid methodology %d", a.Methodology)
+	}
+
+	hash, err := hex.DecodeString(a.DocumentHash)
+	if err != nil {
+		return fmt.Errorf("document hash is not hex encoded: %w", err)
+	}
+	if len(hash) != DocumentHashLength {
+		return fmt.Errorf("document hash must be %d bytes, got %d", DocumentHashLength, len(hash))
+	}
+
+	return nil
+}
+
+// TrimmedMean returns the mean of the values without the trim lowest and the
+// trim highest ones, rounded down. The values are trimmed less if fewer than
+// 2*trim+1 of them are given, so that at least one is kept.
+func TrimmedMean(values []uint64, trim uint32) uint64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	n := int(trim)
+	if limit := (len(sorted) - 1) / 2; n > limit {
+		n = limit
+	}
+	kept := sorted[n : len(sorted)-n]
+
+	sum := math.ZeroInt()
+	for _, value := range kept {
+		sum = sum.Add(math.NewIntFromUint64(value))
+	}
+
+	return sum.QuoRaw(int64(len(kept))).Uint64()
+}
+
+// IsOutlier reports whether the value deviates from the valuation by more
+// than the relative deviation. A zero deviation flags no outlier.
+func IsOutlier(value, valuation uint64, deviation math.LegacyDec) bool {
+	if deviation.IsNil() || !deviation.IsPositive() || valuation == 0 {
+		return false
+	}
+
+	diff := math.NewIntFromUint64(value).Sub(math.NewIntFromUint64(valuation)).Abs()
+	return math.LegacyNewDecFromInt(diff).QuoInt(math.NewIntFromUint64(valuation)).GT(deviation)
+}
@@ -0,0 +1,973 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/realestate/v1/appraisal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Methodology defines the approach of an appraisal.
+type Methodology int32
+
+const (
+	// METHODOLOGY_UNSPECIFIED is an invalid methodology.
+	Methodology_METHODOLOGY_UNSPECIFIED Methodology = 0
+	// METHODOLOGY_COMPARABLES values the property from the prices of
+	// comparable sales.
+	Methodology_METHODOLOGY_COMPARABLES Methodology = 1
+	// METHODOLOGY_INCOME values the property from the income it produces.
+	Methodology_METHODOLOGY_INCOME Methodology = 2
+	// METHODOLOGY_COST values the property from the cost of replacing it.
+	Methodology_METHODOLOGY_COST Methodology = 3
+)
+
+var Methodology_name = map[int32]string{
+	0: "METHODOLOGY_UNSPECIFIED",
+	1: "METHODOLOGY_COMPARABLES",
+	2: "METHODOLOGY_INCOME",
+	3: "METHODOLOGY_COST",
+}
+
+var Methodology_value = map[string]int32{
+	"METHODOLOGY_UNSPECIFIED": 0,
+	"METHODOLOGY_COMPARABLES": 1,
+	"METHODOLOGY_INCOME":      2,
+	"METHODOLOGY_COST":        3,
+}
+
+func (x Methodology) String() string {
+	return proto.EnumName(Methodology_name, int32(x))
+}
+
+func (Methodology) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_73975dd416889640, []int{0}
+}
+
+// Appraiser defines an appraiser accredited by governance to appraise
+// properties.
+type Appraiser struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// revoked is set when governance revokes the accreditation of the
+	// appraiser.
+	Revoked bool `protobuf:"varint,3,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (m *Appraiser) Reset()         { *m = Appraiser{} }
+func (m *Appraiser) String() string { return proto.CompactTextString(m) }
+func (*Appraiser) ProtoMessage()    {}
+func (*Appraiser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73975dd416889640, []int{0}
+}
+func (m *Appraiser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Appraiser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Appraiser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Appraiser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Appraiser.Merge(m, src)
+}
+func (m *Appraiser) XXX_Size() int {
+	return m.Size()
+}
+func (m *Appraiser) XXX_DiscardUnknown() {
+	xxx_messageInfo_Appraiser.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Appraiser proto.InternalMessageInfo
+
+func (m *Appraiser) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Appraiser) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Appraiser) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+// Appraisal is the value of a property submitted by an appraiser in a
+// valuation cycle.
+type Appraisal struct {
+	Id          uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PropertyId  uint64      `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Cycle       uint64      `protobuf:"varint,3,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	Appraiser   string      `protobuf:"bytes,4,opt,name=appraiser,proto3" json:"appraiser,omitempty"`
+	Value       uint64      `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	Methodology Methodology `protobuf:"varint,6,opt,name=methodology,proto3,enum=realfin.realestate.v1.Methodology" json:"methodology,omitempty"`
+	// document_hash is the hex encoded SHA-256 hash of the appraisal report.
+	DocumentHash string    `protobuf:"bytes,7,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	SubmittedAt  time.Time `protobuf:"bytes,8,opt,name=submitted_at,json=submittedAt,proto3,stdtime" json:"submitted_at"`
+	// outlier is set when the cycle is finalised if the value deviates from the
+	// valuation by more than the outlier deviation param.
+	Outlier bool `protobuf:"varint,9,opt,name=outlier,proto3" json:"outlier,omitempty"`
+}
+
+func (m *Appraisal) Reset()         { *m = Appraisal{} }
+func (m *Appraisal) String() string { return proto.CompactTextString(m) }
+func (*Appraisal) ProtoMessage()    {}
+func (*Appraisal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73975dd416889640, []int{1}
+}
+func (m *Appraisal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Appraisal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Appraisal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Appraisal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Appraisal.Merge(m, src)
+}
+func (m *Appraisal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Appraisal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Appraisal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Appraisal proto.InternalMessageInfo
+
+func (m *Appraisal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Appraisal) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *Appraisal) GetCycle() uint64 {
+	if m != nil {
+		return m.Cycle
+	}
+	return 0
+}
+
+func (m *Appraisal) GetAppraiser() string {
+	if m != nil {
+		return m.Appraiser
+	}
+	return ""
+}
+
+func (m *Appraisal) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Appraisal) GetMethodology() Methodology {
+	if m != nil {
+		return m.Methodology
+	}
+	return Methodology_METHODOLOGY_UNSPECIFIED
+}
+
+func (m *Appraisal) GetDocumentHash() string {
+	if m != nil {
+		return m.DocumentHash
+	}
+	return ""
+}
+
+func (m *Appraisal) GetSubmittedAt() time.Time {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return time.Time{}
+}
+
+func (m *Appraisal) GetOutlier() bool {
+	if m != nil {
+		return m.Outlier
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("realfin.realestate.v1.Methodology", Methodology_name, Methodology_value)
+	proto.RegisterType((*Appraiser)(nil), "realfin.realestate.v1.Appraiser")
+	proto.RegisterType((*Appraisal)(nil), "realfin.realestate.v1.Appraisal")
+}
+
+func init() {
+	proto.RegisterFile("realfin/realestate/v1/appraisal.proto", fileDescriptor_73975dd416889640)
+}
+
+var fileDescriptor_73975dd416889640 = []byte{
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x6f, 0x12, 0x41,
+	0x14, 0x67, 0x28, 0x2d, 0x65, 0x68, 0x1b, 0x9c, 0xa0, 0x8e, 0x98, 0x2c, 0x04, 0x63, 0x42, 0x9a,
+	0xb8, 0x9b, 0xa2, 0xf1, 0xce, 0x3f, 0x2d, 0x09, 0x94, 0x66, 0xc1, 0x83, 0x5e, 0xc8, 0xc0, 0x4e,
+	0x97, 0x8d, 0xbb, 0xcc, 0x66, 0x66, 0x96, 0xc8, 0xb7, 0xe8, 0xc7, 0xf0, 0xe8, 0xc1, 0x0f, 0xd1,
+	0x63, 0xe3, 0xc9, 0x93, 0x1a, 0x38, 0xf8, 0x01, 0xfc, 0x02, 0x66, 0x67, 0xd8, 0x82, 0xc6, 0xc4,
+	0xcb, 0xee, 0xfc, 0xde, 0xfb, 0xbd, 0xf7, 0x7b, 0xf3, 0x7e, 0x19, 0xf8, 0x94, 0x53, 0xe2, 0x5f,
+	0x79, 0x73, 0x2b, 0xfe, 0x53, 0x21, 0x89, 0xa4, 0xd6, 0xe2, 0xcc, 0x22, 0x61, 0xc8, 0x89, 0x27,
+	0x88, 0x6f, 0x86, 0x9c, 0x49, 0x86, 0xee, 0x6f, 0x68, 0xe6, 0x96, 0x66, 0x2e, 0xce, 0x4a, 0xf7,
+	0x48, 0xe0, 0xcd, 0x99, 0xa5, 0xbe, 0x9a, 0x59, 0x7a, 0x34, 0x65, 0x22, 0x60, 0x62, 0xac, 0x90,
+	0xa5, 0xc1, 0x26, 0x55, 0x74, 0x99, 0xcb, 0x74, 0x3c, 0x3e, 0x6d, 0xa2, 0x65, 0x97, 0x31, 0xd7,
+	0xa7, 0x96, 0x42, 0x93, 0xe8, 0xca, 0x92, 0x5e, 0x10, 0x2b, 0x04, 0xa1, 0x26, 0x54, 0x03, 0x98,
+	0x6b, 0xe8, 0x71, 0x28, 0x47, 0x75, 0x98, 0x25, 0x8e, 0xc3, 0xa9, 0x10, 0x18, 0x54, 0x40, 0x2d,
+	0xd7, 0xc4, 0x5f, 0x3e, 0x3f, 0x2b, 0x6e, 0x64, 0x1a, 0x3a, 0x33, 0x94, 0xdc, 0x9b, 0xbb, 0x76,
+	0x42, 0x44, 0x08, 0x66, 0xe6, 0x24, 0xa0, 0x38, 0x1d, 0x17, 0xd8, 0xea, 0x8c, 0x30, 0xcc, 0x72,
+	0xba, 0x60, 0xef, 0xa9, 0x83, 0xf7, 0x2a, 0xa0, 0x76, 0x68, 0x27, 0xb0, 0xfa, 0x2b, 0x7d, 0xa7,
+	0x47, 0x7c, 0x74, 0x02, 0xd3, 0x9e, 0xa3, 0xa4, 0x32, 0x76, 0xda, 0x73, 0x50, 0x19, 0xe6, 0x43,
+	0xce, 0x42, 0xca, 0xe5, 0x72, 0xec, 0x39, 0xaa, 0x65, 0xc6, 0x86, 0x49, 0xa8, 0xeb, 0xa0, 0x22,
+	0xdc, 0x9f, 0x2e, 0xa7, 0x3e, 0x55, 0x6d, 0x33, 0xb6, 0x06, 0xe8, 0x25, 0xcc, 0x91, 0xe4, 0x0e,
+	0x38, 0xf3, 0x9f, 0xc1, 0xb7, 0xd4, 0xb8, 0xdb, 0x82, 0xf8, 0x11, 0xc5, 0xfb, 0xba, 0x9b, 0x02,
+	0xa8, 0x0d, 0xf3, 0x01, 0x95, 0x33, 0xe6, 0x30, 0x9f, 0xb9, 0x4b, 0x7c, 0x50, 0x01, 0xb5, 0x93,
+	0x7a, 0xd5, 0xfc, 0xa7, 0x47, 0x66, 0x7f, 0xcb, 0xb4, 0x77, 0xcb, 0xd0, 0x13, 0x78, 0xec, 0xb0,
+	0x69, 0x14, 0xd0, 0xb9, 0x1c, 0xcf, 0x88, 0x98, 0xe1, 0xac, 0xda, 0xcf, 0x51, 0x12, 0x3c, 0x27,
+	0x62, 0x86, 0x7a, 0xf0, 0x48, 0x44, 0x93, 0xc0, 0x93, 0x92, 0x3a, 0x63, 0x22, 0xf1, 0x61, 0x05,
+	0xd4, 0xf2, 0xf5, 0x92, 0xa9, 0x4d, 0x33, 0x13, 0xd3, 0xcc, 0x51, 0x62, 0x5a, 0xf3, 0xf8, 0xe6,
+	0x5b, 0x39, 0x75, 0xfd, 0xbd, 0x0c, 0x3e, 0xfe, 0xfc, 0x74, 0x0a, 0xec, 0xfc, 0x5d, 0x79, 0x43,
+	0xc6, 0x5b, 0x67, 0x91, 0xf4, 0x3d, 0xca, 0x71, 0x4e, 0x6f, 0x7d, 0x03, 0x4f, 0x23, 0x98, 0xdf,
+	0x19, 0x14, 0x3d, 0x86, 0x0f, 0xfb, 0x9d, 0xd1, 0xf9, 0xa0, 0x3d, 0xe8, 0x0d, 0x5e, 0xbf, 0x1d,
+	0xbf, 0xb9, 0x18, 0x5e, 0x76, 0x5a, 0xdd, 0x57, 0xdd, 0x4e, 0xbb, 0x90, 0xfa, 0x3b, 0xd9, 0x1a,
+	0xf4, 0x2f, 0x1b, 0x76, 0xa3, 0xd9, 0xeb, 0x0c, 0x0b, 0x00, 0x3d, 0x80, 0x68, 0x37, 0xd9, 0xbd,
+	0x68, 0x0d, 0xfa, 0x9d, 0x42, 0x1a, 0x15, 0x61, 0xe1, 0xcf, 0xa2, 0xe1, 0xa8, 0xb0, 0xd7, 0x7c,
+	0x71, 0xb3, 0x32, 0xc0, 0xed, 0xca, 0x00, 0x3f, 0x56, 0x06, 0xb8, 0x5e, 0x1b, 0xa9, 0xdb, 0xb5,
+	0x91, 0xfa, 0xba, 0x36, 0x52, 0xef, 0x4a, 0xc9, 0xc3, 0xf8, 0xb0, 0xfb, 0x34, 0xe4, 0x32, 0xa4,
+	0x62, 0x72, 0xa0, 0xae, 0xfd, 0xfc, 0xf7, 0x00, 0x26, 0x2e, 0xb6, 0x47, 0x3d, 0x03, 0x00, 0x00,
+}
+
+func (m *Appraiser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Appraiser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Appraiser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAppraisal(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAppraisal(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Appraisal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Appraisal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Appraisal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Outlier {
+		i--
+		if m.Outlier {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmittedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAppraisal(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintAppraisal(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Methodology != 0 {
+		i = encodeVarintAppraisal(dAtA, i, uint64(m.Methodology))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Value != 0 {
+		i = encodeVarintAppraisal(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Appraiser) > 0 {
+		i -= len(m.Appraiser)
+		copy(dAtA[i:], m.Appraiser)
+		i = encodeVarintAppraisal(dAtA, i, uint64(len(m.Appraiser)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Cycle != 0 {
+		i = encodeVarintAppraisal(dAtA, i, uint64(m.Cycle))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintAppraisal(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintAppraisal(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAppraisal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAppraisal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Appraiser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAppraisal(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAppraisal(uint64(l))
+	}
+	if m.Revoked {
+		n += 2
+	}
+	return n
+}
+
+func (m *Appraisal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAppraisal(uint64(m.Id))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovAppraisal(uint64(m.PropertyId))
+	}
+	if m.Cycle != 0 {
+		n += 1 + sovAppraisal(uint64(m.Cycle))
+	}
+	l = len(m.Appraiser)
+	if l > 0 {
+		n += 1 + l + sovAppraisal(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovAppraisal(uint64(m.Value))
+	}
+	if m.Methodology != 0 {
+		n += 1 + sovAppraisal(uint64(m.Methodology))
+	}
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovAppraisal(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmittedAt)
+	n += 1 + l + sovAppraisal(uint64(l))
+	if m.Outlier {
+		n += 2
+	}
+	return n
+}
+
+func sovAppraisal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAppraisal(x uint64) (n int) {
+	return sovAppraisal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Appraiser) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAppraisal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Appraiser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Appraiser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppraisal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppraisal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppraisal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppraisal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAppraisal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAppraisal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Appraisal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAppraisal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Appraisal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Appraisal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cycle", wireType)
+			}
+			m.Cycle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cycle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appraiser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppraisal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppraisal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appraiser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methodology", wireType)
+			}
+			m.Methodology = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Methodology |= Methodology(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppraisal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppraisal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppraisal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppraisal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SubmittedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outlier", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Outlier = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAppraisal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAppraisal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAppraisal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAppraisal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAppraisal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAppraisal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAppraisal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAppraisal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAppraisal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAppraisal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAppraisal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/realestate/types"
+)
+
+func TestTrimmedMean(t *testing.T) {
+	tests := []struct {
+		desc   string
+		values []uint64
+		trim   uint32
+		mean   uint64
+	}{
+		{desc: "empty", trim: 1},
+		{desc: "no trim", values: []uint64{100, 200, 600}, mean: 300},
+		{desc: "trim one", values: []uint64{600, 100, 200, 300}, trim: 1, mean: 250},
+		{desc: "trim capped to keep one", values: []uint64{100, 500, 200}, trim: 5, mean: 200},
+		{desc: "trim capped to keep two", values: []uint64{100, 400, 200, 300}, trim: 2, mean: 250},
+		{desc: "rounded down", values: []uint64{1, 2}, mean: 1},
+		{desc: "no overflow", values: []uint64{^uint64(0), ^uint64(0)}, mean: ^uint64(0)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.mean, types.TrimmedMean(tc.values, tc.trim))
+		})
+	}
+}
+
+func TestIsOutlier(t *testing.T) {
+	deviation := math.LegacyNewDecWithPrec(20, 2)
+
+	require.False(t, types.IsOutlier(1_200_000, 1_000_000, deviation))
+	require.False(t, types.IsOutlier(800_000, 1_000_000, deviation))
+	require.True(t, types.IsOutlier(1_200_001, 1_000_000, deviation))
+	require.True(t, types.IsOutlier(799_999, 1_000_000, deviation))
+	require.False(t, types.IsOutlier(5_000_000, 1_000_000, math.LegacyZeroDec()))
+}
@@ -8,13 +8,23 @@ import (
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
-		&MsgCreateRate{},
-		&MsgUpdateRate{},
 		&MsgDeleteRate{},
+		&MsgCreateProperty{},
+		&MsgUpdateProperty{},
+		&MsgDeleteProperty{},
+		&MsgSubmitAppraisal{},
+		&MsgSetPropertyManager{},
+		&MsgCreateLease{},
+		&MsgTerminateLease{},
+		&MsgReportCashFlow{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRegisterAppraiser{},
+		&MsgRevokeAppraiser{},
+		&MsgSetRegionalIndex{},
+		&MsgRemoveRegionalIndex{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -8,5 +8,16 @@ import (
 
 // x/realestate module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner       = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidProperty     = errors.Register(ModuleName, 1101, "invalid property")
+	ErrDuplicateParcel     = errors.Register(ModuleName, 1102, "parcel already registered in the jurisdiction")
+	ErrPropertyValued      = errors.Register(ModuleName, 1103, "property has a valuation")
+	ErrAppraiserRegistered = errors.Register(ModuleName, 1104, "appraiser already accredited")
+	ErrNotAccredited       = errors.Register(ModuleName, 1105, "not an accredited appraiser")
+	ErrInvalidAppraisal    = errors.Register(ModuleName, 1106, "invalid appraisal")
+	ErrAlreadyAppraised    = errors.Register(ModuleName, 1107, "property already appraised in the cycle")
+	ErrInvalidIndex        = errors.Register(ModuleName, 1108, "invalid regional index")
+	ErrInvalidLease        = errors.Register(ModuleName, 1109, "invalid lease")
+	ErrInvalidCashFlow     = errors.Register(ModuleName, 1110, "invalid cash flow")
+	ErrPropertyLeased      = errors.Register(ModuleName, 1111, "property has an income record")
 )
@@ -0,0 +1,10 @@
+package types
+
+// realestate module event types
+const (
+	EventTypeValuationExpired = "valuation_expired"
+
+	AttributeKeyPropertyID = "property_id"
+	AttributeKeyCycle      = "cycle"
+	AttributeKeyValidUntil = "valid_until"
+)
@@ -0,0 +1,3678 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/realestate/v1/events.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventPropertyCreated is emitted when a property is registered.
+type EventPropertyCreated struct {
+	Id            uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator       string        `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Jurisdiction  string        `protobuf:"bytes,3,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	ParcelId      string        `protobuf:"bytes,4,opt,name=parcel_id,json=parcelId,proto3" json:"parcel_id,omitempty"`
+	PropertyClass PropertyClass `protobuf:"varint,5,opt,name=property_class,json=propertyClass,proto3,enum=realfin.realestate.v1.PropertyClass" json:"property_class,omitempty"`
+}
+
+func (m *EventPropertyCreated) Reset()         { *m = EventPropertyCreated{} }
+func (m *EventPropertyCreated) String() string { return proto.CompactTextString(m) }
+func (*EventPropertyCreated) ProtoMessage()    {}
+func (*EventPropertyCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{0}
+}
+func (m *EventPropertyCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPropertyCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPropertyCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPropertyCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPropertyCreated.Merge(m, src)
+}
+func (m *EventPropertyCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPropertyCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPropertyCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPropertyCreated proto.InternalMessageInfo
+
+func (m *EventPropertyCreated) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventPropertyCreated) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventPropertyCreated) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
+}
+
+func (m *EventPropertyCreated) GetParcelId() string {
+	if m != nil {
+		return m.ParcelId
+	}
+	return ""
+}
+
+func (m *EventPropertyCreated) GetPropertyClass() PropertyClass {
+	if m != nil {
+		return m.PropertyClass
+	}
+	return PropertyClass_PROPERTY_CLASS_UNSPECIFIED
+}
+
+// EventPropertyUpdated is emitted when the creator of a property updates it.
+type EventPropertyUpdated struct {
+	Id            uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Jurisdiction  string        `protobuf:"bytes,2,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	ParcelId      string        `protobuf:"bytes,3,opt,name=parcel_id,json=parcelId,proto3" json:"parcel_id,omitempty"`
+	PropertyClass PropertyClass `protobuf:"varint,4,opt,name=property_class,json=propertyClass,proto3,enum=realfin.realestate.v1.PropertyClass" json:"property_class,omitempty"`
+}
+
+func (m *EventPropertyUpdated) Reset()         { *m = EventPropertyUpdated{} }
+func (m *EventPropertyUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPropertyUpdated) ProtoMessage()    {}
+func (*EventPropertyUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{1}
+}
+func (m *EventPropertyUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPropertyUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPropertyUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPropertyUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPropertyUpdated.Merge(m, src)
+}
+func (m *EventPropertyUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPropertyUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPropertyUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPropertyUpdated proto.InternalMessageInfo
+
+func (m *EventPropertyUpdated) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventPropertyUpdated) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
+}
+
+func (m *EventPropertyUpdated) GetParcelId() string {
+	if m != nil {
+		return m.ParcelId
+	}
+	return ""
+}
+
+func (m *EventPropertyUpdated) GetPropertyClass() PropertyClass {
+	if m != nil {
+		return m.PropertyClass
+	}
+	return PropertyClass_PROPERTY_CLASS_UNSPECIFIED
+}
+
+// EventPropertyDeleted is emitted when the creator of a property deletes it.
+type EventPropertyDeleted struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *EventPropertyDeleted) Reset()         { *m = EventPropertyDeleted{} }
+func (m *EventPropertyDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventPropertyDeleted) ProtoMessage()    {}
+func (*EventPropertyDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{2}
+}
+func (m *EventPropertyDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPropertyDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPropertyDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPropertyDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPropertyDeleted.Merge(m, src)
+}
+func (m *EventPropertyDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPropertyDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPropertyDeleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPropertyDeleted proto.InternalMessageInfo
+
+func (m *EventPropertyDeleted) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// EventAppraiserRegistered is emitted when governance accredits an appraiser.
+type EventAppraiserRegistered struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *EventAppraiserRegistered) Reset()         { *m = EventAppraiserRegistered{} }
+func (m *EventAppraiserRegistered) String() string { return proto.CompactTextString(m) }
+func (*EventAppraiserRegistered) ProtoMessage()    {}
+func (*EventAppraiserRegistered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{3}
+}
+func (m *EventAppraiserRegistered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAppraiserRegistered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAppraiserRegistered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAppraiserRegistered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAppraiserRegistered.Merge(m, src)
+}
+func (m *EventAppraiserRegistered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAppraiserRegistered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAppraiserRegistered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAppraiserRegistered proto.InternalMessageInfo
+
+func (m *EventAppraiserRegistered) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventAppraiserRegistered) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// EventAppraiserRevoked is emitted when governance revokes the accreditation
+// of an appraiser.
+type EventAppraiserRevoked struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventAppraiserRevoked) Reset()         { *m = EventAppraiserRevoked{} }
+func (m *EventAppraiserRevoked) String() string { return proto.CompactTextString(m) }
+func (*EventAppraiserRevoked) ProtoMessage()    {}
+func (*EventAppraiserRevoked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{4}
+}
+func (m *EventAppraiserRevoked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAppraiserRevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAppraiserRevoked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAppraiserRevoked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAppraiserRevoked.Merge(m, src)
+}
+func (m *EventAppraiserRevoked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAppraiserRevoked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAppraiserRevoked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAppraiserRevoked proto.InternalMessageInfo
+
+func (m *EventAppraiserRevoked) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// EventAppraisalSubmitted is emitted when an appraiser submits an appraisal.
+type EventAppraisalSubmitted struct {
+	Id          uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PropertyId  uint64      `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Cycle       uint64      `protobuf:"varint,3,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	Appraiser   string      `protobuf:"bytes,4,opt,name=appraiser,proto3" json:"appraiser,omitempty"`
+	Value       uint64      `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	Methodology Methodology `protobuf:"varint,6,opt,name=methodology,proto3,enum=realfin.realestate.v1.Methodology" json:"methodology,omitempty"`
+}
+
+func (m *EventAppraisalSubmitted) Reset()         { *m = EventAppraisalSubmitted{} }
+func (m *EventAppraisalSubmitted) String() string { return proto.CompactTextString(m) }
+func (*EventAppraisalSubmitted) ProtoMessage()    {}
+func (*EventAppraisalSubmitted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{5}
+}
+func (m *EventAppraisalSubmitted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAppraisalSubmitted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAppraisalSubmitted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAppraisalSubmitted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAppraisalSubmitted.Merge(m, src)
+}
+func (m *EventAppraisalSubmitted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAppraisalSubmitted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAppraisalSubmitted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAppraisalSubmitted proto.InternalMessageInfo
+
+func (m *EventAppraisalSubmitted) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventAppraisalSubmitted) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *EventAppraisalSubmitted) GetCycle() uint64 {
+	if m != nil {
+		return m.Cycle
+	}
+	return 0
+}
+
+func (m *EventAppraisalSubmitted) GetAppraiser() string {
+	if m != nil {
+		return m.Appraiser
+	}
+	return ""
+}
+
+func (m *EventAppraisalSubmitted) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *EventAppraisalSubmitted) GetMethodology() Methodology {
+	if m != nil {
+		return m.Methodology
+	}
+	return Methodology_METHODOLOGY_UNSPECIFIED
+}
+
+// EventValuationFinalized is emitted when a valuation cycle reaches its
+// quorum and the property is valued at the trimmed mean of its appraisals.
+type EventValuationFinalized struct {
+	PropertyId uint64 `protobuf:"varint,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Cycle      uint64 `protobuf:"varint,2,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	Value      uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Appraisals uint32 `protobuf:"varint,4,opt,name=appraisals,proto3" json:"appraisals,omitempty"`
+	// outliers are the ids of the appraisals flagged as outliers.
+	Outliers []uint64 `protobuf:"varint,5,rep,packed,name=outliers,proto3" json:"outliers,omitempty"`
+}
+
+func (m *EventValuationFinalized) Reset()         { *m = EventValuationFinalized{} }
+func (m *EventValuationFinalized) String() string { return proto.CompactTextString(m) }
+func (*EventValuationFinalized) ProtoMessage()    {}
+func (*EventValuationFinalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{6}
+}
+func (m *EventValuationFinalized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventValuationFinalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventValuationFinalized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventValuationFinalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValuationFinalized.Merge(m, src)
+}
+func (m *EventValuationFinalized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventValuationFinalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValuationFinalized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventValuationFinalized proto.InternalMessageInfo
+
+func (m *EventValuationFinalized) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *EventValuationFinalized) GetCycle() uint64 {
+	if m != nil {
+		return m.Cycle
+	}
+	return 0
+}
+
+func (m *EventValuationFinalized) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *EventValuationFinalized) GetAppraisals() uint32 {
+	if m != nil {
+		return m.Appraisals
+	}
+	return 0
+}
+
+func (m *EventValuationFinalized) GetOutliers() []uint64 {
+	if m != nil {
+		return m.Outliers
+	}
+	return nil
+}
+
+// EventRegionalIndexSet is emitted when governance maps a jurisdiction to the
+// oracle symbol of its regional index.
+type EventRegionalIndexSet struct {
+	Jurisdiction string `protobuf:"bytes,1,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	Symbol       string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (m *EventRegionalIndexSet) Reset()         { *m = EventRegionalIndexSet{} }
+func (m *EventRegionalIndexSet) String() string { return proto.CompactTextString(m) }
+func (*EventRegionalIndexSet) ProtoMessage()    {}
+func (*EventRegionalIndexSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{7}
+}
+func (m *EventRegionalIndexSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRegionalIndexSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRegionalIndexSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRegionalIndexSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRegionalIndexSet.Merge(m, src)
+}
+func (m *EventRegionalIndexSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRegionalIndexSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRegionalIndexSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRegionalIndexSet proto.InternalMessageInfo
+
+func (m *EventRegionalIndexSet) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
+}
+
+func (m *EventRegionalIndexSet) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+// EventRegionalIndexRemoved is emitted when governance removes the regional
+// index of a jurisdiction.
+type EventRegionalIndexRemoved struct {
+	Jurisdiction string `protobuf:"bytes,1,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+}
+
+func (m *EventRegionalIndexRemoved) Reset()         { *m = EventRegionalIndexRemoved{} }
+func (m *EventRegionalIndexRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventRegionalIndexRemoved) ProtoMessage()    {}
+func (*EventRegionalIndexRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{8}
+}
+func (m *EventRegionalIndexRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRegionalIndexRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRegionalIndexRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRegionalIndexRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRegionalIndexRemoved.Merge(m, src)
+}
+func (m *EventRegionalIndexRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRegionalIndexRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRegionalIndexRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRegionalIndexRemoved proto.InternalMessageInfo
+
+func (m *EventRegionalIndexRemoved) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
+}
+
+// EventRegionalIndexRefreshed is emitted when a regional index is refreshed
+// from its oracle price and the indexed values of the properties of its
+// jurisdiction are marked to model.
+type EventRegionalIndexRefreshed struct {
+	Jurisdiction string                      `protobuf:"bytes,1,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	Symbol       string                      `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Level        cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=level,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"level"`
+	// properties is the number of properties whose indexed value was refreshed.
+	Properties uint64 `protobuf:"varint,4,opt,name=properties,proto3" json:"properties,omitempty"`
+}
+
+func (m *EventRegionalIndexRefreshed) Reset()         { *m = EventRegionalIndexRefreshed{} }
+func (m *EventRegionalIndexRefreshed) String() string { return proto.CompactTextString(m) }
+func (*EventRegionalIndexRefreshed) ProtoMessage()    {}
+func (*EventRegionalIndexRefreshed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{9}
+}
+func (m *EventRegionalIndexRefreshed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRegionalIndexRefreshed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRegionalIndexRefreshed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRegionalIndexRefreshed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRegionalIndexRefreshed.Merge(m, src)
+}
+func (m *EventRegionalIndexRefreshed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRegionalIndexRefreshed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRegionalIndexRefreshed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRegionalIndexRefreshed proto.InternalMessageInfo
+
+func (m *EventRegionalIndexRefreshed) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
+}
+
+func (m *EventRegionalIndexRefreshed) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *EventRegionalIndexRefreshed) GetProperties() uint64 {
+	if m != nil {
+		return m.Properties
+	}
+	return 0
+}
+
+// EventPropertyManagerSet is emitted when the owner of a property appoints or
+// dismisses its manager.
+type EventPropertyManagerSet struct {
+	PropertyId uint64 `protobuf:"varint,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// manager is the new manager, empty when the manager was dismissed.
+	Manager string `protobuf:"bytes,2,opt,name=manager,proto3" json:"manager,omitempty"`
+}
+
+func (m *EventPropertyManagerSet) Reset()         { *m = EventPropertyManagerSet{} }
+func (m *EventPropertyManagerSet) String() string { return proto.CompactTextString(m) }
+func (*EventPropertyManagerSet) ProtoMessage()    {}
+func (*EventPropertyManagerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{10}
+}
+func (m *EventPropertyManagerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPropertyManagerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPropertyManagerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPropertyManagerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPropertyManagerSet.Merge(m, src)
+}
+func (m *EventPropertyManagerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPropertyManagerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPropertyManagerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPropertyManagerSet proto.InternalMessageInfo
+
+func (m *EventPropertyManagerSet) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *EventPropertyManagerSet) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
+}
+
+// EventLeaseCreated is emitted when a lease of a property is recorded.
+type EventLeaseCreated struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PropertyId uint64 `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Rent       uint64 `protobuf:"varint,3,opt,name=rent,proto3" json:"rent,omitempty"`
+}
+
+func (m *EventLeaseCreated) Reset()         { *m = EventLeaseCreated{} }
+func (m *EventLeaseCreated) String() string { return proto.CompactTextString(m) }
+func (*EventLeaseCreated) ProtoMessage()    {}
+func (*EventLeaseCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{11}
+}
+func (m *EventLeaseCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLeaseCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLeaseCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLeaseCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLeaseCreated.Merge(m, src)
+}
+func (m *EventLeaseCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLeaseCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLeaseCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLeaseCreated proto.InternalMessageInfo
+
+func (m *EventLeaseCreated) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventLeaseCreated) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *EventLeaseCreated) GetRent() uint64 {
+	if m != nil {
+		return m.Rent
+	}
+	return 0
+}
+
+// EventLeaseTerminated is emitted when a lease is terminated before the end
+// of its term.
+type EventLeaseTerminated struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PropertyId uint64 `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+}
+
+func (m *EventLeaseTerminated) Reset()         { *m = EventLeaseTerminated{} }
+func (m *EventLeaseTerminated) String() string { return proto.CompactTextString(m) }
+func (*EventLeaseTerminated) ProtoMessage()    {}
+func (*EventLeaseTerminated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{12}
+}
+func (m *EventLeaseTerminated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLeaseTerminated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLeaseTerminated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLeaseTerminated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLeaseTerminated.Merge(m, src)
+}
+func (m *EventLeaseTerminated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLeaseTerminated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLeaseTerminated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLeaseTerminated proto.InternalMessageInfo
+
+func (m *EventLeaseTerminated) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventLeaseTerminated) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+// EventCashFlowReported is emitted when a rent receipt or an operating
+// expense of a property is reported.
+type EventCashFlowReported struct {
+	Id         uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PropertyId uint64       `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Kind       CashFlowKind `protobuf:"varint,3,opt,name=kind,proto3,enum=realfin.realestate.v1.CashFlowKind" json:"kind,omitempty"`
+	Amount     uint64       `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventCashFlowReported) Reset()         { *m = EventCashFlowReported{} }
+func (m *EventCashFlowReported) String() string { return proto.CompactTextString(m) }
+func (*EventCashFlowReported) ProtoMessage()    {}
+func (*EventCashFlowReported) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e8d12453f740, []int{13}
+}
+func (m *EventCashFlowReported) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCashFlowReported) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCashFlowReported.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCashFlowReported) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCashFlowReported.Merge(m, src)
+}
+func (m *EventCashFlowReported) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCashFlowReported) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCashFlowReported.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCashFlowReported proto.InternalMessageInfo
+
+func (m *EventCashFlowReported) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventCashFlowReported) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *EventCashFlowReported) GetKind() CashFlowKind {
+	if m != nil {
+		return m.Kind
+	}
+	return CashFlowKind_CASH_FLOW_KIND_UNSPECIFIED
+}
+
+func (m *EventCashFlowReported) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventPropertyCreated)(nil), "realfin.realestate.v1.EventPropertyCreated")
+	proto.RegisterType((*EventPropertyUpdated)(nil), "realfin.realestate.v1.EventPropertyUpdated")
+	proto.RegisterType((*EventPropertyDeleted)(nil), "realfin.realestate.v1.EventPropertyDeleted")
+	proto.RegisterType((*EventAppraiserRegistered)(nil), "realfin.realestate.v1.EventAppraiserRegistered")
+	proto.RegisterType((*EventAppraiserRevoked)(nil), "realfin.realestate.v1.EventAppraiserRevoked")
+	proto.RegisterType((*EventAppraisalSubmitted)(nil), "realfin.realestate.v1.EventAppraisalSubmitted")
+	proto.RegisterType((*EventValuationFinalized)(nil), "realfin.realestate.v1.EventValuationFinalized")
+	proto.RegisterType((*EventRegionalIndexSet)(nil), "realfin.realestate.v1.EventRegionalIndexSet")
+	proto.RegisterType((*EventRegionalIndexRemoved)(nil), "realfin.realestate.v1.EventRegionalIndexRemoved")
+	proto.RegisterType((*EventRegionalIndexRefreshed)(nil), "realfin.realestate.v1.EventRegionalIndexRefreshed")
+	proto.RegisterType((*EventPropertyManagerSet)(nil), "realfin.realestate.v1.EventPropertyManagerSet")
+	proto.RegisterType((*EventLeaseCreated)(nil), "realfin.realestate.v1.EventLeaseCreated")
+	proto.RegisterType((*EventLeaseTerminated)(nil), "realfin.realestate.v1.EventLeaseTerminated")
+	proto.RegisterType((*EventCashFlowReported)(nil), "realfin.realestate.v1.EventCashFlowReported")
+}
+
+func init() {
+	proto.RegisterFile("realfin/realestate/v1/events.proto", fileDescriptor_c644e8d12453f740)
+}
+
+var fileDescriptor_c644e8d12453f740 = []byte{
+	// 792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x9b, 0xb4, 0x9e, 0x52, 0x4b, 0x5d, 0xb9, 0x74, 0xeb, 0x20, 0x27, 0x1a, 0x0a,
+	0xb2, 0x90, 0x58, 0x2b, 0x05, 0xc1, 0x11, 0xd1, 0x9a, 0x42, 0xd4, 0x54, 0x42, 0x9b, 0x82, 0x10,
+	0x97, 0x68, 0xb2, 0xf3, 0x6a, 0x0f, 0x99, 0xdd, 0x59, 0xcd, 0x8c, 0x4d, 0xcd, 0xaf, 0xe0, 0xca,
+	0x95, 0x13, 0x47, 0x0e, 0xfd, 0x0f, 0xf4, 0x84, 0x22, 0x4e, 0x28, 0x87, 0x08, 0x25, 0x07, 0xfe,
+	0x06, 0xda, 0x99, 0x59, 0xdb, 0x71, 0xd6, 0x18, 0x85, 0x8b, 0xbd, 0xef, 0xcd, 0x37, 0x6f, 0xbe,
+	0xf7, 0xde, 0xf7, 0x66, 0x10, 0x96, 0x40, 0xf8, 0x0b, 0x96, 0xf5, 0x8b, 0x7f, 0x50, 0x9a, 0x68,
+	0xe8, 0x4f, 0x76, 0xfb, 0x30, 0x81, 0x4c, 0xab, 0x28, 0x97, 0x42, 0x8b, 0xe0, 0xae, 0xc3, 0x44,
+	0x73, 0x4c, 0x34, 0xd9, 0xed, 0xdc, 0x21, 0x29, 0xcb, 0x44, 0xdf, 0xfc, 0x5a, 0x64, 0xe7, 0x7e,
+	0x22, 0x54, 0x2a, 0xd4, 0xa1, 0xb1, 0xfa, 0xd6, 0x70, 0x4b, 0xed, 0xa1, 0x18, 0x0a, 0xeb, 0x2f,
+	0xbe, 0x9c, 0xf7, 0x9d, 0xea, 0xe3, 0x49, 0x9e, 0x4b, 0xc2, 0x14, 0xe1, 0x0e, 0xb6, 0x82, 0x25,
+	0xcb, 0x12, 0x91, 0x82, 0xc3, 0x3c, 0xa8, 0xc6, 0xe4, 0x52, 0xe4, 0x20, 0xf5, 0xd4, 0xa2, 0xf0,
+	0xef, 0x1e, 0x6a, 0x7f, 0x56, 0x24, 0xf7, 0xa5, 0xf3, 0x3f, 0x96, 0x40, 0x34, 0xd0, 0xa0, 0x85,
+	0xea, 0x8c, 0x86, 0xde, 0x8e, 0xd7, 0xf3, 0xe3, 0x3a, 0xa3, 0x41, 0x88, 0x6e, 0x24, 0xc5, 0x92,
+	0x90, 0x61, 0x7d, 0xc7, 0xeb, 0x35, 0xe3, 0xd2, 0x0c, 0x30, 0x7a, 0xe3, 0xbb, 0xb1, 0x64, 0x8a,
+	0xb2, 0x44, 0x33, 0x91, 0x85, 0x0d, 0xb3, 0x7c, 0xc9, 0x17, 0x6c, 0xa1, 0x66, 0x4e, 0x64, 0x02,
+	0xfc, 0x90, 0xd1, 0xd0, 0x37, 0x80, 0x9b, 0xd6, 0xb1, 0x47, 0x83, 0xa7, 0xa8, 0x55, 0xb2, 0x3a,
+	0x4c, 0x38, 0x51, 0x2a, 0xdc, 0xd8, 0xf1, 0x7a, 0xad, 0x87, 0x0f, 0xa2, 0xca, 0x42, 0x47, 0x33,
+	0xaa, 0x05, 0x36, 0xbe, 0x9d, 0x2f, 0x9a, 0xf8, 0xd5, 0x72, 0x42, 0x5f, 0xe5, 0xb4, 0x32, 0xa1,
+	0x65, 0xda, 0xf5, 0x75, 0xb4, 0x1b, 0x6b, 0x69, 0xfb, 0xd7, 0xa7, 0xfd, 0xee, 0x12, 0xeb, 0x01,
+	0x70, 0xa8, 0x60, 0x8d, 0xbf, 0x40, 0xa1, 0xc1, 0x7d, 0x6a, 0x15, 0x01, 0x32, 0x86, 0x21, 0x53,
+	0x1a, 0x24, 0x98, 0x16, 0x11, 0x4a, 0x25, 0x28, 0x65, 0x36, 0x34, 0xe3, 0xd2, 0x0c, 0x02, 0xe4,
+	0x67, 0x24, 0x05, 0x97, 0xa3, 0xf9, 0xc6, 0xbb, 0xe8, 0xee, 0x72, 0xa4, 0x89, 0x38, 0xfe, 0xb7,
+	0x30, 0xf8, 0xd4, 0x43, 0xf7, 0x16, 0xf7, 0x10, 0x7e, 0x30, 0x3e, 0x4a, 0x99, 0xae, 0x2a, 0xef,
+	0x36, 0xba, 0x35, 0xab, 0x0e, 0xa3, 0xe6, 0x64, 0x3f, 0x46, 0xa5, 0x6b, 0x8f, 0x06, 0x6d, 0xb4,
+	0x91, 0x4c, 0x13, 0x0e, 0xa6, 0xae, 0x7e, 0x6c, 0x8d, 0xe0, 0x2d, 0xd4, 0x24, 0x25, 0x21, 0x27,
+	0x94, 0xb9, 0xa3, 0xd8, 0x33, 0x21, 0x7c, 0x0c, 0x46, 0x20, 0x7e, 0x6c, 0x8d, 0x60, 0x80, 0x6e,
+	0xa5, 0xa0, 0x47, 0x82, 0x0a, 0x2e, 0x86, 0xd3, 0x70, 0xd3, 0x74, 0x01, 0xaf, 0xe8, 0xc2, 0xb3,
+	0x39, 0x32, 0x5e, 0xdc, 0x86, 0x7f, 0x2e, 0x93, 0xfb, 0x9a, 0xf0, 0x31, 0x29, 0xda, 0xff, 0x84,
+	0x65, 0x84, 0xb3, 0x1f, 0xe0, 0x4a, 0x32, 0xde, 0xea, 0x64, 0xea, 0x8b, 0xc9, 0xcc, 0xe8, 0x36,
+	0x16, 0xe9, 0x76, 0x11, 0x9a, 0xcd, 0xb3, 0xd5, 0xcc, 0xed, 0x78, 0xc1, 0x13, 0x74, 0xd0, 0x4d,
+	0x31, 0xd6, 0x9c, 0x81, 0x2c, 0x06, 0xa1, 0xd1, 0xf3, 0xe3, 0x99, 0x8d, 0x0f, 0x5c, 0xd3, 0x8a,
+	0xae, 0x8b, 0x8c, 0xf0, 0xbd, 0x8c, 0xc2, 0xcb, 0x03, 0xd0, 0x57, 0xd4, 0xec, 0x55, 0xa8, 0xf9,
+	0x4d, 0xb4, 0xa9, 0xa6, 0xe9, 0x91, 0xe0, 0x4e, 0x07, 0xce, 0xc2, 0x9f, 0xa0, 0xfb, 0x57, 0x83,
+	0xc6, 0x90, 0x8a, 0x09, 0xd0, 0xff, 0x12, 0x18, 0xff, 0xe6, 0xa1, 0xad, 0xaa, 0x08, 0x2f, 0x24,
+	0xa8, 0x11, 0xd0, 0xff, 0x43, 0x2e, 0xd8, 0x47, 0x1b, 0x1c, 0x26, 0xc0, 0xed, 0xf8, 0x3d, 0xfa,
+	0xe8, 0xf5, 0xd9, 0x76, 0xed, 0xf4, 0x6c, 0x7b, 0xcb, 0x5e, 0xa6, 0x8a, 0x1e, 0x47, 0x4c, 0xf4,
+	0x53, 0xa2, 0x47, 0xd1, 0x3e, 0x0c, 0x49, 0x32, 0x1d, 0x40, 0xf2, 0xc7, 0xab, 0xf7, 0x91, 0x5d,
+	0x8e, 0x06, 0x90, 0xfc, 0xf2, 0xf7, 0xaf, 0xef, 0x79, 0xb1, 0x0d, 0x52, 0xd4, 0xde, 0x75, 0x8d,
+	0x81, 0xad, 0xfd, 0xbc, 0x8f, 0x0c, 0x14, 0x7e, 0x8e, 0xee, 0x5d, 0x1a, 0xc3, 0x67, 0x24, 0x23,
+	0x43, 0x90, 0x45, 0x85, 0xd7, 0x6a, 0x20, 0x44, 0x37, 0x52, 0x0b, 0x2f, 0x6f, 0x48, 0x67, 0xe2,
+	0x6f, 0xd0, 0x1d, 0x13, 0x75, 0x1f, 0x88, 0x82, 0x55, 0x17, 0xec, 0xda, 0x81, 0x09, 0x90, 0x2f,
+	0x21, 0xd3, 0x4e, 0x4c, 0xe6, 0x1b, 0x7f, 0x8e, 0xda, 0xf3, 0xc8, 0xcf, 0x41, 0xa6, 0x2c, 0xbb,
+	0x56, 0x70, 0xfc, 0x93, 0xe7, 0x94, 0xf5, 0x98, 0xa8, 0xd1, 0x13, 0x2e, 0xbe, 0x8f, 0x21, 0x17,
+	0xf2, 0x5a, 0x3c, 0x3f, 0x46, 0xfe, 0x31, 0xcb, 0xec, 0x7d, 0xd9, 0x7a, 0xf8, 0xf6, 0x8a, 0x39,
+	0x2c, 0xcf, 0x79, 0xca, 0x32, 0x1a, 0x9b, 0x0d, 0x85, 0x04, 0x48, 0x2a, 0xc6, 0x99, 0x76, 0x8d,
+	0x71, 0xd6, 0xa3, 0x0f, 0x5f, 0x9f, 0x77, 0xbd, 0x93, 0xf3, 0xae, 0xf7, 0xd7, 0x79, 0xd7, 0xfb,
+	0xf1, 0xa2, 0x5b, 0x3b, 0xb9, 0xe8, 0xd6, 0xfe, 0xbc, 0xe8, 0xd6, 0xbe, 0xed, 0x94, 0x6f, 0xdc,
+	0xcb, 0xc5, 0x57, 0x4e, 0x4f, 0x73, 0x50, 0x47, 0x9b, 0xe6, 0x81, 0xfb, 0xe0, 0x9f, 0x01, 0x00,
+	0x4f, 0xb0, 0x0c, 0x24, 0xd2, 0x07, 0x00, 0x00,
+}
+
+func (m *EventPropertyCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPropertyCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPropertyCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PropertyClass != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PropertyClass))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ParcelId) > 0 {
+		i -= len(m.ParcelId)
+		copy(dAtA[i:], m.ParcelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ParcelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPropertyUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPropertyUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPropertyUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PropertyClass != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PropertyClass))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ParcelId) > 0 {
+		i -= len(m.ParcelId)
+		copy(dAtA[i:], m.ParcelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ParcelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPropertyDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPropertyDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPropertyDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAppraiserRegistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAppraiserRegistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAppraiserRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAppraiserRevoked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAppraiserRevoked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAppraiserRevoked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAppraisalSubmitted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAppraisalSubmitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAppraisalSubmitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Methodology != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Methodology))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Value != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Appraiser) > 0 {
+		i -= len(m.Appraiser)
+		copy(dAtA[i:], m.Appraiser)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Appraiser)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Cycle != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Cycle))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventValuationFinalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValuationFinalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValuationFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outliers) > 0 {
+		dAtA2 := make([]byte, len(m.Outliers)*10)
+		var j1 int
+		for _, num := range m.Outliers {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEvents(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Appraisals != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Appraisals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Value != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Cycle != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Cycle))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRegionalIndexSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRegionalIndexSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRegionalIndexSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRegionalIndexRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRegionalIndexRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRegionalIndexRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRegionalIndexRefreshed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRegionalIndexRefreshed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRegionalIndexRefreshed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Properties != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Properties))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Level.Size()
+		i -= size
+		if _, err := m.Level.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPropertyManagerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPropertyManagerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPropertyManagerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLeaseCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLeaseCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLeaseCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rent != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Rent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLeaseTerminated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLeaseTerminated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLeaseTerminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCashFlowReported) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCashFlowReported) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCashFlowReported) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Kind != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventPropertyCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ParcelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PropertyClass != 0 {
+		n += 1 + sovEvents(uint64(m.PropertyClass))
+	}
+	return n
+}
+
+func (m *EventPropertyUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ParcelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PropertyClass != 0 {
+		n += 1 + sovEvents(uint64(m.PropertyClass))
+	}
+	return n
+}
+
+func (m *EventPropertyDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *EventAppraiserRegistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAppraiserRevoked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAppraisalSubmitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovEvents(uint64(m.PropertyId))
+	}
+	if m.Cycle != 0 {
+		n += 1 + sovEvents(uint64(m.Cycle))
+	}
+	l = len(m.Appraiser)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovEvents(uint64(m.Value))
+	}
+	if m.Methodology != 0 {
+		n += 1 + sovEvents(uint64(m.Methodology))
+	}
+	return n
+}
+
+func (m *EventValuationFinalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		n += 1 + sovEvents(uint64(m.PropertyId))
+	}
+	if m.Cycle != 0 {
+		n += 1 + sovEvents(uint64(m.Cycle))
+	}
+	if m.Value != 0 {
+		n += 1 + sovEvents(uint64(m.Value))
+	}
+	if m.Appraisals != 0 {
+		n += 1 + sovEvents(uint64(m.Appraisals))
+	}
+	if len(m.Outliers) > 0 {
+		l = 0
+		for _, e := range m.Outliers {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventRegionalIndexSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRegionalIndexRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRegionalIndexRefreshed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Level.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Properties != 0 {
+		n += 1 + sovEvents(uint64(m.Properties))
+	}
+	return n
+}
+
+func (m *EventPropertyManagerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		n += 1 + sovEvents(uint64(m.PropertyId))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventLeaseCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovEvents(uint64(m.PropertyId))
+	}
+	if m.Rent != 0 {
+		n += 1 + sovEvents(uint64(m.Rent))
+	}
+	return n
+}
+
+func (m *EventLeaseTerminated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovEvents(uint64(m.PropertyId))
+	}
+	return n
+}
+
+func (m *EventCashFlowReported) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovEvents(uint64(m.PropertyId))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovEvents(uint64(m.Kind))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventPropertyCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPropertyCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPropertyCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParcelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParcelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyClass", wireType)
+			}
+			m.PropertyClass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyClass |= PropertyClass(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPropertyUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPropertyUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPropertyUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParcelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParcelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyClass", wireType)
+			}
+			m.PropertyClass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyClass |= PropertyClass(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPropertyDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPropertyDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPropertyDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAppraiserRegistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAppraiserRegistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAppraiserRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAppraiserRevoked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAppraiserRevoked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAppraiserRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAppraisalSubmitted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAppraisalSubmitted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAppraisalSubmitted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cycle", wireType)
+			}
+			m.Cycle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cycle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appraiser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appraiser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methodology", wireType)
+			}
+			m.Methodology = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Methodology |= Methodology(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventValuationFinalized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValuationFinalized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValuationFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cycle", wireType)
+			}
+			m.Cycle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cycle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appraisals", wireType)
+			}
+			m.Appraisals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Appraisals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Outliers = append(m.Outliers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Outliers) == 0 {
+					m.Outliers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Outliers = append(m.Outliers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outliers", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRegionalIndexSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRegionalIndexSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRegionalIndexSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRegionalIndexRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRegionalIndexRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRegionalIndexRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRegionalIndexRefreshed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRegionalIndexRefreshed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRegionalIndexRefreshed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Level.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			m.Properties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Properties |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPropertyManagerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPropertyManagerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPropertyManagerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLeaseCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLeaseCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLeaseCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rent", wireType)
+			}
+			m.Rent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLeaseTerminated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLeaseTerminated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLeaseTerminated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCashFlowReported) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCashFlowReported: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCashFlowReported: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= CashFlowKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,9 @@ import (
 
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+
+	oracletypes "realfin/x/oracle/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -20,6 +23,18 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 }
 
+// OracleKeeper defines the expected interface of the oracle module, feeding
+// the regional indices.
+type OracleKeeper interface {
+	GetFreshPrice(ctx context.Context, symbol string) (oracletypes.Price, error)
+}
+
+// EpochsKeeper defines the expected interface of the epochs module, timing
+// the refresh of the regional indices.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, error)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -5,21 +5,171 @@ import "fmt"
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:  DefaultParams(),
-		RateMap: []Rate{}}
+		Params:     DefaultParams(),
+		RateMap:    []Rate{},
+		Properties: []Property{},
+		Appraisers: []Appraiser{},
+		Appraisals: []Appraisal{},
+
+		RegionalIndices: []RegionalIndex{},
+
+		PropertyManagers: []PropertyManager{},
+		Leases:           []Lease{},
+		CashFlows:        []CashFlow{},
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	rateIndexMap := make(map[string]struct{})
+	propertyIndexMap := make(map[uint64]struct{})
+	parcelIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.Properties {
+		if _, ok := propertyIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated index for property")
+		}
+		propertyIndexMap[elem.Id] = struct{}{}
+
+		if elem.Id >= gs.PropertySeq {
+			return fmt.Errorf("property id %d is not below the sequence %d", elem.Id, gs.PropertySeq)
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("property %d: %w", elem.Id, err)
+		}
+
+		parcel := elem.Jurisdiction + "/" + elem.ParcelId
+		if _, ok := parcelIndexMap[parcel]; ok {
+			return fmt.Errorf("duplicated parcel %s in jurisdiction %s", elem.ParcelId, elem.Jurisdiction)
+		}
+		parcelIndexMap[parcel] = struct{}{}
+	}
+
+	rateIndexMap := make(map[uint64]struct{})
 
 	for _, elem := range gs.RateMap {
-		index := fmt.Sprint(elem.Symbol)
-		if _, ok := rateIndexMap[index]; ok {
+		if _, ok := rateIndexMap[elem.PropertyId]; ok {
 			return fmt.Errorf("duplicated index for rate")
 		}
-		rateIndexMap[index] = struct{}{}
+		rateIndexMap[elem.PropertyId] = struct{}{}
+
+		if _, ok := propertyIndexMap[elem.PropertyId]; !ok {
+			return fmt.Errorf("rate of unknown property %d", elem.PropertyId)
+		}
+		if elem.Expired && elem.ValidUntil.IsZero() {
+			return fmt.Errorf("rate of property %d expired without validity", elem.PropertyId)
+		}
+	}
+
+	appraiserIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.Appraisers {
+		if _, ok := appraiserIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated index for appraiser")
+		}
+		appraiserIndexMap[elem.Address] = struct{}{}
+	}
+
+	appraisalIndexMap := make(map[string]struct{})
+	appraisalIDMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.Appraisals {
+		if _, ok := appraisalIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated index for appraisal")
+		}
+		appraisalIDMap[elem.Id] = struct{}{}
+
+		if elem.Id >= gs.AppraisalSeq {
+			return fmt.Errorf("appraisal id %d is not below the sequence %d", elem.Id, gs.AppraisalSeq)
+		}
+		if _, ok := propertyIndexMap[elem.PropertyId]; !ok {
+			return fmt.Errorf("appraisal %d of unknown property %d", elem.Id, elem.PropertyId)
+		}
+		if _, ok := appraiserIndexMap[elem.Appraiser]; !ok {
+			return fmt.Errorf("appraisal %d of unknown appraiser %s", elem.Id, elem.Appraiser)
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("appraisal %d: %w", elem.Id, err)
+		}
+
+		index := fmt.Sprintf("%d/%d/%s", elem.PropertyId, elem.Cycle, elem.Appraiser)
+		if _, ok := appraisalIndexMap[index]; ok {
+			return fmt.Errorf("duplicated appraisal of property %d in cycle %d by %s", elem.PropertyId, elem.Cycle, elem.Appraiser)
+		}
+		appraisalIndexMap[index] = struct{}{}
+	}
+
+	indexIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RegionalIndices {
+		if _, ok := indexIndexMap[elem.Jurisdiction]; ok {
+			return fmt.Errorf("duplicated index for regional index")
+		}
+		indexIndexMap[elem.Jurisdiction] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("regional index of %s: %w", elem.Jurisdiction, err)
+		}
+	}
+
+	managerIndexMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.PropertyManagers {
+		if _, ok := managerIndexMap[elem.PropertyId]; ok {
+			return fmt.Errorf("duplicated index for property manager")
+		}
+		managerIndexMap[elem.PropertyId] = struct{}{}
+
+		if _, ok := propertyIndexMap[elem.PropertyId]; !ok {
+			return fmt.Errorf("manager of unknown property %d", elem.PropertyId)
+		}
+		if elem.Manager == "" {
+			return fmt.Errorf("empty manager of property %d", elem.PropertyId)
+		}
+	}
+
+	leaseIndexMap := make(map[uint64]uint64)
+
+	for _, elem := range gs.Leases {
+		if _, ok := leaseIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated index for lease")
+		}
+		leaseIndexMap[elem.Id] = elem.PropertyId
+
+		if elem.Id >= gs.LeaseSeq {
+			return fmt.Errorf("lease id %d is not below the sequence %d", elem.Id, gs.LeaseSeq)
+		}
+		if _, ok := propertyIndexMap[elem.PropertyId]; !ok {
+			return fmt.Errorf("lease %d of unknown property %d", elem.Id, elem.PropertyId)
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("lease %d: %w", elem.Id, err)
+		}
+	}
+
+	cashFlowIndexMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.CashFlows {
+		if _, ok := cashFlowIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated index for cash flow")
+		}
+		cashFlowIndexMap[elem.Id] = struct{}{}
+
+		if elem.Id >= gs.CashFlowSeq {
+			return fmt.Errorf("cash flow id %d is not below the sequence %d", elem.Id, gs.CashFlowSeq)
+		}
+		if _, ok := propertyIndexMap[elem.PropertyId]; !ok {
+			return fmt.Errorf("cash flow %d of unknown property %d", elem.Id, elem.PropertyId)
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("cash flow %d: %w", elem.Id, err)
+		}
+		if elem.Kind != CashFlowKind_CASH_FLOW_KIND_RENT {
+			continue
+		}
+		if propertyID, ok := leaseIndexMap[elem.LeaseId]; !ok || propertyID != elem.PropertyId {
+			return fmt.Errorf("rent %d of unknown lease %d of property %d", elem.Id, elem.LeaseId, elem.PropertyId)
+		}
 	}
 
 	return gs.Params.Validate()
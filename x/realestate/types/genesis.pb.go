@@ -27,8 +27,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the realestate module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params  Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	RateMap []Rate `protobuf:"bytes,2,rep,name=rate_map,json=rateMap,proto3" json:"rate_map"`
+	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	RateMap          []Rate            `protobuf:"bytes,2,rep,name=rate_map,json=rateMap,proto3" json:"rate_map"`
+	Properties       []Property        `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties"`
+	PropertySeq      uint64            `protobuf:"varint,4,opt,name=property_seq,json=propertySeq,proto3" json:"property_seq,omitempty"`
+	Appraisers       []Appraiser       `protobuf:"bytes,5,rep,name=appraisers,proto3" json:"appraisers"`
+	Appraisals       []Appraisal       `protobuf:"bytes,6,rep,name=appraisals,proto3" json:"appraisals"`
+	AppraisalSeq     uint64            `protobuf:"varint,7,opt,name=appraisal_seq,json=appraisalSeq,proto3" json:"appraisal_seq,omitempty"`
+	RegionalIndices  []RegionalIndex   `protobuf:"bytes,8,rep,name=regional_indices,json=regionalIndices,proto3" json:"regional_indices"`
+	PropertyManagers []PropertyManager `protobuf:"bytes,9,rep,name=property_managers,json=propertyManagers,proto3" json:"property_managers"`
+	Leases           []Lease           `protobuf:"bytes,10,rep,name=leases,proto3" json:"leases"`
+	LeaseSeq         uint64            `protobuf:"varint,11,opt,name=lease_seq,json=leaseSeq,proto3" json:"lease_seq,omitempty"`
+	CashFlows        []CashFlow        `protobuf:"bytes,12,rep,name=cash_flows,json=cashFlows,proto3" json:"cash_flows"`
+	CashFlowSeq      uint64            `protobuf:"varint,13,opt,name=cash_flow_seq,json=cashFlowSeq,proto3" json:"cash_flow_seq,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +89,83 @@ func (m *GenesisState) GetRateMap() []Rate {
 	return nil
 }
 
+func (m *GenesisState) GetProperties() []Property {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPropertySeq() uint64 {
+	if m != nil {
+		return m.PropertySeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAppraisers() []Appraiser {
+	if m != nil {
+		return m.Appraisers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAppraisals() []Appraisal {
+	if m != nil {
+		return m.Appraisals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAppraisalSeq() uint64 {
+	if m != nil {
+		return m.AppraisalSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRegionalIndices() []RegionalIndex {
+	if m != nil {
+		return m.RegionalIndices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPropertyManagers() []PropertyManager {
+	if m != nil {
+		return m.PropertyManagers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLeases() []Lease {
+	if m != nil {
+		return m.Leases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLeaseSeq() uint64 {
+	if m != nil {
+		return m.LeaseSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCashFlows() []CashFlow {
+	if m != nil {
+		return m.CashFlows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCashFlowSeq() uint64 {
+	if m != nil {
+		return m.CashFlowSeq
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "realfin.realestate.v1.GenesisState")
 }
@@ -87,23 +175,40 @@ func init() {
 }
 
 var fileDescriptor_b3845512e03b0fd8 = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x4a, 0x4d, 0xcc,
-	0x49, 0xcb, 0xcc, 0xd3, 0x07, 0xd1, 0xa9, 0xc5, 0x25, 0x89, 0x25, 0xa9, 0xfa, 0x65, 0x86, 0xfa,
-	0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xa2, 0x50,
-	0x45, 0x7a, 0x08, 0x45, 0x7a, 0x65, 0x86, 0x52, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60,
-	0x12, 0xa2, 0x52, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0xa0, 0xa2, 0x4a,
-	0xd8, 0x2d, 0x29, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0xda, 0x21, 0xa5, 0x80, 0x5d, 0x4d, 0x11, 0xc8,
-	0x2e, 0xb0, 0x0a, 0xa5, 0x3e, 0x46, 0x2e, 0x1e, 0x77, 0x88, 0xbb, 0x82, 0x41, 0xd2, 0x42, 0x0e,
-	0x5c, 0x6c, 0x10, 0x23, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf5, 0xb0, 0xba, 0x53,
-	0x2f, 0x00, 0xac, 0xc8, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06,
-	0x41, 0xf5, 0x09, 0xd9, 0x70, 0x71, 0x80, 0x2c, 0x88, 0xcf, 0x4d, 0x2c, 0x90, 0x60, 0x52, 0x60,
-	0xd6, 0xe0, 0x36, 0x92, 0xc6, 0x61, 0x46, 0x50, 0x62, 0x49, 0xaa, 0x13, 0x0b, 0xc8, 0x84, 0x20,
-	0x76, 0x90, 0x16, 0xdf, 0xc4, 0x02, 0x27, 0x93, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63,
-	0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96,
-	0x63, 0x88, 0x92, 0x82, 0x79, 0xa6, 0x02, 0xd9, 0x3b, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c,
-	0x60, 0xdf, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x0f, 0xa9, 0xe7, 0x56, 0x7a, 0x01, 0x00,
-	0x00,
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x56, 0xb2, 0xd6, 0x6d, 0xc5, 0x66, 0x81, 0x14, 0x75, 0x90, 0x65, 0xdd, 0x40,
+	0x15, 0x87, 0x44, 0x1b, 0x9c, 0x10, 0x07, 0x28, 0x30, 0x84, 0xc4, 0x24, 0xd4, 0x8a, 0x03, 0x5c,
+	0xaa, 0x97, 0xee, 0x5d, 0x16, 0x29, 0x89, 0x5d, 0x3b, 0xda, 0x9f, 0x6f, 0xc1, 0x67, 0xe0, 0xc4,
+	0x91, 0x8f, 0xb1, 0xe3, 0x8e, 0x9c, 0x10, 0x6a, 0x0f, 0x7c, 0x0d, 0x64, 0xc7, 0x69, 0x0b, 0x6a,
+	0x0a, 0x97, 0xd6, 0x7e, 0xfb, 0x7b, 0x9f, 0xc7, 0xef, 0x53, 0x9b, 0xec, 0x0a, 0x84, 0xf8, 0x24,
+	0x4a, 0x03, 0xf5, 0x8d, 0x32, 0x83, 0x0c, 0x83, 0xb3, 0xfd, 0x20, 0xc4, 0x14, 0x65, 0x24, 0x7d,
+	0x2e, 0x58, 0xc6, 0xe8, 0x1d, 0x03, 0xf9, 0x73, 0xc8, 0x3f, 0xdb, 0x6f, 0x6f, 0x42, 0x12, 0xa5,
+	0x2c, 0xd0, 0x9f, 0x39, 0xd9, 0xbe, 0x1d, 0xb2, 0x90, 0xe9, 0x65, 0xa0, 0x56, 0xa6, 0x7a, 0x7f,
+	0xb9, 0x09, 0x70, 0x2e, 0x20, 0x92, 0x10, 0x1b, 0xac, 0xb3, 0x1c, 0x8b, 0xd2, 0x11, 0x4b, 0xd0,
+	0x30, 0x3b, 0x65, 0xcc, 0x31, 0x5e, 0xac, 0x96, 0xe1, 0x20, 0x20, 0x31, 0x13, 0xb5, 0xf7, 0x4a,
+	0x18, 0xc1, 0x38, 0x8a, 0xec, 0xd2, 0x50, 0xde, 0x72, 0x4a, 0xa8, 0xf9, 0x35, 0xd1, 0xf9, 0x62,
+	0x93, 0xe6, 0xeb, 0x3c, 0xab, 0x81, 0xfa, 0x99, 0x3e, 0x23, 0x76, 0x6e, 0xe4, 0x58, 0x9e, 0xd5,
+	0x6d, 0x1c, 0xdc, 0xf3, 0x97, 0x66, 0xe7, 0xbf, 0xd3, 0x50, 0xaf, 0x7e, 0xf5, 0x63, 0xbb, 0xf2,
+	0xf5, 0xd7, 0xb7, 0x87, 0x56, 0xdf, 0xf4, 0xd1, 0xa7, 0xa4, 0xa6, 0x0c, 0x86, 0x09, 0x70, 0xe7,
+	0x86, 0xb7, 0xd6, 0x6d, 0x1c, 0x6c, 0x95, 0x68, 0xf4, 0x21, 0xc3, 0x5e, 0x55, 0x29, 0xf4, 0xd7,
+	0x55, 0xcb, 0x11, 0x70, 0xfa, 0x8a, 0x10, 0x33, 0x44, 0x84, 0xd2, 0x59, 0xd3, 0xfd, 0xdb, 0x65,
+	0x67, 0x30, 0xd3, 0x1a, 0x8d, 0x85, 0x46, 0xba, 0x43, 0x9a, 0x45, 0x16, 0x43, 0x89, 0x63, 0xa7,
+	0xea, 0x59, 0xdd, 0x6a, 0xbf, 0x51, 0xd4, 0x06, 0x38, 0xa6, 0x87, 0x84, 0x98, 0x3f, 0x10, 0x85,
+	0x74, 0x6e, 0x6a, 0x27, 0xaf, 0xc4, 0xe9, 0x79, 0x01, 0x16, 0x56, 0xf3, 0xce, 0x05, 0x1d, 0x88,
+	0xa5, 0x63, 0xff, 0x8f, 0x0e, 0xc4, 0x7f, 0xe9, 0x40, 0x2c, 0xe9, 0x2e, 0x69, 0xcd, 0x76, 0xfa,
+	0xcc, 0xeb, 0xfa, 0xcc, 0xcd, 0x59, 0x51, 0x1d, 0xfa, 0x3d, 0xd9, 0x10, 0x18, 0x46, 0x2c, 0x85,
+	0x78, 0x18, 0xa5, 0xc7, 0xd1, 0x08, 0xa5, 0x53, 0xd3, 0x96, 0x7b, 0x65, 0x21, 0x1b, 0xfc, 0x8d,
+	0xba, 0x61, 0xc6, 0xf6, 0x96, 0x98, 0x17, 0x95, 0x04, 0xfd, 0x40, 0x36, 0x67, 0x71, 0x25, 0x90,
+	0x42, 0xa8, 0x22, 0xa9, 0x6b, 0xdd, 0x07, 0xff, 0x08, 0xff, 0x28, 0xc7, 0x8d, 0xf2, 0x06, 0xff,
+	0xb3, 0x2c, 0xe9, 0x13, 0x62, 0xc7, 0x08, 0x12, 0xa5, 0x43, 0xb4, 0xde, 0xdd, 0x12, 0xbd, 0xb7,
+	0x0a, 0x32, 0x2a, 0xa6, 0x83, 0x6e, 0x91, 0xba, 0x5e, 0xe9, 0x38, 0x1a, 0x3a, 0x8e, 0x9a, 0x2e,
+	0xa8, 0x28, 0x5e, 0x12, 0x32, 0x02, 0x79, 0x3a, 0x3c, 0x89, 0xd9, 0xb9, 0x74, 0x9a, 0x2b, 0x6f,
+	0xca, 0x0b, 0x90, 0xa7, 0x87, 0x31, 0x3b, 0x37, 0xfa, 0xf5, 0x91, 0xd9, 0x4b, 0xda, 0x21, 0xad,
+	0x99, 0x8a, 0xb6, 0x69, 0xe5, 0x37, 0xa5, 0x20, 0x06, 0x38, 0xee, 0x3d, 0xbe, 0x9a, 0xb8, 0xd6,
+	0xf5, 0xc4, 0xb5, 0x7e, 0x4e, 0x5c, 0xeb, 0xf3, 0xd4, 0xad, 0x5c, 0x4f, 0xdd, 0xca, 0xf7, 0xa9,
+	0x5b, 0xf9, 0xd8, 0x2e, 0x1e, 0xd8, 0xc5, 0xe2, 0x13, 0xcb, 0x2e, 0x39, 0xca, 0x4f, 0xb6, 0x7e,
+	0x61, 0x8f, 0x7e, 0x0f, 0x00, 0x6d, 0xe2, 0xab, 0xa5, 0xa2, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +231,124 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CashFlowSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CashFlowSeq))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.CashFlows) > 0 {
+		for iNdEx := len(m.CashFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CashFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.LeaseSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LeaseSeq))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PropertyManagers) > 0 {
+		for iNdEx := len(m.PropertyManagers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PropertyManagers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RegionalIndices) > 0 {
+		for iNdEx := len(m.RegionalIndices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegionalIndices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.AppraisalSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AppraisalSeq))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Appraisals) > 0 {
+		for iNdEx := len(m.Appraisals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Appraisals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Appraisers) > 0 {
+		for iNdEx := len(m.Appraisers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Appraisers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PropertySeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PropertySeq))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Properties) > 0 {
+		for iNdEx := len(m.Properties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Properties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.RateMap) > 0 {
 		for iNdEx := len(m.RateMap) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -178,6 +401,60 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Properties) > 0 {
+		for _, e := range m.Properties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PropertySeq != 0 {
+		n += 1 + sovGenesis(uint64(m.PropertySeq))
+	}
+	if len(m.Appraisers) > 0 {
+		for _, e := range m.Appraisers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Appraisals) > 0 {
+		for _, e := range m.Appraisals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AppraisalSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.AppraisalSeq))
+	}
+	if len(m.RegionalIndices) > 0 {
+		for _, e := range m.RegionalIndices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PropertyManagers) > 0 {
+		for _, e := range m.PropertyManagers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LeaseSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.LeaseSeq))
+	}
+	if len(m.CashFlows) > 0 {
+		for _, e := range m.CashFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CashFlowSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.CashFlowSeq))
+	}
 	return n
 }
 
@@ -283,6 +560,320 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = append(m.Properties, Property{})
+			if err := m.Properties[len(m.Properties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertySeq", wireType)
+			}
+			m.PropertySeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertySeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appraisers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appraisers = append(m.Appraisers, Appraiser{})
+			if err := m.Appraisers[len(m.Appraisers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appraisals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appraisals = append(m.Appraisals, Appraisal{})
+			if err := m.Appraisals[len(m.Appraisals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppraisalSeq", wireType)
+			}
+			m.AppraisalSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppraisalSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionalIndices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegionalIndices = append(m.RegionalIndices, RegionalIndex{})
+			if err := m.RegionalIndices[len(m.RegionalIndices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyManagers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PropertyManagers = append(m.PropertyManagers, PropertyManager{})
+			if err := m.PropertyManagers[len(m.PropertyManagers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leases = append(m.Leases, Lease{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseSeq", wireType)
+			}
+			m.LeaseSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CashFlows = append(m.CashFlows, CashFlow{})
+			if err := m.CashFlows[len(m.CashFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashFlowSeq", wireType)
+			}
+			m.CashFlowSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CashFlowSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
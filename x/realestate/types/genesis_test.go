@@ -1,7 +1,11 @@
 package types_test
 
 import (
+	stdmath "math"
 	"testing"
+	"time"
+
+	"cosmossdk.io/math"
 
 	"realfin/x/realestate/types"
 
@@ -20,22 +24,239 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
-			genState: &types.GenesisState{RateMap: []types.Rate{{Symbol: "0"}, {Symbol: "1"}}},
-			valid:    true,
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Properties:  []types.Property{property(0, "US-CA", "P-0"), property(1, "US-CA", "P-1")},
+				PropertySeq: 2,
+				RateMap:     []types.Rate{{PropertyId: 0}, {PropertyId: 1}},
+			},
+			valid: true,
 		}, {
 			desc: "duplicated rate",
 			genState: &types.GenesisState{
+				Properties:  []types.Property{property(0, "US-CA", "P-0")},
+				PropertySeq: 1,
 				RateMap: []types.Rate{
 					{
-						Symbol: "0",
+						PropertyId: 0,
 					},
 					{
-						Symbol: "0",
+						PropertyId: 0,
 					},
 				},
 			},
 			valid: false,
+		}, {
+			desc: "rate of unknown property",
+			genState: &types.GenesisState{
+				RateMap: []types.Rate{{PropertyId: 0}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated property",
+			genState: &types.GenesisState{
+				Properties:  []types.Property{property(0, "US-CA", "P-0"), property(0, "US-CA", "P-1")},
+				PropertySeq: 1,
+			},
+			valid: false,
+		}, {
+			desc: "property id above sequence",
+			genState: &types.GenesisState{
+				Properties:  []types.Property{property(1, "US-CA", "P-0")},
+				PropertySeq: 1,
+			},
+			valid: false,
+		}, {
+			desc: "duplicated parcel",
+			genState: &types.GenesisState{
+				Properties:  []types.Property{property(0, "US-CA", "P-0"), property(1, "US-CA", "P-0")},
+				PropertySeq: 2,
+			},
+			valid: false,
+		}, {
+			desc: "same parcel id in another jurisdiction",
+			genState: &types.GenesisState{
+				Properties:  []types.Property{property(0, "US-CA", "P-0"), property(1, "US-NY", "P-0")},
+				PropertySeq: 2,
+			},
+			valid: true,
+		}, {
+			desc: "invalid jurisdiction",
+			genState: &types.GenesisState{
+				Properties:  []types.Property{property(0, "California", "P-0")},
+				PropertySeq: 1,
+			},
+			valid: false,
+		}, {
+			desc: "latitude out of range",
+			genState: &types.GenesisState{
+				Properties:  []types.Property{{Id: 0, ParcelId: "P-0", Jurisdiction: "US", Latitude: 90_000_001}},
+				PropertySeq: 1,
+			},
+			valid: false,
+		}, {
+			desc: "valid appraisals",
+			genState: &types.GenesisState{
+				Properties:   []types.Property{property(0, "US-CA", "P-0")},
+				PropertySeq:  1,
+				Appraisers:   []types.Appraiser{{Address: "appraiser-0"}, {Address: "appraiser-1"}},
+				Appraisals:   []types.Appraisal{appraisal(0, 0, "appraiser-0"), appraisal(1, 0, "appraiser-1")},
+				AppraisalSeq: 2,
+			},
+			valid: true,
+		}, {
+			desc: "duplicated appraiser",
+			genState: &types.GenesisState{
+				Appraisers: []types.Appraiser{{Address: "appraiser-0"}, {Address: "appraiser-0"}},
+			},
+			valid: false,
+		}, {
+			desc: "appraisal id above sequence",
+			genState: &types.GenesisState{
+				Properties:   []types.Property{property(0, "US-CA", "P-0")},
+				PropertySeq:  1,
+				Appraisers:   []types.Appraiser{{Address: "appraiser-0"}},
+				Appraisals:   []types.Appraisal{appraisal(1, 0, "appraiser-0")},
+				AppraisalSeq: 1,
+			},
+			valid: false,
+		}, {
+			desc: "appraisal of unknown appraiser",
+			genState: &types.GenesisState{
+				Properties:   []types.Property{property(0, "US-CA", "P-0")},
+				PropertySeq:  1,
+				Appraisals:   []types.Appraisal{appraisal(0, 0, "appraiser-0")},
+				AppraisalSeq: 1,
+			},
+			valid: false,
+		}, {
+			desc: "appraisal of unknown property",
+			genState: &types.GenesisState{
+				Appraisers:   []types.Appraiser{{Address: "appraiser-0"}},
+				Appraisals:   []types.Appraisal{appraisal(0, 0, "appraiser-0")},
+				AppraisalSeq: 1,
+			},
+			valid: false,
+		}, {
+			desc: "two appraisals of an appraiser in a cycle",
+			genState: &types.GenesisState{
+				Properties:   []types.Property{property(0, "US-CA", "P-0")},
+				PropertySeq:  1,
+				Appraisers:   []types.Appraiser{{Address: "appraiser-0"}},
+				Appraisals:   []types.Appraisal{appraisal(0, 0, "appraiser-0"), appraisal(1, 0, "appraiser-0")},
+				AppraisalSeq: 2,
+			},
+			valid: false,
+		}, {
+			desc: "trim leaving no appraisal",
+			genState: &types.GenesisState{
+				Params: types.NewParams(2, 1, types.DefaultOutlierDeviation, types.DefaultIndexEpochIdentifier, types.DefaultValidityPeriod, nil, types.DefaultExpiryEpochIdentifier),
+			},
+			valid: false,
+		}, {
+			desc: "validity periods by class",
+			genState: &types.GenesisState{
+				Params: types.NewParams(1, 0, types.DefaultOutlierDeviation, "", 0, []types.ValidityPeriod{
+					{PropertyClass: types.PropertyClass_PROPERTY_CLASS_RESIDENTIAL, Seconds: 60},
+					{PropertyClass: types.PropertyClass_PROPERTY_CLASS_LAND},
+				}, ""),
+			},
+			valid: true,
+		}, {
+			desc: "duplicated validity period class",
+			genState: &types.GenesisState{
+				Params: types.NewParams(1, 0, types.DefaultOutlierDeviation, "", 0, []types.ValidityPeriod{
+					{PropertyClass: types.PropertyClass_PROPERTY_CLASS_LAND, Seconds: 60},
+					{PropertyClass: types.PropertyClass_PROPERTY_CLASS_LAND, Seconds: 120},
+				}, ""),
+			},
+			valid: false,
+		}, {
+			desc: "validity period of unknown class",
+			genState: &types.GenesisState{
+				Params: types.NewParams(1, 0, types.DefaultOutlierDeviation, "", 0, []types.ValidityPeriod{{PropertyClass: 99, Seconds: 60}}, ""),
+			},
+			valid: false,
+		}, {
+			desc: "rate expired without validity",
+			genState: &types.GenesisState{
+				Properties:  []types.Property{property(0, "US-CA", "P-0")},
+				PropertySeq: 1,
+				RateMap:     []types.Rate{{PropertyId: 0, Expired: true}},
+			},
+			valid: false,
+		}, {
+			desc: "valid regional indices",
+			genState: &types.GenesisState{
+				RegionalIndices: []types.RegionalIndex{
+					{Jurisdiction: "US-CA", Symbol: "HPI-CA", Level: math.LegacyNewDec(300)},
+					{Jurisdiction: "US-NY", Symbol: "HPI-NY"},
+				},
+			},
+			valid: true,
+		}, {
+			desc: "duplicated regional index",
+			genState: &types.GenesisState{
+				RegionalIndices: []types.RegionalIndex{
+					{Jurisdiction: "US-CA", Symbol: "HPI-CA"},
+					{Jurisdiction: "US-CA", Symbol: "CSI-SF"},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "regional index of invalid symbol",
+			genState: &types.GenesisState{
+				RegionalIndices: []types.RegionalIndex{{Jurisdiction: "US-CA", Symbol: "hpi"}},
+			},
+			valid: false,
+		}, {
+			desc: "negative regional index level",
+			genState: &types.GenesisState{
+				RegionalIndices: []types.RegionalIndex{{Jurisdiction: "US-CA", Symbol: "HPI-CA", Level: math.LegacyNewDec(-1)}},
+			},
+			valid: false,
+		}, {
+			desc: "valid income records",
+			genState: &types.GenesisState{
+				Properties:       []types.Property{property(0, "US-CA", "P-0")},
+				PropertySeq:      1,
+				PropertyManagers: []types.PropertyManager{{PropertyId: 0, Manager: "manager"}},
+				Leases:           []types.Lease{lease(0, 0)},
+				LeaseSeq:         1,
+				CashFlows:        []types.CashFlow{cashFlow(0, 0, types.CashFlowKind_CASH_FLOW_KIND_RENT), cashFlow(1, 0, types.CashFlowKind_CASH_FLOW_KIND_EXPENSE)},
+				CashFlowSeq:      2,
+			},
+			valid: true,
+		}, {
+			desc: "manager of unknown property",
+			genState: &types.GenesisState{
+				PropertyManagers: []types.PropertyManager{{PropertyId: 0, Manager: "manager"}},
+			},
+			valid: false,
+		}, {
+			desc: "lease id not below the sequence",
+			genState: &types.GenesisState{
+				Properties:  []types.Property{property(0, "US-CA", "P-0")},
+				PropertySeq: 1,
+				Leases:      []types.Lease{lease(0, 0)},
+			},
+			valid: false,
+		}, {
+			desc: "rent of unknown lease",
+			genState: &types.GenesisState{
+				Properties:  []types.Property{property(0, "US-CA", "P-0")},
+				PropertySeq: 1,
+				CashFlows:   []types.CashFlow{cashFlow(0, 0, types.CashFlowKind_CASH_FLOW_KIND_RENT)},
+				CashFlowSeq: 1,
+			},
+			valid: false,
+		}, {
+			desc: "invalid encumbrances hash",
+			genState: &types.GenesisState{
+				Properties:  []types.Property{{Id: 0, ParcelId: "P-0", Jurisdiction: "US", EncumbrancesHash: "abcd"}},
+				PropertySeq: 1,
+			},
+			valid: false,
 		},
 	}
 	for _, tc := range tests {
@@ -49,3 +270,62 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ValidityPeriodOf(t *testing.T) {
+	params := types.NewParams(1, 0, types.DefaultOutlierDeviation, "", 3600, []types.ValidityPeriod{
+		{PropertyClass: types.PropertyClass_PROPERTY_CLASS_LAND},
+		{PropertyClass: types.PropertyClass_PROPERTY_CLASS_COMMERCIAL, Seconds: 60},
+	}, "")
+
+	require.Equal(t, time.Hour, params.ValidityPeriodOf(types.PropertyClass_PROPERTY_CLASS_RESIDENTIAL))
+	require.Equal(t, time.Minute, params.ValidityPeriodOf(types.PropertyClass_PROPERTY_CLASS_COMMERCIAL))
+	require.Zero(t, params.ValidityPeriodOf(types.PropertyClass_PROPERTY_CLASS_LAND))
+
+	params.DefaultValidityPeriod = stdmath.MaxUint64
+	require.Positive(t, params.ValidityPeriodOf(types.PropertyClass_PROPERTY_CLASS_RESIDENTIAL))
+}
+
+func property(id uint64, jurisdiction, parcelID string) types.Property {
+	return types.Property{
+		Id:            id,
+		ParcelId:      parcelID,
+		Jurisdiction:  jurisdiction,
+		PropertyClass: types.PropertyClass_PROPERTY_CLASS_RESIDENTIAL,
+	}
+}
+
+func lease(id, propertyID uint64) types.Lease {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	return types.Lease{
+		Id:         id,
+		PropertyId: propertyID,
+		TenantHash: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		Start:      start,
+		End:        start.AddDate(5, 0, 0),
+		Rent:       120_000,
+	}
+}
+
+func cashFlow(id, propertyID uint64, kind types.CashFlowKind) types.CashFlow {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	return types.CashFlow{
+		Id:          id,
+		PropertyId:  propertyID,
+		Kind:        kind,
+		Amount:      10_000,
+		PeriodStart: start,
+		PeriodEnd:   start.AddDate(0, 1, 0),
+	}
+}
+
+func appraisal(id, propertyID uint64, appraiser string) types.Appraisal {
+	return types.Appraisal{
+		Id:           id,
+		PropertyId:   propertyID,
+		Cycle:        1,
+		Appraiser:    appraiser,
+		Value:        1_000_000,
+		Methodology:  types.Methodology_METHODOLOGY_COMPARABLES,
+		DocumentHash: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	}
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// TenantHashLength is the length in bytes of the hash of the identity of
+	// a tenant.
+	TenantHashLength = 32
+
+	// MaxLeaseTerm is the maximum term of a lease in years.
+	MaxLeaseTerm = 99
+
+	// DefaultIncomeWindow is the default number of seconds whose cash flows
+	// are counted in the income metrics of a property: 365 days.
+	DefaultIncomeWindow uint64 = 365 * 24 * 60 * 60
+)
+
+// Validate performs basic validation of the tenant hash, term, rent and
+// escalation of the lease.
+func (l Lease) Validate() error {
+	hash, err := hex.DecodeString(l.TenantHash)
+	if err != nil {
+		return fmt.Errorf("tenant hash is not hex encoded: %w", err)
+	}
+	if len(hash) != TenantHashLength {
+		return fmt.Errorf("tenant hash must be %d bytes, got %d", TenantHashLength, len(hash))
+	}
+	// a lease terminated before its start ends at its start
+	if l.Start.IsZero() || l.End.Before(l.Start) || (l.End.Equal(l.Start) && !l.Terminated) {
+		return fmt.Errorf("lease must end after its start")
+	}
+	if l.End.After(l.Start.AddDate(MaxLeaseTerm, 0, 0)) {
+		return fmt.Errorf("lease term exceeds %d years", MaxLeaseTerm)
+	}
+	if l.Rent == 0 {
+		return fmt.Errorf("rent must be positive")
+	}
+	if !l.Escalation.IsNil() && (l.Escalation.IsNegative() || l.Escalation.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("escalation must be between 0 and 1, got %s", l.Escalation)
+	}
+
+	return nil
+}
+
+// IsActive reports whether the lease runs at the time.
+func (l Lease) IsActive(t time.Time) bool {
+	return !t.Before(l.Start) && t.Before(l.End)
+}
+
+// RentAt returns the annual rent of the lease at the time: its rent escalated
+// on each anniversary of its start by then, at most MaxLeaseTerm times,
+// rounded down and capped at the largest uint64.
+func (l Lease) RentAt(t time.Time) uint64 {
+	if l.Escalation.IsNil() || !l.Escalation.IsPositive() {
+		return l.Rent
+	}
+
+	var years uint64
+	for years < MaxLeaseTerm && !l.Start.AddDate(int(years)+1, 0, 0).After(t) {
+		years++
+	}
+
+	rent := math.LegacyNewDecFromInt(math.NewIntFromUint64(l.Rent)).
+		Mul(math.LegacyOneDec().Add(l.Escalation).Power(years)).
+		TruncateInt()
+	if !rent.IsUint64() {
+		return ^uint64(0)
+	}
+
+	return rent.Uint64()
+}
+
+// Validate performs basic validation of the kind, amount and period of the
+// cash flow.
+func (c CashFlow) Validate() error {
+	if _, ok := CashFlowKind_name[int32(c.Kind)]; !ok || c.Kind == CashFlowKind_CASH_FLOW_KIND_UNSPECIFIED {
+		return fmt.Errorf("invalid cash flow kind %d", c.Kind)
+	}
+	if c.Amount == 0 {
+		return fmt.Errorf("amount must be positive")
+	}
+	if c.PeriodStart.IsZero() || !c.PeriodEnd.After(c.PeriodStart) {
+		return fmt.Errorf("period must end after its start")
+	}
+
+	return nil
+}
@@ -0,0 +1,1492 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/realestate/v1/income.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CashFlowKind defines whether a cash flow is an income or an expense of the
+// property.
+type CashFlowKind int32
+
+const (
+	// CASH_FLOW_KIND_UNSPECIFIED is an invalid kind.
+	CashFlowKind_CASH_FLOW_KIND_UNSPECIFIED CashFlowKind = 0
+	// CASH_FLOW_KIND_RENT is a rent received from the tenant of a lease.
+	CashFlowKind_CASH_FLOW_KIND_RENT CashFlowKind = 1
+	// CASH_FLOW_KIND_EXPENSE is an operating expense of the property, such as
+	// maintenance, insurance, property taxes or management fees.
+	CashFlowKind_CASH_FLOW_KIND_EXPENSE CashFlowKind = 2
+)
+
+var CashFlowKind_name = map[int32]string{
+	0: "CASH_FLOW_KIND_UNSPECIFIED",
+	1: "CASH_FLOW_KIND_RENT",
+	2: "CASH_FLOW_KIND_EXPENSE",
+}
+
+var CashFlowKind_value = map[string]int32{
+	"CASH_FLOW_KIND_UNSPECIFIED": 0,
+	"CASH_FLOW_KIND_RENT":        1,
+	"CASH_FLOW_KIND_EXPENSE":     2,
+}
+
+func (x CashFlowKind) String() string {
+	return proto.EnumName(CashFlowKind_name, int32(x))
+}
+
+func (CashFlowKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80cc1188a2fb8741, []int{0}
+}
+
+// PropertyManager is the manager appointed by the owner of a property to
+// record its leases and report its cash flows.
+type PropertyManager struct {
+	PropertyId uint64 `protobuf:"varint,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Manager    string `protobuf:"bytes,2,opt,name=manager,proto3" json:"manager,omitempty"`
+}
+
+func (m *PropertyManager) Reset()         { *m = PropertyManager{} }
+func (m *PropertyManager) String() string { return proto.CompactTextString(m) }
+func (*PropertyManager) ProtoMessage()    {}
+func (*PropertyManager) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80cc1188a2fb8741, []int{0}
+}
+func (m *PropertyManager) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PropertyManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PropertyManager.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PropertyManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PropertyManager.Merge(m, src)
+}
+func (m *PropertyManager) XXX_Size() int {
+	return m.Size()
+}
+func (m *PropertyManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_PropertyManager.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PropertyManager proto.InternalMessageInfo
+
+func (m *PropertyManager) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *PropertyManager) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
+}
+
+// Lease is a lease of a property, or of a part of it, to a tenant.
+type Lease struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PropertyId uint64 `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// tenant_hash is the hex encoded SHA-256 hash of the identity of the
+	// tenant, which is kept off chain.
+	TenantHash string    `protobuf:"bytes,3,opt,name=tenant_hash,json=tenantHash,proto3" json:"tenant_hash,omitempty"`
+	Start      time.Time `protobuf:"bytes,4,opt,name=start,proto3,stdtime" json:"start"`
+	// end is the end of the term of the lease, or its termination time if it
+	// was terminated early.
+	End time.Time `protobuf:"bytes,5,opt,name=end,proto3,stdtime" json:"end"`
+	// rent is the annual rent of the first year of the lease.
+	Rent uint64 `protobuf:"varint,6,opt,name=rent,proto3" json:"rent,omitempty"`
+	// escalation is the rate by which the rent rises on each anniversary of the
+	// start of the lease.
+	Escalation cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=escalation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"escalation"`
+	// area is the leased floor area in square metres, 0 for the whole property.
+	Area uint64 `protobuf:"varint,8,opt,name=area,proto3" json:"area,omitempty"`
+	// terminated is set when the lease is terminated before the end of its
+	// term.
+	Terminated bool   `protobuf:"varint,9,opt,name=terminated,proto3" json:"terminated,omitempty"`
+	Creator    string `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *Lease) Reset()         { *m = Lease{} }
+func (m *Lease) String() string { return proto.CompactTextString(m) }
+func (*Lease) ProtoMessage()    {}
+func (*Lease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80cc1188a2fb8741, []int{1}
+}
+func (m *Lease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lease.Merge(m, src)
+}
+func (m *Lease) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lease) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lease proto.InternalMessageInfo
+
+func (m *Lease) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Lease) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *Lease) GetTenantHash() string {
+	if m != nil {
+		return m.TenantHash
+	}
+	return ""
+}
+
+func (m *Lease) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *Lease) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
+func (m *Lease) GetRent() uint64 {
+	if m != nil {
+		return m.Rent
+	}
+	return 0
+}
+
+func (m *Lease) GetArea() uint64 {
+	if m != nil {
+		return m.Area
+	}
+	return 0
+}
+
+func (m *Lease) GetTerminated() bool {
+	if m != nil {
+		return m.Terminated
+	}
+	return false
+}
+
+func (m *Lease) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// CashFlow is a rent receipt or an operating expense of a property for a
+// period, reported by its manager.
+type CashFlow struct {
+	Id         uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PropertyId uint64       `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Kind       CashFlowKind `protobuf:"varint,3,opt,name=kind,proto3,enum=realfin.realestate.v1.CashFlowKind" json:"kind,omitempty"`
+	// lease_id is the lease the rent was received for, unused for the
+	// expenses.
+	LeaseId     uint64    `protobuf:"varint,4,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	Amount      uint64    `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	PeriodStart time.Time `protobuf:"bytes,6,opt,name=period_start,json=periodStart,proto3,stdtime" json:"period_start"`
+	PeriodEnd   time.Time `protobuf:"bytes,7,opt,name=period_end,json=periodEnd,proto3,stdtime" json:"period_end"`
+	Reporter    string    `protobuf:"bytes,8,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	ReportedAt  time.Time `protobuf:"bytes,9,opt,name=reported_at,json=reportedAt,proto3,stdtime" json:"reported_at"`
+}
+
+func (m *CashFlow) Reset()         { *m = CashFlow{} }
+func (m *CashFlow) String() string { return proto.CompactTextString(m) }
+func (*CashFlow) ProtoMessage()    {}
+func (*CashFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80cc1188a2fb8741, []int{2}
+}
+func (m *CashFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CashFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CashFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CashFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CashFlow.Merge(m, src)
+}
+func (m *CashFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *CashFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_CashFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CashFlow proto.InternalMessageInfo
+
+func (m *CashFlow) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CashFlow) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *CashFlow) GetKind() CashFlowKind {
+	if m != nil {
+		return m.Kind
+	}
+	return CashFlowKind_CASH_FLOW_KIND_UNSPECIFIED
+}
+
+func (m *CashFlow) GetLeaseId() uint64 {
+	if m != nil {
+		return m.LeaseId
+	}
+	return 0
+}
+
+func (m *CashFlow) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *CashFlow) GetPeriodStart() time.Time {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return time.Time{}
+}
+
+func (m *CashFlow) GetPeriodEnd() time.Time {
+	if m != nil {
+		return m.PeriodEnd
+	}
+	return time.Time{}
+}
+
+func (m *CashFlow) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *CashFlow) GetReportedAt() time.Time {
+	if m != nil {
+		return m.ReportedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("realfin.realestate.v1.CashFlowKind", CashFlowKind_name, CashFlowKind_value)
+	proto.RegisterType((*PropertyManager)(nil), "realfin.realestate.v1.PropertyManager")
+	proto.RegisterType((*Lease)(nil), "realfin.realestate.v1.Lease")
+	proto.RegisterType((*CashFlow)(nil), "realfin.realestate.v1.CashFlow")
+}
+
+func init() {
+	proto.RegisterFile("realfin/realestate/v1/income.proto", fileDescriptor_80cc1188a2fb8741)
+}
+
+var fileDescriptor_80cc1188a2fb8741 = []byte{
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0x93, 0x90, 0x9f, 0x1b, 0x3e, 0x3e, 0x3a, 0xa5, 0xd4, 0xa4, 0x92, 0x13, 0xa5, 0x9b,
+	0x08, 0x09, 0x5b, 0x50, 0xd4, 0x2e, 0xba, 0xa8, 0x80, 0x04, 0x91, 0x92, 0xa6, 0xc8, 0xa1, 0x3f,
+	0xea, 0xc6, 0x1a, 0xec, 0x21, 0xb1, 0x88, 0x67, 0xac, 0xf1, 0x40, 0xcb, 0x5b, 0xd0, 0xb7, 0xe8,
+	0xb2, 0x0b, 0x5e, 0xa0, 0x3b, 0x96, 0x88, 0x55, 0xd5, 0x05, 0xad, 0x60, 0xd1, 0xd7, 0xa8, 0x3c,
+	0x63, 0xd3, 0x88, 0x56, 0xaa, 0xb2, 0x49, 0xee, 0xbd, 0x73, 0xcf, 0x39, 0x73, 0xef, 0xb1, 0x0d,
+	0x0d, 0x4e, 0xf0, 0x68, 0xdf, 0xa7, 0x56, 0xfc, 0x4f, 0x22, 0x81, 0x05, 0xb1, 0x8e, 0x96, 0x2d,
+	0x9f, 0xba, 0x2c, 0x20, 0x66, 0xc8, 0x99, 0x60, 0xe8, 0x5e, 0xd2, 0x63, 0xfe, 0xee, 0x31, 0x8f,
+	0x96, 0xab, 0x77, 0x70, 0xe0, 0x53, 0x66, 0xc9, 0x5f, 0xd5, 0x59, 0x5d, 0x70, 0x59, 0x14, 0xb0,
+	0xc8, 0x91, 0x99, 0xa5, 0x92, 0xe4, 0x68, 0x6e, 0xc0, 0x06, 0x4c, 0xd5, 0xe3, 0x28, 0xa9, 0xd6,
+	0x06, 0x8c, 0x0d, 0x46, 0xc4, 0x92, 0xd9, 0xde, 0xe1, 0xbe, 0x25, 0xfc, 0x20, 0x56, 0x08, 0x42,
+	0xd5, 0xd0, 0xd8, 0x87, 0xff, 0x77, 0x38, 0x0b, 0x09, 0x17, 0xc7, 0x2f, 0x30, 0xc5, 0x03, 0xc2,
+	0x51, 0x0d, 0x2a, 0x61, 0x52, 0x72, 0x7c, 0x4f, 0xd7, 0xea, 0x5a, 0x33, 0x6f, 0x43, 0x5a, 0xea,
+	0x78, 0x68, 0x05, 0x8a, 0x81, 0xea, 0xd5, 0xb3, 0x75, 0xad, 0x59, 0x5e, 0xd7, 0x2f, 0x4e, 0x97,
+	0xe6, 0x92, 0xdb, 0xac, 0x79, 0x1e, 0x27, 0x51, 0xd4, 0x17, 0xdc, 0xa7, 0x03, 0x3b, 0x6d, 0x6c,
+	0x7c, 0xcc, 0xc1, 0x54, 0x97, 0xe0, 0x88, 0xa0, 0x19, 0xc8, 0xde, 0xb0, 0x66, 0x7d, 0xef, 0xb6,
+	0x5c, 0xf6, 0x0f, 0xb9, 0x1a, 0x54, 0x04, 0xa1, 0x98, 0x0a, 0x67, 0x88, 0xa3, 0xa1, 0x9e, 0x8b,
+	0x25, 0x6d, 0x50, 0xa5, 0x2d, 0x1c, 0x0d, 0xd1, 0x33, 0x98, 0x8a, 0x04, 0xe6, 0x42, 0xcf, 0xd7,
+	0xb5, 0x66, 0x65, 0xa5, 0x6a, 0xaa, 0xa1, 0xcd, 0x74, 0x68, 0x73, 0x37, 0x1d, 0x7a, 0xfd, 0xbf,
+	0xb3, 0xcb, 0x5a, 0xe6, 0xe4, 0x7b, 0x4d, 0xfb, 0xf4, 0xf3, 0xf3, 0xa2, 0x66, 0x2b, 0x1c, 0x7a,
+	0x0a, 0x39, 0x42, 0x3d, 0x7d, 0x6a, 0x52, 0x78, 0x8c, 0x42, 0x08, 0xf2, 0x9c, 0x50, 0xa1, 0x17,
+	0xe4, 0xc5, 0x65, 0x8c, 0x5e, 0x03, 0x90, 0xc8, 0xc5, 0x23, 0x2c, 0x7c, 0x46, 0xf5, 0xa2, 0x5c,
+	0xd2, 0xe3, 0x18, 0xfb, 0xed, 0xb2, 0xf6, 0x40, 0x2d, 0x2a, 0xf2, 0x0e, 0x4c, 0x9f, 0x59, 0x01,
+	0x16, 0x43, 0xb3, 0x4b, 0x06, 0xd8, 0x3d, 0x6e, 0x11, 0xf7, 0xe2, 0x74, 0x09, 0x92, 0x3d, 0xb6,
+	0x88, 0xab, 0x44, 0xc6, 0x98, 0x62, 0x2d, 0xcc, 0x09, 0xd6, 0x4b, 0x4a, 0x2b, 0x8e, 0x91, 0x01,
+	0x20, 0x08, 0x0f, 0x7c, 0x8a, 0x05, 0xf1, 0xf4, 0x72, 0x5d, 0x6b, 0x96, 0xec, 0xb1, 0x0a, 0xd2,
+	0xa1, 0xe8, 0x72, 0x82, 0x05, 0xe3, 0x3a, 0xc8, 0xd5, 0xa5, 0x69, 0xe3, 0x4b, 0x0e, 0x4a, 0x1b,
+	0x38, 0x1a, 0x6e, 0x8e, 0xd8, 0xfb, 0xc9, 0x6d, 0x79, 0x02, 0xf9, 0x03, 0x9f, 0x7a, 0xd2, 0x8f,
+	0x99, 0x95, 0x87, 0xe6, 0x5f, 0x1f, 0x62, 0x33, 0xe5, 0xdf, 0xf6, 0xa9, 0x67, 0x4b, 0x00, 0x5a,
+	0x80, 0xd2, 0x28, 0x7e, 0x12, 0x62, 0xda, 0xbc, 0xa4, 0x2d, 0xca, 0xbc, 0xe3, 0xa1, 0x79, 0x28,
+	0xe0, 0x80, 0x1d, 0x52, 0x21, 0xbd, 0xc8, 0xdb, 0x49, 0x86, 0xba, 0x30, 0x1d, 0x12, 0xee, 0x33,
+	0xcf, 0x51, 0x46, 0x17, 0x26, 0x75, 0xaa, 0xa2, 0xe0, 0x7d, 0x69, 0xf7, 0x16, 0x40, 0xc2, 0x16,
+	0xbb, 0x5e, 0x9c, 0x94, 0xab, 0xac, 0xc0, 0x6d, 0xea, 0xa1, 0x55, 0x28, 0x71, 0x12, 0x32, 0x2e,
+	0x08, 0xd7, 0x4b, 0xff, 0x78, 0x15, 0x6e, 0x3a, 0xd1, 0x73, 0xa8, 0x24, 0xb1, 0xe7, 0x60, 0xa1,
+	0x97, 0x27, 0xbd, 0x00, 0xa4, 0xe8, 0x35, 0xb1, 0xe8, 0xc2, 0xf4, 0xf8, 0x8a, 0x91, 0x01, 0xd5,
+	0x8d, 0xb5, 0xfe, 0x96, 0xb3, 0xd9, 0x7d, 0xf9, 0xc6, 0xd9, 0xee, 0xf4, 0x5a, 0xce, 0xab, 0x5e,
+	0x7f, 0xa7, 0xbd, 0xd1, 0xd9, 0xec, 0xb4, 0x5b, 0xb3, 0x19, 0x74, 0x1f, 0xee, 0xde, 0x3a, 0xb7,
+	0xdb, 0xbd, 0xdd, 0x59, 0x0d, 0x55, 0x61, 0xfe, 0xd6, 0x41, 0xfb, 0xed, 0x4e, 0xbb, 0xd7, 0x6f,
+	0xcf, 0x66, 0xd7, 0x57, 0xcf, 0xae, 0x0c, 0xed, 0xfc, 0xca, 0xd0, 0x7e, 0x5c, 0x19, 0xda, 0xc9,
+	0xb5, 0x91, 0x39, 0xbf, 0x36, 0x32, 0x5f, 0xaf, 0x8d, 0xcc, 0xbb, 0x6a, 0xfa, 0x79, 0xfb, 0x30,
+	0xfe, 0x81, 0x13, 0xc7, 0x21, 0x89, 0xf6, 0x0a, 0x72, 0x92, 0x47, 0xbf, 0x06, 0x00, 0xc6, 0x2e,
+	0x38, 0x5e, 0x03, 0x05, 0x00, 0x00,
+}
+
+func (m *PropertyManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PropertyManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PropertyManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintIncome(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintIncome(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Lease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintIncome(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Terminated {
+		i--
+		if m.Terminated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Area != 0 {
+		i = encodeVarintIncome(dAtA, i, uint64(m.Area))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Escalation.Size()
+		i -= size
+		if _, err := m.Escalation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncome(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Rent != 0 {
+		i = encodeVarintIncome(dAtA, i, uint64(m.Rent))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIncome(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintIncome(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TenantHash) > 0 {
+		i -= len(m.TenantHash)
+		copy(dAtA[i:], m.TenantHash)
+		i = encodeVarintIncome(dAtA, i, uint64(len(m.TenantHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintIncome(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintIncome(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CashFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CashFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CashFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReportedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReportedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintIncome(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintIncome(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodEnd):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintIncome(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintIncome(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	if m.Amount != 0 {
+		i = encodeVarintIncome(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LeaseId != 0 {
+		i = encodeVarintIncome(dAtA, i, uint64(m.LeaseId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Kind != 0 {
+		i = encodeVarintIncome(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintIncome(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintIncome(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIncome(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIncome(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PropertyManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		n += 1 + sovIncome(uint64(m.PropertyId))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovIncome(uint64(l))
+	}
+	return n
+}
+
+func (m *Lease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIncome(uint64(m.Id))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovIncome(uint64(m.PropertyId))
+	}
+	l = len(m.TenantHash)
+	if l > 0 {
+		n += 1 + l + sovIncome(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovIncome(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovIncome(uint64(l))
+	if m.Rent != 0 {
+		n += 1 + sovIncome(uint64(m.Rent))
+	}
+	l = m.Escalation.Size()
+	n += 1 + l + sovIncome(uint64(l))
+	if m.Area != 0 {
+		n += 1 + sovIncome(uint64(m.Area))
+	}
+	if m.Terminated {
+		n += 2
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovIncome(uint64(l))
+	}
+	return n
+}
+
+func (m *CashFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIncome(uint64(m.Id))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovIncome(uint64(m.PropertyId))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovIncome(uint64(m.Kind))
+	}
+	if m.LeaseId != 0 {
+		n += 1 + sovIncome(uint64(m.LeaseId))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovIncome(uint64(m.Amount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart)
+	n += 1 + l + sovIncome(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodEnd)
+	n += 1 + l + sovIncome(uint64(l))
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovIncome(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReportedAt)
+	n += 1 + l + sovIncome(uint64(l))
+	return n
+}
+
+func sovIncome(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIncome(x uint64) (n int) {
+	return sovIncome(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PropertyManager) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncome
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PropertyManager: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PropertyManager: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncome
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncome(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Lease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncome
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncome
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncome
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncome
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rent", wireType)
+			}
+			m.Rent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escalation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncome
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escalation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Area", wireType)
+			}
+			m.Area = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Area |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Terminated = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncome
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncome(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CashFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncome
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CashFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CashFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= CashFlowKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseId", wireType)
+			}
+			m.LeaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncome
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncome
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncome
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncome
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReportedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncome(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncome
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIncome(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIncome
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncome
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIncome
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIncome
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIncome
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIncome        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIncome          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIncome = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/realestate/types"
+)
+
+func TestLease_Validate(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		desc   string
+		modify func(*types.Lease)
+		valid  bool
+	}{
+		{desc: "valid", modify: func(*types.Lease) {}, valid: true},
+		{desc: "escalation", modify: func(l *types.Lease) { l.Escalation = math.LegacyNewDecWithPrec(3, 2) }, valid: true},
+		{desc: "invalid tenant hash", modify: func(l *types.Lease) { l.TenantHash = "abcd" }},
+		{desc: "end before start", modify: func(l *types.Lease) { l.End = start.Add(-time.Second) }},
+		{desc: "end at start", modify: func(l *types.Lease) { l.End = start }},
+		{desc: "terminated at start", modify: func(l *types.Lease) { l.End, l.Terminated = start, true }, valid: true},
+		{desc: "term too long", modify: func(l *types.Lease) { l.End = start.AddDate(types.MaxLeaseTerm+1, 0, 0) }},
+		{desc: "no rent", modify: func(l *types.Lease) { l.Rent = 0 }},
+		{desc: "negative escalation", modify: func(l *types.Lease) { l.Escalation = math.LegacyNewDec(-1) }},
+		{desc: "escalation above one", modify: func(l *types.Lease) { l.Escalation = math.LegacyNewDec(2) }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			lease := types.Lease{
+				TenantHash: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+				Start:      start,
+				End:        start.AddDate(5, 0, 0),
+				Rent:       120_000,
+			}
+			tc.modify(&lease)
+			if tc.valid {
+				require.NoError(t, lease.Validate())
+			} else {
+				require.Error(t, lease.Validate())
+			}
+		})
+	}
+}
+
+func TestLease_RentAt(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	lease := types.Lease{
+		Start:      start,
+		End:        start.AddDate(types.MaxLeaseTerm, 0, 0),
+		Rent:       100_000,
+		Escalation: math.LegacyNewDecWithPrec(3, 2),
+	}
+
+	require.Equal(t, uint64(100_000), lease.RentAt(start))
+	require.Equal(t, uint64(100_000), lease.RentAt(start.AddDate(1, 0, 0).Add(-time.Second)))
+	require.Equal(t, uint64(103_000), lease.RentAt(start.AddDate(1, 0, 0)))
+	require.Equal(t, uint64(106_090), lease.RentAt(start.AddDate(2, 6, 0)))
+
+	// the escalations stop after the longest term
+	lease.Escalation = math.LegacyOneDec()
+	lease.Rent = ^uint64(0)
+	require.Equal(t, ^uint64(0), lease.RentAt(start.AddDate(1000, 0, 0)))
+
+	lease.Escalation = math.LegacyDec{}
+	lease.Rent = 100_000
+	require.Equal(t, uint64(100_000), lease.RentAt(start.AddDate(3, 0, 0)))
+}
+
+func TestCashFlow_Validate(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	valid := types.CashFlow{Kind: types.CashFlowKind_CASH_FLOW_KIND_RENT, Amount: 10_000, PeriodStart: start, PeriodEnd: start.AddDate(0, 1, 0)}
+	require.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.Kind = types.CashFlowKind_CASH_FLOW_KIND_UNSPECIFIED
+	require.Error(t, invalid.Validate())
+	invalid = valid
+	invalid.Amount = 0
+	require.Error(t, invalid.Validate())
+	invalid = valid
+	invalid.PeriodEnd = start
+	require.Error(t, invalid.Validate())
+}
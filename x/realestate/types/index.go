@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	oracletypes "realfin/x/oracle/types"
+)
+
+// Validate performs basic validation of the jurisdiction, symbol and level of
+// the regional index.
+func (i RegionalIndex) Validate() error {
+	if err := ValidateJurisdiction(i.Jurisdiction); err != nil {
+		return err
+	}
+	if err := oracletypes.ValidateSymbol(i.Symbol); err != nil {
+		return err
+	}
+	if !i.Level.IsNil() && i.Level.IsNegative() {
+		return fmt.Errorf("level cannot be negative")
+	}
+
+	return nil
+}
+
+// IndexedValue marks the value to model: it scales the value by the change of
+// the index from the base level to the level, rounding down. The value is
+// returned unchanged when either level is not positive, and capped at the
+// largest uint64.
+func IndexedValue(value uint64, base, level math.LegacyDec) uint64 {
+	if base.IsNil() || level.IsNil() || !base.IsPositive() || !level.IsPositive() {
+		return value
+	}
+
+	indexed := math.LegacyNewDecFromInt(math.NewIntFromUint64(value)).Mul(level).Quo(base).TruncateInt()
+	if !indexed.IsUint64() {
+		return ^uint64(0)
+	}
+
+	return indexed.Uint64()
+}
@@ -0,0 +1,488 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/realestate/v1/index.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RegionalIndex is the price index of a jurisdiction, fed from the price of an
+// oracle symbol. The indexed values of the properties of the jurisdiction
+// follow the changes of its level.
+type RegionalIndex struct {
+	Jurisdiction string `protobuf:"bytes,1,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// symbol is the oracle symbol whose price is the level of the index.
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// level is the last fresh price of the symbol, zero until the first refresh
+	// of the index.
+	Level     cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=level,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"level"`
+	UpdatedAt time.Time                   `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+}
+
+func (m *RegionalIndex) Reset()         { *m = RegionalIndex{} }
+func (m *RegionalIndex) String() string { return proto.CompactTextString(m) }
+func (*RegionalIndex) ProtoMessage()    {}
+func (*RegionalIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9a18a56e796869d, []int{0}
+}
+func (m *RegionalIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegionalIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegionalIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegionalIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionalIndex.Merge(m, src)
+}
+func (m *RegionalIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegionalIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionalIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionalIndex proto.InternalMessageInfo
+
+func (m *RegionalIndex) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
+}
+
+func (m *RegionalIndex) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *RegionalIndex) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*RegionalIndex)(nil), "realfin.realestate.v1.RegionalIndex")
+}
+
+func init() { proto.RegisterFile("realfin/realestate/v1/index.proto", fileDescriptor_a9a18a56e796869d) }
+
+var fileDescriptor_a9a18a56e796869d = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0x3d, 0x4f, 0xc3, 0x30,
+	0x10, 0x8d, 0xf9, 0xa8, 0x54, 0x43, 0x07, 0x22, 0x40, 0x21, 0x48, 0x49, 0xe9, 0x54, 0x21, 0x61,
+	0xab, 0x80, 0xd8, 0xa9, 0x3a, 0x80, 0xd4, 0xa9, 0x62, 0x62, 0xa9, 0xdc, 0xc4, 0x0d, 0x86, 0x24,
+	0x17, 0xd5, 0x6e, 0xd5, 0xfe, 0x8b, 0xfe, 0x0c, 0x46, 0x06, 0x7e, 0x44, 0xc7, 0x8a, 0x09, 0x31,
+	0x14, 0xd4, 0x0c, 0xfc, 0x0d, 0x94, 0x38, 0x11, 0xb0, 0xd8, 0x7e, 0xef, 0x9e, 0xef, 0xdd, 0xd3,
+	0xe1, 0x93, 0x11, 0x67, 0xe1, 0x50, 0xc4, 0x34, 0xbb, 0xb9, 0x54, 0x4c, 0x71, 0x3a, 0x69, 0x51,
+	0x11, 0xfb, 0x7c, 0x4a, 0x92, 0x11, 0x28, 0x30, 0x0f, 0x0a, 0x09, 0xf9, 0x95, 0x90, 0x49, 0xcb,
+	0xde, 0x63, 0x91, 0x88, 0x81, 0xe6, 0xa7, 0x56, 0xda, 0x47, 0x1e, 0xc8, 0x08, 0x64, 0x3f, 0x47,
+	0x54, 0x83, 0xa2, 0xb4, 0x1f, 0x40, 0x00, 0x9a, 0xcf, 0x5e, 0x05, 0xeb, 0x06, 0x00, 0x41, 0xc8,
+	0x69, 0x8e, 0x06, 0xe3, 0x21, 0x55, 0x22, 0xca, 0x1c, 0xa2, 0x44, 0x0b, 0x1a, 0x29, 0xc2, 0xb5,
+	0x1e, 0x0f, 0x04, 0xc4, 0x2c, 0xbc, 0xcd, 0x66, 0x32, 0x1b, 0x78, 0xf7, 0x71, 0x3c, 0x12, 0xd2,
+	0x17, 0x9e, 0x12, 0x10, 0x5b, 0xa8, 0x8e, 0x9a, 0xd5, 0xde, 0x3f, 0xce, 0x3c, 0xc4, 0x15, 0x39,
+	0x8b, 0x06, 0x10, 0x5a, 0x1b, 0x79, 0xb5, 0x40, 0x66, 0x17, 0x6f, 0x87, 0x7c, 0xc2, 0x43, 0x6b,
+	0x33, 0xa3, 0xdb, 0x57, 0x8b, 0x95, 0x6b, 0x7c, 0xac, 0xdc, 0x63, 0x3d, 0xa9, 0xf4, 0x9f, 0x88,
+	0x00, 0x1a, 0x31, 0xf5, 0x40, 0xba, 0x3c, 0x60, 0xde, 0xac, 0xc3, 0xbd, 0xb7, 0xd7, 0x33, 0x5c,
+	0x04, 0xe9, 0x70, 0xef, 0xf9, 0xfb, 0xe5, 0x14, 0xf5, 0x74, 0x13, 0xf3, 0x06, 0xe3, 0x71, 0xe2,
+	0x33, 0xc5, 0xfd, 0x3e, 0x53, 0xd6, 0x56, 0x1d, 0x35, 0x77, 0xce, 0x6d, 0xa2, 0x13, 0x91, 0x32,
+	0x11, 0xb9, 0x2b, 0x13, 0xb5, 0x6b, 0x99, 0xdd, 0xfc, 0xd3, 0x45, 0xba, 0x4b, 0xb5, 0xf8, 0x7c,
+	0xad, 0xda, 0x97, 0x8b, 0xb5, 0x83, 0x96, 0x6b, 0x07, 0x7d, 0xad, 0x1d, 0x34, 0x4f, 0x1d, 0x63,
+	0x99, 0x3a, 0xc6, 0x7b, 0xea, 0x18, 0xf7, 0x76, 0xb9, 0x9e, 0xe9, 0xdf, 0x05, 0xa9, 0x59, 0xc2,
+	0xe5, 0xa0, 0x92, 0x7b, 0x5c, 0xfc, 0x0c, 0x00, 0x92, 0x10, 0xd6, 0xaf, 0xc3, 0x01, 0x00, 0x00,
+}
+
+func (m *RegionalIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegionalIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegionalIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIndex(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Level.Size()
+		i -= size
+		if _, err := m.Level.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndex(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIndex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIndex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RegionalIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	l = m.Level.Size()
+	n += 1 + l + sovIndex(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovIndex(uint64(l))
+	return n
+}
+
+func sovIndex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIndex(x uint64) (n int) {
+	return sovIndex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RegionalIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegionalIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegionalIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Level.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIndex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIndex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIndex
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIndex
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIndex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIndex        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIndex          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIndex = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/realestate/types"
+)
+
+func TestIndexedValue(t *testing.T) {
+	tests := []struct {
+		desc        string
+		value       uint64
+		base, level math.LegacyDec
+		indexed     uint64
+	}{
+		{desc: "rise", value: 1_000_000, base: math.LegacyNewDec(300), level: math.LegacyNewDec(330), indexed: 1_100_000},
+		{desc: "fall rounds down", value: 1_000_000, base: math.LegacyNewDec(3), level: math.LegacyNewDec(2), indexed: 666_666},
+		{desc: "no base", value: 1_000_000, base: math.LegacyZeroDec(), level: math.LegacyNewDec(2), indexed: 1_000_000},
+		{desc: "nil base", value: 1_000_000, base: math.LegacyDec{}, level: math.LegacyNewDec(2), indexed: 1_000_000},
+		{desc: "no level", value: 1_000_000, base: math.LegacyNewDec(2), level: math.LegacyZeroDec(), indexed: 1_000_000},
+		{desc: "capped", value: ^uint64(0), base: math.LegacyNewDec(1), level: math.LegacyNewDec(2), indexed: ^uint64(0)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.indexed, types.IndexedValue(tc.value, tc.base, tc.level))
+		})
+	}
+}
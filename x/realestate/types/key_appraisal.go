@@ -0,0 +1,15 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// AppraiserKey is the prefix to retrieve all Appraiser
+	AppraiserKey = collections.NewPrefix("appraiser/value/")
+
+	// AppraisalKey is the prefix to retrieve all Appraisal, keyed by property,
+	// cycle and id
+	AppraisalKey = collections.NewPrefix("appraisal/value/")
+
+	// AppraisalSeqKey is the prefix of the sequence of the appraisal ids.
+	AppraisalSeqKey = collections.NewPrefix("appraisal/seq/")
+)
@@ -0,0 +1,20 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// PropertyManagerKey is the prefix to retrieve all PropertyManager
+	PropertyManagerKey = collections.NewPrefix("property_manager/value/")
+
+	// LeaseKey is the prefix to retrieve all Lease
+	LeaseKey = collections.NewPrefix("lease/value/")
+
+	// LeaseSeqKey is the prefix of the sequence of the lease ids.
+	LeaseSeqKey = collections.NewPrefix("lease/seq/")
+
+	// CashFlowKey is the prefix to retrieve all CashFlow
+	CashFlowKey = collections.NewPrefix("cash_flow/value/")
+
+	// CashFlowSeqKey is the prefix of the sequence of the cash flow ids.
+	CashFlowSeqKey = collections.NewPrefix("cash_flow/seq/")
+)
@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// RegionalIndexKey is the prefix to retrieve all RegionalIndex
+	RegionalIndexKey = collections.NewPrefix("regional_index/value/")
+)
@@ -0,0 +1,23 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// PropertyKey is the prefix to retrieve all Property
+	PropertyKey = collections.NewPrefix("property/value/")
+
+	// PropertySeqKey is the prefix of the sequence of the property ids.
+	PropertySeqKey = collections.NewPrefix("property/seq/")
+
+	// PropertyJurisdictionIndexKey is the prefix of the index of the
+	// properties by jurisdiction.
+	PropertyJurisdictionIndexKey = collections.NewPrefix("property/jurisdiction/")
+
+	// PropertyClassIndexKey is the prefix of the index of the properties by
+	// class.
+	PropertyClassIndexKey = collections.NewPrefix("property/class/")
+
+	// PropertyParcelIndexKey is the prefix of the unique index of the
+	// properties by jurisdiction and parcel id.
+	PropertyParcelIndexKey = collections.NewPrefix("property/parcel/")
+)
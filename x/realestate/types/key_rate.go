@@ -2,5 +2,12 @@ package types
 
 import "cosmossdk.io/collections"
 
-// RateKey is the prefix to retrieve all Rate
-var RateKey = collections.NewPrefix("rate/value/")
+var (
+	// RateKey is the prefix to retrieve all Rate, keyed by property id. It
+	// differs from the prefix of the rates of version 1, keyed by symbol.
+	RateKey = collections.NewPrefix("rate/property/")
+
+	// RateExpiryKey is the prefix of the schedule of the valuations to expire,
+	// keyed by expiry time and property id.
+	RateExpiryKey = collections.NewPrefix("rate/expiry/")
+)
@@ -1,17 +1,113 @@
 package types
 
+import (
+	"fmt"
+	stdmath "math"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// DefaultAppraisalQuorum is the default number of appraisals finalising a
+	// valuation cycle.
+	DefaultAppraisalQuorum uint32 = 3
+
+	// DefaultAppraisalTrim is the default number of the lowest and of the
+	// highest appraisals left out of the valuation.
+	DefaultAppraisalTrim uint32 = 1
+
+	// DefaultIndexEpochIdentifier is the default epoch at the start of which
+	// the regional indices are refreshed.
+	DefaultIndexEpochIdentifier = "day"
+
+	// DefaultValidityPeriod is the default number of seconds after its
+	// finalisation a valuation expires: 365 days.
+	DefaultValidityPeriod uint64 = 365 * 24 * 60 * 60
+
+	// DefaultExpiryEpochIdentifier is the default epoch at the start of which
+	// the valuations past their validity are flagged as expired.
+	DefaultExpiryEpochIdentifier = "hour"
+)
+
+// DefaultOutlierDeviation is the default deviation from the valuation above
+// which an appraisal is an outlier: 20%.
+var DefaultOutlierDeviation = math.LegacyNewDecWithPrec(20, 2)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	appraisalQuorum uint32,
+	appraisalTrim uint32,
+	outlierDeviation math.LegacyDec,
+	indexEpochIdentifier string,
+	defaultValidityPeriod uint64,
+	validityPeriods []ValidityPeriod,
+	expiryEpochIdentifier string,
+) Params {
+	return Params{
+		AppraisalQuorum:       appraisalQuorum,
+		AppraisalTrim:         appraisalTrim,
+		OutlierDeviation:      outlierDeviation,
+		IndexEpochIdentifier:  indexEpochIdentifier,
+		DefaultValidityPeriod: defaultValidityPeriod,
+		ValidityPeriods:       validityPeriods,
+		ExpiryEpochIdentifier: expiryEpochIdentifier,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultAppraisalQuorum, DefaultAppraisalTrim, DefaultOutlierDeviation, DefaultIndexEpochIdentifier,
+		DefaultValidityPeriod, nil, DefaultExpiryEpochIdentifier)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if 2*uint64(p.AppraisalTrim) >= uint64(p.Quorum()) {
+		return fmt.Errorf("appraisal trim %d leaves no appraisal of the quorum %d", p.AppraisalTrim, p.Quorum())
+	}
+	if !p.OutlierDeviation.IsNil() && p.OutlierDeviation.IsNegative() {
+		return fmt.Errorf("outlier deviation cannot be negative")
+	}
+	if strings.TrimSpace(p.IndexEpochIdentifier) != p.IndexEpochIdentifier {
+		return fmt.Errorf("index epoch identifier %q has surrounding spaces", p.IndexEpochIdentifier)
+	}
+
+	classes := make(map[PropertyClass]struct{}, len(p.ValidityPeriods))
+	for _, validity := range p.ValidityPeriods {
+		if _, ok := PropertyClass_name[int32(validity.PropertyClass)]; !ok {
+			return fmt.Errorf("invalid validity period class %d", validity.PropertyClass)
+		}
+		if _, ok := classes[validity.PropertyClass]; ok {
+			return fmt.Errorf("duplicated validity period for class %s", validity.PropertyClass)
+		}
+		classes[validity.PropertyClass] = struct{}{}
+	}
+
+	if strings.TrimSpace(p.ExpiryEpochIdentifier) != p.ExpiryEpochIdentifier {
+		return fmt.Errorf("expiry epoch identifier %q has surrounding spaces", p.ExpiryEpochIdentifier)
+	}
 
 	return nil
 }
+
+// Quorum returns the number of appraisals finalising a valuation cycle, at
+// least one.
+func (p Params) Quorum() uint32 {
+	return max(p.AppraisalQuorum, 1)
+}
+
+// ValidityPeriodOf returns the validity of the valuations of the given
+// property class. Zero means they never expire.
+func (p Params) ValidityPeriodOf(class PropertyClass) time.Duration {
+	seconds := p.DefaultValidityPeriod
+	for _, validity := range p.ValidityPeriods {
+		if validity.PropertyClass == class {
+			seconds = validity.Seconds
+			break
+		}
+	}
+
+	return time.Duration(min(seconds, uint64(stdmath.MaxInt64/int64(time.Second)))) * time.Second
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +28,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// appraisal_quorum is the number of appraisals of accredited appraisers
+	// finalising a valuation cycle. Zero finalises on the first appraisal.
+	AppraisalQuorum uint32 `protobuf:"varint,1,opt,name=appraisal_quorum,json=appraisalQuorum,proto3" json:"appraisal_quorum,omitempty"`
+	// appraisal_trim is the number of the lowest and of the highest appraisals
+	// left out of the trimmed mean valuing a property.
+	AppraisalTrim uint32 `protobuf:"varint,2,opt,name=appraisal_trim,json=appraisalTrim,proto3" json:"appraisal_trim,omitempty"`
+	// outlier_deviation is the relative deviation from the valuation above
+	// which an appraisal is flagged as an outlier. Zero flags none.
+	OutlierDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=outlier_deviation,json=outlierDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"outlier_deviation"`
+	// index_epoch_identifier is the identifier of the x/epochs epoch at the
+	// start of which the regional indices and the indexed values are refreshed,
+	// in the end block. Empty disables the refresh.
+	IndexEpochIdentifier string `protobuf:"bytes,4,opt,name=index_epoch_identifier,json=indexEpochIdentifier,proto3" json:"index_epoch_identifier,omitempty"`
+	// default_validity_period is the number of seconds after its finalisation
+	// a valuation expires, unless overridden for the class of the property.
+	// Zero means valuations never expire.
+	DefaultValidityPeriod uint64 `protobuf:"varint,5,opt,name=default_validity_period,json=defaultValidityPeriod,proto3" json:"default_validity_period,omitempty"`
+	// validity_periods overrides default_validity_period for individual
+	// property classes.
+	ValidityPeriods []ValidityPeriod `protobuf:"bytes,6,rep,name=validity_periods,json=validityPeriods,proto3" json:"validity_periods"`
+	// expiry_epoch_identifier is the identifier of the x/epochs epoch at the
+	// start of which the valuations past their validity are flagged as
+	// expired, in the end block. Empty flags them in every block.
+	ExpiryEpochIdentifier string `protobuf:"bytes,7,opt,name=expiry_epoch_identifier,json=expiryEpochIdentifier,proto3" json:"expiry_epoch_identifier,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,8 +87,106 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAppraisalQuorum() uint32 {
+	if m != nil {
+		return m.AppraisalQuorum
+	}
+	return 0
+}
+
+func (m *Params) GetAppraisalTrim() uint32 {
+	if m != nil {
+		return m.AppraisalTrim
+	}
+	return 0
+}
+
+func (m *Params) GetIndexEpochIdentifier() string {
+	if m != nil {
+		return m.IndexEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetDefaultValidityPeriod() uint64 {
+	if m != nil {
+		return m.DefaultValidityPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetValidityPeriods() []ValidityPeriod {
+	if m != nil {
+		return m.ValidityPeriods
+	}
+	return nil
+}
+
+func (m *Params) GetExpiryEpochIdentifier() string {
+	if m != nil {
+		return m.ExpiryEpochIdentifier
+	}
+	return ""
+}
+
+// ValidityPeriod defines the validity of the valuations of a property class.
+type ValidityPeriod struct {
+	PropertyClass PropertyClass `protobuf:"varint,1,opt,name=property_class,json=propertyClass,proto3,enum=realfin.realestate.v1.PropertyClass" json:"property_class,omitempty"`
+	// seconds is the number of seconds after its finalisation a valuation of
+	// the class expires. Zero means it never expires.
+	Seconds uint64 `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (m *ValidityPeriod) Reset()         { *m = ValidityPeriod{} }
+func (m *ValidityPeriod) String() string { return proto.CompactTextString(m) }
+func (*ValidityPeriod) ProtoMessage()    {}
+func (*ValidityPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c54ef6372b6569ee, []int{1}
+}
+func (m *ValidityPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidityPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidityPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidityPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidityPeriod.Merge(m, src)
+}
+func (m *ValidityPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidityPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidityPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidityPeriod proto.InternalMessageInfo
+
+func (m *ValidityPeriod) GetPropertyClass() PropertyClass {
+	if m != nil {
+		return m.PropertyClass
+	}
+	return PropertyClass_PROPERTY_CLASS_UNSPECIFIED
+}
+
+func (m *ValidityPeriod) GetSeconds() uint64 {
+	if m != nil {
+		return m.Seconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "realfin.realestate.v1.Params")
+	proto.RegisterType((*ValidityPeriod)(nil), "realfin.realestate.v1.ValidityPeriod")
 }
 
 func init() {
@@ -70,17 +194,39 @@ func init() {
 }
 
 var fileDescriptor_c54ef6372b6569ee = []byte{
-	// 160 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x4a, 0x4d, 0xcc,
-	0x49, 0xcb, 0xcc, 0xd3, 0x07, 0xd1, 0xa9, 0xc5, 0x25, 0x89, 0x25, 0xa9, 0xfa, 0x65, 0x86, 0xfa,
-	0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xa2, 0x50, 0x35,
-	0x7a, 0x08, 0x35, 0x7a, 0x65, 0x86, 0x52, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12,
-	0xa2, 0x52, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a, 0x7a,
-	0x5c, 0x6c, 0x01, 0x60, 0xf3, 0xac, 0x54, 0x5e, 0x2c, 0x90, 0x67, 0xec, 0x7a, 0xbe, 0x41, 0x4b,
-	0x1a, 0x66, 0x6d, 0x05, 0xb2, 0xc5, 0x10, 0x55, 0x4e, 0x26, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0x25, 0x85, 0x55, 0x5b, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8,
-	0x32, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba, 0xc0, 0x6e, 0xe0, 0xd2, 0x00, 0x00, 0x00,
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x91, 0x90, 0xaa, 0x87, 0x92, 0xa6, 0x56, 0x03, 0x26, 0x95, 0x9c, 0x28, 0x4a, 0xa5,
+	0x50, 0x09, 0x5b, 0x2d, 0x55, 0x87, 0x8e, 0x21, 0x0c, 0x08, 0x86, 0x60, 0x21, 0x06, 0x18, 0xac,
+	0xc3, 0x7e, 0x49, 0x4f, 0xd8, 0xbe, 0xe3, 0xee, 0x12, 0xc5, 0x03, 0x7f, 0x80, 0x89, 0x9f, 0xc0,
+	0xc8, 0xd8, 0x81, 0x1f, 0xd1, 0xb1, 0x62, 0x42, 0x0c, 0x15, 0x4a, 0x86, 0xf6, 0x67, 0x20, 0x9f,
+	0x9d, 0xb4, 0x0d, 0xed, 0x62, 0xdf, 0xfb, 0xbe, 0x4f, 0xef, 0xbd, 0x4f, 0xdf, 0xc3, 0x6d, 0x01,
+	0x24, 0x1c, 0xd2, 0xd8, 0x49, 0xff, 0x20, 0x15, 0x51, 0xe0, 0x4c, 0xf6, 0x1c, 0x4e, 0x04, 0x89,
+	0xa4, 0xcd, 0x05, 0x53, 0xcc, 0xa8, 0xe7, 0x1a, 0xfb, 0x4a, 0x63, 0x4f, 0xf6, 0x1a, 0x9b, 0x24,
+	0xa2, 0x31, 0x73, 0xf4, 0x37, 0x53, 0x36, 0x1e, 0xfb, 0x4c, 0x46, 0x4c, 0x7a, 0xba, 0x72, 0xb2,
+	0x22, 0xa7, 0xb6, 0x46, 0x6c, 0xc4, 0x32, 0x3c, 0x7d, 0xe5, 0x68, 0xe7, 0x8e, 0xf1, 0x82, 0x71,
+	0x10, 0x2a, 0xc9, 0x54, 0xed, 0xcb, 0x22, 0x2e, 0x0f, 0xf4, 0x46, 0xc6, 0x13, 0x5c, 0x23, 0x9c,
+	0x0b, 0x42, 0x25, 0x09, 0xbd, 0xcf, 0x63, 0x26, 0xc6, 0x91, 0x89, 0x5a, 0xa8, 0x5b, 0x71, 0x37,
+	0x96, 0xf8, 0x1b, 0x0d, 0x1b, 0x3b, 0xb8, 0x7a, 0x25, 0x55, 0x82, 0x46, 0xe6, 0x3d, 0x2d, 0xac,
+	0x2c, 0xd1, 0xb7, 0x82, 0x46, 0x86, 0x8f, 0x37, 0xd9, 0x58, 0x85, 0x14, 0x84, 0x17, 0xc0, 0x84,
+	0x12, 0x45, 0x59, 0x6c, 0x16, 0x5b, 0xa8, 0xbb, 0xde, 0x3b, 0x3c, 0x3d, 0x6f, 0x16, 0xfe, 0x9c,
+	0x37, 0xb7, 0x33, 0x27, 0x32, 0xf8, 0x64, 0x53, 0xe6, 0x44, 0x44, 0x1d, 0xdb, 0xaf, 0x61, 0x44,
+	0xfc, 0xa4, 0x0f, 0xfe, 0xaf, 0x9f, 0x4f, 0x71, 0x6e, 0xb4, 0x0f, 0xfe, 0x8f, 0x8b, 0x93, 0x5d,
+	0xe4, 0xd6, 0xf2, 0x86, 0xfd, 0x45, 0x3f, 0xe3, 0x00, 0x3f, 0xa4, 0x71, 0x00, 0x53, 0x0f, 0x38,
+	0xf3, 0x8f, 0x3d, 0x1a, 0x40, 0xac, 0xe8, 0x90, 0x82, 0x30, 0x4b, 0xe9, 0x24, 0x77, 0x4b, 0xb3,
+	0x2f, 0x52, 0xf2, 0xe5, 0x92, 0x33, 0x0e, 0xf1, 0xa3, 0x00, 0x86, 0x64, 0x1c, 0x2a, 0x6f, 0x42,
+	0x42, 0x1a, 0x50, 0x95, 0x78, 0x1c, 0x04, 0x65, 0x81, 0x79, 0xbf, 0x85, 0xba, 0x25, 0xb7, 0x9e,
+	0xd3, 0xef, 0x72, 0x76, 0xa0, 0x49, 0xe3, 0x03, 0xae, 0xad, 0xe8, 0xa5, 0x59, 0x6e, 0x15, 0xbb,
+	0x0f, 0xf6, 0x77, 0xec, 0x5b, 0xb3, 0xb4, 0x6f, 0x36, 0xe8, 0xad, 0xa7, 0xc6, 0x33, 0x2f, 0x1b,
+	0x93, 0x1b, 0x94, 0x4c, 0x97, 0x82, 0x29, 0xa7, 0x22, 0xf9, 0xdf, 0xcb, 0x9a, 0xf6, 0x52, 0xcf,
+	0xe8, 0x15, 0x33, 0x47, 0x9d, 0xcb, 0xef, 0x4d, 0xf4, 0xf5, 0xe2, 0x64, 0x77, 0x7b, 0x91, 0xf9,
+	0xf4, 0x7a, 0xea, 0x59, 0xbe, 0xed, 0x2f, 0xb8, 0xba, 0x62, 0xe6, 0x15, 0xae, 0x2e, 0xce, 0xc1,
+	0xf3, 0x43, 0x22, 0xa5, 0xce, 0xbb, 0xba, 0xdf, 0xb9, 0xc3, 0xca, 0x20, 0x17, 0x3f, 0x4f, 0xb5,
+	0x6e, 0x85, 0x5f, 0x2f, 0x0d, 0x13, 0xaf, 0x49, 0xf0, 0x59, 0x1c, 0x48, 0x7d, 0x0c, 0x25, 0x77,
+	0x51, 0x1e, 0x95, 0xd2, 0xf5, 0x7a, 0x07, 0xa7, 0x33, 0x0b, 0x9d, 0xcd, 0x2c, 0xf4, 0x77, 0x66,
+	0xa1, 0x6f, 0x73, 0xab, 0x70, 0x36, 0xb7, 0x0a, 0xbf, 0xe7, 0x56, 0xe1, 0x7d, 0xe3, 0xd6, 0xad,
+	0x55, 0xc2, 0x41, 0x7e, 0x2c, 0xeb, 0x33, 0x7d, 0xf6, 0x6f, 0x00, 0xab, 0x83, 0x3a, 0xf0, 0x4d,
+	0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -102,6 +248,59 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.AppraisalQuorum != that1.AppraisalQuorum {
+		return false
+	}
+	if this.AppraisalTrim != that1.AppraisalTrim {
+		return false
+	}
+	if !this.OutlierDeviation.Equal(that1.OutlierDeviation) {
+		return false
+	}
+	if this.IndexEpochIdentifier != that1.IndexEpochIdentifier {
+		return false
+	}
+	if this.DefaultValidityPeriod != that1.DefaultValidityPeriod {
+		return false
+	}
+	if len(this.ValidityPeriods) != len(that1.ValidityPeriods) {
+		return false
+	}
+	for i := range this.ValidityPeriods {
+		if !this.ValidityPeriods[i].Equal(&that1.ValidityPeriods[i]) {
+			return false
+		}
+	}
+	if this.ExpiryEpochIdentifier != that1.ExpiryEpochIdentifier {
+		return false
+	}
+	return true
+}
+func (this *ValidityPeriod) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidityPeriod)
+	if !ok {
+		that2, ok := that.(ValidityPeriod)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PropertyClass != that1.PropertyClass {
+		return false
+	}
+	if this.Seconds != that1.Seconds {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +323,92 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExpiryEpochIdentifier) > 0 {
+		i -= len(m.ExpiryEpochIdentifier)
+		copy(dAtA[i:], m.ExpiryEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ExpiryEpochIdentifier)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ValidityPeriods) > 0 {
+		for iNdEx := len(m.ValidityPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidityPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.DefaultValidityPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultValidityPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.IndexEpochIdentifier) > 0 {
+		i -= len(m.IndexEpochIdentifier)
+		copy(dAtA[i:], m.IndexEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.IndexEpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.OutlierDeviation.Size()
+		i -= size
+		if _, err := m.OutlierDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.AppraisalTrim != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AppraisalTrim))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppraisalQuorum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AppraisalQuorum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidityPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidityPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidityPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Seconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PropertyClass != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PropertyClass))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -144,6 +429,46 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.AppraisalQuorum != 0 {
+		n += 1 + sovParams(uint64(m.AppraisalQuorum))
+	}
+	if m.AppraisalTrim != 0 {
+		n += 1 + sovParams(uint64(m.AppraisalTrim))
+	}
+	l = m.OutlierDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.IndexEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.DefaultValidityPeriod != 0 {
+		n += 1 + sovParams(uint64(m.DefaultValidityPeriod))
+	}
+	if len(m.ValidityPeriods) > 0 {
+		for _, e := range m.ValidityPeriods {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.ExpiryEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidityPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropertyClass != 0 {
+		n += 1 + sovParams(uint64(m.PropertyClass))
+	}
+	if m.Seconds != 0 {
+		n += 1 + sovParams(uint64(m.Seconds))
+	}
 	return n
 }
 
@@ -182,6 +507,283 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppraisalQuorum", wireType)
+			}
+			m.AppraisalQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppraisalQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppraisalTrim", wireType)
+			}
+			m.AppraisalTrim = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppraisalTrim |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutlierDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValidityPeriod", wireType)
+			}
+			m.DefaultValidityPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultValidityPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidityPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidityPeriods = append(m.ValidityPeriods, ValidityPeriod{})
+			if err := m.ValidityPeriods[len(m.ValidityPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiryEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidityPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidityPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidityPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyClass", wireType)
+			}
+			m.PropertyClass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyClass |= PropertyClass(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+			}
+			m.Seconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
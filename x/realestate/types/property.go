@@ -0,0 +1,73 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+)
+
+const (
+	// MaxParcelIDLength is the maximum length of the parcel id of a property.
+	MaxParcelIDLength = 128
+
+	// MaxLatitude is the maximum absolute latitude in micro-degrees.
+	MaxLatitude = 90_000_000
+
+	// MaxLongitude is the maximum absolute longitude in micro-degrees.
+	MaxLongitude = 180_000_000
+
+	// EncumbrancesHashLength is the length in bytes of an encumbrances hash.
+	EncumbrancesHashLength = 32
+
+	// UnknownJurisdiction is the jurisdiction of the properties migrated from
+	// symbol keyed rates, until their creator sets it.
+	UnknownJurisdiction = "XX"
+)
+
+// jurisdictionRegexp matches an ISO 3166-1 alpha-2 country code optionally
+// followed by an ISO 3166-2 subdivision code.
+var jurisdictionRegexp = regexp.MustCompile(`^[A-Z]{2}(-[A-Z0-9]{1,3})?$`)
+
+// ValidateJurisdiction returns an error if the jurisdiction is not an ISO 3166
+// code such as "US" or "US-CA".
+func ValidateJurisdiction(jurisdiction string) error {
+	if !jurisdictionRegexp.MatchString(jurisdiction) {
+		return fmt.Errorf("invalid jurisdiction %q: must be an ISO 3166 code such as US or US-CA", jurisdiction)
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the property. The class of the
+// property may be unspecified for the properties migrated from symbol keyed
+// rates; new properties are required to be classified by the msg server.
+func (p Property) Validate() error {
+	if p.ParcelId == "" || len(p.ParcelId) > MaxParcelIDLength {
+		return fmt.Errorf("parcel id must be 1 to %d characters", MaxParcelIDLength)
+	}
+	if err := ValidateJurisdiction(p.Jurisdiction); err != nil {
+		return err
+	}
+	if p.Latitude < -MaxLatitude || p.Latitude > MaxLatitude {
+		return fmt.Errorf("latitude %d out of range [-%d, %d] micro-degrees", p.Latitude, MaxLatitude, MaxLatitude)
+	}
+	if p.Longitude < -MaxLongitude || p.Longitude > MaxLongitude {
+		return fmt.Errorf("longitude %d out of range [-%d, %d] micro-degrees", p.Longitude, MaxLongitude, MaxLongitude)
+	}
+	if _, ok := PropertyClass_name[int32(p.PropertyClass)]; !ok {
+		return fmt.Errorf("invalid property class %d", p.PropertyClass)
+	}
+	if p.EncumbrancesHash == "" {
+		return nil
+	}
+
+	hash, err := hex.DecodeString(p.EncumbrancesHash)
+	if err != nil {
+		return fmt.Errorf("encumbrances hash is not hex encoded: %w", err)
+	}
+	if len(hash) != EncumbrancesHashLength {
+		return fmt.Errorf("encumbrances hash must be %d bytes, got %d", EncumbrancesHashLength, len(hash))
+	}
+
+	return nil
+}
@@ -0,0 +1,759 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realfin/realestate/v1/property.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PropertyClass defines the use class of a property.
+type PropertyClass int32
+
+const (
+	// PROPERTY_CLASS_UNSPECIFIED is the class of the properties migrated from
+	// symbol keyed rates, which are yet to be classified by their creator.
+	PropertyClass_PROPERTY_CLASS_UNSPECIFIED PropertyClass = 0
+	// PROPERTY_CLASS_RESIDENTIAL is a dwelling.
+	PropertyClass_PROPERTY_CLASS_RESIDENTIAL PropertyClass = 1
+	// PROPERTY_CLASS_COMMERCIAL is an office or retail property.
+	PropertyClass_PROPERTY_CLASS_COMMERCIAL PropertyClass = 2
+	// PROPERTY_CLASS_INDUSTRIAL is a warehouse or manufacturing property.
+	PropertyClass_PROPERTY_CLASS_INDUSTRIAL PropertyClass = 3
+	// PROPERTY_CLASS_LAND is an undeveloped plot.
+	PropertyClass_PROPERTY_CLASS_LAND PropertyClass = 4
+	// PROPERTY_CLASS_AGRICULTURAL is a farm or agricultural land.
+	PropertyClass_PROPERTY_CLASS_AGRICULTURAL PropertyClass = 5
+	// PROPERTY_CLASS_MIXED_USE is a property combining several classes.
+	PropertyClass_PROPERTY_CLASS_MIXED_USE PropertyClass = 6
+)
+
+var PropertyClass_name = map[int32]string{
+	0: "PROPERTY_CLASS_UNSPECIFIED",
+	1: "PROPERTY_CLASS_RESIDENTIAL",
+	2: "PROPERTY_CLASS_COMMERCIAL",
+	3: "PROPERTY_CLASS_INDUSTRIAL",
+	4: "PROPERTY_CLASS_LAND",
+	5: "PROPERTY_CLASS_AGRICULTURAL",
+	6: "PROPERTY_CLASS_MIXED_USE",
+}
+
+var PropertyClass_value = map[string]int32{
+	"PROPERTY_CLASS_UNSPECIFIED":  0,
+	"PROPERTY_CLASS_RESIDENTIAL":  1,
+	"PROPERTY_CLASS_COMMERCIAL":   2,
+	"PROPERTY_CLASS_INDUSTRIAL":   3,
+	"PROPERTY_CLASS_LAND":         4,
+	"PROPERTY_CLASS_AGRICULTURAL": 5,
+	"PROPERTY_CLASS_MIXED_USE":    6,
+}
+
+func (x PropertyClass) String() string {
+	return proto.EnumName(PropertyClass_name, int32(x))
+}
+
+func (PropertyClass) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71c1f78f38d9627b, []int{0}
+}
+
+// Property is a real estate property registered for valuation.
+type Property struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// parcel_id is the cadastral identifier of the parcel, unique within the
+	// jurisdiction.
+	ParcelId string `protobuf:"bytes,2,opt,name=parcel_id,json=parcelId,proto3" json:"parcel_id,omitempty"`
+	// latitude is the latitude of the property in micro-degrees.
+	Latitude int64 `protobuf:"varint,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// longitude is the longitude of the property in micro-degrees.
+	Longitude int64 `protobuf:"varint,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// jurisdiction is the ISO 3166 code of the country, optionally followed by
+	// the subdivision, such as "US" or "US-CA".
+	Jurisdiction  string        `protobuf:"bytes,5,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	PropertyClass PropertyClass `protobuf:"varint,6,opt,name=property_class,json=propertyClass,proto3,enum=realfin.realestate.v1.PropertyClass" json:"property_class,omitempty"`
+	// floor_area is the floor area of the property in square metres.
+	FloorArea uint64 `protobuf:"varint,7,opt,name=floor_area,json=floorArea,proto3" json:"floor_area,omitempty"`
+	// year_built is the year of construction, or 0 if unknown or not built.
+	YearBuilt uint32 `protobuf:"varint,8,opt,name=year_built,json=yearBuilt,proto3" json:"year_built,omitempty"`
+	// encumbrances_hash is the optional hex encoded SHA-256 hash of the
+	// encumbrances document of the property (mortgages, liens, easements).
+	EncumbrancesHash string `protobuf:"bytes,9,opt,name=encumbrances_hash,json=encumbrancesHash,proto3" json:"encumbrances_hash,omitempty"`
+	Creator          string `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *Property) Reset()         { *m = Property{} }
+func (m *Property) String() string { return proto.CompactTextString(m) }
+func (*Property) ProtoMessage()    {}
+func (*Property) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71c1f78f38d9627b, []int{0}
+}
+func (m *Property) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Property) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Property.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Property) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Property.Merge(m, src)
+}
+func (m *Property) XXX_Size() int {
+	return m.Size()
+}
+func (m *Property) XXX_DiscardUnknown() {
+	xxx_messageInfo_Property.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Property proto.InternalMessageInfo
+
+func (m *Property) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Property) GetParcelId() string {
+	if m != nil {
+		return m.ParcelId
+	}
+	return ""
+}
+
+func (m *Property) GetLatitude() int64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *Property) GetLongitude() int64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *Property) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
+}
+
+func (m *Property) GetPropertyClass() PropertyClass {
+	if m != nil {
+		return m.PropertyClass
+	}
+	return PropertyClass_PROPERTY_CLASS_UNSPECIFIED
+}
+
+func (m *Property) GetFloorArea() uint64 {
+	if m != nil {
+		return m.FloorArea
+	}
+	return 0
+}
+
+func (m *Property) GetYearBuilt() uint32 {
+	if m != nil {
+		return m.YearBuilt
+	}
+	return 0
+}
+
+func (m *Property) GetEncumbrancesHash() string {
+	if m != nil {
+		return m.EncumbrancesHash
+	}
+	return ""
+}
+
+func (m *Property) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("realfin.realestate.v1.PropertyClass", PropertyClass_name, PropertyClass_value)
+	proto.RegisterType((*Property)(nil), "realfin.realestate.v1.Property")
+}
+
+func init() {
+	proto.RegisterFile("realfin/realestate/v1/property.proto", fileDescriptor_71c1f78f38d9627b)
+}
+
+var fileDescriptor_71c1f78f38d9627b = []byte{
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x61, 0x6b, 0xd3, 0x40,
+	0x18, 0xc7, 0x7b, 0x69, 0xd7, 0x35, 0x87, 0x2d, 0xf1, 0x44, 0x3c, 0xbb, 0x2d, 0x86, 0xb1, 0x17,
+	0x41, 0x21, 0x65, 0xea, 0x17, 0xc8, 0x92, 0xa8, 0xc1, 0xb4, 0x2b, 0x97, 0x06, 0xd4, 0x37, 0xe1,
+	0x9a, 0xdc, 0x6c, 0x24, 0x26, 0xe1, 0x72, 0x1d, 0xf6, 0x5b, 0xf8, 0xb1, 0x7c, 0xb9, 0x97, 0xbe,
+	0x94, 0x16, 0xf1, 0x6b, 0x48, 0xb2, 0x75, 0x6b, 0x4b, 0x5f, 0x1d, 0xcf, 0xff, 0xf7, 0xe3, 0x78,
+	0xfe, 0xf0, 0xc0, 0x33, 0xce, 0x68, 0x7a, 0x95, 0x64, 0x83, 0xea, 0x65, 0xa5, 0xa0, 0x82, 0x0d,
+	0xae, 0xcf, 0x07, 0x05, 0xcf, 0x0b, 0xc6, 0xc5, 0xc2, 0x28, 0x78, 0x2e, 0x72, 0xf4, 0xf4, 0xce,
+	0x32, 0x1e, 0x2c, 0xe3, 0xfa, 0xfc, 0xf4, 0x9f, 0x04, 0x3b, 0xe3, 0x3b, 0x13, 0xf5, 0xa0, 0x94,
+	0xc4, 0x18, 0x68, 0x40, 0x6f, 0x11, 0x29, 0x89, 0xd1, 0x11, 0x94, 0x0b, 0xca, 0x23, 0x96, 0x86,
+	0x49, 0x8c, 0x25, 0x0d, 0xe8, 0x32, 0xe9, 0xdc, 0x06, 0x6e, 0x8c, 0xfa, 0xb0, 0x93, 0x52, 0x91,
+	0x88, 0x79, 0xcc, 0x70, 0x53, 0x03, 0x7a, 0x93, 0xdc, 0xcf, 0xe8, 0x18, 0xca, 0x69, 0x9e, 0x7d,
+	0xbd, 0x85, 0xad, 0x1a, 0x3e, 0x04, 0xe8, 0x14, 0x3e, 0xfa, 0x36, 0xe7, 0x49, 0x19, 0x27, 0x91,
+	0x48, 0xf2, 0x0c, 0x1f, 0xd4, 0x3f, 0x6f, 0x65, 0xe8, 0x23, 0xec, 0xad, 0x0b, 0x84, 0x51, 0x4a,
+	0xcb, 0x12, 0xb7, 0x35, 0xa0, 0xf7, 0x5e, 0x9f, 0x19, 0x7b, 0x7b, 0x18, 0xeb, 0x0e, 0x56, 0xe5,
+	0x92, 0x6e, 0xb1, 0x39, 0xa2, 0x13, 0x08, 0xaf, 0xd2, 0x3c, 0xe7, 0x21, 0xe5, 0x8c, 0xe2, 0xc3,
+	0xba, 0x9f, 0x5c, 0x27, 0x26, 0x67, 0xb4, 0xc2, 0x0b, 0x46, 0x79, 0x38, 0x9d, 0x27, 0xa9, 0xc0,
+	0x1d, 0x0d, 0xe8, 0x5d, 0x22, 0x57, 0xc9, 0x45, 0x15, 0xa0, 0x57, 0xf0, 0x31, 0xcb, 0xa2, 0xf9,
+	0xf7, 0x29, 0xa7, 0x59, 0xc4, 0xca, 0x70, 0x46, 0xcb, 0x19, 0x96, 0xeb, 0x9d, 0x95, 0x4d, 0xf0,
+	0x81, 0x96, 0x33, 0x84, 0xe1, 0x61, 0xc4, 0x19, 0x15, 0x39, 0xc7, 0xb0, 0x56, 0xd6, 0xe3, 0xcb,
+	0xbf, 0x00, 0x76, 0xb7, 0xb6, 0x44, 0x2a, 0xec, 0x8f, 0xc9, 0xe5, 0xd8, 0x21, 0x93, 0xcf, 0xa1,
+	0xe5, 0x99, 0xbe, 0x1f, 0x06, 0x23, 0x7f, 0xec, 0x58, 0xee, 0x3b, 0xd7, 0xb1, 0x95, 0xc6, 0x1e,
+	0x4e, 0x1c, 0xdf, 0xb5, 0x9d, 0xd1, 0xc4, 0x35, 0x3d, 0x05, 0xa0, 0x13, 0xf8, 0x7c, 0x87, 0x5b,
+	0x97, 0xc3, 0xa1, 0x43, 0xac, 0x0a, 0x4b, 0x7b, 0xb0, 0x3b, 0xb2, 0x03, 0x7f, 0x42, 0x2a, 0xdc,
+	0x44, 0xcf, 0xe0, 0x93, 0x1d, 0xec, 0x99, 0x23, 0x5b, 0x69, 0xa1, 0x17, 0xf0, 0x68, 0x07, 0x98,
+	0xef, 0x89, 0x6b, 0x05, 0xde, 0x24, 0x20, 0xa6, 0xa7, 0x1c, 0xa0, 0x63, 0x88, 0x77, 0x84, 0xa1,
+	0xfb, 0xc9, 0xb1, 0xc3, 0xc0, 0x77, 0x94, 0xf6, 0xc5, 0xdb, 0x5f, 0x4b, 0x15, 0xdc, 0x2c, 0x55,
+	0xf0, 0x67, 0xa9, 0x82, 0x9f, 0x2b, 0xb5, 0x71, 0xb3, 0x52, 0x1b, 0xbf, 0x57, 0x6a, 0xe3, 0x4b,
+	0x7f, 0x7d, 0xa8, 0x3f, 0x36, 0x4f, 0x55, 0x2c, 0x0a, 0x56, 0x4e, 0xdb, 0xf5, 0x95, 0xbe, 0xf9,
+	0x3f, 0x00, 0xfa, 0xe5, 0x3e, 0xd4, 0xcd, 0x02, 0x00, 0x00,
+}
+
+func (m *Property) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Property) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Property) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintProperty(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.EncumbrancesHash) > 0 {
+		i -= len(m.EncumbrancesHash)
+		copy(dAtA[i:], m.EncumbrancesHash)
+		i = encodeVarintProperty(dAtA, i, uint64(len(m.EncumbrancesHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.YearBuilt != 0 {
+		i = encodeVarintProperty(dAtA, i, uint64(m.YearBuilt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FloorArea != 0 {
+		i = encodeVarintProperty(dAtA, i, uint64(m.FloorArea))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PropertyClass != 0 {
+		i = encodeVarintProperty(dAtA, i, uint64(m.PropertyClass))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintProperty(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Longitude != 0 {
+		i = encodeVarintProperty(dAtA, i, uint64(m.Longitude))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Latitude != 0 {
+		i = encodeVarintProperty(dAtA, i, uint64(m.Latitude))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ParcelId) > 0 {
+		i -= len(m.ParcelId)
+		copy(dAtA[i:], m.ParcelId)
+		i = encodeVarintProperty(dAtA, i, uint64(len(m.ParcelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintProperty(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProperty(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProperty(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Property) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProperty(uint64(m.Id))
+	}
+	l = len(m.ParcelId)
+	if l > 0 {
+		n += 1 + l + sovProperty(uint64(l))
+	}
+	if m.Latitude != 0 {
+		n += 1 + sovProperty(uint64(m.Latitude))
+	}
+	if m.Longitude != 0 {
+		n += 1 + sovProperty(uint64(m.Longitude))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovProperty(uint64(l))
+	}
+	if m.PropertyClass != 0 {
+		n += 1 + sovProperty(uint64(m.PropertyClass))
+	}
+	if m.FloorArea != 0 {
+		n += 1 + sovProperty(uint64(m.FloorArea))
+	}
+	if m.YearBuilt != 0 {
+		n += 1 + sovProperty(uint64(m.YearBuilt))
+	}
+	l = len(m.EncumbrancesHash)
+	if l > 0 {
+		n += 1 + l + sovProperty(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovProperty(uint64(l))
+	}
+	return n
+}
+
+func sovProperty(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProperty(x uint64) (n int) {
+	return sovProperty(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Property) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProperty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Property: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Property: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParcelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProperty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProperty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParcelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
+			}
+			m.Latitude = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Latitude |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
+			}
+			m.Longitude = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Longitude |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProperty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProperty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyClass", wireType)
+			}
+			m.PropertyClass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyClass |= PropertyClass(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorArea", wireType)
+			}
+			m.FloorArea = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FloorArea |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YearBuilt", wireType)
+			}
+			m.YearBuilt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YearBuilt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncumbrancesHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProperty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProperty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncumbrancesHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProperty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProperty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProperty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProperty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProperty(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProperty
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProperty
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProperty
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProperty
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProperty        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProperty          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProperty = fmt.Errorf("proto: unexpected end of group")
+)
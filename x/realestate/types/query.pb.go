@@ -5,25 +5,31 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -116,7 +122,7 @@ func (m *QueryParamsResponse) GetParams() Params {
 
 // QueryGetRateRequest defines the QueryGetRateRequest message.
 type QueryGetRateRequest struct {
-	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	PropertyId uint64 `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
 }
 
 func (m *QueryGetRateRequest) Reset()         { *m = QueryGetRateRequest{} }
@@ -152,11 +158,11 @@ func (m *QueryGetRateRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetRateRequest proto.InternalMessageInfo
 
-func (m *QueryGetRateRequest) GetSymbol() string {
+func (m *QueryGetRateRequest) GetPropertyId() uint64 {
 	if m != nil {
-		return m.Symbol
+		return m.PropertyId
 	}
-	return ""
+	return 0
 }
 
 // QueryGetRateResponse defines the QueryGetRateResponse message.
@@ -302,509 +308,6405 @@ func (m *QueryAllRateResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "realfin.realestate.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "realfin.realestate.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetRateRequest)(nil), "realfin.realestate.v1.QueryGetRateRequest")
-	proto.RegisterType((*QueryGetRateResponse)(nil), "realfin.realestate.v1.QueryGetRateResponse")
-	proto.RegisterType((*QueryAllRateRequest)(nil), "realfin.realestate.v1.QueryAllRateRequest")
-	proto.RegisterType((*QueryAllRateResponse)(nil), "realfin.realestate.v1.QueryAllRateResponse")
+// QueryGetPropertyRequest defines the QueryGetPropertyRequest message.
+type QueryGetPropertyRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("realfin/realestate/v1/query.proto", fileDescriptor_737ac26a22dae1b4) }
-
-var fileDescriptor_737ac26a22dae1b4 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x6d, 0x14, 0x66, 0x4e, 0x98, 0x82, 0x50, 0x46, 0xb3, 0x61, 0x60, 0x40, 0x01,
-	0x5b, 0x1d, 0x70, 0x87, 0x1e, 0xd8, 0x05, 0xa4, 0x91, 0x0b, 0x12, 0x12, 0x07, 0x17, 0x99, 0x28,
-	0x52, 0x1a, 0x67, 0xb1, 0x57, 0x51, 0x21, 0x2e, 0x1c, 0x10, 0xc7, 0x49, 0x48, 0x7c, 0x06, 0x4e,
-	0x88, 0x8f, 0xb1, 0xe3, 0x24, 0x2e, 0x9c, 0x10, 0x6a, 0x91, 0xf8, 0x1a, 0xc8, 0xf6, 0x2b, 0x4b,
-	0x58, 0x1b, 0xca, 0x25, 0x71, 0x9c, 0xff, 0xff, 0xbd, 0x5f, 0xf2, 0xfe, 0xc6, 0x97, 0x0a, 0x29,
-	0xd2, 0x97, 0x49, 0xc6, 0xed, 0x5d, 0x6a, 0x23, 0x8c, 0xe4, 0xc3, 0x2e, 0xdf, 0xdd, 0x93, 0xc5,
-	0x88, 0xe5, 0x85, 0x32, 0x8a, 0x9c, 0x03, 0x09, 0x3b, 0x92, 0xb0, 0x61, 0x37, 0x38, 0x23, 0x06,
-	0x49, 0xa6, 0xb8, 0xbb, 0x7a, 0x65, 0xd0, 0x79, 0xa1, 0xf4, 0x40, 0x69, 0xde, 0x17, 0x5a, 0xfa,
-	0x12, 0x7c, 0xd8, 0xed, 0x4b, 0x23, 0xba, 0x3c, 0x17, 0x71, 0x92, 0x09, 0x93, 0xa8, 0x0c, 0xb4,
-	0xad, 0x58, 0xc5, 0xca, 0x2d, 0xb9, 0x5d, 0xc1, 0xee, 0xc5, 0x58, 0xa9, 0x38, 0x95, 0x5c, 0xe4,
-	0x09, 0x17, 0x59, 0xa6, 0x8c, 0xb3, 0x68, 0x78, 0x4b, 0x67, 0xc3, 0xe6, 0xa2, 0x10, 0x83, 0xa9,
-	0x66, 0x63, 0xb6, 0xa6, 0xb0, 0xd4, 0x4e, 0x41, 0x5b, 0x98, 0x3c, 0xb1, 0x6c, 0x3b, 0xce, 0x16,
-	0xc9, 0xdd, 0x3d, 0xa9, 0x0d, 0x7d, 0x8a, 0xcf, 0x56, 0x76, 0x75, 0xae, 0x32, 0x2d, 0xc9, 0x7d,
-	0xdc, 0xf4, 0xe5, 0x2f, 0xa0, 0x0d, 0x74, 0xfd, 0xf4, 0x56, 0x9b, 0xcd, 0xfc, 0x1b, 0xcc, 0xdb,
-	0x7a, 0xab, 0x07, 0xdf, 0xd7, 0x1b, 0x9f, 0x7e, 0x7d, 0xe9, 0xa0, 0x08, 0x7c, 0xf4, 0x36, 0x14,
-	0xde, 0x96, 0x26, 0x12, 0x46, 0x42, 0x3f, 0x72, 0x1e, 0x37, 0xf5, 0x68, 0xd0, 0x57, 0xa9, 0x2b,
-	0xbc, 0x1a, 0xc1, 0x13, 0x7d, 0x8c, 0x5b, 0x55, 0x39, 0x80, 0xdc, 0xc3, 0x2b, 0xf6, 0x1b, 0x00,
-	0x63, 0x6d, 0x0e, 0x86, 0xb5, 0xf4, 0x56, 0x2c, 0x44, 0xe4, 0xe4, 0xf4, 0x39, 0x74, 0x7f, 0x90,
-	0xa6, 0xe5, 0xee, 0x0f, 0x31, 0x3e, 0x9a, 0x08, 0xd4, 0xdc, 0x64, 0x7e, 0x7c, 0xcc, 0x8e, 0x8f,
-	0xf9, 0x04, 0xc0, 0xf8, 0xd8, 0x8e, 0x88, 0xa7, 0xde, 0xa8, 0xe4, 0xa4, 0x1f, 0x11, 0xe0, 0xfe,
-	0xa9, 0x7f, 0x0c, 0x77, 0xf9, 0x3f, 0x70, 0xc9, 0x76, 0x85, 0x6b, 0xc9, 0x71, 0x5d, 0xfb, 0x27,
-	0x97, 0xef, 0x59, 0x06, 0xdb, 0xfa, 0xbc, 0x8c, 0x4f, 0x38, 0x30, 0xf2, 0x0e, 0xe1, 0xa6, 0x9f,
-	0x0e, 0xb9, 0x31, 0x07, 0xe3, 0x78, 0x1c, 0x82, 0xce, 0x22, 0x52, 0xdf, 0x97, 0x5e, 0x7d, 0xfb,
-	0xf5, 0xe7, 0x87, 0xa5, 0x75, 0xd2, 0xe6, 0x75, 0xf9, 0x24, 0xfb, 0x08, 0x9f, 0x84, 0xa9, 0x92,
-	0xda, 0xf2, 0xd5, 0xa4, 0x04, 0x37, 0x17, 0xd2, 0x02, 0xcb, 0x2d, 0xc7, 0xb2, 0x49, 0xae, 0xf0,
-	0xf9, 0xe7, 0x80, 0xbf, 0xf6, 0x59, 0x7b, 0x43, 0xde, 0x23, 0x7c, 0xea, 0x51, 0xa2, 0x17, 0x60,
-	0xaa, 0xe6, 0xa7, 0x9e, 0xe9, 0xaf, 0x2c, 0xd0, 0xcb, 0x8e, 0xa9, 0x4d, 0xd6, 0x6a, 0x98, 0x7a,
-	0x77, 0x0f, 0xc6, 0x21, 0x3a, 0x1c, 0x87, 0xe8, 0xc7, 0x38, 0x44, 0xfb, 0x93, 0xb0, 0x71, 0x38,
-	0x09, 0x1b, 0xdf, 0x26, 0x61, 0xe3, 0x59, 0x30, 0x75, 0xbd, 0x2a, 0xfb, 0xcc, 0x28, 0x97, 0xba,
-	0xdf, 0x74, 0x47, 0xfa, 0xce, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x30, 0xd9, 0x0f, 0xa9, 0xc7,
-	0x04, 0x00, 0x00,
+func (m *QueryGetPropertyRequest) Reset()         { *m = QueryGetPropertyRequest{} }
+func (m *QueryGetPropertyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPropertyRequest) ProtoMessage()    {}
+func (*QueryGetPropertyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{6}
+}
+func (m *QueryGetPropertyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPropertyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPropertyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPropertyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPropertyRequest.Merge(m, src)
+}
+func (m *QueryGetPropertyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPropertyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPropertyRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetPropertyRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListRate Queries a list of Rate items.
-	GetRate(ctx context.Context, in *QueryGetRateRequest, opts ...grpc.CallOption) (*QueryGetRateResponse, error)
-	// ListRate defines the ListRate RPC.
-	ListRate(ctx context.Context, in *QueryAllRateRequest, opts ...grpc.CallOption) (*QueryAllRateResponse, error)
+func (m *QueryGetPropertyRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryGetPropertyResponse defines the QueryGetPropertyResponse message.
+type QueryGetPropertyResponse struct {
+	Property Property `protobuf:"bytes,1,opt,name=property,proto3" json:"property"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetPropertyResponse) Reset()         { *m = QueryGetPropertyResponse{} }
+func (m *QueryGetPropertyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPropertyResponse) ProtoMessage()    {}
+func (*QueryGetPropertyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetPropertyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetRate(ctx context.Context, in *QueryGetRateRequest, opts ...grpc.CallOption) (*QueryGetRateResponse, error) {
-	out := new(QueryGetRateResponse)
-	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/GetRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetPropertyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPropertyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetPropertyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPropertyResponse.Merge(m, src)
+}
+func (m *QueryGetPropertyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPropertyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPropertyResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListRate(ctx context.Context, in *QueryAllRateRequest, opts ...grpc.CallOption) (*QueryAllRateResponse, error) {
-	out := new(QueryAllRateResponse)
-	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/ListRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPropertyResponse proto.InternalMessageInfo
+
+func (m *QueryGetPropertyResponse) GetProperty() Property {
+	if m != nil {
+		return m.Property
 	}
-	return out, nil
+	return Property{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListRate Queries a list of Rate items.
-	GetRate(context.Context, *QueryGetRateRequest) (*QueryGetRateResponse, error)
-	// ListRate defines the ListRate RPC.
-	ListRate(context.Context, *QueryAllRateRequest) (*QueryAllRateResponse, error)
+// QueryAllPropertyRequest defines the QueryAllPropertyRequest message.
+type QueryAllPropertyRequest struct {
+	// jurisdiction filters the properties of the jurisdiction, if set.
+	Jurisdiction string `protobuf:"bytes,1,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// property_class filters the properties of the class, if set.
+	PropertyClass PropertyClass      `protobuf:"varint,2,opt,name=property_class,json=propertyClass,proto3,enum=realfin.realestate.v1.PropertyClass" json:"property_class,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllPropertyRequest) Reset()         { *m = QueryAllPropertyRequest{} }
+func (m *QueryAllPropertyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPropertyRequest) ProtoMessage()    {}
+func (*QueryAllPropertyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllPropertyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetRate(ctx context.Context, req *QueryGetRateRequest) (*QueryGetRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRate not implemented")
+func (m *QueryAllPropertyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPropertyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListRate(ctx context.Context, req *QueryAllRateRequest) (*QueryAllRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRate not implemented")
+func (m *QueryAllPropertyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPropertyRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAllPropertyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPropertyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPropertyRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/realfin.realestate.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryAllPropertyRequest proto.InternalMessageInfo
+
+func (m *QueryAllPropertyRequest) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/realfin.realestate.v1.Query/GetRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRate(ctx, req.(*QueryGetRateRequest))
+func (m *QueryAllPropertyRequest) GetPropertyClass() PropertyClass {
+	if m != nil {
+		return m.PropertyClass
 	}
-	return interceptor(ctx, in, info, handler)
+	return PropertyClass_PROPERTY_CLASS_UNSPECIFIED
 }
 
-func _Query_ListRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/realfin.realestate.v1.Query/ListRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListRate(ctx, req.(*QueryAllRateRequest))
+func (m *QueryAllPropertyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "realfin.realestate.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetRate",
-			Handler:    _Query_GetRate_Handler,
-		},
-		{
-			MethodName: "ListRate",
-			Handler:    _Query_ListRate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "realfin/realestate/v1/query.proto",
+// QueryAllPropertyResponse defines the QueryAllPropertyResponse message.
+type QueryAllPropertyResponse struct {
+	Properties []Property          `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllPropertyResponse) Reset()         { *m = QueryAllPropertyResponse{} }
+func (m *QueryAllPropertyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPropertyResponse) ProtoMessage()    {}
+func (*QueryAllPropertyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{9}
+}
+func (m *QueryAllPropertyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPropertyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPropertyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllPropertyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPropertyResponse.Merge(m, src)
+}
+func (m *QueryAllPropertyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPropertyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPropertyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryAllPropertyResponse proto.InternalMessageInfo
+
+func (m *QueryAllPropertyResponse) GetProperties() []Property {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllPropertyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetAppraiserRequest defines the QueryGetAppraiserRequest message.
+type QueryGetAppraiserRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetAppraiserRequest) Reset()         { *m = QueryGetAppraiserRequest{} }
+func (m *QueryGetAppraiserRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAppraiserRequest) ProtoMessage()    {}
+func (*QueryGetAppraiserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{10}
+}
+func (m *QueryGetAppraiserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAppraiserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAppraiserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAppraiserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAppraiserRequest.Merge(m, src)
+}
+func (m *QueryGetAppraiserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAppraiserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAppraiserRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAppraiserRequest proto.InternalMessageInfo
+
+func (m *QueryGetAppraiserRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetAppraiserResponse defines the QueryGetAppraiserResponse message.
+type QueryGetAppraiserResponse struct {
+	Appraiser Appraiser `protobuf:"bytes,1,opt,name=appraiser,proto3" json:"appraiser"`
 }
 
-func (m *QueryGetRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetAppraiserResponse) Reset()         { *m = QueryGetAppraiserResponse{} }
+func (m *QueryGetAppraiserResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAppraiserResponse) ProtoMessage()    {}
+func (*QueryGetAppraiserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{11}
+}
+func (m *QueryGetAppraiserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAppraiserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAppraiserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAppraiserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAppraiserResponse.Merge(m, src)
+}
+func (m *QueryGetAppraiserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAppraiserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAppraiserResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAppraiserResponse proto.InternalMessageInfo
+
+func (m *QueryGetAppraiserResponse) GetAppraiser() Appraiser {
+	if m != nil {
+		return m.Appraiser
 	}
-	return dAtA[:n], nil
+	return Appraiser{}
 }
 
-func (m *QueryGetRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllAppraiserRequest defines the QueryAllAppraiserRequest message.
+type QueryAllAppraiserRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllAppraiserRequest) Reset()         { *m = QueryAllAppraiserRequest{} }
+func (m *QueryAllAppraiserRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAppraiserRequest) ProtoMessage()    {}
+func (*QueryAllAppraiserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{12}
+}
+func (m *QueryAllAppraiserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAppraiserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAppraiserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllAppraiserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAppraiserRequest.Merge(m, src)
+}
+func (m *QueryAllAppraiserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAppraiserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAppraiserRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllAppraiserRequest proto.InternalMessageInfo
+
+func (m *QueryAllAppraiserRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllAppraiserResponse defines the QueryAllAppraiserResponse message.
+type QueryAllAppraiserResponse struct {
+	Appraisers []Appraiser         `protobuf:"bytes,1,rep,name=appraisers,proto3" json:"appraisers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllAppraiserResponse) Reset()         { *m = QueryAllAppraiserResponse{} }
+func (m *QueryAllAppraiserResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAppraiserResponse) ProtoMessage()    {}
+func (*QueryAllAppraiserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{13}
+}
+func (m *QueryAllAppraiserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAppraiserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAppraiserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllAppraiserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAppraiserResponse.Merge(m, src)
+}
+func (m *QueryAllAppraiserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAppraiserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAppraiserResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllAppraiserResponse proto.InternalMessageInfo
+
+func (m *QueryAllAppraiserResponse) GetAppraisers() []Appraiser {
+	if m != nil {
+		return m.Appraisers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllAppraiserResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Rate) > 0 {
-		for iNdEx := len(m.Rate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// QueryAllAppraisalRequest defines the QueryAllAppraisalRequest message.
+type QueryAllAppraisalRequest struct {
+	PropertyId uint64 `protobuf:"varint,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// cycle filters the appraisals of the valuation cycle, if set.
+	Cycle      uint64             `protobuf:"varint,2,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryAllAppraisalRequest) Reset()         { *m = QueryAllAppraisalRequest{} }
+func (m *QueryAllAppraisalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAppraisalRequest) ProtoMessage()    {}
+func (*QueryAllAppraisalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{14}
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllAppraisalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAppraisalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAppraisalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryAllAppraisalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAppraisalRequest.Merge(m, src)
+}
+func (m *QueryAllAppraisalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAppraisalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAppraisalRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllAppraisalRequest proto.InternalMessageInfo
+
+func (m *QueryAllAppraisalRequest) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryGetRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllAppraisalRequest) GetCycle() uint64 {
+	if m != nil {
+		return m.Cycle
 	}
-	return n
+	return 0
 }
 
-func (m *QueryGetRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllAppraisalRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryAllAppraisalResponse defines the QueryAllAppraisalResponse message.
+type QueryAllAppraisalResponse struct {
+	Appraisals []Appraisal `protobuf:"bytes,1,rep,name=appraisals,proto3" json:"appraisals"`
+	// open_cycle is the valuation cycle of the property open to appraisals.
+	OpenCycle  uint64              `protobuf:"varint,2,opt,name=open_cycle,json=openCycle,proto3" json:"open_cycle,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryAllAppraisalResponse) Reset()         { *m = QueryAllAppraisalResponse{} }
+func (m *QueryAllAppraisalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAppraisalResponse) ProtoMessage()    {}
+func (*QueryAllAppraisalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{15}
+}
+func (m *QueryAllAppraisalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAppraisalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAppraisalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAppraisalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAppraisalResponse.Merge(m, src)
+}
+func (m *QueryAllAppraisalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAppraisalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAppraisalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAppraisalResponse proto.InternalMessageInfo
+
+func (m *QueryAllAppraisalResponse) GetAppraisals() []Appraisal {
+	if m != nil {
+		return m.Appraisals
+	}
+	return nil
+}
+
+func (m *QueryAllAppraisalResponse) GetOpenCycle() uint64 {
+	if m != nil {
+		return m.OpenCycle
+	}
+	return 0
+}
+
+func (m *QueryAllAppraisalResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetRegionalIndexRequest defines the QueryGetRegionalIndexRequest
+// message.
+type QueryGetRegionalIndexRequest struct {
+	Jurisdiction string `protobuf:"bytes,1,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+}
+
+func (m *QueryGetRegionalIndexRequest) Reset()         { *m = QueryGetRegionalIndexRequest{} }
+func (m *QueryGetRegionalIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRegionalIndexRequest) ProtoMessage()    {}
+func (*QueryGetRegionalIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{16}
+}
+func (m *QueryGetRegionalIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRegionalIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRegionalIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRegionalIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRegionalIndexRequest.Merge(m, src)
+}
+func (m *QueryGetRegionalIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRegionalIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRegionalIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRegionalIndexRequest proto.InternalMessageInfo
+
+func (m *QueryGetRegionalIndexRequest) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
+}
+
+// QueryGetRegionalIndexResponse defines the QueryGetRegionalIndexResponse
+// message.
+type QueryGetRegionalIndexResponse struct {
+	RegionalIndex RegionalIndex `protobuf:"bytes,1,opt,name=regional_index,json=regionalIndex,proto3" json:"regional_index"`
+}
+
+func (m *QueryGetRegionalIndexResponse) Reset()         { *m = QueryGetRegionalIndexResponse{} }
+func (m *QueryGetRegionalIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRegionalIndexResponse) ProtoMessage()    {}
+func (*QueryGetRegionalIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{17}
+}
+func (m *QueryGetRegionalIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRegionalIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRegionalIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRegionalIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRegionalIndexResponse.Merge(m, src)
+}
+func (m *QueryGetRegionalIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRegionalIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRegionalIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRegionalIndexResponse proto.InternalMessageInfo
+
+func (m *QueryGetRegionalIndexResponse) GetRegionalIndex() RegionalIndex {
+	if m != nil {
+		return m.RegionalIndex
+	}
+	return RegionalIndex{}
+}
+
+// QueryAllRegionalIndexRequest defines the QueryAllRegionalIndexRequest
+// message.
+type QueryAllRegionalIndexRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRegionalIndexRequest) Reset()         { *m = QueryAllRegionalIndexRequest{} }
+func (m *QueryAllRegionalIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRegionalIndexRequest) ProtoMessage()    {}
+func (*QueryAllRegionalIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{18}
+}
+func (m *QueryAllRegionalIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRegionalIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRegionalIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRegionalIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRegionalIndexRequest.Merge(m, src)
+}
+func (m *QueryAllRegionalIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRegionalIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRegionalIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRegionalIndexRequest proto.InternalMessageInfo
+
+func (m *QueryAllRegionalIndexRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllRegionalIndexResponse defines the QueryAllRegionalIndexResponse
+// message.
+type QueryAllRegionalIndexResponse struct {
+	RegionalIndices []RegionalIndex     `protobuf:"bytes,1,rep,name=regional_indices,json=regionalIndices,proto3" json:"regional_indices"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRegionalIndexResponse) Reset()         { *m = QueryAllRegionalIndexResponse{} }
+func (m *QueryAllRegionalIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRegionalIndexResponse) ProtoMessage()    {}
+func (*QueryAllRegionalIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{19}
+}
+func (m *QueryAllRegionalIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRegionalIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRegionalIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRegionalIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRegionalIndexResponse.Merge(m, src)
+}
+func (m *QueryAllRegionalIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRegionalIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRegionalIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRegionalIndexResponse proto.InternalMessageInfo
+
+func (m *QueryAllRegionalIndexResponse) GetRegionalIndices() []RegionalIndex {
+	if m != nil {
+		return m.RegionalIndices
+	}
+	return nil
+}
+
+func (m *QueryAllRegionalIndexResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetValuationRequest defines the QueryGetValuationRequest message.
+type QueryGetValuationRequest struct {
+	PropertyId uint64 `protobuf:"varint,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+}
+
+func (m *QueryGetValuationRequest) Reset()         { *m = QueryGetValuationRequest{} }
+func (m *QueryGetValuationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetValuationRequest) ProtoMessage()    {}
+func (*QueryGetValuationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{20}
+}
+func (m *QueryGetValuationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetValuationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetValuationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetValuationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetValuationRequest.Merge(m, src)
+}
+func (m *QueryGetValuationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetValuationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetValuationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetValuationRequest proto.InternalMessageInfo
+
+func (m *QueryGetValuationRequest) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+// QueryGetValuationResponse defines the QueryGetValuationResponse message.
+type QueryGetValuationResponse struct {
+	PropertyId uint64 `protobuf:"varint,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// appraised_value is the value of the last finalised valuation cycle.
+	AppraisedValue uint64    `protobuf:"varint,2,opt,name=appraised_value,json=appraisedValue,proto3" json:"appraised_value,omitempty"`
+	AppraisedAt    time.Time `protobuf:"bytes,3,opt,name=appraised_at,json=appraisedAt,proto3,stdtime" json:"appraised_at"`
+	// indexed_value is the appraised value marked to model by the change of the
+	// regional index of the property since the appraisal.
+	IndexedValue uint64    `protobuf:"varint,4,opt,name=indexed_value,json=indexedValue,proto3" json:"indexed_value,omitempty"`
+	IndexedAt    time.Time `protobuf:"bytes,5,opt,name=indexed_at,json=indexedAt,proto3,stdtime" json:"indexed_at"`
+	IndexSymbol  string    `protobuf:"bytes,6,opt,name=index_symbol,json=indexSymbol,proto3" json:"index_symbol,omitempty"`
+	// index_base is the level of the index at the appraisal.
+	IndexBase cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=index_base,json=indexBase,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"index_base"`
+	// index_level is the level of the index at the last refresh.
+	IndexLevel cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=index_level,json=indexLevel,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"index_level"`
+}
+
+func (m *QueryGetValuationResponse) Reset()         { *m = QueryGetValuationResponse{} }
+func (m *QueryGetValuationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetValuationResponse) ProtoMessage()    {}
+func (*QueryGetValuationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{21}
+}
+func (m *QueryGetValuationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetValuationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetValuationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetValuationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetValuationResponse.Merge(m, src)
+}
+func (m *QueryGetValuationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetValuationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetValuationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetValuationResponse proto.InternalMessageInfo
+
+func (m *QueryGetValuationResponse) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *QueryGetValuationResponse) GetAppraisedValue() uint64 {
+	if m != nil {
+		return m.AppraisedValue
+	}
+	return 0
+}
+
+func (m *QueryGetValuationResponse) GetAppraisedAt() time.Time {
+	if m != nil {
+		return m.AppraisedAt
+	}
+	return time.Time{}
+}
+
+func (m *QueryGetValuationResponse) GetIndexedValue() uint64 {
+	if m != nil {
+		return m.IndexedValue
+	}
+	return 0
+}
+
+func (m *QueryGetValuationResponse) GetIndexedAt() time.Time {
+	if m != nil {
+		return m.IndexedAt
+	}
+	return time.Time{}
+}
+
+func (m *QueryGetValuationResponse) GetIndexSymbol() string {
+	if m != nil {
+		return m.IndexSymbol
+	}
+	return ""
+}
+
+// QueryAllReappraisalDueRequest defines the QueryAllReappraisalDueRequest
+// message.
+type QueryAllReappraisalDueRequest struct {
+	// window is the number of seconds from the current block time in which the
+	// valuations expire.
+	Window     uint64             `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllReappraisalDueRequest) Reset()         { *m = QueryAllReappraisalDueRequest{} }
+func (m *QueryAllReappraisalDueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllReappraisalDueRequest) ProtoMessage()    {}
+func (*QueryAllReappraisalDueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{22}
+}
+func (m *QueryAllReappraisalDueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllReappraisalDueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllReappraisalDueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllReappraisalDueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllReappraisalDueRequest.Merge(m, src)
+}
+func (m *QueryAllReappraisalDueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllReappraisalDueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllReappraisalDueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllReappraisalDueRequest proto.InternalMessageInfo
+
+func (m *QueryAllReappraisalDueRequest) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *QueryAllReappraisalDueRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllReappraisalDueResponse defines the QueryAllReappraisalDueResponse
+// message.
+type QueryAllReappraisalDueResponse struct {
+	Rates      []Rate              `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllReappraisalDueResponse) Reset()         { *m = QueryAllReappraisalDueResponse{} }
+func (m *QueryAllReappraisalDueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllReappraisalDueResponse) ProtoMessage()    {}
+func (*QueryAllReappraisalDueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{23}
+}
+func (m *QueryAllReappraisalDueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllReappraisalDueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllReappraisalDueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllReappraisalDueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllReappraisalDueResponse.Merge(m, src)
+}
+func (m *QueryAllReappraisalDueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllReappraisalDueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllReappraisalDueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllReappraisalDueResponse proto.InternalMessageInfo
+
+func (m *QueryAllReappraisalDueResponse) GetRates() []Rate {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+func (m *QueryAllReappraisalDueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllLeaseRequest defines the QueryAllLeaseRequest message.
+type QueryAllLeaseRequest struct {
+	PropertyId uint64             `protobuf:"varint,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllLeaseRequest) Reset()         { *m = QueryAllLeaseRequest{} }
+func (m *QueryAllLeaseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllLeaseRequest) ProtoMessage()    {}
+func (*QueryAllLeaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{24}
+}
+func (m *QueryAllLeaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllLeaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllLeaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllLeaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllLeaseRequest.Merge(m, src)
+}
+func (m *QueryAllLeaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllLeaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllLeaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllLeaseRequest proto.InternalMessageInfo
+
+func (m *QueryAllLeaseRequest) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *QueryAllLeaseRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllLeaseResponse defines the QueryAllLeaseResponse message.
+type QueryAllLeaseResponse struct {
+	Leases     []Lease             `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllLeaseResponse) Reset()         { *m = QueryAllLeaseResponse{} }
+func (m *QueryAllLeaseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllLeaseResponse) ProtoMessage()    {}
+func (*QueryAllLeaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{25}
+}
+func (m *QueryAllLeaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllLeaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllLeaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllLeaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllLeaseResponse.Merge(m, src)
+}
+func (m *QueryAllLeaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllLeaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllLeaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllLeaseResponse proto.InternalMessageInfo
+
+func (m *QueryAllLeaseResponse) GetLeases() []Lease {
+	if m != nil {
+		return m.Leases
+	}
+	return nil
+}
+
+func (m *QueryAllLeaseResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllCashFlowRequest defines the QueryAllCashFlowRequest message.
+type QueryAllCashFlowRequest struct {
+	PropertyId uint64 `protobuf:"varint,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// kind filters the cash flows of the kind, if set.
+	Kind       CashFlowKind       `protobuf:"varint,2,opt,name=kind,proto3,enum=realfin.realestate.v1.CashFlowKind" json:"kind,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllCashFlowRequest) Reset()         { *m = QueryAllCashFlowRequest{} }
+func (m *QueryAllCashFlowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCashFlowRequest) ProtoMessage()    {}
+func (*QueryAllCashFlowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{26}
+}
+func (m *QueryAllCashFlowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCashFlowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCashFlowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllCashFlowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCashFlowRequest.Merge(m, src)
+}
+func (m *QueryAllCashFlowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCashFlowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCashFlowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllCashFlowRequest proto.InternalMessageInfo
+
+func (m *QueryAllCashFlowRequest) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *QueryAllCashFlowRequest) GetKind() CashFlowKind {
+	if m != nil {
+		return m.Kind
+	}
+	return CashFlowKind_CASH_FLOW_KIND_UNSPECIFIED
+}
+
+func (m *QueryAllCashFlowRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllCashFlowResponse defines the QueryAllCashFlowResponse message.
+type QueryAllCashFlowResponse struct {
+	CashFlows  []CashFlow          `protobuf:"bytes,1,rep,name=cash_flows,json=cashFlows,proto3" json:"cash_flows"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllCashFlowResponse) Reset()         { *m = QueryAllCashFlowResponse{} }
+func (m *QueryAllCashFlowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCashFlowResponse) ProtoMessage()    {}
+func (*QueryAllCashFlowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{27}
+}
+func (m *QueryAllCashFlowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCashFlowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCashFlowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllCashFlowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCashFlowResponse.Merge(m, src)
+}
+func (m *QueryAllCashFlowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCashFlowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCashFlowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllCashFlowResponse proto.InternalMessageInfo
+
+func (m *QueryAllCashFlowResponse) GetCashFlows() []CashFlow {
+	if m != nil {
+		return m.CashFlows
+	}
+	return nil
+}
+
+func (m *QueryAllCashFlowResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetIncomeRequest defines the QueryGetIncomeRequest message.
+type QueryGetIncomeRequest struct {
+	PropertyId uint64 `protobuf:"varint,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// window is the number of seconds before the current block time whose cash
+	// flows are counted, 365 days if unset.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *QueryGetIncomeRequest) Reset()         { *m = QueryGetIncomeRequest{} }
+func (m *QueryGetIncomeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetIncomeRequest) ProtoMessage()    {}
+func (*QueryGetIncomeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{28}
+}
+func (m *QueryGetIncomeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetIncomeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetIncomeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetIncomeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetIncomeRequest.Merge(m, src)
+}
+func (m *QueryGetIncomeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetIncomeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetIncomeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetIncomeRequest proto.InternalMessageInfo
+
+func (m *QueryGetIncomeRequest) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *QueryGetIncomeRequest) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// QueryGetIncomeResponse defines the QueryGetIncomeResponse message.
+type QueryGetIncomeResponse struct {
+	PropertyId uint64 `protobuf:"varint,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// manager is the manager of the property, empty if it has none.
+	Manager string `protobuf:"bytes,2,opt,name=manager,proto3" json:"manager,omitempty"`
+	// active_leases is the number of leases running at the current block time.
+	ActiveLeases uint32 `protobuf:"varint,3,opt,name=active_leases,json=activeLeases,proto3" json:"active_leases,omitempty"`
+	// vacant is set when the property has no active lease.
+	Vacant bool `protobuf:"varint,4,opt,name=vacant,proto3" json:"vacant,omitempty"`
+	// occupancy is the share of the floor area of the property under an active
+	// lease.
+	Occupancy cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=occupancy,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"occupancy"`
+	// contract_rent is the current annual rent of the active leases, escalated
+	// since their start.
+	ContractRent uint64 `protobuf:"varint,6,opt,name=contract_rent,json=contractRent,proto3" json:"contract_rent,omitempty"`
+	// window is the number of seconds whose cash flows are counted.
+	Window uint64 `protobuf:"varint,7,opt,name=window,proto3" json:"window,omitempty"`
+	// gross_income is the rent received for the periods ending in the window.
+	GrossIncome uint64 `protobuf:"varint,8,opt,name=gross_income,json=grossIncome,proto3" json:"gross_income,omitempty"`
+	// operating_expenses are the expenses of the periods ending in the window.
+	OperatingExpenses uint64 `protobuf:"varint,9,opt,name=operating_expenses,json=operatingExpenses,proto3" json:"operating_expenses,omitempty"`
+	// net_operating_income is the gross income less the operating expenses.
+	NetOperatingIncome cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=net_operating_income,json=netOperatingIncome,proto3,customtype=cosmossdk.io/math.Int" json:"net_operating_income"`
+	// valuation is the indexed value of the property, or its appraised value if
+	// it was never indexed, 0 if it has no valuation.
+	Valuation uint64 `protobuf:"varint,11,opt,name=valuation,proto3" json:"valuation,omitempty"`
+	// cap_rate is the net operating income annualised over the window divided
+	// by the valuation, zero without valuation.
+	CapRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=cap_rate,json=capRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cap_rate"`
+}
+
+func (m *QueryGetIncomeResponse) Reset()         { *m = QueryGetIncomeResponse{} }
+func (m *QueryGetIncomeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetIncomeResponse) ProtoMessage()    {}
+func (*QueryGetIncomeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737ac26a22dae1b4, []int{29}
+}
+func (m *QueryGetIncomeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetIncomeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetIncomeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetIncomeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetIncomeResponse.Merge(m, src)
+}
+func (m *QueryGetIncomeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetIncomeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetIncomeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetIncomeResponse proto.InternalMessageInfo
+
+func (m *QueryGetIncomeResponse) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *QueryGetIncomeResponse) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
+}
+
+func (m *QueryGetIncomeResponse) GetActiveLeases() uint32 {
+	if m != nil {
+		return m.ActiveLeases
+	}
+	return 0
+}
+
+func (m *QueryGetIncomeResponse) GetVacant() bool {
+	if m != nil {
+		return m.Vacant
+	}
+	return false
+}
+
+func (m *QueryGetIncomeResponse) GetContractRent() uint64 {
+	if m != nil {
+		return m.ContractRent
+	}
+	return 0
+}
+
+func (m *QueryGetIncomeResponse) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *QueryGetIncomeResponse) GetGrossIncome() uint64 {
+	if m != nil {
+		return m.GrossIncome
+	}
+	return 0
+}
+
+func (m *QueryGetIncomeResponse) GetOperatingExpenses() uint64 {
+	if m != nil {
+		return m.OperatingExpenses
+	}
+	return 0
+}
+
+func (m *QueryGetIncomeResponse) GetValuation() uint64 {
+	if m != nil {
+		return m.Valuation
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "realfin.realestate.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "realfin.realestate.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetRateRequest)(nil), "realfin.realestate.v1.QueryGetRateRequest")
+	proto.RegisterType((*QueryGetRateResponse)(nil), "realfin.realestate.v1.QueryGetRateResponse")
+	proto.RegisterType((*QueryAllRateRequest)(nil), "realfin.realestate.v1.QueryAllRateRequest")
+	proto.RegisterType((*QueryAllRateResponse)(nil), "realfin.realestate.v1.QueryAllRateResponse")
+	proto.RegisterType((*QueryGetPropertyRequest)(nil), "realfin.realestate.v1.QueryGetPropertyRequest")
+	proto.RegisterType((*QueryGetPropertyResponse)(nil), "realfin.realestate.v1.QueryGetPropertyResponse")
+	proto.RegisterType((*QueryAllPropertyRequest)(nil), "realfin.realestate.v1.QueryAllPropertyRequest")
+	proto.RegisterType((*QueryAllPropertyResponse)(nil), "realfin.realestate.v1.QueryAllPropertyResponse")
+	proto.RegisterType((*QueryGetAppraiserRequest)(nil), "realfin.realestate.v1.QueryGetAppraiserRequest")
+	proto.RegisterType((*QueryGetAppraiserResponse)(nil), "realfin.realestate.v1.QueryGetAppraiserResponse")
+	proto.RegisterType((*QueryAllAppraiserRequest)(nil), "realfin.realestate.v1.QueryAllAppraiserRequest")
+	proto.RegisterType((*QueryAllAppraiserResponse)(nil), "realfin.realestate.v1.QueryAllAppraiserResponse")
+	proto.RegisterType((*QueryAllAppraisalRequest)(nil), "realfin.realestate.v1.QueryAllAppraisalRequest")
+	proto.RegisterType((*QueryAllAppraisalResponse)(nil), "realfin.realestate.v1.QueryAllAppraisalResponse")
+	proto.RegisterType((*QueryGetRegionalIndexRequest)(nil), "realfin.realestate.v1.QueryGetRegionalIndexRequest")
+	proto.RegisterType((*QueryGetRegionalIndexResponse)(nil), "realfin.realestate.v1.QueryGetRegionalIndexResponse")
+	proto.RegisterType((*QueryAllRegionalIndexRequest)(nil), "realfin.realestate.v1.QueryAllRegionalIndexRequest")
+	proto.RegisterType((*QueryAllRegionalIndexResponse)(nil), "realfin.realestate.v1.QueryAllRegionalIndexResponse")
+	proto.RegisterType((*QueryGetValuationRequest)(nil), "realfin.realestate.v1.QueryGetValuationRequest")
+	proto.RegisterType((*QueryGetValuationResponse)(nil), "realfin.realestate.v1.QueryGetValuationResponse")
+	proto.RegisterType((*QueryAllReappraisalDueRequest)(nil), "realfin.realestate.v1.QueryAllReappraisalDueRequest")
+	proto.RegisterType((*QueryAllReappraisalDueResponse)(nil), "realfin.realestate.v1.QueryAllReappraisalDueResponse")
+	proto.RegisterType((*QueryAllLeaseRequest)(nil), "realfin.realestate.v1.QueryAllLeaseRequest")
+	proto.RegisterType((*QueryAllLeaseResponse)(nil), "realfin.realestate.v1.QueryAllLeaseResponse")
+	proto.RegisterType((*QueryAllCashFlowRequest)(nil), "realfin.realestate.v1.QueryAllCashFlowRequest")
+	proto.RegisterType((*QueryAllCashFlowResponse)(nil), "realfin.realestate.v1.QueryAllCashFlowResponse")
+	proto.RegisterType((*QueryGetIncomeRequest)(nil), "realfin.realestate.v1.QueryGetIncomeRequest")
+	proto.RegisterType((*QueryGetIncomeResponse)(nil), "realfin.realestate.v1.QueryGetIncomeResponse")
+}
+
+func init() { proto.RegisterFile("realfin/realestate/v1/query.proto", fileDescriptor_737ac26a22dae1b4) }
+
+var fileDescriptor_737ac26a22dae1b4 = []byte{
+	// 1872 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x6f, 0xdc, 0xd6,
+	0x11, 0x37, 0xd7, 0xab, 0x95, 0x76, 0xb4, 0x52, 0xec, 0x17, 0xd9, 0x5d, 0xd3, 0x92, 0x56, 0xa2,
+	0xbf, 0xf5, 0x41, 0x4a, 0xb2, 0xe2, 0x00, 0x6d, 0x0f, 0x95, 0xac, 0x58, 0x55, 0xa3, 0xa2, 0x32,
+	0x9b, 0x34, 0x40, 0x81, 0x80, 0x78, 0xe2, 0x3e, 0xad, 0xd9, 0x70, 0x49, 0x86, 0xe4, 0xae, 0x2c,
+	0x08, 0x46, 0x80, 0x1e, 0x8a, 0x1e, 0x03, 0x14, 0x0d, 0xda, 0x22, 0xbd, 0x14, 0x28, 0xda, 0x04,
+	0x2d, 0xd0, 0x02, 0xbd, 0xe5, 0xd0, 0x6b, 0xd0, 0x5e, 0x82, 0xf4, 0x52, 0xf4, 0xe0, 0x16, 0x76,
+	0x81, 0xfe, 0x05, 0xbd, 0x07, 0x7c, 0x1c, 0x72, 0xb9, 0xdc, 0xa5, 0x96, 0x6b, 0xef, 0xc5, 0xf6,
+	0x7b, 0x3b, 0x33, 0xef, 0x37, 0xbf, 0x37, 0x33, 0x9c, 0x37, 0x86, 0x45, 0x97, 0x51, 0xf3, 0xc8,
+	0xb0, 0x94, 0xe0, 0x6f, 0xe6, 0xf9, 0xd4, 0x67, 0x4a, 0x7b, 0x5d, 0x79, 0xbf, 0xc5, 0xdc, 0x13,
+	0xd9, 0x71, 0x6d, 0xdf, 0x26, 0x97, 0x50, 0x44, 0xee, 0x88, 0xc8, 0xed, 0x75, 0xf1, 0x22, 0x6d,
+	0x1a, 0x96, 0xad, 0xf0, 0x3f, 0x43, 0x49, 0xf1, 0x8a, 0x6e, 0x7b, 0x4d, 0xdb, 0xd3, 0xf8, 0x4a,
+	0x09, 0x17, 0xf8, 0xd3, 0x52, 0xb8, 0x52, 0x0e, 0xa9, 0xc7, 0x42, 0xeb, 0x4a, 0x7b, 0xfd, 0x90,
+	0xf9, 0x74, 0x5d, 0x71, 0x68, 0xc3, 0xb0, 0xa8, 0x6f, 0xd8, 0x16, 0xca, 0xce, 0x34, 0xec, 0x86,
+	0x1d, 0xda, 0x08, 0xfe, 0x85, 0xbb, 0xb3, 0x0d, 0xdb, 0x6e, 0x98, 0x4c, 0xa1, 0x8e, 0xa1, 0x50,
+	0xcb, 0xb2, 0x7d, 0xae, 0x12, 0xd9, 0xaf, 0xe1, 0xaf, 0x7c, 0x75, 0xd8, 0x3a, 0x52, 0x7c, 0xa3,
+	0x19, 0x60, 0x6d, 0x3a, 0x28, 0x70, 0xa3, 0xbf, 0xa3, 0xd4, 0x71, 0x5c, 0x6a, 0x78, 0xd4, 0x44,
+	0x31, 0xa9, 0xbf, 0x98, 0x61, 0xe9, 0x76, 0x93, 0xa1, 0xcc, 0x62, 0x96, 0x4c, 0x9d, 0x3d, 0x3e,
+	0xdb, 0x8c, 0x43, 0x5d, 0xda, 0x8c, 0x20, 0x5f, 0xcf, 0x90, 0x71, 0x6d, 0x87, 0xb9, 0x3e, 0xb2,
+	0x2f, 0x2e, 0xf4, 0x97, 0x72, 0x83, 0x5b, 0xe0, 0x12, 0xd2, 0x0c, 0x90, 0x87, 0x01, 0xa1, 0x07,
+	0xdc, 0xb8, 0xca, 0xde, 0x6f, 0x31, 0xcf, 0x97, 0xde, 0x81, 0x57, 0xbb, 0x76, 0x3d, 0xc7, 0xb6,
+	0x3c, 0x46, 0xbe, 0x05, 0xa5, 0x10, 0x44, 0x55, 0x58, 0x10, 0x6e, 0x4f, 0x6e, 0xcc, 0xc9, 0x7d,
+	0x6f, 0x57, 0x0e, 0xd5, 0xb6, 0xcb, 0x9f, 0x3f, 0xad, 0x9d, 0xfb, 0xfd, 0xff, 0xfe, 0xb4, 0x24,
+	0xa8, 0xa8, 0x27, 0xed, 0xa0, 0xe1, 0x5d, 0xe6, 0xab, 0xd4, 0x67, 0x78, 0x1e, 0xa9, 0xc1, 0x64,
+	0x84, 0x5c, 0x33, 0xea, 0xd5, 0xc2, 0x82, 0x70, 0xbb, 0xa8, 0x42, 0xb4, 0xb5, 0x57, 0xff, 0x4e,
+	0x71, 0x42, 0xb8, 0x50, 0x50, 0x4b, 0xde, 0x49, 0xf3, 0xd0, 0x36, 0xa5, 0xef, 0xc2, 0x4c, 0xb7,
+	0x15, 0xc4, 0xf7, 0x1a, 0x14, 0x03, 0xd7, 0x10, 0xdd, 0xd5, 0x0c, 0x74, 0x81, 0xca, 0x76, 0x31,
+	0xc0, 0xa6, 0x72, 0x71, 0xe9, 0x5d, 0x04, 0xb5, 0x65, 0x9a, 0x49, 0x50, 0x0f, 0x00, 0x3a, 0xd1,
+	0x85, 0x36, 0x6f, 0xca, 0x18, 0x98, 0x41, 0x28, 0xca, 0x61, 0xa0, 0x63, 0x28, 0xca, 0x07, 0xb4,
+	0x11, 0xe9, 0xaa, 0x09, 0x4d, 0xe9, 0x23, 0x01, 0x66, 0xba, 0xed, 0xf7, 0xc0, 0x3d, 0x3f, 0x04,
+	0x5c, 0xb2, 0xdb, 0x85, 0xab, 0xc0, 0x71, 0xdd, 0x1a, 0x88, 0x2b, 0x3c, 0xb3, 0x0b, 0xd8, 0x1d,
+	0xf8, 0x5a, 0x44, 0xe3, 0x01, 0x52, 0x1d, 0xf9, 0x3e, 0x0d, 0x05, 0xa3, 0xce, 0x7d, 0x2e, 0xaa,
+	0x05, 0xa3, 0x2e, 0xbd, 0x0b, 0xd5, 0x5e, 0x51, 0x74, 0x63, 0x0b, 0x26, 0xa2, 0x9b, 0x42, 0x96,
+	0x6a, 0x59, 0x71, 0x81, 0x62, 0xe8, 0x4e, 0xac, 0x26, 0x7d, 0x29, 0x20, 0x94, 0x2d, 0xd3, 0x4c,
+	0x43, 0x91, 0xa0, 0xf2, 0xa3, 0x96, 0x6b, 0x78, 0x75, 0x43, 0x8f, 0x2f, 0xa2, 0xac, 0x76, 0xed,
+	0x91, 0x37, 0x61, 0x3a, 0x8e, 0x1f, 0xdd, 0xa4, 0x9e, 0xc7, 0x69, 0x99, 0xde, 0xb8, 0x3e, 0x00,
+	0xc8, 0xfd, 0x40, 0x56, 0x9d, 0x72, 0x92, 0xcb, 0xd4, 0xbd, 0x9f, 0x7f, 0xe1, 0x7b, 0xff, 0x54,
+	0x80, 0x6a, 0xaf, 0x53, 0x48, 0xda, 0x1b, 0x10, 0x85, 0xb7, 0xc1, 0x3c, 0x8c, 0x80, 0x9c, 0xb4,
+	0x25, 0x14, 0x47, 0x17, 0x0b, 0x9b, 0x9d, 0x0b, 0xde, 0x0a, 0xab, 0x1a, 0x73, 0xa3, 0x1b, 0xa8,
+	0xc2, 0x38, 0xad, 0xd7, 0x5d, 0xe6, 0x79, 0x48, 0x7e, 0xb4, 0x94, 0x28, 0x5c, 0xe9, 0xa3, 0x85,
+	0x2e, 0xee, 0x40, 0x99, 0x46, 0x9b, 0x18, 0x18, 0x0b, 0x19, 0x1e, 0xc6, 0xca, 0xe8, 0x62, 0x47,
+	0x51, 0x3a, 0xec, 0x90, 0xd8, 0x03, 0x6c, 0x54, 0x19, 0xfa, 0x07, 0x01, 0xae, 0xf4, 0x39, 0x04,
+	0xfd, 0x78, 0x00, 0x10, 0xc3, 0x89, 0xae, 0x2a, 0xaf, 0x23, 0x09, 0xcd, 0xd1, 0xdd, 0xd5, 0x2f,
+	0x85, 0x1e, 0x4e, 0xa8, 0x99, 0x51, 0x4a, 0x85, 0x74, 0x29, 0x25, 0x33, 0x30, 0xa6, 0x9f, 0xe8,
+	0x26, 0xc3, 0x2a, 0x1b, 0x2e, 0x46, 0x16, 0xf4, 0x7f, 0xef, 0xa5, 0x92, 0x9a, 0x91, 0x17, 0x09,
+	0x2a, 0xa9, 0x99, 0x93, 0x4a, 0x6a, 0xa6, 0xa8, 0xa4, 0xa6, 0x47, 0xe6, 0x00, 0x6c, 0x87, 0x59,
+	0x5a, 0xd2, 0x91, 0x72, 0xb0, 0x73, 0x9f, 0x3b, 0xb3, 0xdb, 0xc7, 0x99, 0x17, 0x62, 0x7a, 0x1b,
+	0x66, 0xe3, 0x0f, 0x0d, 0x6b, 0x18, 0xb6, 0x45, 0xcd, 0xbd, 0xe0, 0x43, 0x3d, 0x44, 0x6d, 0x92,
+	0x5c, 0x98, 0xcb, 0xb0, 0x81, 0xa4, 0x3c, 0x84, 0x69, 0x17, 0x7f, 0xd0, 0x78, 0x1b, 0x80, 0x91,
+	0x9c, 0x55, 0xbc, 0xba, 0xac, 0x20, 0x39, 0x53, 0x6e, 0x72, 0x53, 0x3a, 0x82, 0xd9, 0xf8, 0x8b,
+	0xd3, 0x0f, 0xf7, 0xa8, 0x12, 0xe7, 0xaf, 0x02, 0xcc, 0x65, 0x1c, 0x84, 0xce, 0xbd, 0x0d, 0x17,
+	0x92, 0xce, 0x19, 0x7a, 0x5c, 0xed, 0x86, 0x71, 0xef, 0x95, 0x84, 0x7b, 0x81, 0x89, 0xd1, 0xe5,
+	0xd2, 0x37, 0x3a, 0x75, 0xef, 0x07, 0xd4, 0x6c, 0xf1, 0xcd, 0xbc, 0xa9, 0x24, 0xfd, 0xff, 0x3c,
+	0x5c, 0xe9, 0xa3, 0x8d, 0xae, 0x0f, 0xcc, 0xc4, 0x5b, 0xf0, 0x4a, 0x54, 0x1e, 0xea, 0x5a, 0x9b,
+	0x9a, 0xad, 0x28, 0x94, 0xa7, 0xe3, 0xed, 0xc0, 0x2a, 0x23, 0xfb, 0x50, 0xe9, 0x08, 0x52, 0x1f,
+	0x23, 0x5a, 0x94, 0xc3, 0xb6, 0x55, 0x8e, 0xda, 0x56, 0xf9, 0xad, 0xa8, 0x6d, 0xdd, 0x9e, 0x0a,
+	0x68, 0xfb, 0xf0, 0xdf, 0x35, 0x21, 0x6c, 0xbf, 0x26, 0x63, 0xf5, 0x2d, 0x9f, 0x5c, 0x83, 0x29,
+	0x1e, 0x66, 0xf1, 0xa1, 0x45, 0x7e, 0x68, 0x05, 0x37, 0xc3, 0x23, 0xbf, 0x0d, 0x10, 0x09, 0x51,
+	0xbf, 0x3a, 0x36, 0xec, 0x81, 0x65, 0x54, 0xde, 0xf2, 0xc9, 0x22, 0x84, 0x96, 0xb5, 0xb0, 0x79,
+	0xab, 0x96, 0x78, 0x8e, 0x4c, 0xf2, 0xbd, 0xef, 0xf3, 0x2d, 0xf2, 0x36, 0x1e, 0xa6, 0x05, 0x37,
+	0x57, 0x1d, 0x0f, 0x04, 0xb6, 0xef, 0x05, 0x06, 0xff, 0xf5, 0xb4, 0x76, 0x35, 0xbc, 0x54, 0xaf,
+	0xfe, 0x9e, 0x6c, 0xd8, 0x4a, 0x93, 0xfa, 0x8f, 0xe4, 0x7d, 0xd6, 0xa0, 0xfa, 0xc9, 0x0e, 0xd3,
+	0xbf, 0xfc, 0xcb, 0x2a, 0xe0, 0x9d, 0xef, 0x30, 0x3d, 0x79, 0xf2, 0x36, 0xf5, 0x18, 0x79, 0x07,
+	0xc2, 0x53, 0x34, 0x93, 0xb5, 0x99, 0x59, 0x9d, 0x78, 0x29, 0xbb, 0x21, 0xc2, 0xfd, 0xc0, 0x92,
+	0xf4, 0x41, 0x32, 0xea, 0xe3, 0xb2, 0xb4, 0xd3, 0x8a, 0x5b, 0xc7, 0xcb, 0x50, 0x3a, 0x36, 0xac,
+	0xba, 0x7d, 0x8c, 0xb7, 0x8e, 0xab, 0x54, 0xde, 0x15, 0x5e, 0x38, 0xef, 0x7e, 0x23, 0xc0, 0x7c,
+	0x16, 0x02, 0x8c, 0xbe, 0xd7, 0x61, 0xcc, 0xa5, 0x3e, 0xf3, 0xf2, 0x77, 0x97, 0xa1, 0xfc, 0xe8,
+	0x52, 0xeb, 0x83, 0x4e, 0xdb, 0xbb, 0xcf, 0xa8, 0xc7, 0x72, 0x7f, 0xa1, 0x46, 0xc5, 0xd2, 0xc7,
+	0x02, 0x5c, 0x4a, 0x21, 0x40, 0x72, 0xbe, 0x0e, 0x25, 0x33, 0xd8, 0x88, 0xd8, 0x99, 0xcd, 0x60,
+	0x87, 0x6b, 0x21, 0x3d, 0xa8, 0x31, 0x3a, 0x7e, 0x3e, 0x4b, 0x34, 0xbd, 0xf7, 0xa9, 0xf7, 0xe8,
+	0x81, 0x69, 0x1f, 0xe7, 0xe6, 0xe8, 0x75, 0x28, 0xbe, 0x67, 0x58, 0x75, 0xec, 0x73, 0xaf, 0x65,
+	0xe0, 0x8f, 0xcc, 0xbe, 0x69, 0x58, 0x75, 0x95, 0x2b, 0x8c, 0xec, 0x43, 0xff, 0x49, 0xa2, 0x09,
+	0xe9, 0xa0, 0x8f, 0x5b, 0x3f, 0xd0, 0xa9, 0xf7, 0x48, 0x3b, 0x32, 0xed, 0xe3, 0x41, 0xdd, 0x6d,
+	0xa4, 0x1c, 0xb5, 0x7e, 0x3a, 0xae, 0x47, 0xc8, 0xf4, 0x01, 0xc6, 0xc1, 0x2e, 0xf3, 0xf7, 0xf8,
+	0x5b, 0x3c, 0x37, 0xcd, 0x9d, 0x44, 0x2e, 0x24, 0x13, 0x59, 0xfa, 0x5b, 0x11, 0x2e, 0xa7, 0x4d,
+	0xe6, 0x2d, 0xfb, 0x55, 0x18, 0x6f, 0x52, 0x8b, 0x36, 0x98, 0xcb, 0x8d, 0x96, 0xd5, 0x68, 0x19,
+	0x54, 0x66, 0xaa, 0xfb, 0x46, 0x9b, 0x69, 0x18, 0x9d, 0xc1, 0xf5, 0x4c, 0xa9, 0x95, 0x70, 0x73,
+	0x3f, 0x8c, 0xbf, 0xcb, 0x50, 0x6a, 0x53, 0x9d, 0x5a, 0x3e, 0xaf, 0xdb, 0x13, 0x2a, 0xae, 0xc8,
+	0x5b, 0x50, 0xb6, 0x75, 0xbd, 0xe5, 0x50, 0x4b, 0x3f, 0xa9, 0x8e, 0xbd, 0x54, 0xad, 0xeb, 0x18,
+	0x0a, 0x20, 0xe9, 0xb6, 0xe5, 0xbb, 0x54, 0xf7, 0x35, 0x97, 0x59, 0x3e, 0x2f, 0xdf, 0x45, 0xb5,
+	0x12, 0x6d, 0xaa, 0xcc, 0x4a, 0x96, 0xbb, 0xf1, 0xae, 0x72, 0xb7, 0x08, 0x95, 0x86, 0x6b, 0x7b,
+	0x9e, 0x16, 0x4e, 0x40, 0x78, 0x05, 0x2e, 0xaa, 0x93, 0x7c, 0x2f, 0x64, 0x8d, 0xac, 0x02, 0x09,
+	0x88, 0xa1, 0xbe, 0x61, 0x35, 0x34, 0xf6, 0xd8, 0x61, 0x56, 0xe0, 0x77, 0x99, 0x0b, 0x5e, 0x8c,
+	0x7f, 0x79, 0x03, 0x7f, 0x20, 0x87, 0x30, 0x63, 0x31, 0x5f, 0xeb, 0xa8, 0xa0, 0x65, 0xe0, 0xfe,
+	0xae, 0xa1, 0xbf, 0x97, 0x7a, 0xfd, 0xdd, 0xb3, 0xfc, 0x84, 0xa7, 0x7b, 0x96, 0x1f, 0x7a, 0x4a,
+	0x2c, 0xe6, 0x7f, 0x2f, 0x32, 0x86, 0x90, 0x66, 0xa1, 0xdc, 0x8e, 0x3e, 0xe6, 0xd5, 0xc9, 0xb0,
+	0xb7, 0x8c, 0x37, 0xc8, 0x43, 0x98, 0xd0, 0xa9, 0xa3, 0xf1, 0x87, 0x7b, 0xe5, 0xa5, 0x58, 0x1e,
+	0xd7, 0xa9, 0xa3, 0x52, 0x9f, 0x6d, 0x7c, 0xf4, 0x2a, 0x8c, 0xf1, 0x60, 0x22, 0x3f, 0x11, 0xa0,
+	0x14, 0x0e, 0x4f, 0xc8, 0x9d, 0x8c, 0x74, 0xe9, 0x9d, 0xd6, 0x88, 0x4b, 0x79, 0x44, 0xc3, 0xe8,
+	0x94, 0x6e, 0xfc, 0xf8, 0x1f, 0xff, 0xfd, 0x59, 0xa1, 0x46, 0xe6, 0x94, 0xb3, 0x86, 0x4c, 0xe4,
+	0xe7, 0x02, 0x8c, 0xe3, 0x74, 0x85, 0x9c, 0x69, 0xbe, 0x7b, 0x90, 0x23, 0x2e, 0xe7, 0x92, 0x45,
+	0x2c, 0xeb, 0x1c, 0xcb, 0x32, 0xb9, 0xa3, 0x64, 0x8f, 0xa9, 0x94, 0xd3, 0x44, 0x32, 0x3d, 0x21,
+	0x3f, 0x15, 0x60, 0x62, 0xdf, 0xf0, 0x72, 0x00, 0xeb, 0x1e, 0xe6, 0x88, 0xcb, 0xb9, 0x64, 0x11,
+	0xd8, 0x35, 0x0e, 0x6c, 0x8e, 0x5c, 0x3d, 0x03, 0x18, 0xf9, 0x58, 0x80, 0xc9, 0xc4, 0x38, 0x84,
+	0xc8, 0x03, 0x5c, 0x4f, 0xcd, 0x35, 0x44, 0x25, 0xb7, 0x3c, 0xa2, 0x5a, 0xe1, 0xa8, 0x6e, 0x92,
+	0xeb, 0xca, 0xd9, 0xb3, 0x3f, 0xe5, 0x34, 0x60, 0xea, 0x17, 0x02, 0x54, 0x02, 0xa6, 0xf2, 0xe1,
+	0xeb, 0x9d, 0xbb, 0x88, 0x4a, 0x6e, 0x79, 0xc4, 0x77, 0x8b, 0xe3, 0x5b, 0x24, 0xb5, 0x01, 0xf8,
+	0xc8, 0x6f, 0x05, 0xa8, 0x24, 0x27, 0x06, 0x64, 0x10, 0x15, 0xe9, 0x87, 0xbf, 0xb8, 0x96, 0x5f,
+	0x01, 0xc1, 0x6d, 0x70, 0x70, 0x2b, 0x64, 0x49, 0x39, 0x73, 0x94, 0xcb, 0x5c, 0xe5, 0x14, 0x87,
+	0x1b, 0x4f, 0x82, 0x1b, 0x9e, 0x0a, 0x28, 0xcc, 0x09, 0xb4, 0xcf, 0x84, 0x42, 0x5c, 0xcb, 0xaf,
+	0x80, 0x40, 0x6f, 0x73, 0xa0, 0x12, 0x59, 0x18, 0x04, 0x94, 0x7c, 0xda, 0x0d, 0x8f, 0x9a, 0x79,
+	0xe1, 0x51, 0x73, 0x48, 0x78, 0x9d, 0x17, 0xbc, 0x74, 0x8f, 0xc3, 0x5b, 0x23, 0xb2, 0x32, 0x60,
+	0x24, 0x9e, 0x4a, 0xdc, 0xcf, 0x04, 0xb8, 0x90, 0x7e, 0x01, 0x93, 0xbb, 0x83, 0xaa, 0x45, 0x9f,
+	0xb7, 0xab, 0xb8, 0x39, 0x9c, 0x12, 0xe2, 0xfe, 0x26, 0xc7, 0x7d, 0x8f, 0x6c, 0x66, 0xa5, 0x74,
+	0xd7, 0x0b, 0x5c, 0x39, 0x4d, 0x3e, 0xe1, 0x9f, 0x90, 0x3f, 0x0a, 0x70, 0x91, 0x97, 0x9d, 0xfc,
+	0xf0, 0x33, 0x9e, 0xde, 0xe2, 0xe6, 0x70, 0x4a, 0x08, 0x7f, 0x95, 0xc3, 0xbf, 0x45, 0x6e, 0xe4,
+	0x82, 0x4f, 0x3e, 0x09, 0x33, 0x2c, 0x7e, 0x93, 0x0e, 0xcc, 0xb0, 0xf4, 0xdb, 0x57, 0x5c, 0xcb,
+	0xaf, 0x90, 0x33, 0x32, 0xe2, 0x4f, 0x68, 0x2a, 0x32, 0xfe, 0x2c, 0x00, 0x09, 0xb9, 0x4d, 0xbe,
+	0x63, 0xc8, 0x60, 0x9e, 0xfa, 0x3c, 0xbc, 0xc4, 0xd7, 0x86, 0xd4, 0x42, 0xec, 0x32, 0xc7, 0x7e,
+	0x9b, 0xdc, 0xcc, 0xa4, 0x37, 0x56, 0xd3, 0xea, 0x2d, 0x46, 0x7e, 0x25, 0x40, 0x39, 0xc0, 0xcc,
+	0x5b, 0x32, 0x32, 0xe8, 0xdb, 0x92, 0x7c, 0xfd, 0x88, 0x2b, 0xf9, 0x84, 0x73, 0x96, 0x2d, 0xde,
+	0x27, 0xa6, 0x08, 0xfd, 0x1d, 0x56, 0xfe, 0xa8, 0xb1, 0x1e, 0x58, 0xf9, 0x53, 0x8f, 0x0f, 0x51,
+	0xc9, 0x2d, 0x9f, 0xf3, 0xea, 0xe3, 0xb7, 0x40, 0x0a, 0xe9, 0xaf, 0x05, 0x28, 0xc7, 0x0d, 0x34,
+	0x59, 0x19, 0x10, 0x72, 0x5d, 0xad, 0xbb, 0xb8, 0x9a, 0x53, 0x1a, 0x21, 0xde, 0xe5, 0x10, 0x57,
+	0xc9, 0xb2, 0x72, 0xd6, 0xff, 0xd1, 0x75, 0xe3, 0xdb, 0xde, 0xfc, 0xfc, 0xd9, 0xbc, 0xf0, 0xc5,
+	0xb3, 0x79, 0xe1, 0x3f, 0xcf, 0xe6, 0x85, 0x0f, 0x9f, 0xcf, 0x9f, 0xfb, 0xe2, 0xf9, 0xfc, 0xb9,
+	0x7f, 0x3e, 0x9f, 0x3f, 0xf7, 0x43, 0x31, 0xb2, 0xf2, 0x38, 0x69, 0xc7, 0x3f, 0x71, 0x98, 0x77,
+	0x58, 0xe2, 0xe3, 0x91, 0xbb, 0x5f, 0x0d, 0x00, 0x8f, 0x8d, 0x7b, 0xa6, 0x1e, 0x1d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListRate Queries a list of Rate items.
+	GetRate(ctx context.Context, in *QueryGetRateRequest, opts ...grpc.CallOption) (*QueryGetRateResponse, error)
+	// ListRate defines the ListRate RPC.
+	ListRate(ctx context.Context, in *QueryAllRateRequest, opts ...grpc.CallOption) (*QueryAllRateResponse, error)
+	// GetProperty queries a property by id.
+	GetProperty(ctx context.Context, in *QueryGetPropertyRequest, opts ...grpc.CallOption) (*QueryGetPropertyResponse, error)
+	// ListProperty queries the properties, optionally of a jurisdiction and of
+	// a class.
+	ListProperty(ctx context.Context, in *QueryAllPropertyRequest, opts ...grpc.CallOption) (*QueryAllPropertyResponse, error)
+	// GetAppraiser queries an appraiser by address.
+	GetAppraiser(ctx context.Context, in *QueryGetAppraiserRequest, opts ...grpc.CallOption) (*QueryGetAppraiserResponse, error)
+	// ListAppraiser queries the appraisers.
+	ListAppraiser(ctx context.Context, in *QueryAllAppraiserRequest, opts ...grpc.CallOption) (*QueryAllAppraiserResponse, error)
+	// ListAppraisal queries the appraisals of a property, optionally of a
+	// valuation cycle.
+	ListAppraisal(ctx context.Context, in *QueryAllAppraisalRequest, opts ...grpc.CallOption) (*QueryAllAppraisalResponse, error)
+	// GetRegionalIndex queries the regional index of a jurisdiction.
+	GetRegionalIndex(ctx context.Context, in *QueryGetRegionalIndexRequest, opts ...grpc.CallOption) (*QueryGetRegionalIndexResponse, error)
+	// ListRegionalIndex queries the regional indices.
+	ListRegionalIndex(ctx context.Context, in *QueryAllRegionalIndexRequest, opts ...grpc.CallOption) (*QueryAllRegionalIndexResponse, error)
+	// GetValuation queries the appraised and the indexed values of a property.
+	GetValuation(ctx context.Context, in *QueryGetValuationRequest, opts ...grpc.CallOption) (*QueryGetValuationResponse, error)
+	// ListReappraisalDue queries the rates of the properties due for
+	// re-appraisal within a window: the expired valuations and those expiring
+	// in the window.
+	ListReappraisalDue(ctx context.Context, in *QueryAllReappraisalDueRequest, opts ...grpc.CallOption) (*QueryAllReappraisalDueResponse, error)
+	// ListLease queries the leases of a property.
+	ListLease(ctx context.Context, in *QueryAllLeaseRequest, opts ...grpc.CallOption) (*QueryAllLeaseResponse, error)
+	// ListCashFlow queries the cash flows of a property, optionally of a kind.
+	ListCashFlow(ctx context.Context, in *QueryAllCashFlowRequest, opts ...grpc.CallOption) (*QueryAllCashFlowResponse, error)
+	// GetIncome queries the income metrics of a property: its occupancy, net
+	// operating income and capitalisation rate.
+	GetIncome(ctx context.Context, in *QueryGetIncomeRequest, opts ...grpc.CallOption) (*QueryGetIncomeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRate(ctx context.Context, in *QueryGetRateRequest, opts ...grpc.CallOption) (*QueryGetRateResponse, error) {
+	out := new(QueryGetRateResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/GetRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRate(ctx context.Context, in *QueryAllRateRequest, opts ...grpc.CallOption) (*QueryAllRateResponse, error) {
+	out := new(QueryAllRateResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/ListRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProperty(ctx context.Context, in *QueryGetPropertyRequest, opts ...grpc.CallOption) (*QueryGetPropertyResponse, error) {
+	out := new(QueryGetPropertyResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/GetProperty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListProperty(ctx context.Context, in *QueryAllPropertyRequest, opts ...grpc.CallOption) (*QueryAllPropertyResponse, error) {
+	out := new(QueryAllPropertyResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/ListProperty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAppraiser(ctx context.Context, in *QueryGetAppraiserRequest, opts ...grpc.CallOption) (*QueryGetAppraiserResponse, error) {
+	out := new(QueryGetAppraiserResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/GetAppraiser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAppraiser(ctx context.Context, in *QueryAllAppraiserRequest, opts ...grpc.CallOption) (*QueryAllAppraiserResponse, error) {
+	out := new(QueryAllAppraiserResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/ListAppraiser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAppraisal(ctx context.Context, in *QueryAllAppraisalRequest, opts ...grpc.CallOption) (*QueryAllAppraisalResponse, error) {
+	out := new(QueryAllAppraisalResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/ListAppraisal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRegionalIndex(ctx context.Context, in *QueryGetRegionalIndexRequest, opts ...grpc.CallOption) (*QueryGetRegionalIndexResponse, error) {
+	out := new(QueryGetRegionalIndexResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/GetRegionalIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRegionalIndex(ctx context.Context, in *QueryAllRegionalIndexRequest, opts ...grpc.CallOption) (*QueryAllRegionalIndexResponse, error) {
+	out := new(QueryAllRegionalIndexResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/ListRegionalIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetValuation(ctx context.Context, in *QueryGetValuationRequest, opts ...grpc.CallOption) (*QueryGetValuationResponse, error) {
+	out := new(QueryGetValuationResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/GetValuation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListReappraisalDue(ctx context.Context, in *QueryAllReappraisalDueRequest, opts ...grpc.CallOption) (*QueryAllReappraisalDueResponse, error) {
+	out := new(QueryAllReappraisalDueResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/ListReappraisalDue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListLease(ctx context.Context, in *QueryAllLeaseRequest, opts ...grpc.CallOption) (*QueryAllLeaseResponse, error) {
+	out := new(QueryAllLeaseResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/ListLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListCashFlow(ctx context.Context, in *QueryAllCashFlowRequest, opts ...grpc.CallOption) (*QueryAllCashFlowResponse, error) {
+	out := new(QueryAllCashFlowResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/ListCashFlow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetIncome(ctx context.Context, in *QueryGetIncomeRequest, opts ...grpc.CallOption) (*QueryGetIncomeResponse, error) {
+	out := new(QueryGetIncomeResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Query/GetIncome", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListRate Queries a list of Rate items.
+	GetRate(context.Context, *QueryGetRateRequest) (*QueryGetRateResponse, error)
+	// ListRate defines the ListRate RPC.
+	ListRate(context.Context, *QueryAllRateRequest) (*QueryAllRateResponse, error)
+	// GetProperty queries a property by id.
+	GetProperty(context.Context, *QueryGetPropertyRequest) (*QueryGetPropertyResponse, error)
+	// ListProperty queries the properties, optionally of a jurisdiction and of
+	// a class.
+	ListProperty(context.Context, *QueryAllPropertyRequest) (*QueryAllPropertyResponse, error)
+	// GetAppraiser queries an appraiser by address.
+	GetAppraiser(context.Context, *QueryGetAppraiserRequest) (*QueryGetAppraiserResponse, error)
+	// ListAppraiser queries the appraisers.
+	ListAppraiser(context.Context, *QueryAllAppraiserRequest) (*QueryAllAppraiserResponse, error)
+	// ListAppraisal queries the appraisals of a property, optionally of a
+	// valuation cycle.
+	ListAppraisal(context.Context, *QueryAllAppraisalRequest) (*QueryAllAppraisalResponse, error)
+	// GetRegionalIndex queries the regional index of a jurisdiction.
+	GetRegionalIndex(context.Context, *QueryGetRegionalIndexRequest) (*QueryGetRegionalIndexResponse, error)
+	// ListRegionalIndex queries the regional indices.
+	ListRegionalIndex(context.Context, *QueryAllRegionalIndexRequest) (*QueryAllRegionalIndexResponse, error)
+	// GetValuation queries the appraised and the indexed values of a property.
+	GetValuation(context.Context, *QueryGetValuationRequest) (*QueryGetValuationResponse, error)
+	// ListReappraisalDue queries the rates of the properties due for
+	// re-appraisal within a window: the expired valuations and those expiring
+	// in the window.
+	ListReappraisalDue(context.Context, *QueryAllReappraisalDueRequest) (*QueryAllReappraisalDueResponse, error)
+	// ListLease queries the leases of a property.
+	ListLease(context.Context, *QueryAllLeaseRequest) (*QueryAllLeaseResponse, error)
+	// ListCashFlow queries the cash flows of a property, optionally of a kind.
+	ListCashFlow(context.Context, *QueryAllCashFlowRequest) (*QueryAllCashFlowResponse, error)
+	// GetIncome queries the income metrics of a property: its occupancy, net
+	// operating income and capitalisation rate.
+	GetIncome(context.Context, *QueryGetIncomeRequest) (*QueryGetIncomeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetRate(ctx context.Context, req *QueryGetRateRequest) (*QueryGetRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRate not implemented")
+}
+func (*UnimplementedQueryServer) ListRate(ctx context.Context, req *QueryAllRateRequest) (*QueryAllRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRate not implemented")
+}
+func (*UnimplementedQueryServer) GetProperty(ctx context.Context, req *QueryGetPropertyRequest) (*QueryGetPropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProperty not implemented")
+}
+func (*UnimplementedQueryServer) ListProperty(ctx context.Context, req *QueryAllPropertyRequest) (*QueryAllPropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProperty not implemented")
+}
+func (*UnimplementedQueryServer) GetAppraiser(ctx context.Context, req *QueryGetAppraiserRequest) (*QueryGetAppraiserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppraiser not implemented")
+}
+func (*UnimplementedQueryServer) ListAppraiser(ctx context.Context, req *QueryAllAppraiserRequest) (*QueryAllAppraiserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppraiser not implemented")
+}
+func (*UnimplementedQueryServer) ListAppraisal(ctx context.Context, req *QueryAllAppraisalRequest) (*QueryAllAppraisalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppraisal not implemented")
+}
+func (*UnimplementedQueryServer) GetRegionalIndex(ctx context.Context, req *QueryGetRegionalIndexRequest) (*QueryGetRegionalIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegionalIndex not implemented")
+}
+func (*UnimplementedQueryServer) ListRegionalIndex(ctx context.Context, req *QueryAllRegionalIndexRequest) (*QueryAllRegionalIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegionalIndex not implemented")
+}
+func (*UnimplementedQueryServer) GetValuation(ctx context.Context, req *QueryGetValuationRequest) (*QueryGetValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValuation not implemented")
+}
+func (*UnimplementedQueryServer) ListReappraisalDue(ctx context.Context, req *QueryAllReappraisalDueRequest) (*QueryAllReappraisalDueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReappraisalDue not implemented")
+}
+func (*UnimplementedQueryServer) ListLease(ctx context.Context, req *QueryAllLeaseRequest) (*QueryAllLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLease not implemented")
+}
+func (*UnimplementedQueryServer) ListCashFlow(ctx context.Context, req *QueryAllCashFlowRequest) (*QueryAllCashFlowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCashFlow not implemented")
+}
+func (*UnimplementedQueryServer) GetIncome(ctx context.Context, req *QueryGetIncomeRequest) (*QueryGetIncomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncome not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/GetRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRate(ctx, req.(*QueryGetRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/ListRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRate(ctx, req.(*QueryAllRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPropertyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/GetProperty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProperty(ctx, req.(*QueryGetPropertyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPropertyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/ListProperty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListProperty(ctx, req.(*QueryAllPropertyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAppraiser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAppraiserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAppraiser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/GetAppraiser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAppraiser(ctx, req.(*QueryGetAppraiserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAppraiser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAppraiserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAppraiser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/ListAppraiser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAppraiser(ctx, req.(*QueryAllAppraiserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAppraisal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAppraisalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAppraisal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/ListAppraisal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAppraisal(ctx, req.(*QueryAllAppraisalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRegionalIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRegionalIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRegionalIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/GetRegionalIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRegionalIndex(ctx, req.(*QueryGetRegionalIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRegionalIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRegionalIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRegionalIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/ListRegionalIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRegionalIndex(ctx, req.(*QueryAllRegionalIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/GetValuation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValuation(ctx, req.(*QueryGetValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListReappraisalDue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllReappraisalDueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListReappraisalDue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/ListReappraisalDue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListReappraisalDue(ctx, req.(*QueryAllReappraisalDueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/ListLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListLease(ctx, req.(*QueryAllLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListCashFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCashFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListCashFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/ListCashFlow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListCashFlow(ctx, req.(*QueryAllCashFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetIncomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Query/GetIncome",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetIncome(ctx, req.(*QueryGetIncomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "realfin.realestate.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetRate",
+			Handler:    _Query_GetRate_Handler,
+		},
+		{
+			MethodName: "ListRate",
+			Handler:    _Query_ListRate_Handler,
+		},
+		{
+			MethodName: "GetProperty",
+			Handler:    _Query_GetProperty_Handler,
+		},
+		{
+			MethodName: "ListProperty",
+			Handler:    _Query_ListProperty_Handler,
+		},
+		{
+			MethodName: "GetAppraiser",
+			Handler:    _Query_GetAppraiser_Handler,
+		},
+		{
+			MethodName: "ListAppraiser",
+			Handler:    _Query_ListAppraiser_Handler,
+		},
+		{
+			MethodName: "ListAppraisal",
+			Handler:    _Query_ListAppraisal_Handler,
+		},
+		{
+			MethodName: "GetRegionalIndex",
+			Handler:    _Query_GetRegionalIndex_Handler,
+		},
+		{
+			MethodName: "ListRegionalIndex",
+			Handler:    _Query_ListRegionalIndex_Handler,
+		},
+		{
+			MethodName: "GetValuation",
+			Handler:    _Query_GetValuation_Handler,
+		},
+		{
+			MethodName: "ListReappraisalDue",
+			Handler:    _Query_ListReappraisalDue_Handler,
+		},
+		{
+			MethodName: "ListLease",
+			Handler:    _Query_ListLease_Handler,
+		},
+		{
+			MethodName: "ListCashFlow",
+			Handler:    _Query_ListCashFlow_Handler,
+		},
+		{
+			MethodName: "GetIncome",
+			Handler:    _Query_GetIncome_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "realfin/realestate/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rate) > 0 {
+		for iNdEx := len(m.Rate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPropertyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPropertyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPropertyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPropertyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPropertyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPropertyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Property.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPropertyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPropertyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPropertyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PropertyClass != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PropertyClass))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPropertyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPropertyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPropertyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Properties) > 0 {
+		for iNdEx := len(m.Properties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Properties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAppraiserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAppraiserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAppraiserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAppraiserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAppraiserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAppraiserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Appraiser.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAppraiserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAppraiserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAppraiserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAppraiserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAppraiserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAppraiserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Appraisers) > 0 {
+		for iNdEx := len(m.Appraisers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Appraisers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAppraisalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAppraisalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAppraisalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Cycle != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Cycle))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAppraisalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAppraisalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAppraisalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OpenCycle != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OpenCycle))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Appraisals) > 0 {
+		for iNdEx := len(m.Appraisals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Appraisals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRegionalIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRegionalIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRegionalIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRegionalIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRegionalIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRegionalIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RegionalIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRegionalIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRegionalIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRegionalIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRegionalIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRegionalIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRegionalIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RegionalIndices) > 0 {
+		for iNdEx := len(m.RegionalIndices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegionalIndices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValuationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValuationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValuationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValuationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValuationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValuationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.IndexLevel.Size()
+		i -= size
+		if _, err := m.IndexLevel.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.IndexBase.Size()
+		i -= size
+		if _, err := m.IndexBase.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.IndexSymbol) > 0 {
+		i -= len(m.IndexSymbol)
+		copy(dAtA[i:], m.IndexSymbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IndexSymbol)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.IndexedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.IndexedAt):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x2a
+	if m.IndexedValue != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IndexedValue))
+		i--
+		dAtA[i] = 0x20
+	}
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AppraisedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AppraisedAt):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintQuery(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x1a
+	if m.AppraisedValue != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AppraisedValue))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllReappraisalDueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllReappraisalDueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllReappraisalDueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllReappraisalDueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllReappraisalDueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllReappraisalDueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllLeaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllLeaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCashFlowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCashFlowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCashFlowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Kind != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCashFlowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCashFlowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCashFlowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CashFlows) > 0 {
+		for iNdEx := len(m.CashFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CashFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetIncomeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetIncomeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetIncomeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetIncomeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetIncomeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetIncomeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CapRate.Size()
+		i -= size
+		if _, err := m.CapRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.Valuation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Valuation))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.NetOperatingIncome.Size()
+		i -= size
+		if _, err := m.NetOperatingIncome.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.OperatingExpenses != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperatingExpenses))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GrossIncome != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GrossIncome))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ContractRent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractRent))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Occupancy.Size()
+		i -= size
+		if _, err := m.Occupancy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Vacant {
+		i--
+		if m.Vacant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActiveLeases != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveLeases))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		n += 1 + sovQuery(uint64(m.PropertyId))
+	}
+	return n
+}
+
+func (m *QueryGetRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.Rate) > 0 {
 		for _, e := range m.Rate {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPropertyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetPropertyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Property.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPropertyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PropertyClass != 0 {
+		n += 1 + sovQuery(uint64(m.PropertyClass))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPropertyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Properties) > 0 {
+		for _, e := range m.Properties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAppraiserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAppraiserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Appraiser.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAppraiserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAppraiserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Appraisers) > 0 {
+		for _, e := range m.Appraisers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAppraisalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		n += 1 + sovQuery(uint64(m.PropertyId))
+	}
+	if m.Cycle != 0 {
+		n += 1 + sovQuery(uint64(m.Cycle))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAppraisalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Appraisals) > 0 {
+		for _, e := range m.Appraisals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.OpenCycle != 0 {
+		n += 1 + sovQuery(uint64(m.OpenCycle))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRegionalIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRegionalIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RegionalIndex.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRegionalIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRegionalIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RegionalIndices) > 0 {
+		for _, e := range m.RegionalIndices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetValuationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		n += 1 + sovQuery(uint64(m.PropertyId))
+	}
+	return n
+}
+
+func (m *QueryGetValuationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		n += 1 + sovQuery(uint64(m.PropertyId))
+	}
+	if m.AppraisedValue != 0 {
+		n += 1 + sovQuery(uint64(m.AppraisedValue))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AppraisedAt)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IndexedValue != 0 {
+		n += 1 + sovQuery(uint64(m.IndexedValue))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.IndexedAt)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.IndexSymbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.IndexBase.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.IndexLevel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllReappraisalDueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllReappraisalDueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLeaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		n += 1 + sovQuery(uint64(m.PropertyId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCashFlowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		n += 1 + sovQuery(uint64(m.PropertyId))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovQuery(uint64(m.Kind))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCashFlowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CashFlows) > 0 {
+		for _, e := range m.CashFlows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetIncomeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		n += 1 + sovQuery(uint64(m.PropertyId))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryGetIncomeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		n += 1 + sovQuery(uint64(m.PropertyId))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActiveLeases != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveLeases))
+	}
+	if m.Vacant {
+		n += 2
+	}
+	l = m.Occupancy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ContractRent != 0 {
+		n += 1 + sovQuery(uint64(m.ContractRent))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	if m.GrossIncome != 0 {
+		n += 1 + sovQuery(uint64(m.GrossIncome))
+	}
+	if m.OperatingExpenses != 0 {
+		n += 1 + sovQuery(uint64(m.OperatingExpenses))
+	}
+	l = m.NetOperatingIncome.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Valuation != 0 {
+		n += 1 + sovQuery(uint64(m.Valuation))
+	}
+	l = m.CapRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = append(m.Rate, Rate{})
+			if err := m.Rate[len(m.Rate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPropertyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPropertyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPropertyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPropertyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPropertyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPropertyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Property", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Property.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPropertyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPropertyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPropertyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyClass", wireType)
+			}
+			m.PropertyClass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyClass |= PropertyClass(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPropertyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPropertyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPropertyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = append(m.Properties, Property{})
+			if err := m.Properties[len(m.Properties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAppraiserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAppraiserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAppraiserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAppraiserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAppraiserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAppraiserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appraiser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Appraiser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAppraiserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAppraiserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAppraiserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAppraiserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAppraiserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAppraiserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appraisers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appraisers = append(m.Appraisers, Appraiser{})
+			if err := m.Appraisers[len(m.Appraisers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAppraisalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAppraisalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAppraisalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cycle", wireType)
+			}
+			m.Cycle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cycle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAppraisalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAppraisalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAppraisalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appraisals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appraisals = append(m.Appraisals, Appraisal{})
+			if err := m.Appraisals[len(m.Appraisals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenCycle", wireType)
+			}
+			m.OpenCycle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenCycle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRegionalIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRegionalIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRegionalIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRegionalIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRegionalIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRegionalIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionalIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegionalIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRegionalIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRegionalIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRegionalIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRegionalIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRegionalIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRegionalIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionalIndices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegionalIndices = append(m.RegionalIndices, RegionalIndex{})
+			if err := m.RegionalIndices[len(m.RegionalIndices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValuationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValuationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValuationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValuationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValuationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValuationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppraisedValue", wireType)
+			}
+			m.AppraisedValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppraisedValue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppraisedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AppraisedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedValue", wireType)
+			}
+			m.IndexedValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedValue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.IndexedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexSymbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexSymbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IndexBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexLevel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IndexLevel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllReappraisalDueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllReappraisalDueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllReappraisalDueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllReappraisalDueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllReappraisalDueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllReappraisalDueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rates = append(m.Rates, Rate{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllLeaseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -827,12 +6729,67 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllLeaseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllLeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -854,7 +6811,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllLeaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,15 +6834,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllLeaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -912,7 +6869,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Leases = append(m.Leases, Lease{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -937,7 +6931,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCashFlowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -960,17 +6954,55 @@ func (m *QueryGetRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCashFlowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCashFlowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= CashFlowKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -980,23 +7012,27 @@ func (m *QueryGetRateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1019,7 +7055,7 @@ func (m *QueryGetRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCashFlowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1042,15 +7078,49 @@ func (m *QueryGetRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCashFlowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCashFlowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CashFlows = append(m.CashFlows, CashFlow{})
+			if err := m.CashFlows[len(m.CashFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1077,7 +7147,10 @@ func (m *QueryGetRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1102,7 +7175,7 @@ func (m *QueryGetRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetIncomeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1125,17 +7198,17 @@ func (m *QueryAllRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetIncomeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetIncomeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
 			}
-			var msglen int
+			m.PropertyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1145,28 +7218,30 @@ func (m *QueryAllRateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PropertyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1188,7 +7263,7 @@ func (m *QueryAllRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetIncomeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1211,17 +7286,36 @@ func (m *QueryAllRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetIncomeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetIncomeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1231,31 +7325,178 @@ func (m *QueryAllRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rate = append(m.Rate, Rate{})
-			if err := m.Rate[len(m.Rate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveLeases", wireType)
+			}
+			m.ActiveLeases = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveLeases |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vacant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Vacant = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Occupancy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Occupancy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractRent", wireType)
+			}
+			m.ContractRent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractRent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrossIncome", wireType)
+			}
+			m.GrossIncome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrossIncome |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatingExpenses", wireType)
+			}
+			m.OperatingExpenses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatingExpenses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetOperatingIncome", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1265,25 +7506,76 @@ func (m *QueryAllRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := m.NetOperatingIncome.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valuation", wireType)
+			}
+			m.Valuation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Valuation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CapRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
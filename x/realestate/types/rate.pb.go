@@ -4,17 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,13 +30,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// Rate defines the Rate message.
+// Rate is the valuation of a property: the trimmed mean of the appraisals of
+// its last finalised valuation cycle.
 type Rate struct {
-	Symbol      string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	Rate        uint64 `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`
 	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	Creator     string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	PropertyId  uint64 `protobuf:"varint,6,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// cycle is the valuation cycle finalised by the rate, 0 for the rates
+	// published before appraisals.
+	Cycle uint64 `protobuf:"varint,7,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	// appraisals is the number of appraisals of the cycle.
+	Appraisals  uint32    `protobuf:"varint,8,opt,name=appraisals,proto3" json:"appraisals,omitempty"`
+	FinalizedAt time.Time `protobuf:"bytes,9,opt,name=finalized_at,json=finalizedAt,proto3,stdtime" json:"finalized_at"`
+	// index_symbol is the symbol of the regional index the indexed value
+	// follows, empty until the property is first indexed.
+	IndexSymbol string `protobuf:"bytes,10,opt,name=index_symbol,json=indexSymbol,proto3" json:"index_symbol,omitempty"`
+	// index_base is the level of the regional index the rate was indexed from,
+	// the level at the finalisation of the cycle.
+	IndexBase cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=index_base,json=indexBase,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"index_base"`
+	// indexed_value is the rate marked to model by the change of the regional
+	// index since index_base.
+	IndexedValue uint64    `protobuf:"varint,12,opt,name=indexed_value,json=indexedValue,proto3" json:"indexed_value,omitempty"`
+	IndexedAt    time.Time `protobuf:"bytes,13,opt,name=indexed_at,json=indexedAt,proto3,stdtime" json:"indexed_at"`
+	// valid_until is the time the valuation expires, the zero time when it
+	// does not expire.
+	ValidUntil time.Time `protobuf:"bytes,14,opt,name=valid_until,json=validUntil,proto3,stdtime" json:"valid_until"`
+	// expired is set once the valuation is flagged as expired, until the next
+	// finalised cycle.
+	Expired bool `protobuf:"varint,15,opt,name=expired,proto3" json:"expired,omitempty"`
 }
 
 func (m *Rate) Reset()         { *m = Rate{} }
@@ -64,13 +95,6 @@ func (m *Rate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Rate proto.InternalMessageInfo
 
-func (m *Rate) GetSymbol() string {
-	if m != nil {
-		return m.Symbol
-	}
-	return ""
-}
-
 func (m *Rate) GetRate() uint64 {
 	if m != nil {
 		return m.Rate
@@ -99,6 +123,69 @@ func (m *Rate) GetCreator() string {
 	return ""
 }
 
+func (m *Rate) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *Rate) GetCycle() uint64 {
+	if m != nil {
+		return m.Cycle
+	}
+	return 0
+}
+
+func (m *Rate) GetAppraisals() uint32 {
+	if m != nil {
+		return m.Appraisals
+	}
+	return 0
+}
+
+func (m *Rate) GetFinalizedAt() time.Time {
+	if m != nil {
+		return m.FinalizedAt
+	}
+	return time.Time{}
+}
+
+func (m *Rate) GetIndexSymbol() string {
+	if m != nil {
+		return m.IndexSymbol
+	}
+	return ""
+}
+
+func (m *Rate) GetIndexedValue() uint64 {
+	if m != nil {
+		return m.IndexedValue
+	}
+	return 0
+}
+
+func (m *Rate) GetIndexedAt() time.Time {
+	if m != nil {
+		return m.IndexedAt
+	}
+	return time.Time{}
+}
+
+func (m *Rate) GetValidUntil() time.Time {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return time.Time{}
+}
+
+func (m *Rate) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Rate)(nil), "realfin.realestate.v1.Rate")
 }
@@ -106,20 +193,39 @@ func init() {
 func init() { proto.RegisterFile("realfin/realestate/v1/rate.proto", fileDescriptor_ae79df81899af7d2) }
 
 var fileDescriptor_ae79df81899af7d2 = []byte{
-	// 203 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x4a, 0x4d, 0xcc,
-	0x49, 0xcb, 0xcc, 0xd3, 0x07, 0xd1, 0xa9, 0xc5, 0x25, 0x89, 0x25, 0xa9, 0xfa, 0x65, 0x86, 0xfa,
-	0x45, 0x89, 0x25, 0xa9, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xa2, 0x50, 0x15, 0x7a, 0x08,
-	0x15, 0x7a, 0x65, 0x86, 0x4a, 0x4d, 0x8c, 0x5c, 0x2c, 0x41, 0x89, 0x25, 0xa9, 0x42, 0x62, 0x5c,
-	0x6c, 0xc5, 0x95, 0xb9, 0x49, 0xf9, 0x39, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x50, 0x9e,
-	0x90, 0x10, 0x17, 0x0b, 0xc8, 0x14, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x30, 0x1b, 0x24,
-	0x96, 0x97, 0x98, 0x9b, 0x2a, 0xc1, 0x0c, 0x56, 0x09, 0x66, 0x0b, 0x29, 0x70, 0x71, 0xa7, 0xa4,
-	0x16, 0x27, 0x17, 0x65, 0x16, 0x94, 0x64, 0xe6, 0xe7, 0x49, 0xb0, 0x80, 0xa5, 0x90, 0x85, 0x84,
-	0x24, 0xb8, 0xd8, 0x93, 0x8b, 0x52, 0x13, 0x4b, 0xf2, 0x8b, 0x24, 0x58, 0xc1, 0xb2, 0x30, 0xae,
-	0x93, 0xc9, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x49, 0xc1, 0xfc, 0x55,
-	0x81, 0xec, 0xb3, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xc7, 0x8c, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xe4, 0x5f, 0xd9, 0x32, 0xfc, 0x00, 0x00, 0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xb1, 0x8e, 0xd3, 0x4c,
+	0x10, 0xce, 0xde, 0xef, 0xcb, 0x25, 0xeb, 0xe4, 0x07, 0x56, 0x87, 0xb4, 0x04, 0xc9, 0x31, 0xd0,
+	0x44, 0x48, 0xd8, 0x3a, 0x40, 0xf4, 0x17, 0x5d, 0x01, 0xa7, 0xab, 0x0c, 0x47, 0x41, 0x63, 0x6d,
+	0xec, 0x49, 0x58, 0x61, 0x7b, 0xad, 0xdd, 0x4d, 0x14, 0xf3, 0x14, 0xf7, 0x18, 0x94, 0x14, 0x3c,
+	0xc4, 0x95, 0x27, 0x2a, 0x44, 0x71, 0xa0, 0xa4, 0xa0, 0xe1, 0x21, 0x90, 0x77, 0x6d, 0x48, 0x7b,
+	0x8d, 0xbd, 0xdf, 0x37, 0xdf, 0xcc, 0xec, 0xb7, 0xa3, 0xc1, 0xbe, 0x04, 0x96, 0xcd, 0x79, 0x11,
+	0xd6, 0x7f, 0x50, 0x9a, 0x69, 0x08, 0x57, 0x47, 0xa1, 0x64, 0x1a, 0x82, 0x52, 0x0a, 0x2d, 0xc8,
+	0xdd, 0x46, 0x11, 0xfc, 0x53, 0x04, 0xab, 0xa3, 0xd1, 0x1d, 0x96, 0xf3, 0x42, 0x84, 0xe6, 0x6b,
+	0x95, 0xa3, 0x7b, 0x89, 0x50, 0xb9, 0x50, 0xb1, 0x41, 0xa1, 0x05, 0x4d, 0xe8, 0x70, 0x21, 0x16,
+	0xc2, 0xf2, 0xf5, 0xa9, 0x61, 0xc7, 0x0b, 0x21, 0x16, 0x19, 0x84, 0x06, 0xcd, 0x96, 0xf3, 0x50,
+	0xf3, 0xbc, 0xee, 0x90, 0x97, 0x56, 0xf0, 0xf0, 0xb7, 0x83, 0x9d, 0x88, 0x69, 0x20, 0x04, 0x3b,
+	0xf5, 0x95, 0xe8, 0x9e, 0x8f, 0x26, 0x4e, 0xe4, 0xc8, 0x86, 0x2b, 0x58, 0x0e, 0xf4, 0x3f, 0x1f,
+	0x4d, 0xfa, 0x91, 0x39, 0x13, 0x1f, 0xbb, 0x29, 0xa8, 0x44, 0xf2, 0x52, 0x73, 0x51, 0x50, 0xc7,
+	0x84, 0x76, 0x29, 0x42, 0xf1, 0x41, 0x22, 0x81, 0x69, 0x21, 0xe9, 0xbe, 0x89, 0xb6, 0x90, 0x8c,
+	0xb1, 0x5b, 0x4a, 0x51, 0x82, 0xd4, 0x55, 0xcc, 0x53, 0xda, 0x35, 0xad, 0x70, 0x4b, 0xbd, 0x4a,
+	0xc9, 0x21, 0xde, 0x4f, 0xaa, 0x24, 0x03, 0x7a, 0x60, 0x42, 0x16, 0x10, 0x0f, 0x63, 0x56, 0x96,
+	0x92, 0x71, 0xc5, 0x32, 0x45, 0x7b, 0x3e, 0x9a, 0x0c, 0xa3, 0x1d, 0x86, 0x9c, 0xe1, 0xc1, 0x9c,
+	0x17, 0x2c, 0xe3, 0x1f, 0x21, 0x8d, 0x99, 0xa6, 0x7d, 0x1f, 0x4d, 0xdc, 0xa7, 0xa3, 0xc0, 0x7a,
+	0x0f, 0x5a, 0xef, 0xc1, 0x9b, 0xd6, 0xfb, 0x74, 0x78, 0x79, 0x3d, 0xee, 0x5c, 0xfc, 0x18, 0xa3,
+	0x4f, 0xbf, 0x3e, 0x3f, 0x46, 0x91, 0xfb, 0x37, 0xfd, 0x58, 0x93, 0x07, 0x78, 0xc0, 0x8b, 0x14,
+	0xd6, 0xb1, 0xaa, 0xf2, 0x99, 0xc8, 0x28, 0xb6, 0x0e, 0x0d, 0xf7, 0xda, 0x50, 0xe4, 0x1c, 0x63,
+	0x2b, 0x99, 0x31, 0x05, 0xd4, 0xad, 0x05, 0xd3, 0x17, 0x75, 0xc9, 0xef, 0xd7, 0xe3, 0xfb, 0x76,
+	0x2a, 0x2a, 0xfd, 0x10, 0x70, 0x11, 0xe6, 0x4c, 0xbf, 0x0f, 0xce, 0x60, 0xc1, 0x92, 0xea, 0x04,
+	0x92, 0xaf, 0x5f, 0x9e, 0xe0, 0x66, 0x68, 0x27, 0x90, 0xd8, 0xde, 0x7d, 0x53, 0x69, 0xca, 0x14,
+	0x90, 0x47, 0x78, 0x68, 0x00, 0xa4, 0xf1, 0x8a, 0x65, 0x4b, 0xa0, 0x03, 0xf3, 0x0a, 0x83, 0x86,
+	0x7c, 0x5b, 0x73, 0xe4, 0x65, 0xd3, 0xdb, 0x5a, 0x1d, 0xde, 0xd4, 0x6a, 0xbf, 0x49, 0x3e, 0xd6,
+	0xe4, 0x14, 0xbb, 0x2b, 0x96, 0xf1, 0x34, 0x5e, 0x16, 0x9a, 0x67, 0xf4, 0xff, 0x9b, 0x96, 0xc2,
+	0x26, 0xfb, 0xbc, 0x4e, 0xae, 0x67, 0x0e, 0xeb, 0x92, 0x4b, 0x48, 0xe9, 0x2d, 0x1f, 0x4d, 0x7a,
+	0x51, 0x0b, 0x4f, 0x9d, 0x1e, 0xba, 0xbd, 0x17, 0x75, 0xed, 0x63, 0x4e, 0x9f, 0x5f, 0x6e, 0x3c,
+	0x74, 0xb5, 0xf1, 0xd0, 0xcf, 0x8d, 0x87, 0x2e, 0xb6, 0x5e, 0xe7, 0x6a, 0xeb, 0x75, 0xbe, 0x6d,
+	0xbd, 0xce, 0xbb, 0x51, 0xbb, 0x26, 0xeb, 0xdd, 0x45, 0xd1, 0x55, 0x09, 0x6a, 0xd6, 0x35, 0x97,
+	0x79, 0xf6, 0x67, 0x00, 0xe3, 0x18, 0x00, 0xec, 0x4b, 0x03, 0x00, 0x00,
 }
 
 func (m *Rate) Marshal() (dAtA []byte, err error) {
@@ -142,6 +248,77 @@ func (m *Rate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ValidUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ValidUntil):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRate(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x72
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.IndexedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.IndexedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRate(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x6a
+	if m.IndexedValue != 0 {
+		i = encodeVarintRate(dAtA, i, uint64(m.IndexedValue))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.IndexBase.Size()
+		i -= size
+		if _, err := m.IndexBase.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRate(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.IndexSymbol) > 0 {
+		i -= len(m.IndexSymbol)
+		copy(dAtA[i:], m.IndexSymbol)
+		i = encodeVarintRate(dAtA, i, uint64(len(m.IndexSymbol)))
+		i--
+		dAtA[i] = 0x52
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FinalizedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FinalizedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintRate(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x4a
+	if m.Appraisals != 0 {
+		i = encodeVarintRate(dAtA, i, uint64(m.Appraisals))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Cycle != 0 {
+		i = encodeVarintRate(dAtA, i, uint64(m.Cycle))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintRate(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -168,13 +345,6 @@ func (m *Rate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintRate(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
@@ -195,10 +365,6 @@ func (m *Rate) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovRate(uint64(l))
-	}
 	if m.Rate != 0 {
 		n += 1 + sovRate(uint64(m.Rate))
 	}
@@ -214,6 +380,33 @@ func (m *Rate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRate(uint64(l))
 	}
+	if m.PropertyId != 0 {
+		n += 1 + sovRate(uint64(m.PropertyId))
+	}
+	if m.Cycle != 0 {
+		n += 1 + sovRate(uint64(m.Cycle))
+	}
+	if m.Appraisals != 0 {
+		n += 1 + sovRate(uint64(m.Appraisals))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FinalizedAt)
+	n += 1 + l + sovRate(uint64(l))
+	l = len(m.IndexSymbol)
+	if l > 0 {
+		n += 1 + l + sovRate(uint64(l))
+	}
+	l = m.IndexBase.Size()
+	n += 1 + l + sovRate(uint64(l))
+	if m.IndexedValue != 0 {
+		n += 1 + sovRate(uint64(m.IndexedValue))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.IndexedAt)
+	n += 1 + l + sovRate(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ValidUntil)
+	n += 1 + l + sovRate(uint64(l))
+	if m.Expired {
+		n += 2
+	}
 	return n
 }
 
@@ -252,9 +445,28 @@ func (m *Rate) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Rate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			m.Rate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -282,13 +494,13 @@ func (m *Rate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.Rate = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRate
@@ -298,14 +510,27 @@ func (m *Rate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rate |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -333,11 +558,101 @@ func (m *Rate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cycle", wireType)
+			}
+			m.Cycle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cycle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appraisals", wireType)
+			}
+			m.Appraisals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Appraisals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FinalizedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexSymbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -365,11 +680,11 @@ func (m *Rate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.IndexSymbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexBase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -397,8 +712,115 @@ func (m *Rate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.IndexBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedValue", wireType)
+			}
+			m.IndexedValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedValue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.IndexedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ValidUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRate(dAtA[iNdEx:])
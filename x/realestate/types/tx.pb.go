@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -12,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -124,27 +129,24 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-// MsgCreateRate defines the MsgCreateRate message.
-type MsgCreateRate struct {
-	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Symbol      string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Rate        uint64 `protobuf:"varint,3,opt,name=rate,proto3" json:"rate,omitempty"`
-	Name        string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+// MsgDeleteRate defines the MsgDeleteRate message.
+type MsgDeleteRate struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PropertyId uint64 `protobuf:"varint,3,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
 }
 
-func (m *MsgCreateRate) Reset()         { *m = MsgCreateRate{} }
-func (m *MsgCreateRate) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateRate) ProtoMessage()    {}
-func (*MsgCreateRate) Descriptor() ([]byte, []int) {
+func (m *MsgDeleteRate) Reset()         { *m = MsgDeleteRate{} }
+func (m *MsgDeleteRate) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRate) ProtoMessage()    {}
+func (*MsgDeleteRate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09dc64cc102893c3, []int{2}
 }
-func (m *MsgCreateRate) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateRate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteRate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -154,69 +156,195 @@ func (m *MsgCreateRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateRate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateRate.Merge(m, src)
+func (m *MsgDeleteRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRate.Merge(m, src)
 }
-func (m *MsgCreateRate) XXX_Size() int {
+func (m *MsgDeleteRate) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateRate) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateRate.DiscardUnknown(m)
+func (m *MsgDeleteRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateRate proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteRate proto.InternalMessageInfo
 
-func (m *MsgCreateRate) GetCreator() string {
+func (m *MsgDeleteRate) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreateRate) GetSymbol() string {
+func (m *MsgDeleteRate) GetPropertyId() uint64 {
 	if m != nil {
-		return m.Symbol
+		return m.PropertyId
+	}
+	return 0
+}
+
+// MsgDeleteRateResponse defines the MsgDeleteRateResponse message.
+type MsgDeleteRateResponse struct {
+}
+
+func (m *MsgDeleteRateResponse) Reset()         { *m = MsgDeleteRateResponse{} }
+func (m *MsgDeleteRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRateResponse) ProtoMessage()    {}
+func (*MsgDeleteRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{3}
+}
+func (m *MsgDeleteRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRateResponse.Merge(m, src)
+}
+func (m *MsgDeleteRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteRateResponse proto.InternalMessageInfo
+
+// MsgCreateProperty defines the MsgCreateProperty message.
+type MsgCreateProperty struct {
+	Creator          string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ParcelId         string        `protobuf:"bytes,2,opt,name=parcel_id,json=parcelId,proto3" json:"parcel_id,omitempty"`
+	Latitude         int64         `protobuf:"varint,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude        int64         `protobuf:"varint,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Jurisdiction     string        `protobuf:"bytes,5,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	PropertyClass    PropertyClass `protobuf:"varint,6,opt,name=property_class,json=propertyClass,proto3,enum=realfin.realestate.v1.PropertyClass" json:"property_class,omitempty"`
+	FloorArea        uint64        `protobuf:"varint,7,opt,name=floor_area,json=floorArea,proto3" json:"floor_area,omitempty"`
+	YearBuilt        uint32        `protobuf:"varint,8,opt,name=year_built,json=yearBuilt,proto3" json:"year_built,omitempty"`
+	EncumbrancesHash string        `protobuf:"bytes,9,opt,name=encumbrances_hash,json=encumbrancesHash,proto3" json:"encumbrances_hash,omitempty"`
+}
+
+func (m *MsgCreateProperty) Reset()         { *m = MsgCreateProperty{} }
+func (m *MsgCreateProperty) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateProperty) ProtoMessage()    {}
+func (*MsgCreateProperty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{4}
+}
+func (m *MsgCreateProperty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateProperty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateProperty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateProperty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateProperty.Merge(m, src)
+}
+func (m *MsgCreateProperty) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateProperty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateProperty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateProperty proto.InternalMessageInfo
+
+func (m *MsgCreateProperty) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateProperty) GetParcelId() string {
+	if m != nil {
+		return m.ParcelId
 	}
 	return ""
 }
 
-func (m *MsgCreateRate) GetRate() uint64 {
+func (m *MsgCreateProperty) GetLatitude() int64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *MsgCreateProperty) GetLongitude() int64 {
 	if m != nil {
-		return m.Rate
+		return m.Longitude
 	}
 	return 0
 }
 
-func (m *MsgCreateRate) GetName() string {
+func (m *MsgCreateProperty) GetJurisdiction() string {
 	if m != nil {
-		return m.Name
+		return m.Jurisdiction
 	}
 	return ""
 }
 
-func (m *MsgCreateRate) GetDescription() string {
+func (m *MsgCreateProperty) GetPropertyClass() PropertyClass {
+	if m != nil {
+		return m.PropertyClass
+	}
+	return PropertyClass_PROPERTY_CLASS_UNSPECIFIED
+}
+
+func (m *MsgCreateProperty) GetFloorArea() uint64 {
 	if m != nil {
-		return m.Description
+		return m.FloorArea
+	}
+	return 0
+}
+
+func (m *MsgCreateProperty) GetYearBuilt() uint32 {
+	if m != nil {
+		return m.YearBuilt
+	}
+	return 0
+}
+
+func (m *MsgCreateProperty) GetEncumbrancesHash() string {
+	if m != nil {
+		return m.EncumbrancesHash
 	}
 	return ""
 }
 
-// MsgCreateRateResponse defines the MsgCreateRateResponse message.
-type MsgCreateRateResponse struct {
+// MsgCreatePropertyResponse defines the MsgCreatePropertyResponse message.
+type MsgCreatePropertyResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgCreateRateResponse) Reset()         { *m = MsgCreateRateResponse{} }
-func (m *MsgCreateRateResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateRateResponse) ProtoMessage()    {}
-func (*MsgCreateRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09dc64cc102893c3, []int{3}
+func (m *MsgCreatePropertyResponse) Reset()         { *m = MsgCreatePropertyResponse{} }
+func (m *MsgCreatePropertyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePropertyResponse) ProtoMessage()    {}
+func (*MsgCreatePropertyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{5}
 }
-func (m *MsgCreateRateResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreatePropertyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePropertyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateRateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePropertyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -226,39 +354,51 @@ func (m *MsgCreateRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateRateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateRateResponse.Merge(m, src)
+func (m *MsgCreatePropertyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePropertyResponse.Merge(m, src)
 }
-func (m *MsgCreateRateResponse) XXX_Size() int {
+func (m *MsgCreatePropertyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateRateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateRateResponse.DiscardUnknown(m)
+func (m *MsgCreatePropertyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePropertyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateRateResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreatePropertyResponse proto.InternalMessageInfo
 
-// MsgUpdateRate defines the MsgUpdateRate message.
-type MsgUpdateRate struct {
-	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Symbol      string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Rate        uint64 `protobuf:"varint,3,opt,name=rate,proto3" json:"rate,omitempty"`
-	Name        string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+func (m *MsgCreatePropertyResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgUpdateRate) Reset()         { *m = MsgUpdateRate{} }
-func (m *MsgUpdateRate) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateRate) ProtoMessage()    {}
-func (*MsgUpdateRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09dc64cc102893c3, []int{4}
+// MsgUpdateProperty defines the MsgUpdateProperty message.
+type MsgUpdateProperty struct {
+	Creator          string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id               uint64        `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	ParcelId         string        `protobuf:"bytes,3,opt,name=parcel_id,json=parcelId,proto3" json:"parcel_id,omitempty"`
+	Latitude         int64         `protobuf:"varint,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude        int64         `protobuf:"varint,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Jurisdiction     string        `protobuf:"bytes,6,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	PropertyClass    PropertyClass `protobuf:"varint,7,opt,name=property_class,json=propertyClass,proto3,enum=realfin.realestate.v1.PropertyClass" json:"property_class,omitempty"`
+	FloorArea        uint64        `protobuf:"varint,8,opt,name=floor_area,json=floorArea,proto3" json:"floor_area,omitempty"`
+	YearBuilt        uint32        `protobuf:"varint,9,opt,name=year_built,json=yearBuilt,proto3" json:"year_built,omitempty"`
+	EncumbrancesHash string        `protobuf:"bytes,10,opt,name=encumbrances_hash,json=encumbrancesHash,proto3" json:"encumbrances_hash,omitempty"`
 }
-func (m *MsgUpdateRate) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgUpdateProperty) Reset()         { *m = MsgUpdateProperty{} }
+func (m *MsgUpdateProperty) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProperty) ProtoMessage()    {}
+func (*MsgUpdateProperty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{6}
+}
+func (m *MsgUpdateProperty) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateProperty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateRate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateProperty.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -268,69 +408,104 @@ func (m *MsgUpdateRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateRate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateRate.Merge(m, src)
+func (m *MsgUpdateProperty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProperty.Merge(m, src)
 }
-func (m *MsgUpdateRate) XXX_Size() int {
+func (m *MsgUpdateProperty) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateRate) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateRate.DiscardUnknown(m)
+func (m *MsgUpdateProperty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProperty.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateRate proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateProperty proto.InternalMessageInfo
 
-func (m *MsgUpdateRate) GetCreator() string {
+func (m *MsgUpdateProperty) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateRate) GetSymbol() string {
+func (m *MsgUpdateProperty) GetId() uint64 {
 	if m != nil {
-		return m.Symbol
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgUpdateProperty) GetParcelId() string {
+	if m != nil {
+		return m.ParcelId
 	}
 	return ""
 }
 
-func (m *MsgUpdateRate) GetRate() uint64 {
+func (m *MsgUpdateProperty) GetLatitude() int64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *MsgUpdateProperty) GetLongitude() int64 {
 	if m != nil {
-		return m.Rate
+		return m.Longitude
 	}
 	return 0
 }
 
-func (m *MsgUpdateRate) GetName() string {
+func (m *MsgUpdateProperty) GetJurisdiction() string {
 	if m != nil {
-		return m.Name
+		return m.Jurisdiction
 	}
 	return ""
 }
 
-func (m *MsgUpdateRate) GetDescription() string {
+func (m *MsgUpdateProperty) GetPropertyClass() PropertyClass {
+	if m != nil {
+		return m.PropertyClass
+	}
+	return PropertyClass_PROPERTY_CLASS_UNSPECIFIED
+}
+
+func (m *MsgUpdateProperty) GetFloorArea() uint64 {
+	if m != nil {
+		return m.FloorArea
+	}
+	return 0
+}
+
+func (m *MsgUpdateProperty) GetYearBuilt() uint32 {
+	if m != nil {
+		return m.YearBuilt
+	}
+	return 0
+}
+
+func (m *MsgUpdateProperty) GetEncumbrancesHash() string {
 	if m != nil {
-		return m.Description
+		return m.EncumbrancesHash
 	}
 	return ""
 }
 
-// MsgUpdateRateResponse defines the MsgUpdateRateResponse message.
-type MsgUpdateRateResponse struct {
+// MsgUpdatePropertyResponse defines the MsgUpdatePropertyResponse message.
+type MsgUpdatePropertyResponse struct {
 }
 
-func (m *MsgUpdateRateResponse) Reset()         { *m = MsgUpdateRateResponse{} }
-func (m *MsgUpdateRateResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateRateResponse) ProtoMessage()    {}
-func (*MsgUpdateRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09dc64cc102893c3, []int{5}
+func (m *MsgUpdatePropertyResponse) Reset()         { *m = MsgUpdatePropertyResponse{} }
+func (m *MsgUpdatePropertyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePropertyResponse) ProtoMessage()    {}
+func (*MsgUpdatePropertyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{7}
 }
-func (m *MsgUpdateRateResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdatePropertyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdatePropertyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateRateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdatePropertyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -340,36 +515,36 @@ func (m *MsgUpdateRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateRateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateRateResponse.Merge(m, src)
+func (m *MsgUpdatePropertyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePropertyResponse.Merge(m, src)
 }
-func (m *MsgUpdateRateResponse) XXX_Size() int {
+func (m *MsgUpdatePropertyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateRateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateRateResponse.DiscardUnknown(m)
+func (m *MsgUpdatePropertyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePropertyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateRateResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdatePropertyResponse proto.InternalMessageInfo
 
-// MsgDeleteRate defines the MsgDeleteRate message.
-type MsgDeleteRate struct {
+// MsgDeleteProperty defines the MsgDeleteProperty message.
+type MsgDeleteProperty struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Symbol  string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgDeleteRate) Reset()         { *m = MsgDeleteRate{} }
-func (m *MsgDeleteRate) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteRate) ProtoMessage()    {}
-func (*MsgDeleteRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09dc64cc102893c3, []int{6}
+func (m *MsgDeleteProperty) Reset()         { *m = MsgDeleteProperty{} }
+func (m *MsgDeleteProperty) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteProperty) ProtoMessage()    {}
+func (*MsgDeleteProperty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{8}
 }
-func (m *MsgDeleteRate) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteProperty) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteProperty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteRate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteProperty.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -379,48 +554,48 @@ func (m *MsgDeleteRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteRate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteRate.Merge(m, src)
+func (m *MsgDeleteProperty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteProperty.Merge(m, src)
 }
-func (m *MsgDeleteRate) XXX_Size() int {
+func (m *MsgDeleteProperty) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteRate) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteRate.DiscardUnknown(m)
+func (m *MsgDeleteProperty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteProperty.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteRate proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteProperty proto.InternalMessageInfo
 
-func (m *MsgDeleteRate) GetCreator() string {
+func (m *MsgDeleteProperty) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgDeleteRate) GetSymbol() string {
+func (m *MsgDeleteProperty) GetId() uint64 {
 	if m != nil {
-		return m.Symbol
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-// MsgDeleteRateResponse defines the MsgDeleteRateResponse message.
-type MsgDeleteRateResponse struct {
+// MsgDeletePropertyResponse defines the MsgDeletePropertyResponse message.
+type MsgDeletePropertyResponse struct {
 }
 
-func (m *MsgDeleteRateResponse) Reset()         { *m = MsgDeleteRateResponse{} }
-func (m *MsgDeleteRateResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteRateResponse) ProtoMessage()    {}
-func (*MsgDeleteRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09dc64cc102893c3, []int{7}
+func (m *MsgDeletePropertyResponse) Reset()         { *m = MsgDeletePropertyResponse{} }
+func (m *MsgDeletePropertyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeletePropertyResponse) ProtoMessage()    {}
+func (*MsgDeletePropertyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{9}
 }
-func (m *MsgDeleteRateResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeletePropertyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeletePropertyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteRateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeletePropertyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -430,689 +605,5415 @@ func (m *MsgDeleteRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteRateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteRateResponse.Merge(m, src)
+func (m *MsgDeletePropertyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeletePropertyResponse.Merge(m, src)
 }
-func (m *MsgDeleteRateResponse) XXX_Size() int {
+func (m *MsgDeletePropertyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteRateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteRateResponse.DiscardUnknown(m)
+func (m *MsgDeletePropertyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeletePropertyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteRateResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeletePropertyResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "realfin.realestate.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "realfin.realestate.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateRate)(nil), "realfin.realestate.v1.MsgCreateRate")
-	proto.RegisterType((*MsgCreateRateResponse)(nil), "realfin.realestate.v1.MsgCreateRateResponse")
-	proto.RegisterType((*MsgUpdateRate)(nil), "realfin.realestate.v1.MsgUpdateRate")
-	proto.RegisterType((*MsgUpdateRateResponse)(nil), "realfin.realestate.v1.MsgUpdateRateResponse")
-	proto.RegisterType((*MsgDeleteRate)(nil), "realfin.realestate.v1.MsgDeleteRate")
-	proto.RegisterType((*MsgDeleteRateResponse)(nil), "realfin.realestate.v1.MsgDeleteRateResponse")
+// MsgRegisterAppraiser is the Msg/RegisterAppraiser request type.
+type MsgRegisterAppraiser struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// appraiser defines the appraiser to accredit.
+	Appraiser Appraiser `protobuf:"bytes,2,opt,name=appraiser,proto3" json:"appraiser"`
 }
 
-func init() { proto.RegisterFile("realfin/realestate/v1/tx.proto", fileDescriptor_09dc64cc102893c3) }
-
-var fileDescriptor_09dc64cc102893c3 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0x91, 0x34, 0x28, 0xd7, 0x22, 0xc4, 0xa9, 0x25, 0xae, 0x25, 0x4c, 0x64, 0x45, 0x28,
-	0x8a, 0xc0, 0x56, 0x03, 0x02, 0xa9, 0x13, 0x04, 0xd6, 0x48, 0xc8, 0x88, 0x85, 0x05, 0xae, 0xc9,
-	0x57, 0x63, 0x29, 0xf6, 0x59, 0x77, 0x47, 0xd5, 0x6c, 0x88, 0x91, 0x89, 0x99, 0x5f, 0xc0, 0x18,
-	0x09, 0x7e, 0x02, 0x43, 0xc7, 0x8a, 0x89, 0x09, 0xa1, 0x64, 0xc8, 0xdf, 0x40, 0x3e, 0x9f, 0xb1,
-	0x9b, 0xba, 0x4d, 0x06, 0x16, 0x96, 0xe4, 0xbe, 0xef, 0xbd, 0xbb, 0xf7, 0x9e, 0xee, 0xf3, 0x61,
-	0x8b, 0x03, 0x1d, 0x1f, 0x06, 0x91, 0x9b, 0xfc, 0x83, 0x90, 0x54, 0x82, 0x7b, 0xb4, 0xe7, 0xca,
-	0x63, 0x27, 0xe6, 0x4c, 0x32, 0xb2, 0xa3, 0x71, 0x27, 0xc7, 0x9d, 0xa3, 0x3d, 0xf3, 0x06, 0x0d,
-	0x83, 0x88, 0xb9, 0xea, 0x37, 0x65, 0x9a, 0xcd, 0x21, 0x13, 0x21, 0x13, 0x6e, 0x28, 0xfc, 0xe4,
-	0x84, 0x50, 0xf8, 0x1a, 0xd8, 0x4d, 0x81, 0xd7, 0xaa, 0x72, 0xd3, 0x42, 0x43, 0xdb, 0x3e, 0xf3,
-	0x59, 0xda, 0x4f, 0x56, 0xba, 0x6b, 0x97, 0x7b, 0x8a, 0x29, 0xa7, 0xa1, 0xde, 0x69, 0x7f, 0x47,
-	0xf8, 0xfa, 0x40, 0xf8, 0x2f, 0xe3, 0x11, 0x95, 0xf0, 0x5c, 0x21, 0xe4, 0x21, 0x6e, 0xd0, 0x77,
-	0xf2, 0x2d, 0xe3, 0x81, 0x9c, 0x18, 0xa8, 0x85, 0x3a, 0x8d, 0xbe, 0xf1, 0xe3, 0xdb, 0xbd, 0x6d,
-	0x2d, 0xf9, 0x64, 0x34, 0xe2, 0x20, 0xc4, 0x0b, 0xc9, 0x83, 0xc8, 0xf7, 0x72, 0x2a, 0x79, 0x8c,
-	0xeb, 0xe9, 0xd9, 0xc6, 0x95, 0x16, 0xea, 0x6c, 0xf6, 0x6e, 0x39, 0xa5, 0xa1, 0x9d, 0x54, 0xa6,
-	0xdf, 0x38, 0xf9, 0x75, 0xbb, 0xf2, 0x65, 0x31, 0xed, 0x22, 0x4f, 0xef, 0xdb, 0x7f, 0xf4, 0x61,
-	0x31, 0xed, 0xe6, 0x27, 0x7e, 0x5c, 0x4c, 0xbb, 0xed, 0x2c, 0xc4, 0x71, 0x31, 0xc6, 0x92, 0x65,
-	0x7b, 0x17, 0x37, 0x97, 0x5a, 0x1e, 0x88, 0x98, 0x45, 0x02, 0xec, 0xaf, 0x08, 0x5f, 0x1b, 0x08,
-	0xff, 0x29, 0x07, 0x2a, 0xc1, 0xa3, 0x12, 0x48, 0x0f, 0x5f, 0x1d, 0x26, 0x15, 0xe3, 0x2b, 0xd3,
-	0x65, 0x44, 0x72, 0x13, 0xd7, 0xc5, 0x24, 0x3c, 0x60, 0x63, 0x95, 0xad, 0xe1, 0xe9, 0x8a, 0x10,
-	0x5c, 0xe3, 0x54, 0x82, 0x51, 0x6d, 0xa1, 0x4e, 0xcd, 0x53, 0xeb, 0xa4, 0x17, 0xd1, 0x10, 0x8c,
-	0x9a, 0x62, 0xaa, 0x35, 0x69, 0xe1, 0xcd, 0x11, 0x88, 0x21, 0x0f, 0x62, 0x19, 0xb0, 0xc8, 0xd8,
-	0x50, 0x50, 0xb1, 0xb5, 0xbf, 0x95, 0x64, 0xcf, 0xf4, 0xec, 0x26, 0xde, 0x39, 0x63, 0x7a, 0x39,
-	0x4e, 0x1a, 0xf5, 0x3f, 0x8b, 0x93, 0x9b, 0xfe, 0x1b, 0x27, 0x50, 0x69, 0x9e, 0xc1, 0x18, 0xfe,
-	0x7d, 0x9a, 0x52, 0x0f, 0xb9, 0x54, 0xe6, 0xa1, 0xf7, 0xb9, 0x8a, 0xab, 0x03, 0xe1, 0x93, 0x43,
-	0xbc, 0x75, 0xe6, 0x3b, 0xb8, 0x73, 0xc1, 0xfc, 0x2e, 0x4d, 0x9a, 0xe9, 0xac, 0xc7, 0xcb, 0xf4,
-	0xc8, 0x1b, 0x8c, 0x0b, 0xd3, 0xd8, 0xbe, 0x78, 0x77, 0xce, 0x32, 0xef, 0xae, 0xc3, 0x2a, 0x2a,
-	0x14, 0x06, 0xa4, 0xbd, 0xca, 0xdf, 0x2a, 0x85, 0xf3, 0xf7, 0x96, 0x28, 0x14, 0x2e, 0xed, 0x12,
-	0x85, 0x9c, 0x75, 0x99, 0xc2, 0xf9, 0x5b, 0x31, 0x37, 0xde, 0x27, 0x4f, 0x43, 0xff, 0xc1, 0xc9,
-	0xcc, 0x42, 0xa7, 0x33, 0x0b, 0xfd, 0x9e, 0x59, 0xe8, 0xd3, 0xdc, 0xaa, 0x9c, 0xce, 0xad, 0xca,
-	0xcf, 0xb9, 0x55, 0x79, 0x65, 0x96, 0xbe, 0x0c, 0x72, 0x12, 0x83, 0x38, 0xa8, 0xab, 0xd7, 0xed,
-	0xfe, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x85, 0x5f, 0xaa, 0x2e, 0x97, 0x05, 0x00, 0x00,
+func (m *MsgRegisterAppraiser) Reset()         { *m = MsgRegisterAppraiser{} }
+func (m *MsgRegisterAppraiser) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAppraiser) ProtoMessage()    {}
+func (*MsgRegisterAppraiser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateRate defines the CreateRate RPC.
-	CreateRate(ctx context.Context, in *MsgCreateRate, opts ...grpc.CallOption) (*MsgCreateRateResponse, error)
-	// UpdateRate defines the UpdateRate RPC.
-	UpdateRate(ctx context.Context, in *MsgUpdateRate, opts ...grpc.CallOption) (*MsgUpdateRateResponse, error)
-	// DeleteRate defines the DeleteRate RPC.
-	DeleteRate(ctx context.Context, in *MsgDeleteRate, opts ...grpc.CallOption) (*MsgDeleteRateResponse, error)
+func (m *MsgRegisterAppraiser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterAppraiser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAppraiser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterAppraiser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAppraiser.Merge(m, src)
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRegisterAppraiser) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) CreateRate(ctx context.Context, in *MsgCreateRate, opts ...grpc.CallOption) (*MsgCreateRateResponse, error) {
-	out := new(MsgCreateRateResponse)
-	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/CreateRate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRegisterAppraiser) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAppraiser.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateRate(ctx context.Context, in *MsgUpdateRate, opts ...grpc.CallOption) (*MsgUpdateRateResponse, error) {
-	out := new(MsgUpdateRateResponse)
-	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/UpdateRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterAppraiser proto.InternalMessageInfo
+
+func (m *MsgRegisterAppraiser) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteRate(ctx context.Context, in *MsgDeleteRate, opts ...grpc.CallOption) (*MsgDeleteRateResponse, error) {
-	out := new(MsgDeleteRateResponse)
-	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/DeleteRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterAppraiser) GetAppraiser() Appraiser {
+	if m != nil {
+		return m.Appraiser
 	}
-	return out, nil
+	return Appraiser{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateRate defines the CreateRate RPC.
-	CreateRate(context.Context, *MsgCreateRate) (*MsgCreateRateResponse, error)
-	// UpdateRate defines the UpdateRate RPC.
-	UpdateRate(context.Context, *MsgUpdateRate) (*MsgUpdateRateResponse, error)
-	// DeleteRate defines the DeleteRate RPC.
-	DeleteRate(context.Context, *MsgDeleteRate) (*MsgDeleteRateResponse, error)
+// MsgRegisterAppraiserResponse defines the response structure for executing
+// a MsgRegisterAppraiser message.
+type MsgRegisterAppraiserResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRegisterAppraiserResponse) Reset()         { *m = MsgRegisterAppraiserResponse{} }
+func (m *MsgRegisterAppraiserResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAppraiserResponse) ProtoMessage()    {}
+func (*MsgRegisterAppraiserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{11}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRegisterAppraiserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateRate(ctx context.Context, req *MsgCreateRate) (*MsgCreateRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRate not implemented")
+func (m *MsgRegisterAppraiserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAppraiserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateRate(ctx context.Context, req *MsgUpdateRate) (*MsgUpdateRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRate not implemented")
+func (m *MsgRegisterAppraiserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAppraiserResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteRate(ctx context.Context, req *MsgDeleteRate) (*MsgDeleteRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRate not implemented")
+func (m *MsgRegisterAppraiserResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgRegisterAppraiserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAppraiserResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/realfin.realestate.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRegisterAppraiserResponse proto.InternalMessageInfo
+
+// MsgRevokeAppraiser is the Msg/RevokeAppraiser request type.
+type MsgRevokeAppraiser struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the address of the appraiser to revoke.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Msg_CreateRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/realfin.realestate.v1.Msg/CreateRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRate(ctx, req.(*MsgCreateRate))
+func (m *MsgRevokeAppraiser) Reset()         { *m = MsgRevokeAppraiser{} }
+func (m *MsgRevokeAppraiser) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAppraiser) ProtoMessage()    {}
+func (*MsgRevokeAppraiser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{12}
+}
+func (m *MsgRevokeAppraiser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAppraiser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAppraiser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRevokeAppraiser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAppraiser.Merge(m, src)
+}
+func (m *MsgRevokeAppraiser) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAppraiser) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAppraiser.DiscardUnknown(m)
 }
 
-func _Msg_UpdateRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/realfin.realestate.v1.Msg/UpdateRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRate(ctx, req.(*MsgUpdateRate))
+var xxx_messageInfo_MsgRevokeAppraiser proto.InternalMessageInfo
+
+func (m *MsgRevokeAppraiser) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/realfin.realestate.v1.Msg/DeleteRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteRate(ctx, req.(*MsgDeleteRate))
+func (m *MsgRevokeAppraiser) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "realfin.realestate.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateRate",
-			Handler:    _Msg_CreateRate_Handler,
-		},
-		{
-			MethodName: "UpdateRate",
-			Handler:    _Msg_UpdateRate_Handler,
-		},
-		{
-			MethodName: "DeleteRate",
-			Handler:    _Msg_DeleteRate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "realfin/realestate/v1/tx.proto",
+// MsgRevokeAppraiserResponse defines the response structure for executing a
+// MsgRevokeAppraiser message.
+type MsgRevokeAppraiserResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeAppraiserResponse) Reset()         { *m = MsgRevokeAppraiserResponse{} }
+func (m *MsgRevokeAppraiserResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAppraiserResponse) ProtoMessage()    {}
+func (*MsgRevokeAppraiserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{13}
+}
+func (m *MsgRevokeAppraiserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAppraiserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAppraiserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevokeAppraiserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAppraiserResponse.Merge(m, src)
+}
+func (m *MsgRevokeAppraiserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAppraiserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAppraiserResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeAppraiserResponse proto.InternalMessageInfo
+
+// MsgSubmitAppraisal defines the MsgSubmitAppraisal message.
+type MsgSubmitAppraisal struct {
+	Appraiser    string      `protobuf:"bytes,1,opt,name=appraiser,proto3" json:"appraiser,omitempty"`
+	PropertyId   uint64      `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Value        uint64      `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Methodology  Methodology `protobuf:"varint,4,opt,name=methodology,proto3,enum=realfin.realestate.v1.Methodology" json:"methodology,omitempty"`
+	DocumentHash string      `protobuf:"bytes,5,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSubmitAppraisal) Reset()         { *m = MsgSubmitAppraisal{} }
+func (m *MsgSubmitAppraisal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAppraisal) ProtoMessage()    {}
+func (*MsgSubmitAppraisal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{14}
+}
+func (m *MsgSubmitAppraisal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAppraisal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAppraisal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSubmitAppraisal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAppraisal.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitAppraisal) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSubmitAppraisal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAppraisal.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitAppraisal proto.InternalMessageInfo
+
+func (m *MsgSubmitAppraisal) GetAppraiser() string {
+	if m != nil {
+		return m.Appraiser
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitAppraisal) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
 }
 
-func (m *MsgCreateRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Rate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Rate))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSubmitAppraisal) GetValue() uint64 {
+	if m != nil {
+		return m.Value
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCreateRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitAppraisal) GetMethodology() Methodology {
+	if m != nil {
+		return m.Methodology
 	}
-	return dAtA[:n], nil
+	return Methodology_METHODOLOGY_UNSPECIFIED
 }
 
-func (m *MsgCreateRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitAppraisal) GetDocumentHash() string {
+	if m != nil {
+		return m.DocumentHash
+	}
+	return ""
 }
 
-func (m *MsgCreateRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSubmitAppraisalResponse defines the MsgSubmitAppraisalResponse message.
+type MsgSubmitAppraisalResponse struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cycle uint64 `protobuf:"varint,2,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	// finalized is set when the appraisal reached the quorum of the cycle.
+	Finalized bool `protobuf:"varint,3,opt,name=finalized,proto3" json:"finalized,omitempty"`
 }
 
-func (m *MsgUpdateRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSubmitAppraisalResponse) Reset()         { *m = MsgSubmitAppraisalResponse{} }
+func (m *MsgSubmitAppraisalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAppraisalResponse) ProtoMessage()    {}
+func (*MsgSubmitAppraisalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{15}
 }
-
-func (m *MsgUpdateRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitAppraisalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAppraisalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAppraisalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitAppraisalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAppraisalResponse.Merge(m, src)
+}
+func (m *MsgSubmitAppraisalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAppraisalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAppraisalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
+var xxx_messageInfo_MsgSubmitAppraisalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitAppraisalResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *MsgSubmitAppraisalResponse) GetCycle() uint64 {
+	if m != nil {
+		return m.Cycle
 	}
-	if m.Rate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Rate))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *MsgSubmitAppraisalResponse) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
 	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x12
+	return false
+}
+
+// MsgSetRegionalIndex is the Msg/SetRegionalIndex request type.
+type MsgSetRegionalIndex struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// jurisdiction is the jurisdiction of the index.
+	Jurisdiction string `protobuf:"bytes,2,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// symbol is the oracle symbol whose price is the level of the index.
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (m *MsgSetRegionalIndex) Reset()         { *m = MsgSetRegionalIndex{} }
+func (m *MsgSetRegionalIndex) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRegionalIndex) ProtoMessage()    {}
+func (*MsgSetRegionalIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{16}
+}
+func (m *MsgSetRegionalIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRegionalIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRegionalIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetRegionalIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRegionalIndex.Merge(m, src)
+}
+func (m *MsgSetRegionalIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRegionalIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRegionalIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRegionalIndex proto.InternalMessageInfo
+
+func (m *MsgSetRegionalIndex) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetRegionalIndex) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetRegionalIndex) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
 }
 
-func (m *MsgUpdateRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetRegionalIndexResponse defines the response structure for executing a
+// MsgSetRegionalIndex message.
+type MsgSetRegionalIndexResponse struct {
 }
 
-func (m *MsgDeleteRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetRegionalIndexResponse) Reset()         { *m = MsgSetRegionalIndexResponse{} }
+func (m *MsgSetRegionalIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRegionalIndexResponse) ProtoMessage()    {}
+func (*MsgSetRegionalIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{17}
+}
+func (m *MsgSetRegionalIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRegionalIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRegionalIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetRegionalIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRegionalIndexResponse.Merge(m, src)
+}
+func (m *MsgSetRegionalIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRegionalIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRegionalIndexResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetRegionalIndexResponse proto.InternalMessageInfo
+
+// MsgRemoveRegionalIndex is the Msg/RemoveRegionalIndex request type.
+type MsgRemoveRegionalIndex struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// jurisdiction is the jurisdiction of the index.
+	Jurisdiction string `protobuf:"bytes,2,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
 }
 
-func (m *MsgDeleteRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRemoveRegionalIndex) Reset()         { *m = MsgRemoveRegionalIndex{} }
+func (m *MsgRemoveRegionalIndex) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRegionalIndex) ProtoMessage()    {}
+func (*MsgRemoveRegionalIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{18}
+}
+func (m *MsgRemoveRegionalIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRegionalIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRegionalIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveRegionalIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRegionalIndex.Merge(m, src)
+}
+func (m *MsgRemoveRegionalIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRegionalIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRegionalIndex.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveRegionalIndex proto.InternalMessageInfo
+
+func (m *MsgRemoveRegionalIndex) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveRegionalIndex) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
 }
 
-func (m *MsgDeleteRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRemoveRegionalIndexResponse defines the response structure for executing
+// a MsgRemoveRegionalIndex message.
+type MsgRemoveRegionalIndexResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRemoveRegionalIndexResponse) Reset()         { *m = MsgRemoveRegionalIndexResponse{} }
+func (m *MsgRemoveRegionalIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRegionalIndexResponse) ProtoMessage()    {}
+func (*MsgRemoveRegionalIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{19}
+}
+func (m *MsgRemoveRegionalIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRegionalIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRegionalIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveRegionalIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRegionalIndexResponse.Merge(m, src)
+}
+func (m *MsgRemoveRegionalIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRegionalIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRegionalIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRegionalIndexResponse proto.InternalMessageInfo
+
+// MsgSetPropertyManager defines the MsgSetPropertyManager message.
+type MsgSetPropertyManager struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PropertyId uint64 `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// manager is the address of the manager, empty to dismiss the manager.
+	Manager string `protobuf:"bytes,3,opt,name=manager,proto3" json:"manager,omitempty"`
+}
+
+func (m *MsgSetPropertyManager) Reset()         { *m = MsgSetPropertyManager{} }
+func (m *MsgSetPropertyManager) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPropertyManager) ProtoMessage()    {}
+func (*MsgSetPropertyManager) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{20}
+}
+func (m *MsgSetPropertyManager) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPropertyManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPropertyManager.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetPropertyManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPropertyManager.Merge(m, src)
+}
+func (m *MsgSetPropertyManager) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPropertyManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPropertyManager.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPropertyManager proto.InternalMessageInfo
+
+func (m *MsgSetPropertyManager) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetPropertyManager) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgCreateRate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetPropertyManager) GetManager() string {
+	if m != nil {
+		return m.Manager
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgSetPropertyManagerResponse defines the MsgSetPropertyManagerResponse
+// message.
+type MsgSetPropertyManagerResponse struct {
+}
+
+func (m *MsgSetPropertyManagerResponse) Reset()         { *m = MsgSetPropertyManagerResponse{} }
+func (m *MsgSetPropertyManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPropertyManagerResponse) ProtoMessage()    {}
+func (*MsgSetPropertyManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{21}
+}
+func (m *MsgSetPropertyManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPropertyManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPropertyManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetPropertyManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPropertyManagerResponse.Merge(m, src)
+}
+func (m *MsgSetPropertyManagerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPropertyManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPropertyManagerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPropertyManagerResponse proto.InternalMessageInfo
+
+// MsgCreateLease defines the MsgCreateLease message.
+type MsgCreateLease struct {
+	Creator    string                      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PropertyId uint64                      `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	TenantHash string                      `protobuf:"bytes,3,opt,name=tenant_hash,json=tenantHash,proto3" json:"tenant_hash,omitempty"`
+	Start      time.Time                   `protobuf:"bytes,4,opt,name=start,proto3,stdtime" json:"start"`
+	End        time.Time                   `protobuf:"bytes,5,opt,name=end,proto3,stdtime" json:"end"`
+	Rent       uint64                      `protobuf:"varint,6,opt,name=rent,proto3" json:"rent,omitempty"`
+	Escalation cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=escalation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"escalation"`
+	Area       uint64                      `protobuf:"varint,8,opt,name=area,proto3" json:"area,omitempty"`
+}
+
+func (m *MsgCreateLease) Reset()         { *m = MsgCreateLease{} }
+func (m *MsgCreateLease) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLease) ProtoMessage()    {}
+func (*MsgCreateLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{22}
+}
+func (m *MsgCreateLease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Rate != 0 {
-		n += 1 + sovTx(uint64(m.Rate))
+}
+func (m *MsgCreateLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLease.Merge(m, src)
+}
+func (m *MsgCreateLease) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateLease proto.InternalMessageInfo
+
+func (m *MsgCreateLease) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgCreateLease) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *MsgCreateLease) GetTenantHash() string {
+	if m != nil {
+		return m.TenantHash
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCreateRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateLease) GetStart() time.Time {
+	if m != nil {
+		return m.Start
 	}
-	var l int
-	_ = l
-	return n
+	return time.Time{}
 }
 
-func (m *MsgUpdateRate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateLease) GetEnd() time.Time {
+	if m != nil {
+		return m.End
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return time.Time{}
+}
+
+func (m *MsgCreateLease) GetRent() uint64 {
+	if m != nil {
+		return m.Rent
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *MsgCreateLease) GetArea() uint64 {
+	if m != nil {
+		return m.Area
 	}
-	if m.Rate != 0 {
-		n += 1 + sovTx(uint64(m.Rate))
+	return 0
+}
+
+// MsgCreateLeaseResponse defines the MsgCreateLeaseResponse message.
+type MsgCreateLeaseResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateLeaseResponse) Reset()         { *m = MsgCreateLeaseResponse{} }
+func (m *MsgCreateLeaseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLeaseResponse) ProtoMessage()    {}
+func (*MsgCreateLeaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{23}
+}
+func (m *MsgCreateLeaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLeaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLeaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgCreateLeaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLeaseResponse.Merge(m, src)
+}
+func (m *MsgCreateLeaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLeaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLeaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateLeaseResponse proto.InternalMessageInfo
+
+func (m *MsgCreateLeaseResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgTerminateLease defines the MsgTerminateLease message.
+type MsgTerminateLease struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PropertyId uint64 `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	LeaseId    uint64 `protobuf:"varint,3,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+}
+
+func (m *MsgTerminateLease) Reset()         { *m = MsgTerminateLease{} }
+func (m *MsgTerminateLease) String() string { return proto.CompactTextString(m) }
+func (*MsgTerminateLease) ProtoMessage()    {}
+func (*MsgTerminateLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{24}
+}
+func (m *MsgTerminateLease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTerminateLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTerminateLease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTerminateLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTerminateLease.Merge(m, src)
+}
+func (m *MsgTerminateLease) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTerminateLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTerminateLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTerminateLease proto.InternalMessageInfo
+
+func (m *MsgTerminateLease) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTerminateLease) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *MsgTerminateLease) GetLeaseId() uint64 {
+	if m != nil {
+		return m.LeaseId
+	}
+	return 0
+}
+
+// MsgTerminateLeaseResponse defines the MsgTerminateLeaseResponse message.
+type MsgTerminateLeaseResponse struct {
+}
+
+func (m *MsgTerminateLeaseResponse) Reset()         { *m = MsgTerminateLeaseResponse{} }
+func (m *MsgTerminateLeaseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTerminateLeaseResponse) ProtoMessage()    {}
+func (*MsgTerminateLeaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{25}
+}
+func (m *MsgTerminateLeaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTerminateLeaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTerminateLeaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTerminateLeaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTerminateLeaseResponse.Merge(m, src)
+}
+func (m *MsgTerminateLeaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTerminateLeaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTerminateLeaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTerminateLeaseResponse proto.InternalMessageInfo
+
+// MsgReportCashFlow defines the MsgReportCashFlow message.
+type MsgReportCashFlow struct {
+	Creator     string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PropertyId  uint64       `protobuf:"varint,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Kind        CashFlowKind `protobuf:"varint,3,opt,name=kind,proto3,enum=realfin.realestate.v1.CashFlowKind" json:"kind,omitempty"`
+	Amount      uint64       `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	PeriodStart time.Time    `protobuf:"bytes,5,opt,name=period_start,json=periodStart,proto3,stdtime" json:"period_start"`
+	PeriodEnd   time.Time    `protobuf:"bytes,6,opt,name=period_end,json=periodEnd,proto3,stdtime" json:"period_end"`
+	// lease_id is the lease of the rent, unused for the expenses.
+	LeaseId uint64 `protobuf:"varint,7,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+}
+
+func (m *MsgReportCashFlow) Reset()         { *m = MsgReportCashFlow{} }
+func (m *MsgReportCashFlow) String() string { return proto.CompactTextString(m) }
+func (*MsgReportCashFlow) ProtoMessage()    {}
+func (*MsgReportCashFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{26}
+}
+func (m *MsgReportCashFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportCashFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportCashFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportCashFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportCashFlow.Merge(m, src)
+}
+func (m *MsgReportCashFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportCashFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportCashFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportCashFlow proto.InternalMessageInfo
+
+func (m *MsgReportCashFlow) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgReportCashFlow) GetPropertyId() uint64 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *MsgReportCashFlow) GetKind() CashFlowKind {
+	if m != nil {
+		return m.Kind
+	}
+	return CashFlowKind_CASH_FLOW_KIND_UNSPECIFIED
+}
+
+func (m *MsgReportCashFlow) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MsgReportCashFlow) GetPeriodStart() time.Time {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return time.Time{}
+}
+
+func (m *MsgReportCashFlow) GetPeriodEnd() time.Time {
+	if m != nil {
+		return m.PeriodEnd
+	}
+	return time.Time{}
+}
+
+func (m *MsgReportCashFlow) GetLeaseId() uint64 {
+	if m != nil {
+		return m.LeaseId
+	}
+	return 0
+}
+
+// MsgReportCashFlowResponse defines the MsgReportCashFlowResponse message.
+type MsgReportCashFlowResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgReportCashFlowResponse) Reset()         { *m = MsgReportCashFlowResponse{} }
+func (m *MsgReportCashFlowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportCashFlowResponse) ProtoMessage()    {}
+func (*MsgReportCashFlowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09dc64cc102893c3, []int{27}
+}
+func (m *MsgReportCashFlowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportCashFlowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportCashFlowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportCashFlowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportCashFlowResponse.Merge(m, src)
+}
+func (m *MsgReportCashFlowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportCashFlowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportCashFlowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportCashFlowResponse proto.InternalMessageInfo
+
+func (m *MsgReportCashFlowResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "realfin.realestate.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "realfin.realestate.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgDeleteRate)(nil), "realfin.realestate.v1.MsgDeleteRate")
+	proto.RegisterType((*MsgDeleteRateResponse)(nil), "realfin.realestate.v1.MsgDeleteRateResponse")
+	proto.RegisterType((*MsgCreateProperty)(nil), "realfin.realestate.v1.MsgCreateProperty")
+	proto.RegisterType((*MsgCreatePropertyResponse)(nil), "realfin.realestate.v1.MsgCreatePropertyResponse")
+	proto.RegisterType((*MsgUpdateProperty)(nil), "realfin.realestate.v1.MsgUpdateProperty")
+	proto.RegisterType((*MsgUpdatePropertyResponse)(nil), "realfin.realestate.v1.MsgUpdatePropertyResponse")
+	proto.RegisterType((*MsgDeleteProperty)(nil), "realfin.realestate.v1.MsgDeleteProperty")
+	proto.RegisterType((*MsgDeletePropertyResponse)(nil), "realfin.realestate.v1.MsgDeletePropertyResponse")
+	proto.RegisterType((*MsgRegisterAppraiser)(nil), "realfin.realestate.v1.MsgRegisterAppraiser")
+	proto.RegisterType((*MsgRegisterAppraiserResponse)(nil), "realfin.realestate.v1.MsgRegisterAppraiserResponse")
+	proto.RegisterType((*MsgRevokeAppraiser)(nil), "realfin.realestate.v1.MsgRevokeAppraiser")
+	proto.RegisterType((*MsgRevokeAppraiserResponse)(nil), "realfin.realestate.v1.MsgRevokeAppraiserResponse")
+	proto.RegisterType((*MsgSubmitAppraisal)(nil), "realfin.realestate.v1.MsgSubmitAppraisal")
+	proto.RegisterType((*MsgSubmitAppraisalResponse)(nil), "realfin.realestate.v1.MsgSubmitAppraisalResponse")
+	proto.RegisterType((*MsgSetRegionalIndex)(nil), "realfin.realestate.v1.MsgSetRegionalIndex")
+	proto.RegisterType((*MsgSetRegionalIndexResponse)(nil), "realfin.realestate.v1.MsgSetRegionalIndexResponse")
+	proto.RegisterType((*MsgRemoveRegionalIndex)(nil), "realfin.realestate.v1.MsgRemoveRegionalIndex")
+	proto.RegisterType((*MsgRemoveRegionalIndexResponse)(nil), "realfin.realestate.v1.MsgRemoveRegionalIndexResponse")
+	proto.RegisterType((*MsgSetPropertyManager)(nil), "realfin.realestate.v1.MsgSetPropertyManager")
+	proto.RegisterType((*MsgSetPropertyManagerResponse)(nil), "realfin.realestate.v1.MsgSetPropertyManagerResponse")
+	proto.RegisterType((*MsgCreateLease)(nil), "realfin.realestate.v1.MsgCreateLease")
+	proto.RegisterType((*MsgCreateLeaseResponse)(nil), "realfin.realestate.v1.MsgCreateLeaseResponse")
+	proto.RegisterType((*MsgTerminateLease)(nil), "realfin.realestate.v1.MsgTerminateLease")
+	proto.RegisterType((*MsgTerminateLeaseResponse)(nil), "realfin.realestate.v1.MsgTerminateLeaseResponse")
+	proto.RegisterType((*MsgReportCashFlow)(nil), "realfin.realestate.v1.MsgReportCashFlow")
+	proto.RegisterType((*MsgReportCashFlowResponse)(nil), "realfin.realestate.v1.MsgReportCashFlowResponse")
+}
+
+func init() { proto.RegisterFile("realfin/realestate/v1/tx.proto", fileDescriptor_09dc64cc102893c3) }
+
+var fileDescriptor_09dc64cc102893c3 = []byte{
+	// 1558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0xce, 0x87, 0x5f, 0x12, 0xb7, 0xd9, 0xa6, 0xad, 0xb3, 0x69, 0x9c, 0xc8, 0x6d,
+	0xc1, 0x4d, 0x1a, 0xbb, 0x71, 0x4b, 0x2b, 0xa5, 0x08, 0x48, 0x1a, 0x50, 0x43, 0x1b, 0xa9, 0xda,
+	0x14, 0x0e, 0x5c, 0xd2, 0xc9, 0xee, 0x64, 0xb3, 0x74, 0x77, 0xc7, 0xda, 0x19, 0x87, 0x18, 0x2e,
+	0x15, 0x27, 0xc4, 0xa9, 0x20, 0x0e, 0xfc, 0x03, 0x48, 0x1c, 0x7b, 0xe8, 0x91, 0x23, 0x42, 0x3d,
+	0x56, 0xbd, 0x80, 0x38, 0x14, 0xd4, 0x1e, 0xfa, 0x3f, 0x70, 0x40, 0x68, 0x67, 0x76, 0xd7, 0xf6,
+	0x7e, 0x38, 0x4e, 0x3f, 0xb8, 0xd8, 0x3b, 0x6f, 0x7e, 0xef, 0xfb, 0xcd, 0x9b, 0x37, 0x50, 0x72,
+	0x31, 0xb2, 0x76, 0x4c, 0xa7, 0xe6, 0xfd, 0x63, 0xca, 0x10, 0xc3, 0xb5, 0xbd, 0xa5, 0x1a, 0xdb,
+	0xaf, 0x36, 0x5c, 0xc2, 0x88, 0x7c, 0xdc, 0xdf, 0xaf, 0xb6, 0xf7, 0xab, 0x7b, 0x4b, 0xca, 0x04,
+	0xb2, 0x4d, 0x87, 0xd4, 0xf8, 0xaf, 0x40, 0x2a, 0x27, 0x35, 0x42, 0x6d, 0x42, 0x6b, 0x36, 0x35,
+	0x3c, 0x09, 0x36, 0x35, 0xfc, 0x8d, 0x29, 0xb1, 0xb1, 0xc5, 0x57, 0x35, 0xb1, 0xf0, 0xb7, 0x26,
+	0x0d, 0x62, 0x10, 0x41, 0xf7, 0xbe, 0x7c, 0xea, 0xac, 0x41, 0x88, 0x61, 0xe1, 0x1a, 0x5f, 0x6d,
+	0x37, 0x77, 0x6a, 0xcc, 0xb4, 0x3d, 0xd5, 0x76, 0xc3, 0x07, 0x9c, 0x4d, 0x36, 0x1a, 0x35, 0x1a,
+	0x2e, 0x32, 0x29, 0xb2, 0x7c, 0x58, 0x39, 0x19, 0x66, 0x3a, 0x1a, 0xb1, 0x71, 0x6f, 0x4c, 0x03,
+	0xb9, 0xc8, 0x0e, 0xac, 0x3c, 0x93, 0x82, 0x71, 0x49, 0x03, 0xbb, 0xac, 0x25, 0x50, 0xe5, 0x5f,
+	0x25, 0x38, 0xb2, 0x41, 0x8d, 0x4f, 0x1a, 0x3a, 0x62, 0xf8, 0x16, 0xe7, 0x97, 0x2f, 0x43, 0x1e,
+	0x35, 0xd9, 0x2e, 0x71, 0x4d, 0xd6, 0x2a, 0x4a, 0x73, 0x52, 0x25, 0xbf, 0x5a, 0x7c, 0xf2, 0x70,
+	0x71, 0xd2, 0x0f, 0xc2, 0x8a, 0xae, 0xbb, 0x98, 0xd2, 0x4d, 0xe6, 0x9a, 0x8e, 0xa1, 0xb6, 0xa1,
+	0xf2, 0x07, 0x30, 0x24, 0x2c, 0x28, 0x66, 0xe6, 0xa4, 0xca, 0x68, 0x7d, 0xa6, 0x9a, 0x98, 0x86,
+	0xaa, 0x50, 0xb3, 0x9a, 0x7f, 0xf4, 0x74, 0x76, 0xe0, 0xe7, 0x17, 0x0f, 0xe6, 0x25, 0xd5, 0xe7,
+	0x5b, 0xbe, 0xf2, 0xf5, 0x8b, 0x07, 0xf3, 0x6d, 0x89, 0xdf, 0xbe, 0x78, 0x30, 0x1f, 0xba, 0xb1,
+	0xdf, 0xe9, 0x48, 0xc4, 0xe4, 0xf2, 0x14, 0x9c, 0x8c, 0x90, 0x54, 0x4c, 0x1b, 0xc4, 0xa1, 0xb8,
+	0x7c, 0x4f, 0x82, 0xf1, 0x0d, 0x6a, 0xac, 0x61, 0x0b, 0x33, 0xac, 0x22, 0x86, 0xe5, 0x3a, 0x0c,
+	0x6b, 0x2e, 0x46, 0x8c, 0xb8, 0x07, 0x7a, 0x17, 0x00, 0xe5, 0x59, 0x18, 0x0d, 0x22, 0xb7, 0x65,
+	0xea, 0xc5, 0xec, 0x9c, 0x54, 0xc9, 0xa9, 0x10, 0x90, 0xd6, 0xf5, 0xe5, 0x31, 0xcf, 0xf4, 0x00,
+	0xfe, 0x71, 0x6e, 0x24, 0x73, 0x34, 0xab, 0x0e, 0xd1, 0x96, 0xbd, 0x4d, 0xac, 0xf2, 0x49, 0x38,
+	0xde, 0x65, 0x41, 0x68, 0xdb, 0x37, 0x59, 0x98, 0xd8, 0xa0, 0xc6, 0x35, 0x8f, 0x0b, 0xdf, 0xf2,
+	0x85, 0xbd, 0x94, 0x7d, 0xd3, 0x90, 0x6f, 0x20, 0x57, 0xc3, 0x96, 0x67, 0x9d, 0x17, 0xfe, 0xbc,
+	0x3a, 0x22, 0x08, 0xeb, 0xba, 0xac, 0xc0, 0x88, 0x85, 0x98, 0xc9, 0x9a, 0x3a, 0xe6, 0x96, 0x67,
+	0xd5, 0x70, 0x2d, 0x9f, 0x82, 0xbc, 0x45, 0x1c, 0x43, 0x6c, 0xe6, 0xf8, 0x66, 0x9b, 0x20, 0x97,
+	0x61, 0xec, 0xf3, 0xa6, 0x6b, 0x52, 0xdd, 0xd4, 0x98, 0x49, 0x9c, 0xe2, 0x20, 0x97, 0xdc, 0x45,
+	0x93, 0x6f, 0x40, 0x21, 0x0c, 0x8d, 0x66, 0x21, 0x4a, 0x8b, 0x43, 0x73, 0x52, 0xa5, 0x50, 0x3f,
+	0x93, 0x96, 0x7e, 0x1f, 0x7c, 0xcd, 0xc3, 0xaa, 0xe3, 0x8d, 0xce, 0xa5, 0x3c, 0x03, 0xb0, 0x63,
+	0x11, 0xe2, 0x6e, 0x21, 0x17, 0xa3, 0xe2, 0x30, 0x0f, 0x73, 0x9e, 0x53, 0x56, 0x5c, 0x8c, 0xbc,
+	0xed, 0x16, 0x46, 0xee, 0xd6, 0x76, 0xd3, 0xb4, 0x58, 0x71, 0x64, 0x4e, 0xaa, 0x8c, 0xab, 0x79,
+	0x8f, 0xb2, 0xea, 0x11, 0xe4, 0x05, 0x98, 0xc0, 0x8e, 0xd6, 0xb4, 0xb7, 0x5d, 0xe4, 0x68, 0x98,
+	0x6e, 0xed, 0x22, 0xba, 0x5b, 0xcc, 0x73, 0x9b, 0x8f, 0x76, 0x6e, 0x5c, 0x47, 0x74, 0xb7, 0x3b,
+	0x63, 0xe5, 0x05, 0x98, 0x8a, 0x65, 0x22, 0xc8, 0x93, 0x5c, 0x80, 0x8c, 0xa9, 0xf3, 0x64, 0xe4,
+	0xd4, 0x8c, 0xa9, 0x97, 0x7f, 0x14, 0x79, 0xf3, 0xeb, 0xed, 0x55, 0xf2, 0x26, 0x24, 0x67, 0x02,
+	0xc9, 0xdd, 0x79, 0xcc, 0xf6, 0xc8, 0x63, 0xae, 0x57, 0x1e, 0x07, 0x0f, 0xca, 0xe3, 0x50, 0x5f,
+	0x79, 0x1c, 0x7e, 0x5d, 0x79, 0x1c, 0xe9, 0x9d, 0xc7, 0x7c, 0x5f, 0x79, 0x84, 0xbe, 0xf2, 0x38,
+	0x0d, 0x53, 0xb1, 0xcc, 0x84, 0xe7, 0x0d, 0xc3, 0x44, 0x78, 0x10, 0x5f, 0x67, 0xda, 0x12, 0x6d,
+	0xe8, 0x56, 0x13, 0xda, 0xf0, 0xbb, 0x04, 0x93, 0x1b, 0xd4, 0x50, 0xb1, 0x61, 0x52, 0x86, 0xdd,
+	0x15, 0xd1, 0xfe, 0xb1, 0xfb, 0xd2, 0x6d, 0x77, 0x1d, 0xf2, 0x28, 0x10, 0xe2, 0x77, 0xde, 0xb9,
+	0x94, 0x94, 0x85, 0xca, 0x3a, 0x9b, 0x6f, 0x9b, 0x7b, 0xf9, 0xdd, 0x78, 0xff, 0x3d, 0x97, 0xd6,
+	0x7f, 0x63, 0x0e, 0x94, 0x4b, 0x70, 0x2a, 0x89, 0x1e, 0x7a, 0xfe, 0x8b, 0x04, 0x32, 0x07, 0xec,
+	0x91, 0xbb, 0xf8, 0xd5, 0xfd, 0xae, 0xc3, 0x30, 0x12, 0x7b, 0xc5, 0xcc, 0x01, 0x5c, 0x01, 0x70,
+	0x79, 0x39, 0xee, 0xe0, 0xdb, 0xe9, 0x0e, 0x76, 0xd9, 0x59, 0x3e, 0x05, 0x4a, 0x9c, 0x1a, 0x3a,
+	0xf7, 0xaf, 0x70, 0x6e, 0xb3, 0xb9, 0x6d, 0x9b, 0x6c, 0x25, 0xb8, 0xd3, 0xb9, 0x73, 0x61, 0x72,
+	0x0e, 0x76, 0x2e, 0x0c, 0x4a, 0xe4, 0xbe, 0xc9, 0x44, 0xef, 0x1b, 0x79, 0x12, 0x06, 0xf7, 0x90,
+	0xd5, 0xc4, 0xfe, 0x55, 0x24, 0x16, 0xf2, 0x1a, 0x8c, 0xda, 0x98, 0xed, 0x12, 0x9d, 0x58, 0xc4,
+	0x68, 0xf1, 0x26, 0x51, 0xa8, 0x97, 0x53, 0xaa, 0x61, 0xa3, 0x8d, 0x54, 0x3b, 0xd9, 0xe4, 0xd3,
+	0x30, 0xae, 0x13, 0xad, 0x69, 0x63, 0x87, 0x89, 0xa3, 0xe7, 0xb7, 0xfd, 0x80, 0xc8, 0x8f, 0x5d,
+	0x41, 0x84, 0x32, 0x0c, 0xcf, 0x1d, 0x50, 0xe2, 0xfe, 0xa7, 0x75, 0x50, 0xcf, 0x7c, 0xad, 0xa5,
+	0x59, 0xd8, 0xf7, 0x4c, 0x2c, 0xbc, 0x26, 0xb6, 0x63, 0x3a, 0xc8, 0x32, 0xbf, 0xc4, 0xa2, 0xfb,
+	0x8d, 0xa8, 0x6d, 0x42, 0xf9, 0x37, 0x09, 0x8e, 0x79, 0x2a, 0x30, 0xf3, 0x6a, 0x8c, 0x38, 0xc8,
+	0x5a, 0x77, 0x74, 0xbc, 0xff, 0xd2, 0x05, 0x14, 0x6d, 0x8a, 0x99, 0x84, 0xa6, 0x78, 0x02, 0xfc,
+	0x4b, 0xdc, 0x6f, 0xc6, 0xfe, 0x6a, 0xf9, 0x6a, 0xbc, 0x90, 0x2a, 0x69, 0x85, 0x14, 0x35, 0xb8,
+	0x3c, 0x03, 0xd3, 0x09, 0xe4, 0xb0, 0x94, 0x1e, 0x4a, 0x70, 0x82, 0x57, 0x9a, 0x4d, 0xf6, 0xf0,
+	0xff, 0xe6, 0xea, 0xf2, 0x7b, 0x71, 0x97, 0x16, 0xd2, 0xcf, 0x46, 0xcc, 0xb6, 0xf2, 0x1c, 0x94,
+	0x92, 0x77, 0x42, 0xc7, 0x7e, 0x90, 0xf8, 0x20, 0xb4, 0x89, 0x59, 0xd0, 0x15, 0x37, 0x90, 0x83,
+	0x0c, 0xec, 0xbe, 0x8e, 0x91, 0x2c, 0x7e, 0x44, 0x8a, 0x30, 0x6c, 0x0b, 0xf9, 0x7e, 0xf2, 0x82,
+	0x65, 0xa4, 0x5d, 0xcf, 0xc2, 0x4c, 0xa2, 0x55, 0xa1, 0xdd, 0xdf, 0x67, 0xa1, 0x10, 0x0e, 0x07,
+	0x37, 0x31, 0xa2, 0xf8, 0xcd, 0x18, 0x3c, 0x0b, 0xa3, 0x0c, 0x3b, 0x28, 0x38, 0x75, 0xc2, 0x68,
+	0x10, 0x24, 0xef, 0xcc, 0xc9, 0xef, 0xc3, 0x20, 0x65, 0xc8, 0x65, 0xfc, 0x60, 0x8f, 0xd6, 0x95,
+	0xaa, 0x78, 0x73, 0x54, 0x83, 0x37, 0x47, 0xf5, 0x76, 0xf0, 0xe6, 0x58, 0x1d, 0xf7, 0x1a, 0xfc,
+	0xfd, 0xbf, 0x66, 0x25, 0xd1, 0xe4, 0x05, 0x9f, 0x7c, 0x15, 0xb2, 0xd8, 0xd1, 0x8b, 0x83, 0x87,
+	0x65, 0xf7, 0xb8, 0x64, 0x19, 0x72, 0x2e, 0x76, 0x18, 0x1f, 0x1e, 0x72, 0x2a, 0xff, 0x96, 0x3f,
+	0x05, 0xc0, 0x54, 0x43, 0xde, 0x18, 0x42, 0x1c, 0x3e, 0x30, 0xe4, 0x57, 0x2f, 0x7b, 0xbc, 0x7f,
+	0x3e, 0x9d, 0x9d, 0x16, 0xe1, 0xa0, 0xfa, 0xdd, 0xaa, 0x49, 0x6a, 0x36, 0x62, 0xbb, 0xd5, 0x9b,
+	0xd8, 0x40, 0x5a, 0x6b, 0x0d, 0x6b, 0x4f, 0x1e, 0x2e, 0x82, 0x1f, 0xad, 0x35, 0xac, 0x09, 0x25,
+	0x1d, 0x92, 0x3c, 0x5d, 0x1d, 0x93, 0x03, 0xff, 0x8e, 0x64, 0xad, 0x02, 0x27, 0xba, 0x73, 0x92,
+	0x3a, 0xad, 0x7d, 0x27, 0xf1, 0x6b, 0xff, 0x36, 0x76, 0x6d, 0xd3, 0x79, 0xb3, 0x19, 0x9c, 0x82,
+	0x11, 0xcb, 0x93, 0xde, 0x7e, 0x23, 0x0c, 0xf3, 0xf5, 0x7a, 0xf2, 0x88, 0xd0, 0x6d, 0x52, 0x58,
+	0x6f, 0xff, 0x64, 0xb8, 0xc1, 0x2a, 0x6e, 0x10, 0x97, 0x5d, 0x43, 0x74, 0xf7, 0x23, 0x8b, 0x7c,
+	0xf1, 0x66, 0x0c, 0xbe, 0x02, 0xb9, 0xbb, 0xa6, 0x23, 0x8c, 0x2d, 0xd4, 0x4f, 0xa7, 0xdc, 0x14,
+	0x81, 0x0d, 0x37, 0x4c, 0x47, 0x57, 0x39, 0x83, 0xd7, 0x18, 0x91, 0x4d, 0x9a, 0x8e, 0xa8, 0xc5,
+	0x9c, 0xea, 0xaf, 0xe4, 0x9b, 0x30, 0xd6, 0xc0, 0xae, 0x49, 0xf4, 0x2d, 0x51, 0xa9, 0x87, 0x2e,
+	0xb5, 0x51, 0xc1, 0xbe, 0xc9, 0xeb, 0xf5, 0x3a, 0x80, 0x2f, 0xcd, 0x2b, 0xdb, 0xa1, 0xc3, 0xca,
+	0xca, 0x0b, 0xe6, 0x0f, 0x9d, 0xee, 0xcc, 0x0c, 0xf7, 0xca, 0x8c, 0x78, 0x08, 0x74, 0xc7, 0x3e,
+	0xad, 0xb4, 0xea, 0x3f, 0x8d, 0x41, 0x76, 0x83, 0x1a, 0xf2, 0x0e, 0x8c, 0x75, 0x3d, 0xa1, 0xdf,
+	0x4a, 0xbb, 0x72, 0xbb, 0x1f, 0xa9, 0x4a, 0xb5, 0x3f, 0x5c, 0xa8, 0xff, 0x0e, 0x40, 0xc7, 0x43,
+	0xf6, 0x4c, 0x3a, 0x77, 0x1b, 0xa5, 0x9c, 0xef, 0x07, 0x15, 0x6a, 0xb0, 0xa0, 0x10, 0x79, 0x8e,
+	0x56, 0xd2, 0xf9, 0xbb, 0x91, 0xca, 0x85, 0x7e, 0x91, 0x9d, 0xda, 0x22, 0x8f, 0xa8, 0xca, 0x81,
+	0x11, 0xe9, 0x43, 0x5b, 0xf2, 0xf8, 0xef, 0x69, 0x8b, 0xcc, 0xfe, 0x95, 0x83, 0x62, 0xd3, 0x8f,
+	0xb6, 0xe4, 0x41, 0x5f, 0x6e, 0xc2, 0x44, 0x7c, 0xc8, 0x5f, 0x48, 0x17, 0x13, 0x03, 0x2b, 0x17,
+	0x0f, 0x01, 0x0e, 0xd5, 0x12, 0x38, 0x12, 0x9d, 0xb0, 0xcf, 0xf5, 0x92, 0xd3, 0x05, 0x55, 0x96,
+	0xfa, 0x86, 0x76, 0x2a, 0x8c, 0x4e, 0xbd, 0x3d, 0x14, 0x46, 0xa0, 0xca, 0x52, 0xdf, 0xd0, 0x50,
+	0xa1, 0x0b, 0x47, 0x63, 0x33, 0xe0, 0x7c, 0x0f, 0x31, 0x11, 0xac, 0x52, 0xef, 0x1f, 0x1b, 0xea,
+	0xfc, 0x0a, 0x8e, 0x25, 0xcd, 0x63, 0x8b, 0xbd, 0xc2, 0x15, 0x83, 0x2b, 0xef, 0x1c, 0x0a, 0x1e,
+	0x2a, 0xdf, 0x07, 0x39, 0x61, 0x66, 0x3a, 0xdf, 0xd3, 0x8d, 0x08, 0x5a, 0xb9, 0x74, 0x18, 0x74,
+	0xa8, 0x59, 0x83, 0xd1, 0xce, 0xa9, 0xe7, 0xec, 0x41, 0x07, 0x9c, 0xc3, 0x94, 0xc5, 0xbe, 0x60,
+	0x9d, 0xc7, 0x32, 0x72, 0x37, 0xf7, 0x38, 0x96, 0xdd, 0x48, 0xe5, 0x42, 0xbf, 0xc8, 0x4e, 0x6d,
+	0x91, 0x8b, 0xb5, 0xd2, 0x2b, 0x2b, 0x9d, 0x48, 0xe5, 0x42, 0xbf, 0xc8, 0x40, 0x9b, 0x32, 0x78,
+	0xcf, 0xbb, 0x88, 0x56, 0x2f, 0x3d, 0x7a, 0x56, 0x92, 0x1e, 0x3f, 0x2b, 0x49, 0x7f, 0x3f, 0x2b,
+	0x49, 0xf7, 0x9f, 0x97, 0x06, 0x1e, 0x3f, 0x2f, 0x0d, 0xfc, 0xf1, 0xbc, 0x34, 0xf0, 0x99, 0x92,
+	0x38, 0x62, 0xb3, 0x56, 0x03, 0xd3, 0xed, 0x21, 0x7e, 0xc3, 0x5d, 0xfc, 0x6f, 0x00, 0x83, 0x0b,
+	0xdc, 0x23, 0xef, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// DeleteRate deletes a rate published by the creator before appraisals.
+	DeleteRate(ctx context.Context, in *MsgDeleteRate, opts ...grpc.CallOption) (*MsgDeleteRateResponse, error)
+	// CreateProperty registers a property.
+	CreateProperty(ctx context.Context, in *MsgCreateProperty, opts ...grpc.CallOption) (*MsgCreatePropertyResponse, error)
+	// UpdateProperty updates a property registered by the creator.
+	UpdateProperty(ctx context.Context, in *MsgUpdateProperty, opts ...grpc.CallOption) (*MsgUpdatePropertyResponse, error)
+	// DeleteProperty deletes a property registered by the creator which has no
+	// valuation.
+	DeleteProperty(ctx context.Context, in *MsgDeleteProperty, opts ...grpc.CallOption) (*MsgDeletePropertyResponse, error)
+	// RegisterAppraiser defines a (governance) operation accrediting an
+	// appraiser.
+	RegisterAppraiser(ctx context.Context, in *MsgRegisterAppraiser, opts ...grpc.CallOption) (*MsgRegisterAppraiserResponse, error)
+	// RevokeAppraiser defines a (governance) operation revoking the
+	// accreditation of an appraiser.
+	RevokeAppraiser(ctx context.Context, in *MsgRevokeAppraiser, opts ...grpc.CallOption) (*MsgRevokeAppraiserResponse, error)
+	// SubmitAppraisal submits the appraisal of a property by an accredited
+	// appraiser in the open valuation cycle of the property.
+	SubmitAppraisal(ctx context.Context, in *MsgSubmitAppraisal, opts ...grpc.CallOption) (*MsgSubmitAppraisalResponse, error)
+	// SetRegionalIndex defines a (governance) operation mapping a jurisdiction
+	// to the oracle symbol of its regional index.
+	SetRegionalIndex(ctx context.Context, in *MsgSetRegionalIndex, opts ...grpc.CallOption) (*MsgSetRegionalIndexResponse, error)
+	// RemoveRegionalIndex defines a (governance) operation removing the
+	// regional index of a jurisdiction.
+	RemoveRegionalIndex(ctx context.Context, in *MsgRemoveRegionalIndex, opts ...grpc.CallOption) (*MsgRemoveRegionalIndexResponse, error)
+	// SetPropertyManager defines an operation of the owner of a property
+	// appointing or dismissing its manager.
+	SetPropertyManager(ctx context.Context, in *MsgSetPropertyManager, opts ...grpc.CallOption) (*MsgSetPropertyManagerResponse, error)
+	// CreateLease defines an operation of the owner or the manager of a
+	// property recording a lease of the property.
+	CreateLease(ctx context.Context, in *MsgCreateLease, opts ...grpc.CallOption) (*MsgCreateLeaseResponse, error)
+	// TerminateLease defines an operation of the owner or the manager of a
+	// property terminating a lease before the end of its term.
+	TerminateLease(ctx context.Context, in *MsgTerminateLease, opts ...grpc.CallOption) (*MsgTerminateLeaseResponse, error)
+	// ReportCashFlow defines an operation of the owner or the manager of a
+	// property reporting a rent receipt or an operating expense.
+	ReportCashFlow(ctx context.Context, in *MsgReportCashFlow, opts ...grpc.CallOption) (*MsgReportCashFlowResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteRate(ctx context.Context, in *MsgDeleteRate, opts ...grpc.CallOption) (*MsgDeleteRateResponse, error) {
+	out := new(MsgDeleteRateResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/DeleteRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateProperty(ctx context.Context, in *MsgCreateProperty, opts ...grpc.CallOption) (*MsgCreatePropertyResponse, error) {
+	out := new(MsgCreatePropertyResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/CreateProperty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateProperty(ctx context.Context, in *MsgUpdateProperty, opts ...grpc.CallOption) (*MsgUpdatePropertyResponse, error) {
+	out := new(MsgUpdatePropertyResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/UpdateProperty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteProperty(ctx context.Context, in *MsgDeleteProperty, opts ...grpc.CallOption) (*MsgDeletePropertyResponse, error) {
+	out := new(MsgDeletePropertyResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/DeleteProperty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterAppraiser(ctx context.Context, in *MsgRegisterAppraiser, opts ...grpc.CallOption) (*MsgRegisterAppraiserResponse, error) {
+	out := new(MsgRegisterAppraiserResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/RegisterAppraiser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeAppraiser(ctx context.Context, in *MsgRevokeAppraiser, opts ...grpc.CallOption) (*MsgRevokeAppraiserResponse, error) {
+	out := new(MsgRevokeAppraiserResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/RevokeAppraiser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitAppraisal(ctx context.Context, in *MsgSubmitAppraisal, opts ...grpc.CallOption) (*MsgSubmitAppraisalResponse, error) {
+	out := new(MsgSubmitAppraisalResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/SubmitAppraisal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRegionalIndex(ctx context.Context, in *MsgSetRegionalIndex, opts ...grpc.CallOption) (*MsgSetRegionalIndexResponse, error) {
+	out := new(MsgSetRegionalIndexResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/SetRegionalIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRegionalIndex(ctx context.Context, in *MsgRemoveRegionalIndex, opts ...grpc.CallOption) (*MsgRemoveRegionalIndexResponse, error) {
+	out := new(MsgRemoveRegionalIndexResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/RemoveRegionalIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPropertyManager(ctx context.Context, in *MsgSetPropertyManager, opts ...grpc.CallOption) (*MsgSetPropertyManagerResponse, error) {
+	out := new(MsgSetPropertyManagerResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/SetPropertyManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateLease(ctx context.Context, in *MsgCreateLease, opts ...grpc.CallOption) (*MsgCreateLeaseResponse, error) {
+	out := new(MsgCreateLeaseResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/CreateLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TerminateLease(ctx context.Context, in *MsgTerminateLease, opts ...grpc.CallOption) (*MsgTerminateLeaseResponse, error) {
+	out := new(MsgTerminateLeaseResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/TerminateLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportCashFlow(ctx context.Context, in *MsgReportCashFlow, opts ...grpc.CallOption) (*MsgReportCashFlowResponse, error) {
+	out := new(MsgReportCashFlowResponse)
+	err := c.cc.Invoke(ctx, "/realfin.realestate.v1.Msg/ReportCashFlow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// DeleteRate deletes a rate published by the creator before appraisals.
+	DeleteRate(context.Context, *MsgDeleteRate) (*MsgDeleteRateResponse, error)
+	// CreateProperty registers a property.
+	CreateProperty(context.Context, *MsgCreateProperty) (*MsgCreatePropertyResponse, error)
+	// UpdateProperty updates a property registered by the creator.
+	UpdateProperty(context.Context, *MsgUpdateProperty) (*MsgUpdatePropertyResponse, error)
+	// DeleteProperty deletes a property registered by the creator which has no
+	// valuation.
+	DeleteProperty(context.Context, *MsgDeleteProperty) (*MsgDeletePropertyResponse, error)
+	// RegisterAppraiser defines a (governance) operation accrediting an
+	// appraiser.
+	RegisterAppraiser(context.Context, *MsgRegisterAppraiser) (*MsgRegisterAppraiserResponse, error)
+	// RevokeAppraiser defines a (governance) operation revoking the
+	// accreditation of an appraiser.
+	RevokeAppraiser(context.Context, *MsgRevokeAppraiser) (*MsgRevokeAppraiserResponse, error)
+	// SubmitAppraisal submits the appraisal of a property by an accredited
+	// appraiser in the open valuation cycle of the property.
+	SubmitAppraisal(context.Context, *MsgSubmitAppraisal) (*MsgSubmitAppraisalResponse, error)
+	// SetRegionalIndex defines a (governance) operation mapping a jurisdiction
+	// to the oracle symbol of its regional index.
+	SetRegionalIndex(context.Context, *MsgSetRegionalIndex) (*MsgSetRegionalIndexResponse, error)
+	// RemoveRegionalIndex defines a (governance) operation removing the
+	// regional index of a jurisdiction.
+	RemoveRegionalIndex(context.Context, *MsgRemoveRegionalIndex) (*MsgRemoveRegionalIndexResponse, error)
+	// SetPropertyManager defines an operation of the owner of a property
+	// appointing or dismissing its manager.
+	SetPropertyManager(context.Context, *MsgSetPropertyManager) (*MsgSetPropertyManagerResponse, error)
+	// CreateLease defines an operation of the owner or the manager of a
+	// property recording a lease of the property.
+	CreateLease(context.Context, *MsgCreateLease) (*MsgCreateLeaseResponse, error)
+	// TerminateLease defines an operation of the owner or the manager of a
+	// property terminating a lease before the end of its term.
+	TerminateLease(context.Context, *MsgTerminateLease) (*MsgTerminateLeaseResponse, error)
+	// ReportCashFlow defines an operation of the owner or the manager of a
+	// property reporting a rent receipt or an operating expense.
+	ReportCashFlow(context.Context, *MsgReportCashFlow) (*MsgReportCashFlowResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRate(ctx context.Context, req *MsgDeleteRate) (*MsgDeleteRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRate not implemented")
+}
+func (*UnimplementedMsgServer) CreateProperty(ctx context.Context, req *MsgCreateProperty) (*MsgCreatePropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProperty not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProperty(ctx context.Context, req *MsgUpdateProperty) (*MsgUpdatePropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProperty not implemented")
+}
+func (*UnimplementedMsgServer) DeleteProperty(ctx context.Context, req *MsgDeleteProperty) (*MsgDeletePropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProperty not implemented")
+}
+func (*UnimplementedMsgServer) RegisterAppraiser(ctx context.Context, req *MsgRegisterAppraiser) (*MsgRegisterAppraiserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAppraiser not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAppraiser(ctx context.Context, req *MsgRevokeAppraiser) (*MsgRevokeAppraiserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAppraiser not implemented")
+}
+func (*UnimplementedMsgServer) SubmitAppraisal(ctx context.Context, req *MsgSubmitAppraisal) (*MsgSubmitAppraisalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAppraisal not implemented")
+}
+func (*UnimplementedMsgServer) SetRegionalIndex(ctx context.Context, req *MsgSetRegionalIndex) (*MsgSetRegionalIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRegionalIndex not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRegionalIndex(ctx context.Context, req *MsgRemoveRegionalIndex) (*MsgRemoveRegionalIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegionalIndex not implemented")
+}
+func (*UnimplementedMsgServer) SetPropertyManager(ctx context.Context, req *MsgSetPropertyManager) (*MsgSetPropertyManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPropertyManager not implemented")
+}
+func (*UnimplementedMsgServer) CreateLease(ctx context.Context, req *MsgCreateLease) (*MsgCreateLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLease not implemented")
+}
+func (*UnimplementedMsgServer) TerminateLease(ctx context.Context, req *MsgTerminateLease) (*MsgTerminateLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateLease not implemented")
+}
+func (*UnimplementedMsgServer) ReportCashFlow(ctx context.Context, req *MsgReportCashFlow) (*MsgReportCashFlowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportCashFlow not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/DeleteRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRate(ctx, req.(*MsgDeleteRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateProperty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/CreateProperty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateProperty(ctx, req.(*MsgCreateProperty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProperty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/UpdateProperty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProperty(ctx, req.(*MsgUpdateProperty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteProperty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/DeleteProperty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteProperty(ctx, req.(*MsgDeleteProperty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterAppraiser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAppraiser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAppraiser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/RegisterAppraiser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAppraiser(ctx, req.(*MsgRegisterAppraiser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAppraiser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAppraiser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAppraiser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/RevokeAppraiser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAppraiser(ctx, req.(*MsgRevokeAppraiser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitAppraisal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitAppraisal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitAppraisal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/SubmitAppraisal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitAppraisal(ctx, req.(*MsgSubmitAppraisal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRegionalIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRegionalIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRegionalIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/SetRegionalIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRegionalIndex(ctx, req.(*MsgSetRegionalIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRegionalIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRegionalIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRegionalIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/RemoveRegionalIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRegionalIndex(ctx, req.(*MsgRemoveRegionalIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPropertyManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPropertyManager)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPropertyManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/SetPropertyManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPropertyManager(ctx, req.(*MsgSetPropertyManager))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/CreateLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLease(ctx, req.(*MsgCreateLease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TerminateLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTerminateLease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TerminateLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/TerminateLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TerminateLease(ctx, req.(*MsgTerminateLease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportCashFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportCashFlow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportCashFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realfin.realestate.v1.Msg/ReportCashFlow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportCashFlow(ctx, req.(*MsgReportCashFlow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "realfin.realestate.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "DeleteRate",
+			Handler:    _Msg_DeleteRate_Handler,
+		},
+		{
+			MethodName: "CreateProperty",
+			Handler:    _Msg_CreateProperty_Handler,
+		},
+		{
+			MethodName: "UpdateProperty",
+			Handler:    _Msg_UpdateProperty_Handler,
+		},
+		{
+			MethodName: "DeleteProperty",
+			Handler:    _Msg_DeleteProperty_Handler,
+		},
+		{
+			MethodName: "RegisterAppraiser",
+			Handler:    _Msg_RegisterAppraiser_Handler,
+		},
+		{
+			MethodName: "RevokeAppraiser",
+			Handler:    _Msg_RevokeAppraiser_Handler,
+		},
+		{
+			MethodName: "SubmitAppraisal",
+			Handler:    _Msg_SubmitAppraisal_Handler,
+		},
+		{
+			MethodName: "SetRegionalIndex",
+			Handler:    _Msg_SetRegionalIndex_Handler,
+		},
+		{
+			MethodName: "RemoveRegionalIndex",
+			Handler:    _Msg_RemoveRegionalIndex_Handler,
+		},
+		{
+			MethodName: "SetPropertyManager",
+			Handler:    _Msg_SetPropertyManager_Handler,
+		},
+		{
+			MethodName: "CreateLease",
+			Handler:    _Msg_CreateLease_Handler,
+		},
+		{
+			MethodName: "TerminateLease",
+			Handler:    _Msg_TerminateLease_Handler,
+		},
+		{
+			MethodName: "ReportCashFlow",
+			Handler:    _Msg_ReportCashFlow_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "realfin/realestate/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PropertyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateProperty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateProperty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateProperty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncumbrancesHash) > 0 {
+		i -= len(m.EncumbrancesHash)
+		copy(dAtA[i:], m.EncumbrancesHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EncumbrancesHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.YearBuilt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.YearBuilt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FloorArea != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FloorArea))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PropertyClass != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PropertyClass))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Longitude != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Longitude))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Latitude != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Latitude))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ParcelId) > 0 {
+		i -= len(m.ParcelId)
+		copy(dAtA[i:], m.ParcelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParcelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePropertyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePropertyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePropertyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProperty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProperty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProperty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncumbrancesHash) > 0 {
+		i -= len(m.EncumbrancesHash)
+		copy(dAtA[i:], m.EncumbrancesHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EncumbrancesHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.YearBuilt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.YearBuilt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FloorArea != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FloorArea))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PropertyClass != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PropertyClass))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Longitude != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Longitude))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Latitude != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Latitude))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ParcelId) > 0 {
+		i -= len(m.ParcelId)
+		copy(dAtA[i:], m.ParcelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParcelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePropertyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePropertyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePropertyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteProperty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteProperty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteProperty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeletePropertyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeletePropertyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeletePropertyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAppraiser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAppraiser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAppraiser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Appraiser.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAppraiserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAppraiserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAppraiserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAppraiser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAppraiser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAppraiser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAppraiserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAppraiserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAppraiserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAppraisal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAppraisal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAppraisal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Methodology != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Methodology))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Value != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Appraiser) > 0 {
+		i -= len(m.Appraiser)
+		copy(dAtA[i:], m.Appraiser)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appraiser)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAppraisalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAppraisalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAppraisalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Cycle != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cycle))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRegionalIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRegionalIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRegionalIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRegionalIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRegionalIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRegionalIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRegionalIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRegionalIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRegionalIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRegionalIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRegionalIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRegionalIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPropertyManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPropertyManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPropertyManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPropertyManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPropertyManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPropertyManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Area != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Area))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Escalation.Size()
+		i -= size
+		if _, err := m.Escalation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Rent != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Rent))
+		i--
+		dAtA[i] = 0x30
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TenantHash) > 0 {
+		i -= len(m.TenantHash)
+		copy(dAtA[i:], m.TenantHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TenantHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTerminateLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTerminateLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTerminateLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LeaseId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LeaseId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTerminateLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTerminateLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTerminateLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportCashFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportCashFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportCashFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LeaseId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LeaseId))
+		i--
+		dAtA[i] = 0x38
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodEnd):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Kind != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PropertyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PropertyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportCashFlowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportCashFlowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportCashFlowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovTx(uint64(m.PropertyId))
+	}
+	return n
+}
+
+func (m *MsgDeleteRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateProperty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ParcelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Latitude != 0 {
+		n += 1 + sovTx(uint64(m.Latitude))
+	}
+	if m.Longitude != 0 {
+		n += 1 + sovTx(uint64(m.Longitude))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PropertyClass != 0 {
+		n += 1 + sovTx(uint64(m.PropertyClass))
+	}
+	if m.FloorArea != 0 {
+		n += 1 + sovTx(uint64(m.FloorArea))
+	}
+	if m.YearBuilt != 0 {
+		n += 1 + sovTx(uint64(m.YearBuilt))
+	}
+	l = len(m.EncumbrancesHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreatePropertyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateProperty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.ParcelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Latitude != 0 {
+		n += 1 + sovTx(uint64(m.Latitude))
+	}
+	if m.Longitude != 0 {
+		n += 1 + sovTx(uint64(m.Longitude))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PropertyClass != 0 {
+		n += 1 + sovTx(uint64(m.PropertyClass))
+	}
+	if m.FloorArea != 0 {
+		n += 1 + sovTx(uint64(m.FloorArea))
+	}
+	if m.YearBuilt != 0 {
+		n += 1 + sovTx(uint64(m.YearBuilt))
+	}
+	l = len(m.EncumbrancesHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePropertyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteProperty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeletePropertyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterAppraiser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Appraiser.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterAppraiserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAppraiser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAppraiserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitAppraisal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Appraiser)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovTx(uint64(m.PropertyId))
+	}
+	if m.Value != 0 {
+		n += 1 + sovTx(uint64(m.Value))
+	}
+	if m.Methodology != 0 {
+		n += 1 + sovTx(uint64(m.Methodology))
+	}
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitAppraisalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Cycle != 0 {
+		n += 1 + sovTx(uint64(m.Cycle))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetRegionalIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRegionalIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRegionalIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRegionalIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPropertyManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovTx(uint64(m.PropertyId))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPropertyManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovTx(uint64(m.PropertyId))
+	}
+	l = len(m.TenantHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Rent != 0 {
+		n += 1 + sovTx(uint64(m.Rent))
+	}
+	l = m.Escalation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Area != 0 {
+		n += 1 + sovTx(uint64(m.Area))
+	}
+	return n
+}
+
+func (m *MsgCreateLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgTerminateLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovTx(uint64(m.PropertyId))
+	}
+	if m.LeaseId != 0 {
+		n += 1 + sovTx(uint64(m.LeaseId))
+	}
+	return n
+}
+
+func (m *MsgTerminateLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportCashFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PropertyId != 0 {
+		n += 1 + sovTx(uint64(m.PropertyId))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovTx(uint64(m.Kind))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodEnd)
+	n += 1 + l + sovTx(uint64(l))
+	if m.LeaseId != 0 {
+		n += 1 + sovTx(uint64(m.LeaseId))
+	}
+	return n
+}
+
+func (m *MsgReportCashFlowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateProperty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateProperty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateProperty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParcelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParcelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
+			}
+			m.Latitude = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Latitude |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
+			}
+			m.Longitude = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Longitude |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyClass", wireType)
+			}
+			m.PropertyClass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyClass |= PropertyClass(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorArea", wireType)
+			}
+			m.FloorArea = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FloorArea |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YearBuilt", wireType)
+			}
+			m.YearBuilt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YearBuilt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncumbrancesHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncumbrancesHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePropertyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePropertyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePropertyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProperty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProperty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProperty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParcelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParcelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
+			}
+			m.Latitude = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Latitude |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
+			}
+			m.Longitude = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Longitude |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyClass", wireType)
+			}
+			m.PropertyClass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyClass |= PropertyClass(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorArea", wireType)
+			}
+			m.FloorArea = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FloorArea |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YearBuilt", wireType)
+			}
+			m.YearBuilt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YearBuilt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncumbrancesHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncumbrancesHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePropertyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePropertyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePropertyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteProperty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteProperty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteProperty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeletePropertyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeletePropertyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeletePropertyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAppraiser) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAppraiser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAppraiser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appraiser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Appraiser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAppraiserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAppraiserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAppraiserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAppraiser) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAppraiser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAppraiser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAppraiserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAppraiserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAppraiserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitAppraisal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitAppraisal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitAppraisal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appraiser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appraiser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methodology", wireType)
+			}
+			m.Methodology = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Methodology |= Methodology(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitAppraisalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitAppraisalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitAppraisalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cycle", wireType)
+			}
+			m.Cycle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cycle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRegionalIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRegionalIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRegionalIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRegionalIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRegionalIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRegionalIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRegionalIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRegionalIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRegionalIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgDeleteRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRemoveRegionalIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRegionalIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRegionalIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDeleteRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPropertyManager) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1135,15 +6036,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPropertyManager: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPropertyManager: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1171,13 +6072,32 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1187,24 +6107,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1227,7 +6146,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPropertyManagerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1250,10 +6169,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPropertyManagerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPropertyManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1277,7 +6196,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRate) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateLease) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1300,10 +6219,10 @@ func (m *MsgCreateRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateLease: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateLease: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1339,10 +6258,94 @@ func (m *MsgCreateRate) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1352,29 +6355,30 @@ func (m *MsgCreateRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rent", wireType)
 			}
-			m.Rate = 0
+			m.Rent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1384,14 +6388,14 @@ func (m *MsgCreateRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rate |= uint64(b&0x7F) << shift
+				m.Rent |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escalation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1419,13 +6423,15 @@ func (m *MsgCreateRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Escalation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Area", wireType)
 			}
-			var stringLen uint64
+			m.Area = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1435,24 +6441,11 @@ func (m *MsgCreateRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Area |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1474,7 +6467,7 @@ func (m *MsgCreateRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateLeaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1497,12 +6490,31 @@ func (m *MsgCreateRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateLeaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1524,7 +6536,7 @@ func (m *MsgCreateRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRate) Unmarshal(dAtA []byte) error {
+func (m *MsgTerminateLease) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1547,10 +6559,10 @@ func (m *MsgUpdateRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTerminateLease: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTerminateLease: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1586,10 +6598,10 @@ func (m *MsgUpdateRate) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
 			}
-			var stringLen uint64
+			m.PropertyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1599,80 +6611,16 @@ func (m *MsgUpdateRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PropertyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
-			}
-			m.Rate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Rate |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseId", wireType)
 			}
-			var stringLen uint64
+			m.LeaseId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1682,24 +6630,11 @@ func (m *MsgUpdateRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LeaseId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1721,7 +6656,7 @@ func (m *MsgUpdateRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTerminateLeaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1744,10 +6679,10 @@ func (m *MsgUpdateRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTerminateLeaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTerminateLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1771,7 +6706,7 @@ func (m *MsgUpdateRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRate) Unmarshal(dAtA []byte) error {
+func (m *MsgReportCashFlow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1794,10 +6729,10 @@ func (m *MsgDeleteRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportCashFlow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportCashFlow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1833,10 +6768,67 @@ func (m *MsgDeleteRate) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			m.PropertyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PropertyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= CashFlowKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1846,24 +6838,77 @@ func (m *MsgDeleteRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseId", wireType)
+			}
+			m.LeaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1885,7 +6930,7 @@ func (m *MsgDeleteRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportCashFlowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1908,12 +6953,31 @@ func (m *MsgDeleteRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportCashFlowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportCashFlowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"realfin/x/tokenization/types"
+)
+
+// CreatorAssets returns the assets tokenized by the creator, in symbol
+// order.
+func (k Keeper) CreatorAssets(ctx context.Context, creator string) ([]types.Asset, error) {
+	var assets []types.Asset
+	err := k.Asset.Walk(ctx, nil, func(_ string, asset types.Asset) (bool, error) {
+		if asset.Creator == creator {
+			assets = append(assets, asset)
+		}
+		return false, nil
+	})
+
+	return assets, err
+}
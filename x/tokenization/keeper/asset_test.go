@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"realfin/x/tokenization/types"
+)
+
+func TestCreatorAssets(t *testing.T) {
+	f := initFixture(t)
+
+	for _, asset := range []types.Asset{
+		{Symbol: "B", Creator: "alice"},
+		{Symbol: "A", Creator: "alice"},
+		{Symbol: "C", Creator: "bob"},
+	} {
+		require.NoError(t, f.keeper.Asset.Set(f.ctx, asset.Symbol, asset))
+	}
+
+	assets, err := f.keeper.CreatorAssets(f.ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, []types.Asset{{Symbol: "A", Creator: "alice"}, {Symbol: "B", Creator: "alice"}}, assets)
+
+	assets, err = f.keeper.CreatorAssets(f.ctx, "carol")
+	require.NoError(t, err)
+	require.Empty(t, assets)
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "realfin/x/oracle/types"
+	"realfin/x/tokenization/types"
+)
+
+var _ oracletypes.OracleHooks = OracleHooks{}
+
+// OracleHooks re-values the tokenized assets when their oracle price changes.
+type OracleHooks struct {
+	k Keeper
+}
+
+// OracleHooks returns the oracle hooks of the module.
+func (k Keeper) OracleHooks() OracleHooks {
+	return OracleHooks{k: k}
+}
+
+// AfterPriceUpdated emits an asset_revalued event when the rate of the price
+// of a tokenized asset changes.
+func (h OracleHooks) AfterPriceUpdated(ctx context.Context, symbol string, oldPrice, newPrice oracletypes.Price) error {
+	found, err := h.k.Asset.Has(ctx, symbol)
+	if err != nil || !found {
+		return err
+	}
+	if oldPrice.Rate == newPrice.Rate {
+		return nil
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAssetRevalued,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyOldRate, strconv.FormatUint(oldPrice.Rate, 10)),
+		sdk.NewAttribute(types.AttributeKeyNewRate, strconv.FormatUint(newPrice.Rate, 10)),
+		sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(uint64(newPrice.Decimals), 10)),
+		sdk.NewAttribute(types.AttributeKeyQuote, newPrice.Quote),
+	))
+
+	return nil
+}
+
+// AfterPriceDeleted emits an asset_unpriced event when the price of a
+// tokenized asset is deleted.
+func (h OracleHooks) AfterPriceDeleted(ctx context.Context, symbol string) error {
+	found, err := h.k.Asset.Has(ctx, symbol)
+	if err != nil || !found {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAssetUnpriced,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+	))
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	oracletypes "realfin/x/oracle/types"
+	"realfin/x/tokenization/types"
+)
+
+func TestOracleHooks(t *testing.T) {
+	f := initFixture(t)
+	hooks := f.keeper.OracleHooks()
+	require.NoError(t, f.keeper.Asset.Set(f.ctx, "VILLA", types.Asset{Symbol: "VILLA"}))
+
+	events := func(run func(ctx sdk.Context)) sdk.Events {
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+		run(ctx)
+		return ctx.EventManager().Events()
+	}
+
+	old := oracletypes.Price{Symbol: "VILLA", Rate: 100, Decimals: 2, Quote: "USD"}
+	updated := oracletypes.Price{Symbol: "VILLA", Rate: 120, Decimals: 2, Quote: "USD"}
+	emitted := events(func(ctx sdk.Context) {
+		require.NoError(t, hooks.AfterPriceUpdated(ctx, "VILLA", old, updated))
+	})
+	require.Len(t, emitted, 1)
+	require.Equal(t, types.EventTypeAssetRevalued, emitted[0].Type)
+	value, ok := emitted[0].GetAttribute(types.AttributeKeyNewRate)
+	require.True(t, ok)
+	require.Equal(t, "120", value.Value)
+
+	// unchanged rates and prices of other symbols are ignored
+	require.Empty(t, events(func(ctx sdk.Context) {
+		require.NoError(t, hooks.AfterPriceUpdated(ctx, "VILLA", updated, updated))
+		require.NoError(t, hooks.AfterPriceUpdated(ctx, "ETH", old, updated))
+		require.NoError(t, hooks.AfterPriceDeleted(ctx, "ETH"))
+	}))
+
+	emitted = events(func(ctx sdk.Context) {
+		require.NoError(t, hooks.AfterPriceDeleted(ctx, "VILLA"))
+	})
+	require.Len(t, emitted, 1)
+	require.Equal(t, types.EventTypeAssetUnpriced, emitted[0].Type)
+}
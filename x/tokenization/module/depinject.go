@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	oracletypes "realfin/x/oracle/types"
 	"realfin/x/tokenization/keeper"
 	"realfin/x/tokenization/types"
 )
@@ -42,6 +43,7 @@ type ModuleOutputs struct {
 
 	TokenizationKeeper keeper.Keeper
 	Module             appmodule.AppModule
+	OracleHooks        oracletypes.OracleHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -58,5 +60,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{TokenizationKeeper: k, Module: m}
+	return ModuleOutputs{
+		TokenizationKeeper: k,
+		Module:             m,
+		OracleHooks:        oracletypes.OracleHooksWrapper{OracleHooks: k.OracleHooks()},
+	}
 }
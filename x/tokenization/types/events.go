@@ -0,0 +1,13 @@
+package types
+
+// tokenization module event types
+const (
+	EventTypeAssetRevalued = "asset_revalued"
+	EventTypeAssetUnpriced = "asset_unpriced"
+
+	AttributeKeySymbol   = "symbol"
+	AttributeKeyOldRate  = "old_rate"
+	AttributeKeyNewRate  = "new_rate"
+	AttributeKeyDecimals = "decimals"
+	AttributeKeyQuote    = "quote"
+)